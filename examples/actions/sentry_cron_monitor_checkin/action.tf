@@ -0,0 +1,23 @@
+# Send a check-in after the job's infrastructure is deployed to prove that the
+# monitor is wired up.
+action "sentry_cron_monitor_checkin" "smoke_test" {
+  config {
+    organization = sentry_cron_monitor.default.organization
+    monitor_id   = sentry_cron_monitor.default.id
+    dsn          = sentry_key.default.dsn["public"]
+    status       = "ok"
+    duration     = 1.5
+    environment  = "production"
+  }
+}
+
+resource "terraform_data" "deploy" {
+  input = sentry_cron_monitor.default.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.sentry_cron_monitor_checkin.smoke_test]
+    }
+  }
+}
@@ -0,0 +1,12 @@
+# Retrieve all organization auth tokens
+data "sentry_all_organization_auth_tokens" "default" {
+  organization = "my-organization"
+}
+
+# List tokens that have never been used
+output "unused_tokens" {
+  value = [
+    for token in data.sentry_all_organization_auth_tokens.default.tokens : token.name
+    if token.date_last_used == null
+  ]
+}
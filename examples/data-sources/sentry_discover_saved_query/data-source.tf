@@ -0,0 +1,11 @@
+# Retrieve a saved query by ID
+data "sentry_discover_saved_query" "by_id" {
+  organization = "my-organization"
+  id           = "1"
+}
+
+# Retrieve a saved query by name
+data "sentry_discover_saved_query" "by_name" {
+  organization = "my-organization"
+  name         = "Backend errors"
+}
@@ -0,0 +1,6 @@
+# Retrieve the hidden environments of a project
+data "sentry_project_environments" "hidden" {
+  organization = "my-organization"
+  project      = "web-app"
+  visibility   = "hidden"
+}
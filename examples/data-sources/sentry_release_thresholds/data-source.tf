@@ -0,0 +1,6 @@
+# Retrieve the release thresholds of the production environment
+data "sentry_release_thresholds" "production" {
+  organization = "my-organization"
+  project      = "web-app"
+  environment  = "production"
+}
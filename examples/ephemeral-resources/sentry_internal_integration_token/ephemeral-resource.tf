@@ -0,0 +1,6 @@
+# Create a short-lived token for the duration of a Terraform run, e.g. to
+# configure another provider. The token is revoked once Terraform no longer
+# needs it.
+ephemeral "sentry_internal_integration_token" "default" {
+  integration = sentry_internal_integration.default.slug
+}
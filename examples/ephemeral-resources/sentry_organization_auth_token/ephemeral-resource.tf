@@ -0,0 +1,7 @@
+# Create a short-lived organization auth token for the duration of a Terraform
+# run, e.g. to upload source maps. The token is revoked once Terraform no
+# longer needs it.
+ephemeral "sentry_organization_auth_token" "default" {
+  organization = "my-organization"
+  name         = "Terraform run"
+}
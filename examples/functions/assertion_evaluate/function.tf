@@ -0,0 +1,31 @@
+locals {
+  assertion = provider::sentry::assertion(
+    provider::sentry::op_and(
+      provider::sentry::op_status_code_check("equals", 200),
+      provider::sentry::op_jsonpath(
+        provider::sentry::op_jsonpath_operand_literal("ok"),
+        "equals",
+        "$.status",
+      ),
+    ),
+  )
+}
+
+output "result" {
+  value = provider::sentry::assertion_evaluate(local.assertion, {
+    status_code = 200
+    headers     = { "Content-Type" = "application/json" }
+    body        = jsonencode({ status = "ok" })
+  })
+}
+
+# In a `terraform test` file:
+#
+# run "healthy_response_passes" {
+#   command = plan
+#
+#   assert {
+#     condition     = output.result.passed
+#     error_message = output.result.explanation
+#   }
+# }
@@ -0,0 +1,3 @@
+output "nightly_runs" {
+  value = provider::sentry::cron_next_runs(sentry_cron_monitor.nightly.schedule.crontab, sentry_cron_monitor.nightly.timezone, 5, plantimestamp())
+}
@@ -0,0 +1,2 @@
+# import using the organization slug, alert ID and monitor ID:
+terraform import sentry_alert_monitor_link.default org-slug/alert-id/monitor-id
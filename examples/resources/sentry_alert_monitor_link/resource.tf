@@ -0,0 +1,26 @@
+# Owned by the platform team: an alert without `monitor_ids`
+resource "sentry_alert" "default" {
+  organization      = "my-organization"
+  name              = "Page the on-call engineer"
+  frequency_minutes = 60
+
+  trigger_conditions = [
+    { first_seen_event = {} },
+  ]
+
+  action_filters = [
+    {
+      logic_type = "all"
+      actions = [
+        # ...
+      ]
+    }
+  ]
+}
+
+# Owned by a product team: connect their monitor to the shared alert
+resource "sentry_alert_monitor_link" "checkout" {
+  organization = sentry_alert.default.organization
+  alert_id     = sentry_alert.default.id
+  monitor_id   = sentry_uptime_monitor.checkout.id
+}
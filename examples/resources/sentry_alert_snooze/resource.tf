@@ -0,0 +1,21 @@
+# Snooze an issue alert for everyone during planned maintenance
+resource "sentry_alert_snooze" "maintenance" {
+  organization   = sentry_issue_alert.main.organization
+  project        = sentry_issue_alert.main.project
+  issue_alert_id = sentry_issue_alert.main.id
+  until          = "2025-01-01T06:00:00Z"
+}
+
+# Snooze an alert indefinitely. Alerts are snoozed by disabling them.
+resource "sentry_alert_snooze" "noisy" {
+  organization = sentry_alert.main.organization
+  alert_id     = sentry_alert.main.id
+}
+
+resource "sentry_alert" "main" {
+  # ...
+
+  lifecycle {
+    ignore_changes = [enabled]
+  }
+}
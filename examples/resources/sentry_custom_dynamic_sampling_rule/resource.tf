@@ -0,0 +1,16 @@
+resource "sentry_project" "default" {
+  organization = "my-organization"
+
+  teams = ["my-first-team", "my-second-team"]
+  name  = "web-app"
+
+  platform = "javascript"
+}
+
+# Collect more samples of the checkout transaction for the next day
+resource "sentry_custom_dynamic_sampling_rule" "default" {
+  organization = "my-organization"
+  query        = "event.type:transaction transaction:/checkout"
+  projects     = [sentry_project.default.internal_id]
+  period       = "1d"
+}
@@ -0,0 +1,6 @@
+# import using the organization slug and data forwarder ID:
+terraform import sentry_data_forwarder.default org-slug/data-forwarder-id
+
+# import a forwarder configured through the legacy plugins, using the
+# organization slug, project ID and plugin ID (amazon-sqs, segment or splunk):
+terraform import sentry_data_forwarder.default org-slug/project-id/splunk
@@ -0,0 +1,39 @@
+# Forward events to Amazon SQS
+resource "sentry_data_forwarder" "sqs" {
+  organization = data.sentry_organization.main.slug
+  projects     = [sentry_project.main.internal_id]
+
+  sqs = {
+    queue_url     = "https://sqs.us-east-1.amazonaws.com/123456789012/sentry-events"
+    region        = "us-east-1"
+    access_key    = var.aws_access_key_id
+    secret_key_wo = var.aws_secret_access_key
+  }
+
+  # Increment to send a rotated secret key
+  secrets_wo_version = 1
+}
+
+# Forward events to Segment
+resource "sentry_data_forwarder" "segment" {
+  organization        = data.sentry_organization.main.slug
+  projects            = [sentry_project.main.internal_id]
+  enroll_new_projects = true
+
+  segment = {
+    write_key = var.segment_write_key
+  }
+}
+
+# Forward events to Splunk
+resource "sentry_data_forwarder" "splunk" {
+  organization = data.sentry_organization.main.slug
+  projects     = [sentry_project.main.internal_id]
+
+  splunk = {
+    instance_url = "https://splunk.example.com:8088"
+    index        = "main"
+    source       = "sentry"
+    token        = var.splunk_token
+  }
+}
@@ -0,0 +1,3 @@
+# import using the organization slug and saved query id from the URL:
+# https://sentry.io/organizations/[org-slug]/discover/results/?id=[query-id]
+terraform import sentry_discover_saved_query.default org-slug/query-id
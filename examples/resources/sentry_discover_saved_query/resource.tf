@@ -0,0 +1,40 @@
+data "sentry_project" "backend" {
+  organization = "my-organization"
+  slug         = "backend"
+}
+
+# Error budget of a service
+resource "sentry_discover_saved_query" "errors" {
+  organization = "my-organization"
+  name         = "Backend errors"
+  dataset      = "error-events"
+
+  fields   = ["title", "count()", "count_unique(user)"]
+  y_axis   = ["count()"]
+  query    = "level:error OR level:fatal"
+  order_by = "-count()"
+
+  projects     = [data.sentry_project.backend.internal_id]
+  environments = ["production"]
+
+  range    = "7d"
+  interval = "1h"
+  display  = "top5"
+
+  top_events = 5
+}
+
+# Slow endpoints
+resource "sentry_discover_saved_query" "slow_endpoints" {
+  organization = "my-organization"
+  name         = "Slow endpoints"
+  dataset      = "transaction-like"
+
+  fields   = ["transaction", "p95(transaction.duration)", "count()"]
+  y_axis   = ["p95(transaction.duration)"]
+  query    = "event.type:transaction"
+  order_by = "-p95(transaction.duration)"
+
+  projects = [data.sentry_project.backend.internal_id]
+  range    = "14d"
+}
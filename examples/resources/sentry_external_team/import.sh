@@ -0,0 +1,2 @@
+# import using the organization and team slugs, and the external team ID:
+terraform import sentry_external_team.default org-slug/team-slug/external-team-id
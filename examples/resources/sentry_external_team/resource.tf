@@ -0,0 +1,19 @@
+data "sentry_organization_integration" "github" {
+  organization = "my-organization"
+  provider_key = "github"
+  name         = "my-github-organization"
+}
+
+resource "sentry_team" "default" {
+  organization = "my-organization"
+  name         = "Backend"
+  slug         = "backend"
+}
+
+resource "sentry_external_team" "default" {
+  organization   = "my-organization"
+  team           = sentry_team.default.slug
+  integration_id = data.sentry_organization_integration.github.id
+  provider_key   = "github"
+  external_name  = "@my-github-organization/backend"
+}
@@ -0,0 +1,19 @@
+data "sentry_organization_integration" "github" {
+  organization = "my-organization"
+  provider_key = "github"
+  name         = "my-github-organization"
+}
+
+resource "sentry_organization_member" "john_doe" {
+  organization = "my-organization"
+  email        = "test@example.com"
+  role         = "member"
+}
+
+resource "sentry_external_user" "john_doe" {
+  organization   = "my-organization"
+  member_id      = sentry_organization_member.john_doe.id
+  integration_id = data.sentry_organization_integration.github.id
+  provider_key   = "github"
+  external_name  = "@johndoe"
+}
@@ -0,0 +1,3 @@
+# import using the organization slug and integration slug from the URL:
+# https://sentry.io/settings/[org-slug]/developer-settings/[integration-slug]/
+terraform import sentry_internal_integration.default org-slug/integration-slug
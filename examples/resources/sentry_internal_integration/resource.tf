@@ -0,0 +1,35 @@
+# Create an internal integration that receives issue webhooks and can be
+# used as an alert action
+resource "sentry_internal_integration" "default" {
+  organization = "my-organization"
+  name         = "My Integration"
+  overview     = "Creates tickets in our internal tracker."
+
+  webhook_url = "https://example.com/sentry/webhook"
+  events      = ["issue", "error"]
+  alertable   = true
+
+  scopes = ["project:read", "event:read", "event:write"]
+
+  allowed_origins = ["https://example.com"]
+
+  schema = jsonencode({
+    elements = [
+      {
+        type  = "alert-rule-action"
+        title = "Create a ticket"
+        settings = {
+          type = "alert-rule-settings"
+          uri  = "/sentry/alert-rule-action/"
+          required_fields = [
+            {
+              type  = "text"
+              label = "Title"
+              name  = "title"
+            },
+          ]
+        }
+      },
+    ]
+  })
+}
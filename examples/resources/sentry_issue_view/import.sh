@@ -0,0 +1,3 @@
+# import using the organization slug and issue view id from the URL:
+# https://sentry.io/organizations/[org-slug]/issues/views/[view-id]/
+terraform import sentry_issue_view.default org-slug/view-id
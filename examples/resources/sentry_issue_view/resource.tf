@@ -0,0 +1,20 @@
+data "sentry_project" "backend" {
+  organization = "my-organization"
+  slug         = "backend"
+}
+
+resource "sentry_issue_view" "backend_production" {
+  organization = "my-organization"
+  name         = "Backend production"
+  query        = "is:unresolved issue.priority:[high, medium]"
+  query_sort   = "freq"
+
+  projects     = [data.sentry_project.backend.internal_id]
+  environments = ["production"]
+
+  time_filters = {
+    period = "7d"
+  }
+
+  starred = true
+}
@@ -0,0 +1,22 @@
+# Mute a cron monitor in the staging environment during planned maintenance
+resource "sentry_monitor_mute" "staging" {
+  organization = sentry_cron_monitor.nightly.organization
+  monitor_id   = sentry_cron_monitor.nightly.id
+  environment  = "staging"
+  until        = "2025-01-01T06:00:00Z"
+}
+
+# Mute an uptime monitor indefinitely. Monitors other than cron monitors are
+# muted by disabling them.
+resource "sentry_monitor_mute" "checkout" {
+  organization = sentry_uptime_monitor.checkout.organization
+  monitor_id   = sentry_uptime_monitor.checkout.id
+}
+
+resource "sentry_uptime_monitor" "checkout" {
+  # ...
+
+  lifecycle {
+    ignore_changes = [enabled]
+  }
+}
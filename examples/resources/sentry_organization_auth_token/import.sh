@@ -0,0 +1,4 @@
+# import using the organization slug and token id from the URL:
+# https://sentry.io/settings/[org-slug]/auth-tokens/[token-id]/
+# The token value cannot be retrieved after import.
+terraform import sentry_organization_auth_token.ci org-slug/token-id
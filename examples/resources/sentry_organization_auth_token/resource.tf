@@ -0,0 +1,6 @@
+# Create an organization auth token for uploading source maps from CI. The
+# token value is not stored in the state.
+resource "sentry_organization_auth_token" "ci" {
+  organization = "my-organization"
+  name         = "GitHub Actions"
+}
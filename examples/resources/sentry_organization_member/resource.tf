@@ -5,3 +5,25 @@ resource "sentry_organization_member" "john_doe" {
   email = "test@example.com"
   role  = "member"
 }
+
+# Create an organization member and manage their team memberships
+resource "sentry_organization_member" "jane_doe" {
+  organization = "my-organization"
+
+  email = "jane@example.com"
+  role  = "member"
+
+  # Teams joined with the default team role
+  teams = ["backend"]
+
+  # Teams joined with an explicit team role
+  team_roles = [
+    {
+      team = "frontend"
+      role = "admin"
+    },
+  ]
+
+  # Resend the invitation if it has expired
+  reinvite = true
+}
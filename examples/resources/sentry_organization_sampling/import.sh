@@ -0,0 +1,3 @@
+# import using the organization slug from the URL:
+# https://sentry.io/settings/[org-slug]/dynamic-sampling/
+terraform import sentry_organization_sampling.default org-slug
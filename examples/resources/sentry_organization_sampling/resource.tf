@@ -0,0 +1,6 @@
+# Sample 25% of the organization's spans
+resource "sentry_organization_sampling" "default" {
+  organization       = "my-organization"
+  sampling_mode      = "organization"
+  target_sample_rate = 0.25
+}
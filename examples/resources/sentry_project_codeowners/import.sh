@@ -0,0 +1,2 @@
+# import using the organization slug, project slug and CODEOWNERS ID:
+terraform import sentry_project_codeowners.default org-slug/project-slug/codeowners-id
@@ -0,0 +1,29 @@
+resource "sentry_organization_code_mapping" "default" {
+  organization   = "my-organization"
+  integration_id = data.sentry_organization_integration.github.id
+  repository_id  = sentry_organization_repository.default.id
+  project_id     = sentry_project.default.internal_id
+  default_branch = "main"
+}
+
+# Import the CODEOWNERS file from the repository
+resource "sentry_project_codeowners" "default" {
+  organization    = "my-organization"
+  project         = sentry_project.default.slug
+  code_mapping_id = sentry_organization_code_mapping.default.id
+}
+
+# Or provide the contents explicitly
+resource "sentry_project_codeowners" "explicit" {
+  organization    = "my-organization"
+  project         = sentry_project.default.slug
+  code_mapping_id = sentry_organization_code_mapping.default.id
+  raw             = <<EOT
+* @my-github-organization/backend
+*.js @my-github-organization/frontend
+EOT
+}
+
+output "codeowners_errors" {
+  value = sentry_project_codeowners.default.errors
+}
@@ -0,0 +1,3 @@
+# import using the organization and project slugs from the URL and the environment name:
+# https://sentry.io/settings/[org-slug]/projects/[project-slug]/environments/
+terraform import sentry_project_environment.default org-slug/project-slug/environment-name
@@ -0,0 +1,7 @@
+# Hide an environment reported by a misconfigured SDK
+resource "sentry_project_environment" "local" {
+  organization = "my-organization"
+  project      = "web-app"
+  name         = "local"
+  is_hidden    = true
+}
@@ -0,0 +1,3 @@
+# import using the organization and project slugs from the URL:
+# https://sentry.io/settings/[org-slug]/projects/[project-slug]/performance/
+terraform import sentry_project_performance_issue_settings.default org-slug/project-slug
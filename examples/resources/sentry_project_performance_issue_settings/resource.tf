@@ -0,0 +1,22 @@
+resource "sentry_project" "default" {
+  organization = "my-organization"
+
+  teams = ["my-first-team", "my-second-team"]
+  name  = "web-app"
+
+  platform = "python"
+}
+
+resource "sentry_project_performance_issue_settings" "default" {
+  organization = sentry_project.default.organization
+  project      = sentry_project.default.id
+
+  n_plus_one_db_queries_detection_enabled = true
+  n_plus_one_db_duration_threshold        = 100
+
+  slow_db_queries_detection_enabled = true
+  slow_db_query_duration_threshold  = 2000
+
+  # Thresholds can only be set while the detector is enabled
+  file_io_on_main_thread_detection_enabled = false
+}
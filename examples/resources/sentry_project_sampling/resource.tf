@@ -0,0 +1,20 @@
+resource "sentry_organization_sampling" "default" {
+  organization  = "my-organization"
+  sampling_mode = "project"
+}
+
+resource "sentry_project" "default" {
+  organization = "my-organization"
+
+  teams = ["my-first-team", "my-second-team"]
+  name  = "web-app"
+
+  platform = "javascript"
+}
+
+# Sample 10% of the project's spans
+resource "sentry_project_sampling" "default" {
+  organization = sentry_organization_sampling.default.organization
+  project      = sentry_project.default.id
+  sample_rate  = 0.1
+}
@@ -0,0 +1,2 @@
+# import using the organization slug, project slug and release threshold ID:
+terraform import sentry_release_threshold.default org-slug/project-slug/release-threshold-id
@@ -0,0 +1,20 @@
+# Mark a release as unhealthy when more than 10 new issues are seen in the first hour
+resource "sentry_release_threshold" "new_issues" {
+  organization      = sentry_project.main.organization
+  project           = sentry_project.main.slug
+  threshold_type    = "new_issue_count"
+  trigger_type      = "over"
+  value             = 10
+  window_in_seconds = 3600
+}
+
+# Mark a production release as unhealthy when the crash-free session rate drops below 99%
+resource "sentry_release_threshold" "crash_free_sessions" {
+  organization      = sentry_project.main.organization
+  project           = sentry_project.main.slug
+  environment       = "production"
+  threshold_type    = "crash_free_session_rate"
+  trigger_type      = "under"
+  value             = 99
+  window_in_seconds = 86400
+}
@@ -0,0 +1,3 @@
+# import using the organization slug and saved search id from the URL:
+# https://sentry.io/organizations/[org-slug]/issues/searches/[search-id]/
+terraform import sentry_saved_search.default org-slug/search-id
@@ -0,0 +1,22 @@
+# Saved search shared with the organization
+resource "sentry_saved_search" "high_priority" {
+  organization = "my-organization"
+  name         = "Unresolved high priority"
+  query        = "is:unresolved issue.priority:high"
+  sort         = "freq"
+}
+
+# Saved search only visible to the owner of the auth token
+resource "sentry_saved_search" "mine" {
+  organization = "my-organization"
+  name         = "Assigned to me"
+  query        = "is:unresolved assigned:me"
+  visibility   = "owner"
+}
+
+# Pinned search, used as the default issue stream query of the owner
+resource "sentry_saved_search" "pinned" {
+  organization = "my-organization"
+  query        = "is:unresolved is:for_review"
+  visibility   = "owner_pinned"
+}
@@ -0,0 +1,3 @@
+# import using the organization and team slug from the URL:
+# https://sentry.io/settings/[org-slug]/teams/[team-slug]/members/
+terraform import sentry_team_members.default org-slug/team-slug
@@ -0,0 +1,14 @@
+resource "sentry_team_members" "default" {
+  organization = "my-organization"
+  team         = "my-team"
+
+  members = [
+    {
+      member_id = sentry_organization_member.john_doe.internal_id
+    },
+    {
+      member_id = sentry_organization_member.jane_doe.internal_id
+      role      = "admin"
+    },
+  ]
+}
@@ -0,0 +1,49 @@
+# Assertion written as attributes instead of functions
+resource "sentry_uptime_monitor" "test" {
+  organization = data.sentry_organization.test.slug
+  project      = sentry_project.test.slug
+  name         = "Uptime check for sentry.io"
+
+  environment = "production"
+
+  url              = "https://sentry.io/api/health/"
+  method           = "GET"
+  interval_seconds = 60
+  timeout_ms       = 5000
+
+  # Passes for a 2xx status code response whose body reports a healthy status,
+  # unless the response is marked as under maintenance.
+  assertion = {
+    and = [
+      {
+        status_code = {
+          operator = "greater_than"
+          value    = 199
+        }
+      },
+      {
+        status_code = {
+          operator = "less_than"
+          value    = 300
+        }
+      },
+      {
+        json_path = {
+          path          = "$.status"
+          operator      = "equals"
+          value_pattern = "healthy*"
+        }
+      },
+      {
+        not = {
+          header = {
+            key_operator   = "equals"
+            key            = "X-Maintenance"
+            value_operator = "always"
+            value_pattern  = "*"
+          }
+        }
+      },
+    ]
+  }
+}
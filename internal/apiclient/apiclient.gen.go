@@ -484,22 +484,22 @@ func (e OrganizationWorkflowActionFilterConditionAgeComparisonComparisonComparis
 
 // Defines values for OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime.
 const (
-	Day    OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "day"
-	Hour   OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "hour"
-	Minute OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "minute"
-	Week   OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "week"
+	OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTimeDay    OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "day"
+	OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTimeHour   OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "hour"
+	OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTimeMinute OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "minute"
+	OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTimeWeek   OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime = "week"
 )
 
 // Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime enum.
 func (e OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTime) Valid() bool {
 	switch e {
-	case Day:
+	case OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTimeDay:
 		return true
-	case Hour:
+	case OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTimeHour:
 		return true
-	case Minute:
+	case OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTimeMinute:
 		return true
-	case Week:
+	case OrganizationWorkflowActionFilterConditionAgeComparisonComparisonTimeWeek:
 		return true
 	default:
 		return false
@@ -572,6 +572,21 @@ func (e OrganizationWorkflowActionFilterConditionEventAttributeType) Valid() boo
 	}
 }
 
+// Defines values for OrganizationWorkflowActionFilterConditionEventCreatedByDetectorType.
+const (
+	EventCreatedByDetector OrganizationWorkflowActionFilterConditionEventCreatedByDetectorType = "event_created_by_detector"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionEventCreatedByDetectorType enum.
+func (e OrganizationWorkflowActionFilterConditionEventCreatedByDetectorType) Valid() bool {
+	switch e {
+	case EventCreatedByDetector:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for OrganizationWorkflowActionFilterConditionEventFrequencyCountType.
 const (
 	EventFrequencyCount OrganizationWorkflowActionFilterConditionEventFrequencyCountType = "event_frequency_count"
@@ -602,6 +617,21 @@ func (e OrganizationWorkflowActionFilterConditionEventFrequencyPercentType) Vali
 	}
 }
 
+// Defines values for OrganizationWorkflowActionFilterConditionEventSeenCountType.
+const (
+	EventSeenCount OrganizationWorkflowActionFilterConditionEventSeenCountType = "event_seen_count"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionEventSeenCountType enum.
+func (e OrganizationWorkflowActionFilterConditionEventSeenCountType) Valid() bool {
+	switch e {
+	case EventSeenCount:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountType.
 const (
 	EventUniqueUserFrequencyCount OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountType = "event_unique_user_frequency_count"
@@ -617,6 +647,51 @@ func (e OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountTy
 	}
 }
 
+// Defines values for OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercentType.
+const (
+	EventUniqueUserFrequencyPercent OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercentType = "event_unique_user_frequency_percent"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercentType enum.
+func (e OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercentType) Valid() bool {
+	switch e {
+	case EventUniqueUserFrequencyPercent:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionEveryEventType.
+const (
+	EveryEvent OrganizationWorkflowActionFilterConditionEveryEventType = "every_event"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionEveryEventType enum.
+func (e OrganizationWorkflowActionFilterConditionEveryEventType) Valid() bool {
+	switch e {
+	case EveryEvent:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionExistingHighPriorityIssueType.
+const (
+	ExistingHighPriorityIssue OrganizationWorkflowActionFilterConditionExistingHighPriorityIssueType = "existing_high_priority_issue"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionExistingHighPriorityIssueType enum.
+func (e OrganizationWorkflowActionFilterConditionExistingHighPriorityIssueType) Valid() bool {
+	switch e {
+	case ExistingHighPriorityIssue:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for OrganizationWorkflowActionFilterConditionIssueCategoryType.
 const (
 	IssueCategory OrganizationWorkflowActionFilterConditionIssueCategoryType = "issue_category"
@@ -647,6 +722,45 @@ func (e OrganizationWorkflowActionFilterConditionIssueOccurrencesType) Valid() b
 	}
 }
 
+// Defines values for OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime.
+const (
+	OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTimeDay    OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime = "day"
+	OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTimeHour   OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime = "hour"
+	OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTimeMinute OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime = "minute"
+	OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTimeWeek   OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime = "week"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime enum.
+func (e OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime) Valid() bool {
+	switch e {
+	case OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTimeDay:
+		return true
+	case OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTimeHour:
+		return true
+	case OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTimeMinute:
+		return true
+	case OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTimeWeek:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationWorkflowActionFilterConditionIssueOpenDurationType.
+const (
+	IssueOpenDuration OrganizationWorkflowActionFilterConditionIssueOpenDurationType = "issue_open_duration"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionIssueOpenDurationType enum.
+func (e OrganizationWorkflowActionFilterConditionIssueOpenDurationType) Valid() bool {
+	switch e {
+	case IssueOpenDuration:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType.
 const (
 	IssuePriorityDeescalating OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType = "issue_priority_deescalating"
@@ -662,6 +776,21 @@ func (e OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType)
 	}
 }
 
+// Defines values for OrganizationWorkflowActionFilterConditionIssuePriorityEqualsType.
+const (
+	IssuePriorityEquals OrganizationWorkflowActionFilterConditionIssuePriorityEqualsType = "issue_priority_equals"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionIssuePriorityEqualsType enum.
+func (e OrganizationWorkflowActionFilterConditionIssuePriorityEqualsType) Valid() bool {
+	switch e {
+	case IssuePriorityEquals:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType.
 const (
 	IssuePriorityGreaterOrEqual OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType = "issue_priority_greater_or_equal"
@@ -677,6 +806,21 @@ func (e OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType
 	}
 }
 
+// Defines values for OrganizationWorkflowActionFilterConditionIssueResolutionChangeType.
+const (
+	IssueResolutionChange OrganizationWorkflowActionFilterConditionIssueResolutionChangeType = "issue_resolution_change"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionIssueResolutionChangeType enum.
+func (e OrganizationWorkflowActionFilterConditionIssueResolutionChangeType) Valid() bool {
+	switch e {
+	case IssueResolutionChange:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for OrganizationWorkflowActionFilterConditionIssueTypeType.
 const (
 	IssueType OrganizationWorkflowActionFilterConditionIssueTypeType = "issue_type"
@@ -737,6 +881,21 @@ func (e OrganizationWorkflowActionFilterConditionLevelType) Valid() bool {
 	}
 }
 
+// Defines values for OrganizationWorkflowActionFilterConditionNewHighPriorityIssueType.
+const (
+	NewHighPriorityIssue OrganizationWorkflowActionFilterConditionNewHighPriorityIssueType = "new_high_priority_issue"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionNewHighPriorityIssueType enum.
+func (e OrganizationWorkflowActionFilterConditionNewHighPriorityIssueType) Valid() bool {
+	switch e {
+	case NewHighPriorityIssue:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for OrganizationWorkflowActionFilterConditionPercentSessionsCountType.
 const (
 	PercentSessionsCount OrganizationWorkflowActionFilterConditionPercentSessionsCountType = "percent_sessions_count"
@@ -767,6 +926,21 @@ func (e OrganizationWorkflowActionFilterConditionPercentSessionsPercentType) Val
 	}
 }
 
+// Defines values for OrganizationWorkflowActionFilterConditionSeerActivityTriggerType.
+const (
+	SeerActivityTrigger OrganizationWorkflowActionFilterConditionSeerActivityTriggerType = "seer_activity_trigger"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationWorkflowActionFilterConditionSeerActivityTriggerType enum.
+func (e OrganizationWorkflowActionFilterConditionSeerActivityTriggerType) Valid() bool {
+	switch e {
+	case SeerActivityTrigger:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for OrganizationWorkflowActionFilterConditionTaggedEventType.
 const (
 	TaggedEvent OrganizationWorkflowActionFilterConditionTaggedEventType = "tagged_event"
@@ -844,22 +1018,22 @@ func (e ProjectMonitorRequestUptimeDomainFailureType) Valid() bool {
 
 // Defines values for ProjectMonitorConditionGroupLogicType.
 const (
-	All      ProjectMonitorConditionGroupLogicType = "all"
-	Any      ProjectMonitorConditionGroupLogicType = "any"
-	AnyShort ProjectMonitorConditionGroupLogicType = "any-short"
-	None     ProjectMonitorConditionGroupLogicType = "none"
+	ProjectMonitorConditionGroupLogicTypeAll      ProjectMonitorConditionGroupLogicType = "all"
+	ProjectMonitorConditionGroupLogicTypeAny      ProjectMonitorConditionGroupLogicType = "any"
+	ProjectMonitorConditionGroupLogicTypeAnyShort ProjectMonitorConditionGroupLogicType = "any-short"
+	ProjectMonitorConditionGroupLogicTypeNone     ProjectMonitorConditionGroupLogicType = "none"
 )
 
 // Valid indicates whether the value is a known member of the ProjectMonitorConditionGroupLogicType enum.
 func (e ProjectMonitorConditionGroupLogicType) Valid() bool {
 	switch e {
-	case All:
+	case ProjectMonitorConditionGroupLogicTypeAll:
 		return true
-	case Any:
+	case ProjectMonitorConditionGroupLogicTypeAny:
 		return true
-	case AnyShort:
+	case ProjectMonitorConditionGroupLogicTypeAnyShort:
 		return true
-	case None:
+	case ProjectMonitorConditionGroupLogicTypeNone:
 		return true
 	default:
 		return false
@@ -1457,6 +1631,63 @@ func (e ProjectRuleFilterTaggedEventId) Valid() bool {
 	}
 }
 
+// Defines values for RuleSnoozeDeleteRequestTarget.
+const (
+	RuleSnoozeDeleteRequestTargetEveryone RuleSnoozeDeleteRequestTarget = "everyone"
+	RuleSnoozeDeleteRequestTargetMe       RuleSnoozeDeleteRequestTarget = "me"
+)
+
+// Valid indicates whether the value is a known member of the RuleSnoozeDeleteRequestTarget enum.
+func (e RuleSnoozeDeleteRequestTarget) Valid() bool {
+	switch e {
+	case RuleSnoozeDeleteRequestTargetEveryone:
+		return true
+	case RuleSnoozeDeleteRequestTargetMe:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RuleSnoozeRequestTarget.
+const (
+	RuleSnoozeRequestTargetEveryone RuleSnoozeRequestTarget = "everyone"
+	RuleSnoozeRequestTargetMe       RuleSnoozeRequestTarget = "me"
+)
+
+// Valid indicates whether the value is a known member of the RuleSnoozeRequestTarget enum.
+func (e RuleSnoozeRequestTarget) Valid() bool {
+	switch e {
+	case RuleSnoozeRequestTargetEveryone:
+		return true
+	case RuleSnoozeRequestTargetMe:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ListProjectEnvironmentsParamsVisibility.
+const (
+	ListProjectEnvironmentsParamsVisibilityAll     ListProjectEnvironmentsParamsVisibility = "all"
+	ListProjectEnvironmentsParamsVisibilityHidden  ListProjectEnvironmentsParamsVisibility = "hidden"
+	ListProjectEnvironmentsParamsVisibilityVisible ListProjectEnvironmentsParamsVisibility = "visible"
+)
+
+// Valid indicates whether the value is a known member of the ListProjectEnvironmentsParamsVisibility enum.
+func (e ListProjectEnvironmentsParamsVisibility) Valid() bool {
+	switch e {
+	case ListProjectEnvironmentsParamsVisibilityAll:
+		return true
+	case ListProjectEnvironmentsParamsVisibilityHidden:
+		return true
+	case ListProjectEnvironmentsParamsVisibilityVisible:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ListProjectClientKeysParamsStatus.
 const (
 	Active   ListProjectClientKeysParamsStatus = "active"
@@ -1475,14 +1706,296 @@ func (e ListProjectClientKeysParamsStatus) Valid() bool {
 	}
 }
 
+// BulkEnabledRequest defines model for BulkEnabledRequest.
+type BulkEnabledRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// CronMonitorMuteRequest defines model for CronMonitorMuteRequest.
+type CronMonitorMuteRequest struct {
+	IsMuted bool `json:"isMuted"`
+}
+
+// CustomDynamicSamplingRule defines model for CustomDynamicSamplingRule.
+type CustomDynamicSamplingRule struct {
+	Condition  map[string]interface{} `json:"condition"`
+	DateAdded  time.Time              `json:"dateAdded"`
+	EndDate    time.Time              `json:"endDate"`
+	NumSamples int64                  `json:"numSamples"`
+	Projects   []int64                `json:"projects"`
+	RuleId     int64                  `json:"ruleId"`
+	SampleRate float64                `json:"sampleRate"`
+	StartDate  time.Time              `json:"startDate"`
+}
+
+// Dashboard defines model for Dashboard.
+type Dashboard struct {
+	End         nullable.Nullable[string]               `json:"end,omitempty"`
+	Environment *[]string                               `json:"environment,omitempty"`
+	Filters     DashboardFilters                        `json:"filters"`
+	Id          string                                  `json:"id"`
+	Period      nullable.Nullable[string]               `json:"period,omitempty"`
+	Permissions nullable.Nullable[DashboardPermissions] `json:"permissions,omitempty"`
+	Projects    []int64                                 `json:"projects"`
+	Start       nullable.Nullable[string]               `json:"start,omitempty"`
+	Title       string                                  `json:"title"`
+	Utc         nullable.Nullable[bool]                 `json:"utc,omitempty"`
+	Widgets     []DashboardWidget                       `json:"widgets"`
+}
+
+// DashboardFilters defines model for DashboardFilters.
+type DashboardFilters struct {
+	GlobalFilter *[]DashboardGlobalFilter `json:"globalFilter,omitempty"`
+	Release      *[]string                `json:"release,omitempty"`
+}
+
+// DashboardGlobalFilter defines model for DashboardGlobalFilter.
+type DashboardGlobalFilter struct {
+	Dataset string `json:"dataset"`
+	Tag     struct {
+		Key  string  `json:"key"`
+		Kind *string `json:"kind,omitempty"`
+		Name *string `json:"name,omitempty"`
+	} `json:"tag"`
+	Value string `json:"value"`
+}
+
+// DashboardPermissions defines model for DashboardPermissions.
+type DashboardPermissions struct {
+	IsEditableByEveryone bool     `json:"isEditableByEveryone"`
+	TeamsWithEditAccess  *[]int64 `json:"teamsWithEditAccess,omitempty"`
+}
+
+// DashboardRequest defines model for DashboardRequest.
+type DashboardRequest struct {
+	End         *string               `json:"end,omitempty"`
+	Environment *[]string             `json:"environment,omitempty"`
+	Filters     *DashboardFilters     `json:"filters,omitempty"`
+	Period      *string               `json:"period,omitempty"`
+	Permissions *DashboardPermissions `json:"permissions,omitempty"`
+	Projects    []int64               `json:"projects"`
+	Start       *string               `json:"start,omitempty"`
+	Title       string                `json:"title"`
+	Utc         *bool                 `json:"utc,omitempty"`
+	Widgets     []DashboardWidget     `json:"widgets"`
+}
+
+// DashboardWidget defines model for DashboardWidget.
+type DashboardWidget struct {
+	DatasetSource *string                                      `json:"datasetSource,omitempty"`
+	Description   nullable.Nullable[string]                    `json:"description,omitempty"`
+	DisplayType   string                                       `json:"displayType"`
+	Id            *string                                      `json:"id,omitempty"`
+	Interval      *string                                      `json:"interval,omitempty"`
+	Layout        nullable.Nullable[DashboardWidgetLayout]     `json:"layout,omitempty"`
+	Limit         nullable.Nullable[int64]                     `json:"limit,omitempty"`
+	Queries       []DashboardWidgetQuery                       `json:"queries"`
+	Thresholds    nullable.Nullable[DashboardWidgetThresholds] `json:"thresholds,omitempty"`
+	Title         string                                       `json:"title"`
+	WidgetType    *string                                      `json:"widgetType,omitempty"`
+}
+
+// DashboardWidgetLayout defines model for DashboardWidgetLayout.
+type DashboardWidgetLayout struct {
+	H    int64 `json:"h"`
+	MinH int64 `json:"minH"`
+	W    int64 `json:"w"`
+	X    int64 `json:"x"`
+	Y    int64 `json:"y"`
+}
+
+// DashboardWidgetQuery defines model for DashboardWidgetQuery.
+type DashboardWidgetQuery struct {
+	Aggregates   *[]string `json:"aggregates,omitempty"`
+	Columns      *[]string `json:"columns,omitempty"`
+	Conditions   *string   `json:"conditions,omitempty"`
+	FieldAliases *[]string `json:"fieldAliases,omitempty"`
+	Fields       *[]string `json:"fields,omitempty"`
+	Id           *string   `json:"id,omitempty"`
+	IsHidden     *bool     `json:"isHidden,omitempty"`
+	Name         *string   `json:"name,omitempty"`
+	Orderby      *string   `json:"orderby,omitempty"`
+}
+
+// DashboardWidgetThresholds defines model for DashboardWidgetThresholds.
+type DashboardWidgetThresholds struct {
+	MaxValues struct {
+		Max1 *float64 `json:"max1,omitempty"`
+		Max2 *float64 `json:"max2,omitempty"`
+	} `json:"max_values"`
+	Unit nullable.Nullable[string] `json:"unit,omitempty"`
+}
+
+// DataForwarder defines model for DataForwarder.
+type DataForwarder struct {
+	Config            map[string]string `json:"config"`
+	EnrollNewProjects bool              `json:"enrollNewProjects"`
+	EnrolledProjects  []struct {
+		Id   string  `json:"id"`
+		Slug *string `json:"slug,omitempty"`
+	} `json:"enrolledProjects"`
+	Id        string `json:"id"`
+	IsEnabled bool   `json:"isEnabled"`
+	Provider  string `json:"provider"`
+}
+
+// DataForwarderRequest defines model for DataForwarderRequest.
+type DataForwarderRequest struct {
+	Config            map[string]string `json:"config"`
+	EnrollNewProjects bool              `json:"enroll_new_projects"`
+	IsEnabled         bool              `json:"is_enabled"`
+	ProjectIds        []int64           `json:"project_ids"`
+	Provider          string            `json:"provider"`
+}
+
+// DiscoverSavedQuery defines model for DiscoverSavedQuery.
+type DiscoverSavedQuery struct {
+	DateCreated  *time.Time `json:"dateCreated,omitempty"`
+	DateUpdated  *time.Time `json:"dateUpdated,omitempty"`
+	Display      *string    `json:"display,omitempty"`
+	End          *string    `json:"end,omitempty"`
+	Environment  *[]string  `json:"environment,omitempty"`
+	Fields       *[]string  `json:"fields,omitempty"`
+	Id           string     `json:"id"`
+	Interval     *string    `json:"interval,omitempty"`
+	Name         string     `json:"name"`
+	Orderby      *string    `json:"orderby,omitempty"`
+	Projects     []int64    `json:"projects"`
+	Query        *string    `json:"query,omitempty"`
+	QueryDataset *string    `json:"queryDataset,omitempty"`
+	Range        *string    `json:"range,omitempty"`
+	Start        *string    `json:"start,omitempty"`
+	TopEvents    *int64     `json:"topEvents,omitempty"`
+	Version      *int64     `json:"version,omitempty"`
+	YAxis        *[]string  `json:"yAxis,omitempty"`
+}
+
+// DiscoverSavedQueryRequest defines model for DiscoverSavedQueryRequest.
+type DiscoverSavedQueryRequest struct {
+	Display      *string   `json:"display,omitempty"`
+	End          *string   `json:"end,omitempty"`
+	Environment  *[]string `json:"environment,omitempty"`
+	Fields       []string  `json:"fields"`
+	Interval     *string   `json:"interval,omitempty"`
+	Name         string    `json:"name"`
+	Orderby      *string   `json:"orderby,omitempty"`
+	Projects     []int64   `json:"projects"`
+	Query        *string   `json:"query,omitempty"`
+	QueryDataset *string   `json:"queryDataset,omitempty"`
+	Range        *string   `json:"range,omitempty"`
+	Start        *string   `json:"start,omitempty"`
+	TopEvents    *int64    `json:"topEvents,omitempty"`
+	Version      int64     `json:"version"`
+	YAxis        *[]string `json:"yAxis,omitempty"`
+}
+
+// ExternalActor defines model for ExternalActor.
+type ExternalActor struct {
+	ExternalId    nullable.Nullable[string] `json:"externalId,omitempty"`
+	ExternalName  string                    `json:"externalName"`
+	Id            string                    `json:"id"`
+	IntegrationId string                    `json:"integrationId"`
+	Provider      string                    `json:"provider"`
+	TeamId        *string                   `json:"teamId,omitempty"`
+	UserId        *string                   `json:"userId,omitempty"`
+}
+
+// ExternalTeamRequest defines model for ExternalTeamRequest.
+type ExternalTeamRequest struct {
+	ExternalId    *string `json:"externalId,omitempty"`
+	ExternalName  string  `json:"externalName"`
+	IntegrationId int64   `json:"integrationId"`
+	Provider      string  `json:"provider"`
+}
+
+// ExternalUserRequest defines model for ExternalUserRequest.
+type ExternalUserRequest struct {
+	ExternalId    *string `json:"externalId,omitempty"`
+	ExternalName  string  `json:"externalName"`
+	IntegrationId int64   `json:"integrationId"`
+	MemberId      int64   `json:"memberId"`
+	Provider      string  `json:"provider"`
+}
+
+// GroupSearchView defines model for GroupSearchView.
+type GroupSearchView struct {
+	Environments  []string                   `json:"environments"`
+	Id            string                     `json:"id"`
+	IsAllProjects bool                       `json:"isAllProjects"`
+	Name          string                     `json:"name"`
+	Projects      []int64                    `json:"projects"`
+	Query         string                     `json:"query"`
+	QuerySort     string                     `json:"querySort"`
+	Starred       *bool                      `json:"starred,omitempty"`
+	TimeFilters   GroupSearchViewTimeFilters `json:"timeFilters"`
+}
+
+// GroupSearchViewRequest defines model for GroupSearchViewRequest.
+type GroupSearchViewRequest struct {
+	Environments  []string                   `json:"environments"`
+	IsAllProjects bool                       `json:"isAllProjects"`
+	Name          string                     `json:"name"`
+	Projects      []int64                    `json:"projects"`
+	Query         string                     `json:"query"`
+	QuerySort     string                     `json:"querySort"`
+	Starred       *bool                      `json:"starred,omitempty"`
+	TimeFilters   GroupSearchViewTimeFilters `json:"timeFilters"`
+}
+
+// GroupSearchViewTimeFilters defines model for GroupSearchViewTimeFilters.
+type GroupSearchViewTimeFilters struct {
+	End    nullable.Nullable[string] `json:"end,omitempty"`
+	Period nullable.Nullable[string] `json:"period,omitempty"`
+	Start  nullable.Nullable[string] `json:"start,omitempty"`
+	Utc    nullable.Nullable[bool]   `json:"utc,omitempty"`
+}
+
 // Organization defines model for Organization.
 type Organization struct {
-	Features     *[]string                  `json:"features,omitempty"`
-	Id           string                     `json:"id"`
-	Name         string                     `json:"name"`
-	OrgRoleList  []OrganizationRoleListItem `json:"orgRoleList"`
-	Slug         string                     `json:"slug"`
-	TeamRoleList []TeamRoleListItem         `json:"teamRoleList"`
+	Features         *[]string                  `json:"features,omitempty"`
+	Id               string                     `json:"id"`
+	Name             string                     `json:"name"`
+	OrgRoleList      []OrganizationRoleListItem `json:"orgRoleList"`
+	SamplingMode     *string                    `json:"samplingMode,omitempty"`
+	Slug             string                     `json:"slug"`
+	TargetSampleRate nullable.Nullable[float64] `json:"targetSampleRate,omitempty"`
+	TeamRoleList     []TeamRoleListItem         `json:"teamRoleList"`
+}
+
+// OrganizationAuthToken defines model for OrganizationAuthToken.
+type OrganizationAuthToken struct {
+	DateCreated         time.Time                    `json:"dateCreated"`
+	DateLastUsed        nullable.Nullable[time.Time] `json:"dateLastUsed"`
+	Id                  string                       `json:"id"`
+	Name                string                       `json:"name"`
+	ProjectLastUsedId   nullable.Nullable[string]    `json:"projectLastUsedId"`
+	Scopes              []string                     `json:"scopes"`
+	TokenLastCharacters nullable.Nullable[string]    `json:"tokenLastCharacters"`
+}
+
+// OrganizationAuthTokenWithToken defines model for OrganizationAuthTokenWithToken.
+type OrganizationAuthTokenWithToken struct {
+	DateCreated         time.Time                    `json:"dateCreated"`
+	DateLastUsed        nullable.Nullable[time.Time] `json:"dateLastUsed"`
+	Id                  string                       `json:"id"`
+	Name                string                       `json:"name"`
+	ProjectLastUsedId   nullable.Nullable[string]    `json:"projectLastUsedId"`
+	Scopes              []string                     `json:"scopes"`
+	Token               string                       `json:"token"`
+	TokenLastCharacters nullable.Nullable[string]    `json:"tokenLastCharacters"`
+}
+
+// OrganizationDetectorWorkflow defines model for OrganizationDetectorWorkflow.
+type OrganizationDetectorWorkflow struct {
+	DetectorId string `json:"detectorId"`
+	Id         string `json:"id"`
+	WorkflowId string `json:"workflowId"`
+}
+
+// OrganizationDetectorWorkflowRequest defines model for OrganizationDetectorWorkflowRequest.
+type OrganizationDetectorWorkflowRequest struct {
+	DetectorId string `json:"detectorId"`
+	WorkflowId string `json:"workflowId"`
 }
 
 // OrganizationIntegration defines model for OrganizationIntegration.
@@ -1553,13 +2066,14 @@ type OrganizationIntegrationPagerDutyServiceTableItem struct {
 
 // OrganizationMember defines model for OrganizationMember.
 type OrganizationMember struct {
-	Email   string `json:"email"`
-	Expired bool   `json:"expired"`
-	Id      string `json:"id"`
-	Name    string `json:"name"`
-	OrgRole string `json:"orgRole"`
-	Pending bool   `json:"pending"`
-	User    struct {
+	Email         string           `json:"email"`
+	Expired       bool             `json:"expired"`
+	ExternalUsers *[]ExternalActor `json:"externalUsers,omitempty"`
+	Id            string           `json:"id"`
+	Name          string           `json:"name"`
+	OrgRole       string           `json:"orgRole"`
+	Pending       bool             `json:"pending"`
+	User          struct {
 		Id string `json:"id"`
 	} `json:"user"`
 }
@@ -1624,7 +2138,7 @@ type OrganizationWorkflow_Triggers struct {
 type OrganizationWorkflowRequest struct {
 	ActionFilters []OrganizationWorkflowActionFilter `json:"actionFilters"`
 	Config        OrganizationWorkflowConfig         `json:"config"`
-	DetectorIds   []string                           `json:"detectorIds"`
+	DetectorIds   *[]string                          `json:"detectorIds,omitempty"`
 	Enabled       bool                               `json:"enabled"`
 	Environment   nullable.Nullable[string]          `json:"environment,omitempty"`
 	Name          string                             `json:"name"`
@@ -1963,6 +2477,16 @@ type OrganizationWorkflowActionFilterConditionEventAttribute struct {
 // OrganizationWorkflowActionFilterConditionEventAttributeType defines model for OrganizationWorkflowActionFilterConditionEventAttribute.Type.
 type OrganizationWorkflowActionFilterConditionEventAttributeType string
 
+// OrganizationWorkflowActionFilterConditionEventCreatedByDetector defines model for OrganizationWorkflow_ActionFilter_Condition_EventCreatedByDetector.
+type OrganizationWorkflowActionFilterConditionEventCreatedByDetector struct {
+	Comparison      int64                                                               `json:"comparison"`
+	ConditionResult bool                                                                `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionEventCreatedByDetectorType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionEventCreatedByDetectorType defines model for OrganizationWorkflowActionFilterConditionEventCreatedByDetector.Type.
+type OrganizationWorkflowActionFilterConditionEventCreatedByDetectorType string
+
 // OrganizationWorkflowActionFilterConditionEventFrequencyCount defines model for OrganizationWorkflow_ActionFilter_Condition_EventFrequencyCount.
 type OrganizationWorkflowActionFilterConditionEventFrequencyCount struct {
 	Comparison struct {
@@ -1992,6 +2516,16 @@ type OrganizationWorkflowActionFilterConditionEventFrequencyPercent struct {
 // OrganizationWorkflowActionFilterConditionEventFrequencyPercentType defines model for OrganizationWorkflowActionFilterConditionEventFrequencyPercent.Type.
 type OrganizationWorkflowActionFilterConditionEventFrequencyPercentType string
 
+// OrganizationWorkflowActionFilterConditionEventSeenCount defines model for OrganizationWorkflow_ActionFilter_Condition_EventSeenCount.
+type OrganizationWorkflowActionFilterConditionEventSeenCount struct {
+	Comparison      int64                                                       `json:"comparison"`
+	ConditionResult bool                                                        `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionEventSeenCountType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionEventSeenCountType defines model for OrganizationWorkflowActionFilterConditionEventSeenCount.Type.
+type OrganizationWorkflowActionFilterConditionEventSeenCountType string
+
 // OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount defines model for OrganizationWorkflow_ActionFilter_Condition_EventUniqueUserFrequencyCount.
 type OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount struct {
 	Comparison struct {
@@ -2014,6 +2548,41 @@ type OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountFilte
 	Value     *string `json:"value,omitempty"`
 }
 
+// OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent defines model for OrganizationWorkflow_ActionFilter_Condition_EventUniqueUserFrequencyPercent.
+type OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent struct {
+	Comparison struct {
+		ComparisonInterval string                                                                          `json:"comparisonInterval"`
+		Filters            *[]OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountFilter `json:"filters,omitempty"`
+		Interval           string                                                                          `json:"interval"`
+		Value              int64                                                                           `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                                         `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercentType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercentType defines model for OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent.Type.
+type OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercentType string
+
+// OrganizationWorkflowActionFilterConditionEveryEvent defines model for OrganizationWorkflow_ActionFilter_Condition_EveryEvent.
+type OrganizationWorkflowActionFilterConditionEveryEvent struct {
+	Comparison      bool                                                    `json:"comparison"`
+	ConditionResult bool                                                    `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionEveryEventType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionEveryEventType defines model for OrganizationWorkflowActionFilterConditionEveryEvent.Type.
+type OrganizationWorkflowActionFilterConditionEveryEventType string
+
+// OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue defines model for OrganizationWorkflow_ActionFilter_Condition_ExistingHighPriorityIssue.
+type OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue struct {
+	Comparison      bool                                                                   `json:"comparison"`
+	ConditionResult bool                                                                   `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionExistingHighPriorityIssueType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionExistingHighPriorityIssueType defines model for OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue.Type.
+type OrganizationWorkflowActionFilterConditionExistingHighPriorityIssueType string
+
 // OrganizationWorkflowActionFilterConditionIssueCategory defines model for OrganizationWorkflow_ActionFilter_Condition_IssueCategory.
 type OrganizationWorkflowActionFilterConditionIssueCategory struct {
 	Comparison struct {
@@ -2039,6 +2608,22 @@ type OrganizationWorkflowActionFilterConditionIssueOccurrences struct {
 // OrganizationWorkflowActionFilterConditionIssueOccurrencesType defines model for OrganizationWorkflowActionFilterConditionIssueOccurrences.Type.
 type OrganizationWorkflowActionFilterConditionIssueOccurrencesType string
 
+// OrganizationWorkflowActionFilterConditionIssueOpenDuration defines model for OrganizationWorkflow_ActionFilter_Condition_IssueOpenDuration.
+type OrganizationWorkflowActionFilterConditionIssueOpenDuration struct {
+	Comparison struct {
+		Time  OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime `json:"time"`
+		Value int64                                                                    `json:"value"`
+	} `json:"comparison"`
+	ConditionResult bool                                                           `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionIssueOpenDurationType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime defines model for OrganizationWorkflowActionFilterConditionIssueOpenDuration.Comparison.Time.
+type OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime string
+
+// OrganizationWorkflowActionFilterConditionIssueOpenDurationType defines model for OrganizationWorkflowActionFilterConditionIssueOpenDuration.Type.
+type OrganizationWorkflowActionFilterConditionIssueOpenDurationType string
+
 // OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating defines model for OrganizationWorkflow_ActionFilter_Condition_IssuePriorityDeescalating.
 type OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating struct {
 	Comparison      int64                                                                  `json:"comparison"`
@@ -2049,6 +2634,16 @@ type OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating struct {
 // OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType defines model for OrganizationWorkflowActionFilterConditionIssuePriorityDeescalating.Type.
 type OrganizationWorkflowActionFilterConditionIssuePriorityDeescalatingType string
 
+// OrganizationWorkflowActionFilterConditionIssuePriorityEquals defines model for OrganizationWorkflow_ActionFilter_Condition_IssuePriorityEquals.
+type OrganizationWorkflowActionFilterConditionIssuePriorityEquals struct {
+	Comparison      int64                                                            `json:"comparison"`
+	ConditionResult bool                                                             `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionIssuePriorityEqualsType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionIssuePriorityEqualsType defines model for OrganizationWorkflowActionFilterConditionIssuePriorityEquals.Type.
+type OrganizationWorkflowActionFilterConditionIssuePriorityEqualsType string
+
 // OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual defines model for OrganizationWorkflow_ActionFilter_Condition_IssuePriorityGreaterOrEqual.
 type OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual struct {
 	Comparison      int64                                                                    `json:"comparison"`
@@ -2059,6 +2654,16 @@ type OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual struct
 // OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType defines model for OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual.Type.
 type OrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqualType string
 
+// OrganizationWorkflowActionFilterConditionIssueResolutionChange defines model for OrganizationWorkflow_ActionFilter_Condition_IssueResolutionChange.
+type OrganizationWorkflowActionFilterConditionIssueResolutionChange struct {
+	Comparison      int64                                                              `json:"comparison"`
+	ConditionResult bool                                                               `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionIssueResolutionChangeType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionIssueResolutionChangeType defines model for OrganizationWorkflowActionFilterConditionIssueResolutionChange.Type.
+type OrganizationWorkflowActionFilterConditionIssueResolutionChangeType string
+
 // OrganizationWorkflowActionFilterConditionIssueType defines model for OrganizationWorkflow_ActionFilter_Condition_IssueType.
 type OrganizationWorkflowActionFilterConditionIssueType struct {
 	Comparison struct {
@@ -2109,6 +2714,16 @@ type OrganizationWorkflowActionFilterConditionLevel struct {
 // OrganizationWorkflowActionFilterConditionLevelType defines model for OrganizationWorkflowActionFilterConditionLevel.Type.
 type OrganizationWorkflowActionFilterConditionLevelType string
 
+// OrganizationWorkflowActionFilterConditionNewHighPriorityIssue defines model for OrganizationWorkflow_ActionFilter_Condition_NewHighPriorityIssue.
+type OrganizationWorkflowActionFilterConditionNewHighPriorityIssue struct {
+	Comparison      bool                                                              `json:"comparison"`
+	ConditionResult bool                                                              `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionNewHighPriorityIssueType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionNewHighPriorityIssueType defines model for OrganizationWorkflowActionFilterConditionNewHighPriorityIssue.Type.
+type OrganizationWorkflowActionFilterConditionNewHighPriorityIssueType string
+
 // OrganizationWorkflowActionFilterConditionPercentSessionsCount defines model for OrganizationWorkflow_ActionFilter_Condition_PercentSessionsCount.
 type OrganizationWorkflowActionFilterConditionPercentSessionsCount struct {
 	Comparison struct {
@@ -2137,6 +2752,16 @@ type OrganizationWorkflowActionFilterConditionPercentSessionsPercent struct {
 // OrganizationWorkflowActionFilterConditionPercentSessionsPercentType defines model for OrganizationWorkflowActionFilterConditionPercentSessionsPercent.Type.
 type OrganizationWorkflowActionFilterConditionPercentSessionsPercentType string
 
+// OrganizationWorkflowActionFilterConditionSeerActivityTrigger defines model for OrganizationWorkflow_ActionFilter_Condition_SeerActivityTrigger.
+type OrganizationWorkflowActionFilterConditionSeerActivityTrigger struct {
+	Comparison      bool                                                             `json:"comparison"`
+	ConditionResult bool                                                             `json:"conditionResult"`
+	Type            OrganizationWorkflowActionFilterConditionSeerActivityTriggerType `json:"type"`
+}
+
+// OrganizationWorkflowActionFilterConditionSeerActivityTriggerType defines model for OrganizationWorkflowActionFilterConditionSeerActivityTrigger.Type.
+type OrganizationWorkflowActionFilterConditionSeerActivityTriggerType string
+
 // OrganizationWorkflowActionFilterConditionTaggedEvent defines model for OrganizationWorkflow_ActionFilter_Condition_TaggedEvent.
 type OrganizationWorkflowActionFilterConditionTaggedEvent struct {
 	Comparison struct {
@@ -2211,6 +2836,40 @@ type Project struct {
 	VerifySSL            bool                      `json:"verifySSL"`
 }
 
+// ProjectCodeOwners defines model for ProjectCodeOwners.
+type ProjectCodeOwners struct {
+	CodeMappingId   string                   `json:"codeMappingId"`
+	DateCreated     *time.Time               `json:"dateCreated,omitempty"`
+	DateUpdated     *time.Time               `json:"dateUpdated,omitempty"`
+	Errors          *ProjectCodeOwnersErrors `json:"errors,omitempty"`
+	Id              string                   `json:"id"`
+	OwnershipSyntax *string                  `json:"ownershipSyntax,omitempty"`
+	Provider        *string                  `json:"provider,omitempty"`
+	Raw             string                   `json:"raw"`
+}
+
+// ProjectCodeOwnersErrors defines model for ProjectCodeOwnersErrors.
+type ProjectCodeOwnersErrors struct {
+	MissingExternalTeams *[]string `json:"missing_external_teams,omitempty"`
+	MissingExternalUsers *[]string `json:"missing_external_users,omitempty"`
+	MissingUserEmails    *[]string `json:"missing_user_emails,omitempty"`
+	TeamsWithoutAccess   *[]string `json:"teams_without_access,omitempty"`
+	UsersWithoutAccess   *[]string `json:"users_without_access,omitempty"`
+}
+
+// ProjectCodeOwnersRequest defines model for ProjectCodeOwnersRequest.
+type ProjectCodeOwnersRequest struct {
+	CodeMappingId string `json:"codeMappingId"`
+	Raw           string `json:"raw"`
+}
+
+// ProjectEnvironment defines model for ProjectEnvironment.
+type ProjectEnvironment struct {
+	Id       string `json:"id"`
+	IsHidden bool   `json:"isHidden"`
+	Name     string `json:"name"`
+}
+
 // ProjectKey defines model for ProjectKey.
 type ProjectKey struct {
 	BrowserSdkVersion       string            `json:"browserSdkVersion"`
@@ -2423,8 +3082,20 @@ type ProjectMonitorDataSourceConfigCronIntervalValue = int64
 
 // ProjectMonitorDataSourceCron defines model for ProjectMonitor_DataSource_Cron.
 type ProjectMonitorDataSourceCron struct {
-	Config ProjectMonitorDataSourceConfigCron `json:"config"`
-	Name   string                             `json:"name"`
+	Config       ProjectMonitorDataSourceConfigCron         `json:"config"`
+	Environments *[]ProjectMonitorDataSourceCronEnvironment `json:"environments,omitempty"`
+	IsMuted      *bool                                      `json:"isMuted,omitempty"`
+	Name         string                                     `json:"name"`
+	Slug         *string                                    `json:"slug,omitempty"`
+}
+
+// ProjectMonitorDataSourceCronEnvironment defines model for ProjectMonitor_DataSource_Cron_Environment.
+type ProjectMonitorDataSourceCronEnvironment struct {
+	IsMuted     bool                         `json:"isMuted"`
+	LastCheckIn nullable.Nullable[time.Time] `json:"lastCheckIn,omitempty"`
+	Name        string                       `json:"name"`
+	NextCheckIn nullable.Nullable[time.Time] `json:"nextCheckIn,omitempty"`
+	Status      *string                      `json:"status,omitempty"`
 }
 
 // ProjectMonitorDataSourceSnubaQuerySubscription defines model for ProjectMonitor_DataSource_SnubaQuerySubscription.
@@ -2521,19 +3192,63 @@ type ProjectOwnership struct {
 	Raw                string    `json:"raw"`
 }
 
+// ProjectPerformanceIssueSettings defines model for ProjectPerformanceIssueSettings.
+type ProjectPerformanceIssueSettings struct {
+	ConsecutiveDbQueriesDetectionEnabled          *bool    `json:"consecutive_db_queries_detection_enabled,omitempty"`
+	ConsecutiveDbQueriesMinTimeSavedThreshold     *int64   `json:"consecutive_db_queries_min_time_saved_threshold,omitempty"`
+	ConsecutiveHttpSpansDetectionEnabled          *bool    `json:"consecutive_http_spans_detection_enabled,omitempty"`
+	ConsecutiveHttpSpansMinTimeSavedThreshold     *int64   `json:"consecutive_http_spans_min_time_saved_threshold,omitempty"`
+	DbOnMainThreadDetectionEnabled                *bool    `json:"db_on_main_thread_detection_enabled,omitempty"`
+	DbOnMainThreadDurationThreshold               *int64   `json:"db_on_main_thread_duration_threshold,omitempty"`
+	FileIoOnMainThreadDetectionEnabled            *bool    `json:"file_io_on_main_thread_detection_enabled,omitempty"`
+	FileIoOnMainThreadDurationThreshold           *int64   `json:"file_io_on_main_thread_duration_threshold,omitempty"`
+	FunctionDurationRegressionDetectionEnabled    *bool    `json:"function_duration_regression_detection_enabled,omitempty"`
+	HttpOverheadDetectionEnabled                  *bool    `json:"http_overhead_detection_enabled,omitempty"`
+	HttpRequestDelayThreshold                     *int64   `json:"http_request_delay_threshold,omitempty"`
+	LargeHttpPayloadDetectionEnabled              *bool    `json:"large_http_payload_detection_enabled,omitempty"`
+	LargeHttpPayloadSizeThreshold                 *int64   `json:"large_http_payload_size_threshold,omitempty"`
+	LargeRenderBlockingAssetDetectionEnabled      *bool    `json:"large_render_blocking_asset_detection_enabled,omitempty"`
+	LargeRenderBlockingAssetFcpRatio              *float64 `json:"large_render_blocking_asset_fcp_ratio,omitempty"`
+	NPlusOneApiCallsDetectionEnabled              *bool    `json:"n_plus_one_api_calls_detection_enabled,omitempty"`
+	NPlusOneApiCallsTotalDurationThreshold        *int64   `json:"n_plus_one_api_calls_total_duration_threshold,omitempty"`
+	NPlusOneDbDurationThreshold                   *int64   `json:"n_plus_one_db_duration_threshold,omitempty"`
+	NPlusOneDbQueriesDetectionEnabled             *bool    `json:"n_plus_one_db_queries_detection_enabled,omitempty"`
+	RenderBlockingFcpMaxDurationThreshold         *int64   `json:"render_blocking_fcp_max_duration_threshold,omitempty"`
+	RenderBlockingFcpMinDurationThreshold         *int64   `json:"render_blocking_fcp_min_duration_threshold,omitempty"`
+	SlowDbQueriesDetectionEnabled                 *bool    `json:"slow_db_queries_detection_enabled,omitempty"`
+	SlowDbQueryDurationThreshold                  *int64   `json:"slow_db_query_duration_threshold,omitempty"`
+	TransactionDurationRegressionDetectionEnabled *bool    `json:"transaction_duration_regression_detection_enabled,omitempty"`
+	UncompressedAssetDurationThreshold            *int64   `json:"uncompressed_asset_duration_threshold,omitempty"`
+	UncompressedAssetSizeThreshold                *int64   `json:"uncompressed_asset_size_threshold,omitempty"`
+	UncompressedAssetsDetectionEnabled            *bool    `json:"uncompressed_assets_detection_enabled,omitempty"`
+}
+
+// ProjectPlugin defines model for ProjectPlugin.
+type ProjectPlugin struct {
+	Config []struct {
+		Name  string                         `json:"name"`
+		Value nullable.Nullable[interface{}] `json:"value,omitempty"`
+	} `json:"config"`
+	Enabled bool   `json:"enabled"`
+	Id      string `json:"id"`
+}
+
 // ProjectRule defines model for ProjectRule.
 type ProjectRule struct {
-	ActionMatch string                    `json:"actionMatch"`
-	Actions     []ProjectRuleAction       `json:"actions"`
-	Conditions  []ProjectRuleCondition    `json:"conditions"`
-	Environment nullable.Nullable[string] `json:"environment"`
-	FilterMatch string                    `json:"filterMatch"`
-	Filters     []ProjectRuleFilter       `json:"filters"`
-	Frequency   int64                     `json:"frequency"`
-	Id          string                    `json:"id"`
-	Name        string                    `json:"name"`
-	Owner       nullable.Nullable[string] `json:"owner"`
-	Projects    []string                  `json:"projects"`
+	ActionMatch       string                    `json:"actionMatch"`
+	Actions           []ProjectRuleAction       `json:"actions"`
+	Conditions        []ProjectRuleCondition    `json:"conditions"`
+	Environment       nullable.Nullable[string] `json:"environment"`
+	FilterMatch       string                    `json:"filterMatch"`
+	Filters           []ProjectRuleFilter       `json:"filters"`
+	Frequency         int64                     `json:"frequency"`
+	Id                string                    `json:"id"`
+	Name              string                    `json:"name"`
+	Owner             nullable.Nullable[string] `json:"owner"`
+	Projects          []string                  `json:"projects"`
+	Snooze            *bool                     `json:"snooze,omitempty"`
+	SnoozeCreatedBy   *string                   `json:"snoozeCreatedBy,omitempty"`
+	SnoozeForEveryone *bool                     `json:"snoozeForEveryone,omitempty"`
 }
 
 // ProjectRuleAction defines model for ProjectRuleAction.
@@ -2957,6 +3672,119 @@ type ProjectRuleFilterTaggedEvent struct {
 // ProjectRuleFilterTaggedEventId defines model for ProjectRuleFilterTaggedEvent.Id.
 type ProjectRuleFilterTaggedEventId string
 
+// ProjectSampleRate defines model for ProjectSampleRate.
+type ProjectSampleRate struct {
+	Id         int64   `json:"id"`
+	SampleRate float64 `json:"sampleRate"`
+}
+
+// ProjectTagValue defines model for ProjectTagValue.
+type ProjectTagValue struct {
+	Count     int64                        `json:"count"`
+	FirstSeen nullable.Nullable[time.Time] `json:"firstSeen"`
+	Key       string                       `json:"key"`
+	LastSeen  nullable.Nullable[time.Time] `json:"lastSeen"`
+	Value     string                       `json:"value"`
+}
+
+// ReleaseThreshold defines model for ReleaseThreshold.
+type ReleaseThreshold struct {
+	DateAdded   *string `json:"date_added,omitempty"`
+	Environment nullable.Nullable[struct {
+		Name string `json:"name"`
+	}] `json:"environment,omitempty"`
+	Id      string `json:"id"`
+	Project struct {
+		Id   string `json:"id"`
+		Slug string `json:"slug"`
+	} `json:"project"`
+	ThresholdType   string `json:"threshold_type"`
+	TriggerType     string `json:"trigger_type"`
+	Value           int64  `json:"value"`
+	WindowInSeconds int64  `json:"window_in_seconds"`
+}
+
+// ReleaseThresholdRequest defines model for ReleaseThresholdRequest.
+type ReleaseThresholdRequest struct {
+	Environment     *string `json:"environment,omitempty"`
+	ThresholdType   string  `json:"threshold_type"`
+	TriggerType     string  `json:"trigger_type"`
+	Value           int64   `json:"value"`
+	WindowInSeconds int64   `json:"window_in_seconds"`
+}
+
+// RuleSnooze defines model for RuleSnooze.
+type RuleSnooze struct {
+	DateAdded *time.Time                   `json:"dateAdded,omitempty"`
+	OwnerId   nullable.Nullable[int64]     `json:"ownerId,omitempty"`
+	RuleId    int64                        `json:"ruleId"`
+	Until     nullable.Nullable[time.Time] `json:"until,omitempty"`
+	UserId    nullable.Nullable[int64]     `json:"userId,omitempty"`
+}
+
+// RuleSnoozeDeleteRequest defines model for RuleSnoozeDeleteRequest.
+type RuleSnoozeDeleteRequest struct {
+	Target RuleSnoozeDeleteRequestTarget `json:"target"`
+}
+
+// RuleSnoozeDeleteRequestTarget defines model for RuleSnoozeDeleteRequest.Target.
+type RuleSnoozeDeleteRequestTarget string
+
+// RuleSnoozeRequest defines model for RuleSnoozeRequest.
+type RuleSnoozeRequest struct {
+	Target RuleSnoozeRequestTarget      `json:"target"`
+	Until  nullable.Nullable[time.Time] `json:"until,omitempty"`
+}
+
+// RuleSnoozeRequestTarget defines model for RuleSnoozeRequest.Target.
+type RuleSnoozeRequestTarget string
+
+// SavedSearch defines model for SavedSearch.
+type SavedSearch struct {
+	DateCreated *time.Time                `json:"dateCreated,omitempty"`
+	Id          string                    `json:"id"`
+	IsGlobal    *bool                     `json:"isGlobal,omitempty"`
+	IsPinned    *bool                     `json:"isPinned,omitempty"`
+	Name        string                    `json:"name"`
+	Query       string                    `json:"query"`
+	Sort        nullable.Nullable[string] `json:"sort,omitempty"`
+	Type        int64                     `json:"type"`
+	Visibility  string                    `json:"visibility"`
+}
+
+// SavedSearchRequest defines model for SavedSearchRequest.
+type SavedSearchRequest struct {
+	Name       string  `json:"name"`
+	Query      string  `json:"query"`
+	Sort       *string `json:"sort,omitempty"`
+	Type       int64   `json:"type"`
+	Visibility string  `json:"visibility"`
+}
+
+// SentryApp defines model for SentryApp.
+type SentryApp struct {
+	AllowedOrigins []string                  `json:"allowedOrigins"`
+	Events         []string                  `json:"events"`
+	IsAlertable    bool                      `json:"isAlertable"`
+	Name           string                    `json:"name"`
+	Overview       nullable.Nullable[string] `json:"overview"`
+	RedirectUrl    nullable.Nullable[string] `json:"redirectUrl"`
+	Schema         map[string]interface{}    `json:"schema"`
+	Scopes         []string                  `json:"scopes"`
+	Slug           string                    `json:"slug"`
+	Status         string                    `json:"status"`
+	Uuid           string                    `json:"uuid"`
+	WebhookUrl     nullable.Nullable[string] `json:"webhookUrl"`
+}
+
+// SentryAppApiToken defines model for SentryAppApiToken.
+type SentryAppApiToken struct {
+	DateCreated time.Time `json:"dateCreated"`
+	Id          string    `json:"id"`
+	Scopes      []string  `json:"scopes"`
+	Token       string    `json:"token"`
+}
+
 // SentryAppInstallation defines model for SentryAppInstallation.
 type SentryAppInstallation struct {
 	App struct {
@@ -2971,14 +3799,41 @@ type SentryAppInstallation struct {
 	Uuid   string `json:"uuid"`
 }
 
+// SentryAppRequest defines model for SentryAppRequest.
+type SentryAppRequest struct {
+	AllowedOrigins *[]string                 `json:"allowedOrigins,omitempty"`
+	Author         *string                   `json:"author,omitempty"`
+	Events         *[]string                 `json:"events,omitempty"`
+	IsAlertable    *bool                     `json:"isAlertable,omitempty"`
+	IsInternal     *bool                     `json:"isInternal,omitempty"`
+	Name           string                    `json:"name"`
+	Organization   *string                   `json:"organization,omitempty"`
+	Overview       nullable.Nullable[string] `json:"overview,omitempty"`
+	RedirectUrl    nullable.Nullable[string] `json:"redirectUrl,omitempty"`
+	Schema         *map[string]interface{}   `json:"schema,omitempty"`
+	Scopes         []string                  `json:"scopes"`
+	VerifyInstall  *bool                     `json:"verifyInstall,omitempty"`
+	WebhookUrl     nullable.Nullable[string] `json:"webhookUrl,omitempty"`
+}
+
 // Team defines model for Team.
 type Team struct {
-	HasAccess *bool  `json:"hasAccess,omitempty"`
-	Id        string `json:"id"`
-	IsMember  *bool  `json:"isMember,omitempty"`
-	IsPending *bool  `json:"isPending,omitempty"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
+	ExternalTeams *[]ExternalActor `json:"externalTeams,omitempty"`
+	HasAccess     *bool            `json:"hasAccess,omitempty"`
+	Id            string           `json:"id"`
+	IsMember      *bool            `json:"isMember,omitempty"`
+	IsPending     *bool            `json:"isPending,omitempty"`
+	Name          string           `json:"name"`
+	Slug          string           `json:"slug"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	Email    string                    `json:"email"`
+	Id       string                    `json:"id"`
+	OrgRole  string                    `json:"orgRole"`
+	TeamRole nullable.Nullable[string] `json:"teamRole"`
+	TeamSlug string                    `json:"teamSlug"`
 }
 
 // TeamRole defines model for TeamRole.
@@ -3002,7 +3857,7 @@ type TeamRoleListItem struct {
 type UpdateOrganizationWorkflowRequest struct {
 	ActionFilters []OrganizationWorkflowActionFilter `json:"actionFilters"`
 	Config        OrganizationWorkflowConfig         `json:"config"`
-	DetectorIds   []string                           `json:"detectorIds"`
+	DetectorIds   *[]string                          `json:"detectorIds,omitempty"`
 	Enabled       bool                               `json:"enabled"`
 	Environment   nullable.Nullable[string]          `json:"environment,omitempty"`
 	Id            string                             `json:"id"`
@@ -3010,30 +3865,93 @@ type UpdateOrganizationWorkflowRequest struct {
 	Triggers      OrganizationWorkflowTrigger        `json:"triggers"`
 }
 
+// ApiTokenId defines model for api_token_id.
+type ApiTokenId = string
+
+// CodeMappingId defines model for code_mapping_id.
+type CodeMappingId = string
+
+// CodeownersId defines model for codeowners_id.
+type CodeownersId = string
+
 // Cursor defines model for cursor.
 type Cursor = string
 
+// DashboardId defines model for dashboard_id.
+type DashboardId = string
+
+// DataForwarderId defines model for data_forwarder_id.
+type DataForwarderId = string
+
 // DetectorId defines model for detector_id.
 type DetectorId = string
 
-// IntegrationId defines model for integration_id.
-type IntegrationId = string
+// Environment defines model for environment.
+type Environment = string
+
+// Expand defines model for expand.
+type Expand = []string
+
+// ExternalTeamId defines model for external_team_id.
+type ExternalTeamId = string
+
+// ExternalUserId defines model for external_user_id.
+type ExternalUserId = string
+
+// IntegrationId defines model for integration_id.
+type IntegrationId = string
 
 // MemberId defines model for member_id.
 type MemberId = string
 
+// MonitorIdOrSlug defines model for monitor_id_or_slug.
+type MonitorIdOrSlug = string
+
 // OrganizationIdOrSlug defines model for organization_id_or_slug.
 type OrganizationIdOrSlug = string
 
+// PluginId defines model for plugin_id.
+type PluginId = string
+
 // ProjectIdOrSlug defines model for project_id_or_slug.
 type ProjectIdOrSlug = string
 
+// QueryId defines model for query_id.
+type QueryId = string
+
+// ReleaseThresholdId defines model for release_threshold_id.
+type ReleaseThresholdId = string
+
+// SearchId defines model for search_id.
+type SearchId = string
+
+// SentryAppIdOrSlug defines model for sentry_app_id_or_slug.
+type SentryAppIdOrSlug = string
+
 // TeamIdOrSlug defines model for team_id_or_slug.
 type TeamIdOrSlug = string
 
+// TokenId defines model for token_id.
+type TokenId = string
+
+// ViewId defines model for view_id.
+type ViewId = string
+
 // bearerAuthContextKey is the context key for bearerAuth security scheme
 type bearerAuthContextKey string
 
+// UpdateOrganizationJSONBody defines parameters for UpdateOrganization.
+type UpdateOrganizationJSONBody struct {
+	SamplingMode     *string  `json:"samplingMode,omitempty"`
+	TargetSampleRate *float64 `json:"targetSampleRate,omitempty"`
+}
+
+// ListOrganizationDetectorWorkflowsParams defines parameters for ListOrganizationDetectorWorkflows.
+type ListOrganizationDetectorWorkflowsParams struct {
+	DetectorId *string `form:"detector_id,omitempty" json:"detector_id,omitempty"`
+	WorkflowId *string `form:"workflow_id,omitempty" json:"workflow_id,omitempty"`
+}
+
 // ListOrganizationMonitorsParams defines parameters for ListOrganizationMonitors.
 type ListOrganizationMonitorsParams struct {
 	Cursor  *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
@@ -3041,6 +3959,38 @@ type ListOrganizationMonitorsParams struct {
 	Query   *string `form:"query,omitempty" json:"query,omitempty"`
 }
 
+// BulkUpdateOrganizationMonitorsParams defines parameters for BulkUpdateOrganizationMonitors.
+type BulkUpdateOrganizationMonitorsParams struct {
+	Id      []string `form:"id" json:"id"`
+	Cursor  *Cursor  `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Project *string  `form:"project,omitempty" json:"project,omitempty"`
+	Query   *string  `form:"query,omitempty" json:"query,omitempty"`
+}
+
+// ListOrganizationDiscoverSavedQueriesParams defines parameters for ListOrganizationDiscoverSavedQueries.
+type ListOrganizationDiscoverSavedQueriesParams struct {
+	Query  *string `form:"query,omitempty" json:"query,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetOrganizationCustomDynamicSamplingRuleParams defines parameters for GetOrganizationCustomDynamicSamplingRule.
+type GetOrganizationCustomDynamicSamplingRuleParams struct {
+	Query   string    `form:"query" json:"query"`
+	Project *[]string `form:"project,omitempty" json:"project,omitempty"`
+}
+
+// CreateOrganizationCustomDynamicSamplingRuleJSONBody defines parameters for CreateOrganizationCustomDynamicSamplingRule.
+type CreateOrganizationCustomDynamicSamplingRuleJSONBody struct {
+	Period   string   `json:"period"`
+	Projects []string `json:"projects"`
+	Query    string   `json:"query"`
+}
+
+// StarOrganizationGroupSearchViewJSONBody defines parameters for StarOrganizationGroupSearchView.
+type StarOrganizationGroupSearchViewJSONBody struct {
+	Starred bool `json:"starred"`
+}
+
 // ListOrganizationIntegrationsParams defines parameters for ListOrganizationIntegrations.
 type ListOrganizationIntegrationsParams struct {
 	Cursor      *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
@@ -3055,19 +4005,48 @@ type UpdateOrganizationIntegrationJSONBody struct {
 // ListOrganizationMembersParams defines parameters for ListOrganizationMembers.
 type ListOrganizationMembersParams struct {
 	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Expand *Expand `form:"expand,omitempty" json:"expand,omitempty"`
+	Query  *string `form:"query,omitempty" json:"query,omitempty"`
 }
 
 // CreateOrganizationMemberJSONBody defines parameters for CreateOrganizationMember.
 type CreateOrganizationMemberJSONBody struct {
-	Email   string    `json:"email"`
-	OrgRole string    `json:"orgRole"`
-	Teams   *[]string `json:"teams,omitempty"`
+	Email      string      `json:"email"`
+	OrgRole    string      `json:"orgRole"`
+	Reinvite   *bool       `json:"reinvite,omitempty"`
+	SendInvite *bool       `json:"sendInvite,omitempty"`
+	TeamRoles  *[]TeamRole `json:"teamRoles,omitempty"`
+	Teams      *[]string   `json:"teams,omitempty"`
 }
 
 // UpdateOrganizationMemberJSONBody defines parameters for UpdateOrganizationMember.
 type UpdateOrganizationMemberJSONBody struct {
-	OrgRole   *string     `json:"orgRole,omitempty"`
-	TeamRoles *[]TeamRole `json:"teamRoles,omitempty"`
+	OrgRole    *string     `json:"orgRole,omitempty"`
+	Regenerate *bool       `json:"regenerate,omitempty"`
+	Reinvite   *bool       `json:"reinvite,omitempty"`
+	TeamRoles  *[]TeamRole `json:"teamRoles,omitempty"`
+}
+
+// CreateOrganizationAuthTokenJSONBody defines parameters for CreateOrganizationAuthToken.
+type CreateOrganizationAuthTokenJSONBody struct {
+	Name string `json:"name"`
+}
+
+// UpdateOrganizationAuthTokenJSONBody defines parameters for UpdateOrganizationAuthToken.
+type UpdateOrganizationAuthTokenJSONBody struct {
+	Name string `json:"name"`
+}
+
+// UnpinOrganizationSearchJSONBody defines parameters for UnpinOrganizationSearch.
+type UnpinOrganizationSearchJSONBody struct {
+	Type int64 `json:"type"`
+}
+
+// PinOrganizationSearchJSONBody defines parameters for PinOrganizationSearch.
+type PinOrganizationSearchJSONBody struct {
+	Query string  `json:"query"`
+	Sort  *string `json:"sort,omitempty"`
+	Type  int64   `json:"type"`
 }
 
 // ListOrganizationProjectsParams defines parameters for ListOrganizationProjects.
@@ -3076,6 +4055,19 @@ type ListOrganizationProjectsParams struct {
 	Options *[]string `form:"options,omitempty" json:"options,omitempty"`
 }
 
+// ListOrganizationProjectSampleRatesParams defines parameters for ListOrganizationProjectSampleRates.
+type ListOrganizationProjectSampleRatesParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// UpdateOrganizationProjectSampleRatesJSONBody defines parameters for UpdateOrganizationProjectSampleRates.
+type UpdateOrganizationProjectSampleRatesJSONBody = []ProjectSampleRate
+
+// ListOrganizationSavedSearchesParams defines parameters for ListOrganizationSavedSearches.
+type ListOrganizationSavedSearchesParams struct {
+	Type *int64 `form:"type,omitempty" json:"type,omitempty"`
+}
+
 // ListSentryAppInstallationsParams defines parameters for ListSentryAppInstallations.
 type ListSentryAppInstallationsParams struct {
 	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
@@ -3091,6 +4083,13 @@ type EnableSpikeProtectionJSONBody struct {
 	Projects []string `json:"projects"`
 }
 
+// ListOrganizationTeamsParams defines parameters for ListOrganizationTeams.
+type ListOrganizationTeamsParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Expand *Expand `form:"expand,omitempty" json:"expand,omitempty"`
+	Query  *string `form:"query,omitempty" json:"query,omitempty"`
+}
+
 // CreateOrganizationTeamJSONBody defines parameters for CreateOrganizationTeam.
 type CreateOrganizationTeamJSONBody struct {
 	Name string `json:"name"`
@@ -3101,6 +4100,11 @@ type ListOrganizationWorkflowsParams struct {
 	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// BulkUpdateOrganizationWorkflowsParams defines parameters for BulkUpdateOrganizationWorkflows.
+type BulkUpdateOrganizationWorkflowsParams struct {
+	Id []string `form:"id" json:"id"`
+}
+
 // UpdateOrganizationProjectJSONBody defines parameters for UpdateOrganizationProject.
 type UpdateOrganizationProjectJSONBody struct {
 	AllowedDomains       *[]string               `json:"allowedDomains,omitempty"`
@@ -3121,6 +4125,24 @@ type UpdateOrganizationProjectJSONBody struct {
 	VerifySSL            *bool                   `json:"verifySSL,omitempty"`
 }
 
+// ListProjectCodeOwnersParams defines parameters for ListProjectCodeOwners.
+type ListProjectCodeOwnersParams struct {
+	Expand *Expand `form:"expand,omitempty" json:"expand,omitempty"`
+}
+
+// ListProjectEnvironmentsParams defines parameters for ListProjectEnvironments.
+type ListProjectEnvironmentsParams struct {
+	Visibility *ListProjectEnvironmentsParamsVisibility `form:"visibility,omitempty" json:"visibility,omitempty"`
+}
+
+// ListProjectEnvironmentsParamsVisibility defines parameters for ListProjectEnvironments.
+type ListProjectEnvironmentsParamsVisibility string
+
+// UpdateProjectEnvironmentJSONBody defines parameters for UpdateProjectEnvironment.
+type UpdateProjectEnvironmentJSONBody struct {
+	IsHidden bool `json:"isHidden"`
+}
+
 // ListProjectClientKeysParams defines parameters for ListProjectClientKeys.
 type ListProjectClientKeysParams struct {
 	Cursor *Cursor                            `form:"cursor,omitempty" json:"cursor,omitempty"`
@@ -3168,6 +4190,14 @@ type UpdateProjectOwnershipJSONBody struct {
 	Raw                string `json:"raw"`
 }
 
+// EnableProjectPluginJSONBody defines parameters for EnableProjectPlugin.
+type EnableProjectPluginJSONBody struct {
+	Reset *bool `json:"reset,omitempty"`
+}
+
+// UpdateProjectPluginJSONBody defines parameters for UpdateProjectPlugin.
+type UpdateProjectPluginJSONBody map[string]string
+
 // CreateProjectRuleJSONBody defines parameters for CreateProjectRule.
 type CreateProjectRuleJSONBody struct {
 	ActionMatch string                 `json:"actionMatch"`
@@ -3196,6 +4226,16 @@ type UpdateProjectRuleJSONBody struct {
 	Projects    []string               `json:"projects"`
 }
 
+// ListProjectTagKeyValuesParams defines parameters for ListProjectTagKeyValues.
+type ListProjectTagKeyValuesParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListTeamMembersParams defines parameters for ListTeamMembers.
+type ListTeamMembersParams struct {
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
 // CreateOrganizationTeamProjectJSONBody defines parameters for CreateOrganizationTeamProject.
 type CreateOrganizationTeamProjectJSONBody struct {
 	DefaultRules *bool   `json:"default_rules,omitempty"`
@@ -3204,9 +4244,54 @@ type CreateOrganizationTeamProjectJSONBody struct {
 	Slug         *string `json:"slug,omitempty"`
 }
 
+// UpdateOrganizationJSONRequestBody defines body for UpdateOrganization for application/json ContentType.
+type UpdateOrganizationJSONRequestBody UpdateOrganizationJSONBody
+
+// CreateOrganizationDashboardJSONRequestBody defines body for CreateOrganizationDashboard for application/json ContentType.
+type CreateOrganizationDashboardJSONRequestBody = DashboardRequest
+
+// UpdateOrganizationDashboardJSONRequestBody defines body for UpdateOrganizationDashboard for application/json ContentType.
+type UpdateOrganizationDashboardJSONRequestBody = DashboardRequest
+
+// CreateOrganizationDetectorWorkflowJSONRequestBody defines body for CreateOrganizationDetectorWorkflow for application/json ContentType.
+type CreateOrganizationDetectorWorkflowJSONRequestBody = OrganizationDetectorWorkflowRequest
+
+// BulkUpdateOrganizationMonitorsJSONRequestBody defines body for BulkUpdateOrganizationMonitors for application/json ContentType.
+type BulkUpdateOrganizationMonitorsJSONRequestBody = BulkEnabledRequest
+
 // UpdateProjectMonitorJSONRequestBody defines body for UpdateProjectMonitor for application/json ContentType.
 type UpdateProjectMonitorJSONRequestBody = ProjectMonitorRequest
 
+// CreateOrganizationDiscoverSavedQueryJSONRequestBody defines body for CreateOrganizationDiscoverSavedQuery for application/json ContentType.
+type CreateOrganizationDiscoverSavedQueryJSONRequestBody = DiscoverSavedQueryRequest
+
+// UpdateOrganizationDiscoverSavedQueryJSONRequestBody defines body for UpdateOrganizationDiscoverSavedQuery for application/json ContentType.
+type UpdateOrganizationDiscoverSavedQueryJSONRequestBody = DiscoverSavedQueryRequest
+
+// CreateOrganizationCustomDynamicSamplingRuleJSONRequestBody defines body for CreateOrganizationCustomDynamicSamplingRule for application/json ContentType.
+type CreateOrganizationCustomDynamicSamplingRuleJSONRequestBody CreateOrganizationCustomDynamicSamplingRuleJSONBody
+
+// CreateExternalUserJSONRequestBody defines body for CreateExternalUser for application/json ContentType.
+type CreateExternalUserJSONRequestBody = ExternalUserRequest
+
+// UpdateExternalUserJSONRequestBody defines body for UpdateExternalUser for application/json ContentType.
+type UpdateExternalUserJSONRequestBody = ExternalUserRequest
+
+// CreateOrganizationDataForwarderJSONRequestBody defines body for CreateOrganizationDataForwarder for application/json ContentType.
+type CreateOrganizationDataForwarderJSONRequestBody = DataForwarderRequest
+
+// UpdateOrganizationDataForwarderJSONRequestBody defines body for UpdateOrganizationDataForwarder for application/json ContentType.
+type UpdateOrganizationDataForwarderJSONRequestBody = DataForwarderRequest
+
+// CreateOrganizationGroupSearchViewJSONRequestBody defines body for CreateOrganizationGroupSearchView for application/json ContentType.
+type CreateOrganizationGroupSearchViewJSONRequestBody = GroupSearchViewRequest
+
+// UpdateOrganizationGroupSearchViewJSONRequestBody defines body for UpdateOrganizationGroupSearchView for application/json ContentType.
+type UpdateOrganizationGroupSearchViewJSONRequestBody = GroupSearchViewRequest
+
+// StarOrganizationGroupSearchViewJSONRequestBody defines body for StarOrganizationGroupSearchView for application/json ContentType.
+type StarOrganizationGroupSearchViewJSONRequestBody StarOrganizationGroupSearchViewJSONBody
+
 // UpdateOrganizationIntegrationJSONRequestBody defines body for UpdateOrganizationIntegration for application/json ContentType.
 type UpdateOrganizationIntegrationJSONRequestBody UpdateOrganizationIntegrationJSONBody
 
@@ -3216,9 +4301,30 @@ type CreateOrganizationMemberJSONRequestBody CreateOrganizationMemberJSONBody
 // UpdateOrganizationMemberJSONRequestBody defines body for UpdateOrganizationMember for application/json ContentType.
 type UpdateOrganizationMemberJSONRequestBody UpdateOrganizationMemberJSONBody
 
+// CreateOrganizationAuthTokenJSONRequestBody defines body for CreateOrganizationAuthToken for application/json ContentType.
+type CreateOrganizationAuthTokenJSONRequestBody CreateOrganizationAuthTokenJSONBody
+
+// UpdateOrganizationAuthTokenJSONRequestBody defines body for UpdateOrganizationAuthToken for application/json ContentType.
+type UpdateOrganizationAuthTokenJSONRequestBody UpdateOrganizationAuthTokenJSONBody
+
+// UnpinOrganizationSearchJSONRequestBody defines body for UnpinOrganizationSearch for application/json ContentType.
+type UnpinOrganizationSearchJSONRequestBody UnpinOrganizationSearchJSONBody
+
+// PinOrganizationSearchJSONRequestBody defines body for PinOrganizationSearch for application/json ContentType.
+type PinOrganizationSearchJSONRequestBody PinOrganizationSearchJSONBody
+
 // CreateProjectMonitorJSONRequestBody defines body for CreateProjectMonitor for application/json ContentType.
 type CreateProjectMonitorJSONRequestBody = ProjectMonitorRequest
 
+// UpdateOrganizationProjectSampleRatesJSONRequestBody defines body for UpdateOrganizationProjectSampleRates for application/json ContentType.
+type UpdateOrganizationProjectSampleRatesJSONRequestBody = UpdateOrganizationProjectSampleRatesJSONBody
+
+// CreateOrganizationSavedSearchJSONRequestBody defines body for CreateOrganizationSavedSearch for application/json ContentType.
+type CreateOrganizationSavedSearchJSONRequestBody = SavedSearchRequest
+
+// UpdateOrganizationSavedSearchJSONRequestBody defines body for UpdateOrganizationSavedSearch for application/json ContentType.
+type UpdateOrganizationSavedSearchJSONRequestBody = SavedSearchRequest
+
 // DisableSpikeProtectionJSONRequestBody defines body for DisableSpikeProtection for application/json ContentType.
 type DisableSpikeProtectionJSONRequestBody DisableSpikeProtectionJSONBody
 
@@ -3231,27 +4337,78 @@ type CreateOrganizationTeamJSONRequestBody CreateOrganizationTeamJSONBody
 // CreateOrganizationWorkflowJSONRequestBody defines body for CreateOrganizationWorkflow for application/json ContentType.
 type CreateOrganizationWorkflowJSONRequestBody = OrganizationWorkflowRequest
 
+// BulkUpdateOrganizationWorkflowsJSONRequestBody defines body for BulkUpdateOrganizationWorkflows for application/json ContentType.
+type BulkUpdateOrganizationWorkflowsJSONRequestBody = BulkEnabledRequest
+
 // UpdateOrganizationWorkflowJSONRequestBody defines body for UpdateOrganizationWorkflow for application/json ContentType.
 type UpdateOrganizationWorkflowJSONRequestBody = UpdateOrganizationWorkflowRequest
 
 // UpdateOrganizationProjectJSONRequestBody defines body for UpdateOrganizationProject for application/json ContentType.
 type UpdateOrganizationProjectJSONRequestBody UpdateOrganizationProjectJSONBody
 
+// CreateProjectCodeOwnersJSONRequestBody defines body for CreateProjectCodeOwners for application/json ContentType.
+type CreateProjectCodeOwnersJSONRequestBody = ProjectCodeOwnersRequest
+
+// UpdateProjectCodeOwnersJSONRequestBody defines body for UpdateProjectCodeOwners for application/json ContentType.
+type UpdateProjectCodeOwnersJSONRequestBody = ProjectCodeOwnersRequest
+
+// UpdateProjectEnvironmentJSONRequestBody defines body for UpdateProjectEnvironment for application/json ContentType.
+type UpdateProjectEnvironmentJSONRequestBody UpdateProjectEnvironmentJSONBody
+
 // CreateProjectClientKeyJSONRequestBody defines body for CreateProjectClientKey for application/json ContentType.
 type CreateProjectClientKeyJSONRequestBody CreateProjectClientKeyJSONBody
 
 // UpdateProjectClientKeyJSONRequestBody defines body for UpdateProjectClientKey for application/json ContentType.
 type UpdateProjectClientKeyJSONRequestBody UpdateProjectClientKeyJSONBody
 
+// UpdateProjectCronMonitorMuteJSONRequestBody defines body for UpdateProjectCronMonitorMute for application/json ContentType.
+type UpdateProjectCronMonitorMuteJSONRequestBody = CronMonitorMuteRequest
+
+// UpdateProjectCronMonitorEnvironmentMuteJSONRequestBody defines body for UpdateProjectCronMonitorEnvironmentMute for application/json ContentType.
+type UpdateProjectCronMonitorEnvironmentMuteJSONRequestBody = CronMonitorMuteRequest
+
 // UpdateProjectOwnershipJSONRequestBody defines body for UpdateProjectOwnership for application/json ContentType.
 type UpdateProjectOwnershipJSONRequestBody UpdateProjectOwnershipJSONBody
 
+// UpdateProjectPerformanceIssueSettingsJSONRequestBody defines body for UpdateProjectPerformanceIssueSettings for application/json ContentType.
+type UpdateProjectPerformanceIssueSettingsJSONRequestBody = ProjectPerformanceIssueSettings
+
+// EnableProjectPluginJSONRequestBody defines body for EnableProjectPlugin for application/json ContentType.
+type EnableProjectPluginJSONRequestBody EnableProjectPluginJSONBody
+
+// UpdateProjectPluginJSONRequestBody defines body for UpdateProjectPlugin for application/json ContentType.
+type UpdateProjectPluginJSONRequestBody UpdateProjectPluginJSONBody
+
+// CreateProjectReleaseThresholdJSONRequestBody defines body for CreateProjectReleaseThreshold for application/json ContentType.
+type CreateProjectReleaseThresholdJSONRequestBody = ReleaseThresholdRequest
+
+// UpdateProjectReleaseThresholdJSONRequestBody defines body for UpdateProjectReleaseThreshold for application/json ContentType.
+type UpdateProjectReleaseThresholdJSONRequestBody = ReleaseThresholdRequest
+
 // CreateProjectRuleJSONRequestBody defines body for CreateProjectRule for application/json ContentType.
 type CreateProjectRuleJSONRequestBody CreateProjectRuleJSONBody
 
 // UpdateProjectRuleJSONRequestBody defines body for UpdateProjectRule for application/json ContentType.
 type UpdateProjectRuleJSONRequestBody UpdateProjectRuleJSONBody
 
+// DeleteProjectRuleSnoozeJSONRequestBody defines body for DeleteProjectRuleSnooze for application/json ContentType.
+type DeleteProjectRuleSnoozeJSONRequestBody = RuleSnoozeDeleteRequest
+
+// CreateProjectRuleSnoozeJSONRequestBody defines body for CreateProjectRuleSnooze for application/json ContentType.
+type CreateProjectRuleSnoozeJSONRequestBody = RuleSnoozeRequest
+
+// CreateSentryAppJSONRequestBody defines body for CreateSentryApp for application/json ContentType.
+type CreateSentryAppJSONRequestBody = SentryAppRequest
+
+// UpdateSentryAppJSONRequestBody defines body for UpdateSentryApp for application/json ContentType.
+type UpdateSentryAppJSONRequestBody = SentryAppRequest
+
+// CreateExternalTeamJSONRequestBody defines body for CreateExternalTeam for application/json ContentType.
+type CreateExternalTeamJSONRequestBody = ExternalTeamRequest
+
+// UpdateExternalTeamJSONRequestBody defines body for UpdateExternalTeam for application/json ContentType.
+type UpdateExternalTeamJSONRequestBody = ExternalTeamRequest
+
 // CreateOrganizationTeamProjectJSONRequestBody defines body for CreateOrganizationTeamProject for application/json ContentType.
 type CreateOrganizationTeamProjectJSONRequestBody CreateOrganizationTeamProjectJSONBody
 
@@ -4515,85 +5672,52 @@ func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowAct
 	return err
 }
 
-func (t OrganizationWorkflowActionFilterCondition) Discriminator() (string, error) {
-	var discriminator struct {
-		Discriminator string `json:"type"`
-	}
-	err := json.Unmarshal(t.union, &discriminator)
-	return discriminator.Discriminator, err
+// AsOrganizationWorkflowActionFilterConditionIssueOpenDuration returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionIssueOpenDuration
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionIssueOpenDuration() (OrganizationWorkflowActionFilterConditionIssueOpenDuration, error) {
+	var body OrganizationWorkflowActionFilterConditionIssueOpenDuration
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (t OrganizationWorkflowActionFilterCondition) ValueByDiscriminator() (interface{}, error) {
-	discriminator, err := t.Discriminator()
-	if err != nil {
-		return nil, err
-	}
-	switch discriminator {
-	case "age_comparison":
-		return t.AsOrganizationWorkflowActionFilterConditionAgeComparison()
-	case "assigned_to":
-		return t.AsOrganizationWorkflowActionFilterConditionAssignedTo()
-	case "event_attribute":
-		return t.AsOrganizationWorkflowActionFilterConditionEventAttribute()
-	case "event_frequency_count":
-		return t.AsOrganizationWorkflowActionFilterConditionEventFrequencyCount()
-	case "event_frequency_percent":
-		return t.AsOrganizationWorkflowActionFilterConditionEventFrequencyPercent()
-	case "event_unique_user_frequency_count":
-		return t.AsOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount()
-	case "issue_category":
-		return t.AsOrganizationWorkflowActionFilterConditionIssueCategory()
-	case "issue_occurrences":
-		return t.AsOrganizationWorkflowActionFilterConditionIssueOccurrences()
-	case "issue_priority_deescalating":
-		return t.AsOrganizationWorkflowActionFilterConditionIssuePriorityDeescalating()
-	case "issue_priority_greater_or_equal":
-		return t.AsOrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual()
-	case "issue_type":
-		return t.AsOrganizationWorkflowActionFilterConditionIssueType()
-	case "latest_adopted_release":
-		return t.AsOrganizationWorkflowActionFilterConditionLatestAdoptedRelease()
-	case "latest_release":
-		return t.AsOrganizationWorkflowActionFilterConditionLatestRelease()
-	case "level":
-		return t.AsOrganizationWorkflowActionFilterConditionLevel()
-	case "percent_sessions_count":
-		return t.AsOrganizationWorkflowActionFilterConditionPercentSessionsCount()
-	case "percent_sessions_percent":
-		return t.AsOrganizationWorkflowActionFilterConditionPercentSessionsPercent()
-	case "tagged_event":
-		return t.AsOrganizationWorkflowActionFilterConditionTaggedEvent()
-	default:
-		return nil, errors.New("unknown discriminator value: " + discriminator)
-	}
+// FromOrganizationWorkflowActionFilterConditionIssueOpenDuration overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionIssueOpenDuration
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionIssueOpenDuration(v OrganizationWorkflowActionFilterConditionIssueOpenDuration) error {
+	v.Type = "issue_open_duration"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-func (t OrganizationWorkflowActionFilterCondition) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
+// MergeOrganizationWorkflowActionFilterConditionIssueOpenDuration performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionIssueOpenDuration
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionIssueOpenDuration(v OrganizationWorkflowActionFilterConditionIssueOpenDuration) error {
+	v.Type = "issue_open_duration"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-func (t *OrganizationWorkflowActionFilterCondition) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
 	return err
 }
 
-// AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 returns the union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as a OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
-func (t OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0() (OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0, error) {
-	var body OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
+// AsOrganizationWorkflowActionFilterConditionIssuePriorityEquals returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionIssuePriorityEquals
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionIssuePriorityEquals() (OrganizationWorkflowActionFilterConditionIssuePriorityEquals, error) {
+	var body OrganizationWorkflowActionFilterConditionIssuePriorityEquals
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 overwrites any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
-func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0) error {
+// FromOrganizationWorkflowActionFilterConditionIssuePriorityEquals overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionIssuePriorityEquals
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionIssuePriorityEquals(v OrganizationWorkflowActionFilterConditionIssuePriorityEquals) error {
+	v.Type = "issue_priority_equals"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 performs a merge with any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier, using the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
-func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0) error {
+// MergeOrganizationWorkflowActionFilterConditionIssuePriorityEquals performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionIssuePriorityEquals
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionIssuePriorityEquals(v OrganizationWorkflowActionFilterConditionIssuePriorityEquals) error {
+	v.Type = "issue_priority_equals"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4604,22 +5728,24 @@ func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetId
 	return err
 }
 
-// AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 returns the union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as a OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
-func (t OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1() (OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1, error) {
-	var body OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
+// AsOrganizationWorkflowActionFilterConditionIssueResolutionChange returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionIssueResolutionChange
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionIssueResolutionChange() (OrganizationWorkflowActionFilterConditionIssueResolutionChange, error) {
+	var body OrganizationWorkflowActionFilterConditionIssueResolutionChange
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 overwrites any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
-func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1) error {
+// FromOrganizationWorkflowActionFilterConditionIssueResolutionChange overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionIssueResolutionChange
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionIssueResolutionChange(v OrganizationWorkflowActionFilterConditionIssueResolutionChange) error {
+	v.Type = "issue_resolution_change"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 performs a merge with any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier, using the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
-func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1) error {
+// MergeOrganizationWorkflowActionFilterConditionIssueResolutionChange performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionIssueResolutionChange
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionIssueResolutionChange(v OrganizationWorkflowActionFilterConditionIssueResolutionChange) error {
+	v.Type = "issue_resolution_change"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4630,32 +5756,24 @@ func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetId
 	return err
 }
 
-func (t OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
-
-func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
-
-// AsOrganizationWorkflowTriggerConditionComparison0 returns the union data inside the OrganizationWorkflowTriggerCondition_Comparison as a OrganizationWorkflowTriggerConditionComparison0
-func (t OrganizationWorkflowTriggerCondition_Comparison) AsOrganizationWorkflowTriggerConditionComparison0() (OrganizationWorkflowTriggerConditionComparison0, error) {
-	var body OrganizationWorkflowTriggerConditionComparison0
+// AsOrganizationWorkflowActionFilterConditionEventSeenCount returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionEventSeenCount
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionEventSeenCount() (OrganizationWorkflowActionFilterConditionEventSeenCount, error) {
+	var body OrganizationWorkflowActionFilterConditionEventSeenCount
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromOrganizationWorkflowTriggerConditionComparison0 overwrites any union data inside the OrganizationWorkflowTriggerCondition_Comparison as the provided OrganizationWorkflowTriggerConditionComparison0
-func (t *OrganizationWorkflowTriggerCondition_Comparison) FromOrganizationWorkflowTriggerConditionComparison0(v OrganizationWorkflowTriggerConditionComparison0) error {
+// FromOrganizationWorkflowActionFilterConditionEventSeenCount overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionEventSeenCount
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionEventSeenCount(v OrganizationWorkflowActionFilterConditionEventSeenCount) error {
+	v.Type = "event_seen_count"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeOrganizationWorkflowTriggerConditionComparison0 performs a merge with any union data inside the OrganizationWorkflowTriggerCondition_Comparison, using the provided OrganizationWorkflowTriggerConditionComparison0
-func (t *OrganizationWorkflowTriggerCondition_Comparison) MergeOrganizationWorkflowTriggerConditionComparison0(v OrganizationWorkflowTriggerConditionComparison0) error {
+// MergeOrganizationWorkflowActionFilterConditionEventSeenCount performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionEventSeenCount
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionEventSeenCount(v OrganizationWorkflowActionFilterConditionEventSeenCount) error {
+	v.Type = "event_seen_count"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4666,22 +5784,24 @@ func (t *OrganizationWorkflowTriggerCondition_Comparison) MergeOrganizationWorkf
 	return err
 }
 
-// AsOrganizationWorkflowTriggerConditionComparison1 returns the union data inside the OrganizationWorkflowTriggerCondition_Comparison as a OrganizationWorkflowTriggerConditionComparison1
-func (t OrganizationWorkflowTriggerCondition_Comparison) AsOrganizationWorkflowTriggerConditionComparison1() (OrganizationWorkflowTriggerConditionComparison1, error) {
-	var body OrganizationWorkflowTriggerConditionComparison1
+// AsOrganizationWorkflowActionFilterConditionExistingHighPriorityIssue returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionExistingHighPriorityIssue() (OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue, error) {
+	var body OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromOrganizationWorkflowTriggerConditionComparison1 overwrites any union data inside the OrganizationWorkflowTriggerCondition_Comparison as the provided OrganizationWorkflowTriggerConditionComparison1
-func (t *OrganizationWorkflowTriggerCondition_Comparison) FromOrganizationWorkflowTriggerConditionComparison1(v OrganizationWorkflowTriggerConditionComparison1) error {
+// FromOrganizationWorkflowActionFilterConditionExistingHighPriorityIssue overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionExistingHighPriorityIssue(v OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue) error {
+	v.Type = "existing_high_priority_issue"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeOrganizationWorkflowTriggerConditionComparison1 performs a merge with any union data inside the OrganizationWorkflowTriggerCondition_Comparison, using the provided OrganizationWorkflowTriggerConditionComparison1
-func (t *OrganizationWorkflowTriggerCondition_Comparison) MergeOrganizationWorkflowTriggerConditionComparison1(v OrganizationWorkflowTriggerConditionComparison1) error {
+// MergeOrganizationWorkflowActionFilterConditionExistingHighPriorityIssue performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionExistingHighPriorityIssue(v OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue) error {
+	v.Type = "existing_high_priority_issue"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4692,34 +5812,52 @@ func (t *OrganizationWorkflowTriggerCondition_Comparison) MergeOrganizationWorkf
 	return err
 }
 
-func (t OrganizationWorkflowTriggerCondition_Comparison) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// AsOrganizationWorkflowActionFilterConditionNewHighPriorityIssue returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionNewHighPriorityIssue
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionNewHighPriorityIssue() (OrganizationWorkflowActionFilterConditionNewHighPriorityIssue, error) {
+	var body OrganizationWorkflowActionFilterConditionNewHighPriorityIssue
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (t *OrganizationWorkflowTriggerCondition_Comparison) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+// FromOrganizationWorkflowActionFilterConditionNewHighPriorityIssue overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionNewHighPriorityIssue
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionNewHighPriorityIssue(v OrganizationWorkflowActionFilterConditionNewHighPriorityIssue) error {
+	v.Type = "new_high_priority_issue"
+	b, err := json.Marshal(v)
+	t.union = b
 	return err
 }
 
-// AsProjectMonitorRequestMetricIssue returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestMetricIssue
-func (t ProjectMonitorRequest) AsProjectMonitorRequestMetricIssue() (ProjectMonitorRequestMetricIssue, error) {
-	var body ProjectMonitorRequestMetricIssue
+// MergeOrganizationWorkflowActionFilterConditionNewHighPriorityIssue performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionNewHighPriorityIssue
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionNewHighPriorityIssue(v OrganizationWorkflowActionFilterConditionNewHighPriorityIssue) error {
+	v.Type = "new_high_priority_issue"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOrganizationWorkflowActionFilterConditionEveryEvent returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionEveryEvent
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionEveryEvent() (OrganizationWorkflowActionFilterConditionEveryEvent, error) {
+	var body OrganizationWorkflowActionFilterConditionEveryEvent
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorRequestMetricIssue overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestMetricIssue
-func (t *ProjectMonitorRequest) FromProjectMonitorRequestMetricIssue(v ProjectMonitorRequestMetricIssue) error {
-	v.Type = "metric_issue"
+// FromOrganizationWorkflowActionFilterConditionEveryEvent overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionEveryEvent
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionEveryEvent(v OrganizationWorkflowActionFilterConditionEveryEvent) error {
+	v.Type = "every_event"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorRequestMetricIssue performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestMetricIssue
-func (t *ProjectMonitorRequest) MergeProjectMonitorRequestMetricIssue(v ProjectMonitorRequestMetricIssue) error {
-	v.Type = "metric_issue"
+// MergeOrganizationWorkflowActionFilterConditionEveryEvent performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionEveryEvent
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionEveryEvent(v OrganizationWorkflowActionFilterConditionEveryEvent) error {
+	v.Type = "every_event"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4730,24 +5868,24 @@ func (t *ProjectMonitorRequest) MergeProjectMonitorRequestMetricIssue(v ProjectM
 	return err
 }
 
-// AsProjectMonitorRequestMonitorCheckInFailure returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestMonitorCheckInFailure
-func (t ProjectMonitorRequest) AsProjectMonitorRequestMonitorCheckInFailure() (ProjectMonitorRequestMonitorCheckInFailure, error) {
-	var body ProjectMonitorRequestMonitorCheckInFailure
+// AsOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent() (OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent, error) {
+	var body OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorRequestMonitorCheckInFailure overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestMonitorCheckInFailure
-func (t *ProjectMonitorRequest) FromProjectMonitorRequestMonitorCheckInFailure(v ProjectMonitorRequestMonitorCheckInFailure) error {
-	v.Type = "monitor_check_in_failure"
+// FromOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent(v OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent) error {
+	v.Type = "event_unique_user_frequency_percent"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorRequestMonitorCheckInFailure performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestMonitorCheckInFailure
-func (t *ProjectMonitorRequest) MergeProjectMonitorRequestMonitorCheckInFailure(v ProjectMonitorRequestMonitorCheckInFailure) error {
-	v.Type = "monitor_check_in_failure"
+// MergeOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent(v OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent) error {
+	v.Type = "event_unique_user_frequency_percent"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4758,24 +5896,24 @@ func (t *ProjectMonitorRequest) MergeProjectMonitorRequestMonitorCheckInFailure(
 	return err
 }
 
-// AsProjectMonitorRequestUptimeDomainFailure returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestUptimeDomainFailure
-func (t ProjectMonitorRequest) AsProjectMonitorRequestUptimeDomainFailure() (ProjectMonitorRequestUptimeDomainFailure, error) {
-	var body ProjectMonitorRequestUptimeDomainFailure
+// AsOrganizationWorkflowActionFilterConditionEventCreatedByDetector returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionEventCreatedByDetector
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionEventCreatedByDetector() (OrganizationWorkflowActionFilterConditionEventCreatedByDetector, error) {
+	var body OrganizationWorkflowActionFilterConditionEventCreatedByDetector
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorRequestUptimeDomainFailure overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestUptimeDomainFailure
-func (t *ProjectMonitorRequest) FromProjectMonitorRequestUptimeDomainFailure(v ProjectMonitorRequestUptimeDomainFailure) error {
-	v.Type = "uptime_domain_failure"
+// FromOrganizationWorkflowActionFilterConditionEventCreatedByDetector overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionEventCreatedByDetector
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionEventCreatedByDetector(v OrganizationWorkflowActionFilterConditionEventCreatedByDetector) error {
+	v.Type = "event_created_by_detector"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorRequestUptimeDomainFailure performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestUptimeDomainFailure
-func (t *ProjectMonitorRequest) MergeProjectMonitorRequestUptimeDomainFailure(v ProjectMonitorRequestUptimeDomainFailure) error {
-	v.Type = "uptime_domain_failure"
+// MergeOrganizationWorkflowActionFilterConditionEventCreatedByDetector performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionEventCreatedByDetector
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionEventCreatedByDetector(v OrganizationWorkflowActionFilterConditionEventCreatedByDetector) error {
+	v.Type = "event_created_by_detector"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4786,7 +5924,35 @@ func (t *ProjectMonitorRequest) MergeProjectMonitorRequestUptimeDomainFailure(v
 	return err
 }
 
-func (t ProjectMonitorRequest) Discriminator() (string, error) {
+// AsOrganizationWorkflowActionFilterConditionSeerActivityTrigger returns the union data inside the OrganizationWorkflowActionFilterCondition as a OrganizationWorkflowActionFilterConditionSeerActivityTrigger
+func (t OrganizationWorkflowActionFilterCondition) AsOrganizationWorkflowActionFilterConditionSeerActivityTrigger() (OrganizationWorkflowActionFilterConditionSeerActivityTrigger, error) {
+	var body OrganizationWorkflowActionFilterConditionSeerActivityTrigger
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOrganizationWorkflowActionFilterConditionSeerActivityTrigger overwrites any union data inside the OrganizationWorkflowActionFilterCondition as the provided OrganizationWorkflowActionFilterConditionSeerActivityTrigger
+func (t *OrganizationWorkflowActionFilterCondition) FromOrganizationWorkflowActionFilterConditionSeerActivityTrigger(v OrganizationWorkflowActionFilterConditionSeerActivityTrigger) error {
+	v.Type = "seer_activity_trigger"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOrganizationWorkflowActionFilterConditionSeerActivityTrigger performs a merge with any union data inside the OrganizationWorkflowActionFilterCondition, using the provided OrganizationWorkflowActionFilterConditionSeerActivityTrigger
+func (t *OrganizationWorkflowActionFilterCondition) MergeOrganizationWorkflowActionFilterConditionSeerActivityTrigger(v OrganizationWorkflowActionFilterConditionSeerActivityTrigger) error {
+	v.Type = "seer_activity_trigger"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OrganizationWorkflowActionFilterCondition) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"type"`
 	}
@@ -4794,49 +5960,97 @@ func (t ProjectMonitorRequest) Discriminator() (string, error) {
 	return discriminator.Discriminator, err
 }
 
-func (t ProjectMonitorRequest) ValueByDiscriminator() (interface{}, error) {
+func (t OrganizationWorkflowActionFilterCondition) ValueByDiscriminator() (interface{}, error) {
 	discriminator, err := t.Discriminator()
 	if err != nil {
 		return nil, err
 	}
 	switch discriminator {
-	case "metric_issue":
-		return t.AsProjectMonitorRequestMetricIssue()
-	case "monitor_check_in_failure":
-		return t.AsProjectMonitorRequestMonitorCheckInFailure()
-	case "uptime_domain_failure":
-		return t.AsProjectMonitorRequestUptimeDomainFailure()
+	case "age_comparison":
+		return t.AsOrganizationWorkflowActionFilterConditionAgeComparison()
+	case "assigned_to":
+		return t.AsOrganizationWorkflowActionFilterConditionAssignedTo()
+	case "event_attribute":
+		return t.AsOrganizationWorkflowActionFilterConditionEventAttribute()
+	case "event_created_by_detector":
+		return t.AsOrganizationWorkflowActionFilterConditionEventCreatedByDetector()
+	case "event_frequency_count":
+		return t.AsOrganizationWorkflowActionFilterConditionEventFrequencyCount()
+	case "event_frequency_percent":
+		return t.AsOrganizationWorkflowActionFilterConditionEventFrequencyPercent()
+	case "event_seen_count":
+		return t.AsOrganizationWorkflowActionFilterConditionEventSeenCount()
+	case "event_unique_user_frequency_count":
+		return t.AsOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCount()
+	case "event_unique_user_frequency_percent":
+		return t.AsOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent()
+	case "every_event":
+		return t.AsOrganizationWorkflowActionFilterConditionEveryEvent()
+	case "existing_high_priority_issue":
+		return t.AsOrganizationWorkflowActionFilterConditionExistingHighPriorityIssue()
+	case "issue_category":
+		return t.AsOrganizationWorkflowActionFilterConditionIssueCategory()
+	case "issue_occurrences":
+		return t.AsOrganizationWorkflowActionFilterConditionIssueOccurrences()
+	case "issue_open_duration":
+		return t.AsOrganizationWorkflowActionFilterConditionIssueOpenDuration()
+	case "issue_priority_deescalating":
+		return t.AsOrganizationWorkflowActionFilterConditionIssuePriorityDeescalating()
+	case "issue_priority_equals":
+		return t.AsOrganizationWorkflowActionFilterConditionIssuePriorityEquals()
+	case "issue_priority_greater_or_equal":
+		return t.AsOrganizationWorkflowActionFilterConditionIssuePriorityGreaterOrEqual()
+	case "issue_resolution_change":
+		return t.AsOrganizationWorkflowActionFilterConditionIssueResolutionChange()
+	case "issue_type":
+		return t.AsOrganizationWorkflowActionFilterConditionIssueType()
+	case "latest_adopted_release":
+		return t.AsOrganizationWorkflowActionFilterConditionLatestAdoptedRelease()
+	case "latest_release":
+		return t.AsOrganizationWorkflowActionFilterConditionLatestRelease()
+	case "level":
+		return t.AsOrganizationWorkflowActionFilterConditionLevel()
+	case "new_high_priority_issue":
+		return t.AsOrganizationWorkflowActionFilterConditionNewHighPriorityIssue()
+	case "percent_sessions_count":
+		return t.AsOrganizationWorkflowActionFilterConditionPercentSessionsCount()
+	case "percent_sessions_percent":
+		return t.AsOrganizationWorkflowActionFilterConditionPercentSessionsPercent()
+	case "seer_activity_trigger":
+		return t.AsOrganizationWorkflowActionFilterConditionSeerActivityTrigger()
+	case "tagged_event":
+		return t.AsOrganizationWorkflowActionFilterConditionTaggedEvent()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
 }
 
-func (t ProjectMonitorRequest) MarshalJSON() ([]byte, error) {
+func (t OrganizationWorkflowActionFilterCondition) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *ProjectMonitorRequest) UnmarshalJSON(b []byte) error {
+func (t *OrganizationWorkflowActionFilterCondition) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// AsProjectMonitorConditionGroupConditionComparison0 returns the union data inside the ProjectMonitorConditionGroupCondition_Comparison as a ProjectMonitorConditionGroupConditionComparison0
-func (t ProjectMonitorConditionGroupCondition_Comparison) AsProjectMonitorConditionGroupConditionComparison0() (ProjectMonitorConditionGroupConditionComparison0, error) {
-	var body ProjectMonitorConditionGroupConditionComparison0
+// AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 returns the union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as a OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
+func (t OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0() (OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0, error) {
+	var body OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorConditionGroupConditionComparison0 overwrites any union data inside the ProjectMonitorConditionGroupCondition_Comparison as the provided ProjectMonitorConditionGroupConditionComparison0
-func (t *ProjectMonitorConditionGroupCondition_Comparison) FromProjectMonitorConditionGroupConditionComparison0(v ProjectMonitorConditionGroupConditionComparison0) error {
+// FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 overwrites any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
+func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorConditionGroupConditionComparison0 performs a merge with any union data inside the ProjectMonitorConditionGroupCondition_Comparison, using the provided ProjectMonitorConditionGroupConditionComparison0
-func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorConditionGroupConditionComparison0(v ProjectMonitorConditionGroupConditionComparison0) error {
+// MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0 performs a merge with any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier, using the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0
+func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier0) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4847,22 +6061,22 @@ func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorCo
 	return err
 }
 
-// AsProjectMonitorConditionGroupConditionComparison1 returns the union data inside the ProjectMonitorConditionGroupCondition_Comparison as a ProjectMonitorConditionGroupConditionComparison1
-func (t ProjectMonitorConditionGroupCondition_Comparison) AsProjectMonitorConditionGroupConditionComparison1() (ProjectMonitorConditionGroupConditionComparison1, error) {
-	var body ProjectMonitorConditionGroupConditionComparison1
+// AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 returns the union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as a OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
+func (t OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) AsOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1() (OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1, error) {
+	var body OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorConditionGroupConditionComparison1 overwrites any union data inside the ProjectMonitorConditionGroupCondition_Comparison as the provided ProjectMonitorConditionGroupConditionComparison1
-func (t *ProjectMonitorConditionGroupCondition_Comparison) FromProjectMonitorConditionGroupConditionComparison1(v ProjectMonitorConditionGroupConditionComparison1) error {
+// FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 overwrites any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier as the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
+func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) FromOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorConditionGroupConditionComparison1 performs a merge with any union data inside the ProjectMonitorConditionGroupCondition_Comparison, using the provided ProjectMonitorConditionGroupConditionComparison1
-func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorConditionGroupConditionComparison1(v ProjectMonitorConditionGroupConditionComparison1) error {
+// MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1 performs a merge with any union data inside the OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier, using the provided OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1
+func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) MergeOrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1(v OrganizationWorkflowActionFilterConditionAssignedToComparisonTargetIdentifier1) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4873,22 +6087,32 @@ func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorCo
 	return err
 }
 
-// AsProjectMonitorConditionGroupConditionComparison2 returns the union data inside the ProjectMonitorConditionGroupCondition_Comparison as a ProjectMonitorConditionGroupConditionComparison2
-func (t ProjectMonitorConditionGroupCondition_Comparison) AsProjectMonitorConditionGroupConditionComparison2() (ProjectMonitorConditionGroupConditionComparison2, error) {
-	var body ProjectMonitorConditionGroupConditionComparison2
+func (t OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *OrganizationWorkflowActionFilterConditionAssignedTo_Comparison_TargetIdentifier) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOrganizationWorkflowTriggerConditionComparison0 returns the union data inside the OrganizationWorkflowTriggerCondition_Comparison as a OrganizationWorkflowTriggerConditionComparison0
+func (t OrganizationWorkflowTriggerCondition_Comparison) AsOrganizationWorkflowTriggerConditionComparison0() (OrganizationWorkflowTriggerConditionComparison0, error) {
+	var body OrganizationWorkflowTriggerConditionComparison0
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorConditionGroupConditionComparison2 overwrites any union data inside the ProjectMonitorConditionGroupCondition_Comparison as the provided ProjectMonitorConditionGroupConditionComparison2
-func (t *ProjectMonitorConditionGroupCondition_Comparison) FromProjectMonitorConditionGroupConditionComparison2(v ProjectMonitorConditionGroupConditionComparison2) error {
+// FromOrganizationWorkflowTriggerConditionComparison0 overwrites any union data inside the OrganizationWorkflowTriggerCondition_Comparison as the provided OrganizationWorkflowTriggerConditionComparison0
+func (t *OrganizationWorkflowTriggerCondition_Comparison) FromOrganizationWorkflowTriggerConditionComparison0(v OrganizationWorkflowTriggerConditionComparison0) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorConditionGroupConditionComparison2 performs a merge with any union data inside the ProjectMonitorConditionGroupCondition_Comparison, using the provided ProjectMonitorConditionGroupConditionComparison2
-func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorConditionGroupConditionComparison2(v ProjectMonitorConditionGroupConditionComparison2) error {
+// MergeOrganizationWorkflowTriggerConditionComparison0 performs a merge with any union data inside the OrganizationWorkflowTriggerCondition_Comparison, using the provided OrganizationWorkflowTriggerConditionComparison0
+func (t *OrganizationWorkflowTriggerCondition_Comparison) MergeOrganizationWorkflowTriggerConditionComparison0(v OrganizationWorkflowTriggerConditionComparison0) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4899,32 +6123,22 @@ func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorCo
 	return err
 }
 
-func (t ProjectMonitorConditionGroupCondition_Comparison) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
-
-func (t *ProjectMonitorConditionGroupCondition_Comparison) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
-
-// AsProjectMonitorConfigMetricIssue returns the union data inside the ProjectMonitorConfig as a ProjectMonitorConfigMetricIssue
-func (t ProjectMonitorConfig) AsProjectMonitorConfigMetricIssue() (ProjectMonitorConfigMetricIssue, error) {
-	var body ProjectMonitorConfigMetricIssue
+// AsOrganizationWorkflowTriggerConditionComparison1 returns the union data inside the OrganizationWorkflowTriggerCondition_Comparison as a OrganizationWorkflowTriggerConditionComparison1
+func (t OrganizationWorkflowTriggerCondition_Comparison) AsOrganizationWorkflowTriggerConditionComparison1() (OrganizationWorkflowTriggerConditionComparison1, error) {
+	var body OrganizationWorkflowTriggerConditionComparison1
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorConfigMetricIssue overwrites any union data inside the ProjectMonitorConfig as the provided ProjectMonitorConfigMetricIssue
-func (t *ProjectMonitorConfig) FromProjectMonitorConfigMetricIssue(v ProjectMonitorConfigMetricIssue) error {
+// FromOrganizationWorkflowTriggerConditionComparison1 overwrites any union data inside the OrganizationWorkflowTriggerCondition_Comparison as the provided OrganizationWorkflowTriggerConditionComparison1
+func (t *OrganizationWorkflowTriggerCondition_Comparison) FromOrganizationWorkflowTriggerConditionComparison1(v OrganizationWorkflowTriggerConditionComparison1) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorConfigMetricIssue performs a merge with any union data inside the ProjectMonitorConfig, using the provided ProjectMonitorConfigMetricIssue
-func (t *ProjectMonitorConfig) MergeProjectMonitorConfigMetricIssue(v ProjectMonitorConfigMetricIssue) error {
+// MergeOrganizationWorkflowTriggerConditionComparison1 performs a merge with any union data inside the OrganizationWorkflowTriggerCondition_Comparison, using the provided OrganizationWorkflowTriggerConditionComparison1
+func (t *OrganizationWorkflowTriggerCondition_Comparison) MergeOrganizationWorkflowTriggerConditionComparison1(v OrganizationWorkflowTriggerConditionComparison1) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4935,22 +6149,34 @@ func (t *ProjectMonitorConfig) MergeProjectMonitorConfigMetricIssue(v ProjectMon
 	return err
 }
 
-// AsProjectMonitorConfigUptimeDomainFailure returns the union data inside the ProjectMonitorConfig as a ProjectMonitorConfigUptimeDomainFailure
-func (t ProjectMonitorConfig) AsProjectMonitorConfigUptimeDomainFailure() (ProjectMonitorConfigUptimeDomainFailure, error) {
-	var body ProjectMonitorConfigUptimeDomainFailure
+func (t OrganizationWorkflowTriggerCondition_Comparison) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *OrganizationWorkflowTriggerCondition_Comparison) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorRequestMetricIssue returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestMetricIssue
+func (t ProjectMonitorRequest) AsProjectMonitorRequestMetricIssue() (ProjectMonitorRequestMetricIssue, error) {
+	var body ProjectMonitorRequestMetricIssue
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorConfigUptimeDomainFailure overwrites any union data inside the ProjectMonitorConfig as the provided ProjectMonitorConfigUptimeDomainFailure
-func (t *ProjectMonitorConfig) FromProjectMonitorConfigUptimeDomainFailure(v ProjectMonitorConfigUptimeDomainFailure) error {
+// FromProjectMonitorRequestMetricIssue overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestMetricIssue
+func (t *ProjectMonitorRequest) FromProjectMonitorRequestMetricIssue(v ProjectMonitorRequestMetricIssue) error {
+	v.Type = "metric_issue"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorConfigUptimeDomainFailure performs a merge with any union data inside the ProjectMonitorConfig, using the provided ProjectMonitorConfigUptimeDomainFailure
-func (t *ProjectMonitorConfig) MergeProjectMonitorConfigUptimeDomainFailure(v ProjectMonitorConfigUptimeDomainFailure) error {
+// MergeProjectMonitorRequestMetricIssue performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestMetricIssue
+func (t *ProjectMonitorRequest) MergeProjectMonitorRequestMetricIssue(v ProjectMonitorRequestMetricIssue) error {
+	v.Type = "metric_issue"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4961,34 +6187,24 @@ func (t *ProjectMonitorConfig) MergeProjectMonitorConfigUptimeDomainFailure(v Pr
 	return err
 }
 
-func (t ProjectMonitorConfig) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
-
-func (t *ProjectMonitorConfig) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
-
-// AsProjectMonitorDataSourceConfigCronCrontab returns the union data inside the ProjectMonitorDataSourceConfigCron as a ProjectMonitorDataSourceConfigCronCrontab
-func (t ProjectMonitorDataSourceConfigCron) AsProjectMonitorDataSourceConfigCronCrontab() (ProjectMonitorDataSourceConfigCronCrontab, error) {
-	var body ProjectMonitorDataSourceConfigCronCrontab
+// AsProjectMonitorRequestMonitorCheckInFailure returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestMonitorCheckInFailure
+func (t ProjectMonitorRequest) AsProjectMonitorRequestMonitorCheckInFailure() (ProjectMonitorRequestMonitorCheckInFailure, error) {
+	var body ProjectMonitorRequestMonitorCheckInFailure
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorDataSourceConfigCronCrontab overwrites any union data inside the ProjectMonitorDataSourceConfigCron as the provided ProjectMonitorDataSourceConfigCronCrontab
-func (t *ProjectMonitorDataSourceConfigCron) FromProjectMonitorDataSourceConfigCronCrontab(v ProjectMonitorDataSourceConfigCronCrontab) error {
-	v.ScheduleType = "crontab"
+// FromProjectMonitorRequestMonitorCheckInFailure overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestMonitorCheckInFailure
+func (t *ProjectMonitorRequest) FromProjectMonitorRequestMonitorCheckInFailure(v ProjectMonitorRequestMonitorCheckInFailure) error {
+	v.Type = "monitor_check_in_failure"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorDataSourceConfigCronCrontab performs a merge with any union data inside the ProjectMonitorDataSourceConfigCron, using the provided ProjectMonitorDataSourceConfigCronCrontab
-func (t *ProjectMonitorDataSourceConfigCron) MergeProjectMonitorDataSourceConfigCronCrontab(v ProjectMonitorDataSourceConfigCronCrontab) error {
-	v.ScheduleType = "crontab"
+// MergeProjectMonitorRequestMonitorCheckInFailure performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestMonitorCheckInFailure
+func (t *ProjectMonitorRequest) MergeProjectMonitorRequestMonitorCheckInFailure(v ProjectMonitorRequestMonitorCheckInFailure) error {
+	v.Type = "monitor_check_in_failure"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -4999,24 +6215,24 @@ func (t *ProjectMonitorDataSourceConfigCron) MergeProjectMonitorDataSourceConfig
 	return err
 }
 
-// AsProjectMonitorDataSourceConfigCronInterval returns the union data inside the ProjectMonitorDataSourceConfigCron as a ProjectMonitorDataSourceConfigCronInterval
-func (t ProjectMonitorDataSourceConfigCron) AsProjectMonitorDataSourceConfigCronInterval() (ProjectMonitorDataSourceConfigCronInterval, error) {
-	var body ProjectMonitorDataSourceConfigCronInterval
+// AsProjectMonitorRequestUptimeDomainFailure returns the union data inside the ProjectMonitorRequest as a ProjectMonitorRequestUptimeDomainFailure
+func (t ProjectMonitorRequest) AsProjectMonitorRequestUptimeDomainFailure() (ProjectMonitorRequestUptimeDomainFailure, error) {
+	var body ProjectMonitorRequestUptimeDomainFailure
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorDataSourceConfigCronInterval overwrites any union data inside the ProjectMonitorDataSourceConfigCron as the provided ProjectMonitorDataSourceConfigCronInterval
-func (t *ProjectMonitorDataSourceConfigCron) FromProjectMonitorDataSourceConfigCronInterval(v ProjectMonitorDataSourceConfigCronInterval) error {
-	v.ScheduleType = "interval"
+// FromProjectMonitorRequestUptimeDomainFailure overwrites any union data inside the ProjectMonitorRequest as the provided ProjectMonitorRequestUptimeDomainFailure
+func (t *ProjectMonitorRequest) FromProjectMonitorRequestUptimeDomainFailure(v ProjectMonitorRequestUptimeDomainFailure) error {
+	v.Type = "uptime_domain_failure"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorDataSourceConfigCronInterval performs a merge with any union data inside the ProjectMonitorDataSourceConfigCron, using the provided ProjectMonitorDataSourceConfigCronInterval
-func (t *ProjectMonitorDataSourceConfigCron) MergeProjectMonitorDataSourceConfigCronInterval(v ProjectMonitorDataSourceConfigCronInterval) error {
-	v.ScheduleType = "interval"
+// MergeProjectMonitorRequestUptimeDomainFailure performs a merge with any union data inside the ProjectMonitorRequest, using the provided ProjectMonitorRequestUptimeDomainFailure
+func (t *ProjectMonitorRequest) MergeProjectMonitorRequestUptimeDomainFailure(v ProjectMonitorRequestUptimeDomainFailure) error {
+	v.Type = "uptime_domain_failure"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -5027,55 +6243,57 @@ func (t *ProjectMonitorDataSourceConfigCron) MergeProjectMonitorDataSourceConfig
 	return err
 }
 
-func (t ProjectMonitorDataSourceConfigCron) Discriminator() (string, error) {
+func (t ProjectMonitorRequest) Discriminator() (string, error) {
 	var discriminator struct {
-		Discriminator string `json:"schedule_type"`
+		Discriminator string `json:"type"`
 	}
 	err := json.Unmarshal(t.union, &discriminator)
 	return discriminator.Discriminator, err
 }
 
-func (t ProjectMonitorDataSourceConfigCron) ValueByDiscriminator() (interface{}, error) {
+func (t ProjectMonitorRequest) ValueByDiscriminator() (interface{}, error) {
 	discriminator, err := t.Discriminator()
 	if err != nil {
 		return nil, err
 	}
 	switch discriminator {
-	case "crontab":
-		return t.AsProjectMonitorDataSourceConfigCronCrontab()
-	case "interval":
-		return t.AsProjectMonitorDataSourceConfigCronInterval()
+	case "metric_issue":
+		return t.AsProjectMonitorRequestMetricIssue()
+	case "monitor_check_in_failure":
+		return t.AsProjectMonitorRequestMonitorCheckInFailure()
+	case "uptime_domain_failure":
+		return t.AsProjectMonitorRequestUptimeDomainFailure()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
 }
 
-func (t ProjectMonitorDataSourceConfigCron) MarshalJSON() ([]byte, error) {
+func (t ProjectMonitorRequest) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *ProjectMonitorDataSourceConfigCron) UnmarshalJSON(b []byte) error {
+func (t *ProjectMonitorRequest) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// AsProjectMonitorDataSourceConfigCronIntervalValue returns the union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as a ProjectMonitorDataSourceConfigCronIntervalValue
-func (t ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) AsProjectMonitorDataSourceConfigCronIntervalValue() (ProjectMonitorDataSourceConfigCronIntervalValue, error) {
-	var body ProjectMonitorDataSourceConfigCronIntervalValue
+// AsProjectMonitorConditionGroupConditionComparison0 returns the union data inside the ProjectMonitorConditionGroupCondition_Comparison as a ProjectMonitorConditionGroupConditionComparison0
+func (t ProjectMonitorConditionGroupCondition_Comparison) AsProjectMonitorConditionGroupConditionComparison0() (ProjectMonitorConditionGroupConditionComparison0, error) {
+	var body ProjectMonitorConditionGroupConditionComparison0
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorDataSourceConfigCronIntervalValue overwrites any union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as the provided ProjectMonitorDataSourceConfigCronIntervalValue
-func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) FromProjectMonitorDataSourceConfigCronIntervalValue(v ProjectMonitorDataSourceConfigCronIntervalValue) error {
+// FromProjectMonitorConditionGroupConditionComparison0 overwrites any union data inside the ProjectMonitorConditionGroupCondition_Comparison as the provided ProjectMonitorConditionGroupConditionComparison0
+func (t *ProjectMonitorConditionGroupCondition_Comparison) FromProjectMonitorConditionGroupConditionComparison0(v ProjectMonitorConditionGroupConditionComparison0) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeProjectMonitorDataSourceConfigCronIntervalValue performs a merge with any union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item, using the provided ProjectMonitorDataSourceConfigCronIntervalValue
-func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) MergeProjectMonitorDataSourceConfigCronIntervalValue(v ProjectMonitorDataSourceConfigCronIntervalValue) error {
+// MergeProjectMonitorConditionGroupConditionComparison0 performs a merge with any union data inside the ProjectMonitorConditionGroupCondition_Comparison, using the provided ProjectMonitorConditionGroupConditionComparison0
+func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorConditionGroupConditionComparison0(v ProjectMonitorConditionGroupConditionComparison0) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -5086,14 +6304,253 @@ func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) MergeProjectM
 	return err
 }
 
-// AsProjectMonitorDataSourceConfigCronIntervalUnit returns the union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as a ProjectMonitorDataSourceConfigCronIntervalUnit
-func (t ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) AsProjectMonitorDataSourceConfigCronIntervalUnit() (ProjectMonitorDataSourceConfigCronIntervalUnit, error) {
-	var body ProjectMonitorDataSourceConfigCronIntervalUnit
+// AsProjectMonitorConditionGroupConditionComparison1 returns the union data inside the ProjectMonitorConditionGroupCondition_Comparison as a ProjectMonitorConditionGroupConditionComparison1
+func (t ProjectMonitorConditionGroupCondition_Comparison) AsProjectMonitorConditionGroupConditionComparison1() (ProjectMonitorConditionGroupConditionComparison1, error) {
+	var body ProjectMonitorConditionGroupConditionComparison1
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromProjectMonitorDataSourceConfigCronIntervalUnit overwrites any union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as the provided ProjectMonitorDataSourceConfigCronIntervalUnit
+// FromProjectMonitorConditionGroupConditionComparison1 overwrites any union data inside the ProjectMonitorConditionGroupCondition_Comparison as the provided ProjectMonitorConditionGroupConditionComparison1
+func (t *ProjectMonitorConditionGroupCondition_Comparison) FromProjectMonitorConditionGroupConditionComparison1(v ProjectMonitorConditionGroupConditionComparison1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorConditionGroupConditionComparison1 performs a merge with any union data inside the ProjectMonitorConditionGroupCondition_Comparison, using the provided ProjectMonitorConditionGroupConditionComparison1
+func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorConditionGroupConditionComparison1(v ProjectMonitorConditionGroupConditionComparison1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorConditionGroupConditionComparison2 returns the union data inside the ProjectMonitorConditionGroupCondition_Comparison as a ProjectMonitorConditionGroupConditionComparison2
+func (t ProjectMonitorConditionGroupCondition_Comparison) AsProjectMonitorConditionGroupConditionComparison2() (ProjectMonitorConditionGroupConditionComparison2, error) {
+	var body ProjectMonitorConditionGroupConditionComparison2
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorConditionGroupConditionComparison2 overwrites any union data inside the ProjectMonitorConditionGroupCondition_Comparison as the provided ProjectMonitorConditionGroupConditionComparison2
+func (t *ProjectMonitorConditionGroupCondition_Comparison) FromProjectMonitorConditionGroupConditionComparison2(v ProjectMonitorConditionGroupConditionComparison2) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorConditionGroupConditionComparison2 performs a merge with any union data inside the ProjectMonitorConditionGroupCondition_Comparison, using the provided ProjectMonitorConditionGroupConditionComparison2
+func (t *ProjectMonitorConditionGroupCondition_Comparison) MergeProjectMonitorConditionGroupConditionComparison2(v ProjectMonitorConditionGroupConditionComparison2) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectMonitorConditionGroupCondition_Comparison) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectMonitorConditionGroupCondition_Comparison) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorConfigMetricIssue returns the union data inside the ProjectMonitorConfig as a ProjectMonitorConfigMetricIssue
+func (t ProjectMonitorConfig) AsProjectMonitorConfigMetricIssue() (ProjectMonitorConfigMetricIssue, error) {
+	var body ProjectMonitorConfigMetricIssue
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorConfigMetricIssue overwrites any union data inside the ProjectMonitorConfig as the provided ProjectMonitorConfigMetricIssue
+func (t *ProjectMonitorConfig) FromProjectMonitorConfigMetricIssue(v ProjectMonitorConfigMetricIssue) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorConfigMetricIssue performs a merge with any union data inside the ProjectMonitorConfig, using the provided ProjectMonitorConfigMetricIssue
+func (t *ProjectMonitorConfig) MergeProjectMonitorConfigMetricIssue(v ProjectMonitorConfigMetricIssue) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorConfigUptimeDomainFailure returns the union data inside the ProjectMonitorConfig as a ProjectMonitorConfigUptimeDomainFailure
+func (t ProjectMonitorConfig) AsProjectMonitorConfigUptimeDomainFailure() (ProjectMonitorConfigUptimeDomainFailure, error) {
+	var body ProjectMonitorConfigUptimeDomainFailure
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorConfigUptimeDomainFailure overwrites any union data inside the ProjectMonitorConfig as the provided ProjectMonitorConfigUptimeDomainFailure
+func (t *ProjectMonitorConfig) FromProjectMonitorConfigUptimeDomainFailure(v ProjectMonitorConfigUptimeDomainFailure) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorConfigUptimeDomainFailure performs a merge with any union data inside the ProjectMonitorConfig, using the provided ProjectMonitorConfigUptimeDomainFailure
+func (t *ProjectMonitorConfig) MergeProjectMonitorConfigUptimeDomainFailure(v ProjectMonitorConfigUptimeDomainFailure) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectMonitorConfig) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectMonitorConfig) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorDataSourceConfigCronCrontab returns the union data inside the ProjectMonitorDataSourceConfigCron as a ProjectMonitorDataSourceConfigCronCrontab
+func (t ProjectMonitorDataSourceConfigCron) AsProjectMonitorDataSourceConfigCronCrontab() (ProjectMonitorDataSourceConfigCronCrontab, error) {
+	var body ProjectMonitorDataSourceConfigCronCrontab
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceConfigCronCrontab overwrites any union data inside the ProjectMonitorDataSourceConfigCron as the provided ProjectMonitorDataSourceConfigCronCrontab
+func (t *ProjectMonitorDataSourceConfigCron) FromProjectMonitorDataSourceConfigCronCrontab(v ProjectMonitorDataSourceConfigCronCrontab) error {
+	v.ScheduleType = "crontab"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorDataSourceConfigCronCrontab performs a merge with any union data inside the ProjectMonitorDataSourceConfigCron, using the provided ProjectMonitorDataSourceConfigCronCrontab
+func (t *ProjectMonitorDataSourceConfigCron) MergeProjectMonitorDataSourceConfigCronCrontab(v ProjectMonitorDataSourceConfigCronCrontab) error {
+	v.ScheduleType = "crontab"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorDataSourceConfigCronInterval returns the union data inside the ProjectMonitorDataSourceConfigCron as a ProjectMonitorDataSourceConfigCronInterval
+func (t ProjectMonitorDataSourceConfigCron) AsProjectMonitorDataSourceConfigCronInterval() (ProjectMonitorDataSourceConfigCronInterval, error) {
+	var body ProjectMonitorDataSourceConfigCronInterval
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceConfigCronInterval overwrites any union data inside the ProjectMonitorDataSourceConfigCron as the provided ProjectMonitorDataSourceConfigCronInterval
+func (t *ProjectMonitorDataSourceConfigCron) FromProjectMonitorDataSourceConfigCronInterval(v ProjectMonitorDataSourceConfigCronInterval) error {
+	v.ScheduleType = "interval"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorDataSourceConfigCronInterval performs a merge with any union data inside the ProjectMonitorDataSourceConfigCron, using the provided ProjectMonitorDataSourceConfigCronInterval
+func (t *ProjectMonitorDataSourceConfigCron) MergeProjectMonitorDataSourceConfigCronInterval(v ProjectMonitorDataSourceConfigCronInterval) error {
+	v.ScheduleType = "interval"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ProjectMonitorDataSourceConfigCron) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"schedule_type"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t ProjectMonitorDataSourceConfigCron) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "crontab":
+		return t.AsProjectMonitorDataSourceConfigCronCrontab()
+	case "interval":
+		return t.AsProjectMonitorDataSourceConfigCronInterval()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t ProjectMonitorDataSourceConfigCron) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ProjectMonitorDataSourceConfigCron) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsProjectMonitorDataSourceConfigCronIntervalValue returns the union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as a ProjectMonitorDataSourceConfigCronIntervalValue
+func (t ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) AsProjectMonitorDataSourceConfigCronIntervalValue() (ProjectMonitorDataSourceConfigCronIntervalValue, error) {
+	var body ProjectMonitorDataSourceConfigCronIntervalValue
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceConfigCronIntervalValue overwrites any union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as the provided ProjectMonitorDataSourceConfigCronIntervalValue
+func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) FromProjectMonitorDataSourceConfigCronIntervalValue(v ProjectMonitorDataSourceConfigCronIntervalValue) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProjectMonitorDataSourceConfigCronIntervalValue performs a merge with any union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item, using the provided ProjectMonitorDataSourceConfigCronIntervalValue
+func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) MergeProjectMonitorDataSourceConfigCronIntervalValue(v ProjectMonitorDataSourceConfigCronIntervalValue) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProjectMonitorDataSourceConfigCronIntervalUnit returns the union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as a ProjectMonitorDataSourceConfigCronIntervalUnit
+func (t ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) AsProjectMonitorDataSourceConfigCronIntervalUnit() (ProjectMonitorDataSourceConfigCronIntervalUnit, error) {
+	var body ProjectMonitorDataSourceConfigCronIntervalUnit
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProjectMonitorDataSourceConfigCronIntervalUnit overwrites any union data inside the ProjectMonitorDataSourceConfigCronInterval_Schedule_Item as the provided ProjectMonitorDataSourceConfigCronIntervalUnit
 func (t *ProjectMonitorDataSourceConfigCronInterval_Schedule_Item) FromProjectMonitorDataSourceConfigCronIntervalUnit(v ProjectMonitorDataSourceConfigCronIntervalUnit) error {
 	b, err := json.Marshal(v)
 	t.union = b
@@ -6674,9 +8131,49 @@ type ClientInterface interface {
 	// GetOrganization request
 	GetOrganization(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateOrganizationWithBody request with any body
+	UpdateOrganizationWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganization(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationCodeMappingCodeOwnersFile request
+	GetOrganizationCodeMappingCodeOwnersFile(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, codeMappingId CodeMappingId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationDashboardWithBody request with any body
+	CreateOrganizationDashboardWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrganizationDashboard request
+	DeleteOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationDashboard request
+	GetOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationDashboardWithBody request with any body
+	UpdateOrganizationDashboardWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, body UpdateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganizationDetectorWorkflows request
+	ListOrganizationDetectorWorkflows(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDetectorWorkflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationDetectorWorkflowWithBody request with any body
+	CreateOrganizationDetectorWorkflowWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganizationDetectorWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDetectorWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrganizationDetectorWorkflow request
+	DeleteOrganizationDetectorWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorWorkflowId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListOrganizationMonitors request
 	ListOrganizationMonitors(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// BulkUpdateOrganizationMonitorsWithBody request with any body
+	BulkUpdateOrganizationMonitorsWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationMonitorsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BulkUpdateOrganizationMonitors(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationMonitorsParams, body BulkUpdateOrganizationMonitorsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteProjectMonitor request
 	DeleteProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -6688,7 +8185,84 @@ type ClientInterface interface {
 
 	UpdateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ListOrganizationIntegrations request
+	// ListOrganizationDiscoverSavedQueries request
+	ListOrganizationDiscoverSavedQueries(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDiscoverSavedQueriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationDiscoverSavedQueryWithBody request with any body
+	CreateOrganizationDiscoverSavedQueryWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganizationDiscoverSavedQuery(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDiscoverSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrganizationDiscoverSavedQuery request
+	DeleteOrganizationDiscoverSavedQuery(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationDiscoverSavedQuery request
+	GetOrganizationDiscoverSavedQuery(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationDiscoverSavedQueryWithBody request with any body
+	UpdateOrganizationDiscoverSavedQueryWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizationDiscoverSavedQuery(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, body UpdateOrganizationDiscoverSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationCustomDynamicSamplingRule request
+	GetOrganizationCustomDynamicSamplingRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationCustomDynamicSamplingRuleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationCustomDynamicSamplingRuleWithBody request with any body
+	CreateOrganizationCustomDynamicSamplingRuleWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganizationCustomDynamicSamplingRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationCustomDynamicSamplingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateExternalUserWithBody request with any body
+	CreateExternalUserWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateExternalUser(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteExternalUser request
+	DeleteExternalUser(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateExternalUserWithBody request with any body
+	UpdateExternalUserWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateExternalUser(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, body UpdateExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganizationDataForwarders request
+	ListOrganizationDataForwarders(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationDataForwarderWithBody request with any body
+	CreateOrganizationDataForwarderWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganizationDataForwarder(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDataForwarderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrganizationDataForwarder request
+	DeleteOrganizationDataForwarder(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationDataForwarderWithBody request with any body
+	UpdateOrganizationDataForwarderWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizationDataForwarder(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, body UpdateOrganizationDataForwarderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationGroupSearchViewWithBody request with any body
+	CreateOrganizationGroupSearchViewWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganizationGroupSearchView(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrganizationGroupSearchView request
+	DeleteOrganizationGroupSearchView(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationGroupSearchView request
+	GetOrganizationGroupSearchView(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationGroupSearchViewWithBody request with any body
+	UpdateOrganizationGroupSearchViewWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizationGroupSearchView(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, body UpdateOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StarOrganizationGroupSearchViewWithBody request with any body
+	StarOrganizationGroupSearchViewWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StarOrganizationGroupSearchView(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, body StarOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganizationIntegrations request
 	ListOrganizationIntegrations(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrganizationIntegration request
@@ -6718,6 +8292,35 @@ type ClientInterface interface {
 
 	UpdateOrganizationMember(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListOrganizationAuthTokens request
+	ListOrganizationAuthTokens(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationAuthTokenWithBody request with any body
+	CreateOrganizationAuthTokenWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganizationAuthToken(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationAuthTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrganizationAuthToken request
+	DeleteOrganizationAuthToken(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationAuthToken request
+	GetOrganizationAuthToken(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationAuthTokenWithBody request with any body
+	UpdateOrganizationAuthTokenWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizationAuthToken(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, body UpdateOrganizationAuthTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnpinOrganizationSearchWithBody request with any body
+	UnpinOrganizationSearchWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UnpinOrganizationSearch(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UnpinOrganizationSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PinOrganizationSearchWithBody request with any body
+	PinOrganizationSearchWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PinOrganizationSearch(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body PinOrganizationSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListOrganizationProjects request
 	ListOrganizationProjects(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -6726,6 +8329,30 @@ type ClientInterface interface {
 
 	CreateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListOrganizationProjectSampleRates request
+	ListOrganizationProjectSampleRates(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectSampleRatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationProjectSampleRatesWithBody request with any body
+	UpdateOrganizationProjectSampleRatesWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizationProjectSampleRates(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationProjectSampleRatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganizationSavedSearches request
+	ListOrganizationSavedSearches(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationSavedSearchesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationSavedSearchWithBody request with any body
+	CreateOrganizationSavedSearchWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganizationSavedSearch(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationSavedSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrganizationSavedSearch request
+	DeleteOrganizationSavedSearch(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationSavedSearchWithBody request with any body
+	UpdateOrganizationSavedSearchWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizationSavedSearch(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, body UpdateOrganizationSavedSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListSentryAppInstallations request
 	ListSentryAppInstallations(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -6740,7 +8367,7 @@ type ClientInterface interface {
 	EnableSpikeProtection(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListOrganizationTeams request
-	ListOrganizationTeams(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListOrganizationTeams(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationTeamsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateOrganizationTeamWithBody request with any body
 	CreateOrganizationTeamWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -6755,6 +8382,11 @@ type ClientInterface interface {
 
 	CreateOrganizationWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// BulkUpdateOrganizationWorkflowsWithBody request with any body
+	BulkUpdateOrganizationWorkflowsWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationWorkflowsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BulkUpdateOrganizationWorkflows(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationWorkflowsParams, body BulkUpdateOrganizationWorkflowsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteOrganizationWorkflow request
 	DeleteOrganizationWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -6777,6 +8409,33 @@ type ClientInterface interface {
 
 	UpdateOrganizationProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListProjectCodeOwners request
+	ListProjectCodeOwners(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectCodeOwnersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectCodeOwnersWithBody request with any body
+	CreateProjectCodeOwnersWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProjectCodeOwners(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectCodeOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProjectCodeOwners request
+	DeleteProjectCodeOwners(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectCodeOwnersWithBody request with any body
+	UpdateProjectCodeOwnersWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProjectCodeOwners(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, body UpdateProjectCodeOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjectEnvironments request
+	ListProjectEnvironments(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectEnvironment request
+	GetProjectEnvironment(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectEnvironmentWithBody request with any body
+	UpdateProjectEnvironmentWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProjectEnvironment(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, body UpdateProjectEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListProjectClientKeys request
 	ListProjectClientKeys(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -6796,6 +8455,16 @@ type ClientInterface interface {
 
 	UpdateProjectClientKey(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateProjectCronMonitorMuteWithBody request with any body
+	UpdateProjectCronMonitorMuteWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProjectCronMonitorMute(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, body UpdateProjectCronMonitorMuteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectCronMonitorEnvironmentMuteWithBody request with any body
+	UpdateProjectCronMonitorEnvironmentMuteWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, environment string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProjectCronMonitorEnvironmentMute(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, environment string, body UpdateProjectCronMonitorEnvironmentMuteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetProjectOwnership request
 	GetProjectOwnership(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -6804,6 +8473,52 @@ type ClientInterface interface {
 
 	UpdateProjectOwnership(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ResetProjectPerformanceIssueSettings request
+	ResetProjectPerformanceIssueSettings(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectPerformanceIssueSettings request
+	GetProjectPerformanceIssueSettings(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectPerformanceIssueSettingsWithBody request with any body
+	UpdateProjectPerformanceIssueSettingsWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProjectPerformanceIssueSettings(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectPerformanceIssueSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableProjectPlugin request
+	DisableProjectPlugin(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectPlugin request
+	GetProjectPlugin(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnableProjectPluginWithBody request with any body
+	EnableProjectPluginWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EnableProjectPlugin(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, body EnableProjectPluginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectPluginWithBody request with any body
+	UpdateProjectPluginWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProjectPlugin(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, body UpdateProjectPluginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjectReleaseThresholds request
+	ListProjectReleaseThresholds(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectReleaseThresholdWithBody request with any body
+	CreateProjectReleaseThresholdWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProjectReleaseThreshold(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectReleaseThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProjectReleaseThreshold request
+	DeleteProjectReleaseThreshold(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectReleaseThreshold request
+	GetProjectReleaseThreshold(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectReleaseThresholdWithBody request with any body
+	UpdateProjectReleaseThresholdWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProjectReleaseThreshold(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, body UpdateProjectReleaseThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateProjectRuleWithBody request with any body
 	CreateProjectRuleWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -6820,18 +8535,69 @@ type ClientInterface interface {
 
 	UpdateProjectRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteProjectRuleSnoozeWithBody request with any body
+	DeleteProjectRuleSnoozeWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteProjectRuleSnooze(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body DeleteProjectRuleSnoozeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectRuleSnoozeWithBody request with any body
+	CreateProjectRuleSnoozeWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProjectRuleSnooze(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body CreateProjectRuleSnoozeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjectTagKeyValues request
+	ListProjectTagKeyValues(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, key string, params *ListProjectTagKeyValuesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RemoveTeamFromProject request
 	RemoveTeamFromProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// AddTeamToProject request
 	AddTeamToProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateSentryAppWithBody request with any body
+	CreateSentryAppWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSentryApp(ctx context.Context, body CreateSentryAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSentryApp request
+	DeleteSentryApp(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSentryApp request
+	GetSentryApp(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSentryAppWithBody request with any body
+	UpdateSentryAppWithBody(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSentryApp(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, body UpdateSentryAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSentryAppApiToken request
+	CreateSentryAppApiToken(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSentryAppApiToken request
+	DeleteSentryAppApiToken(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, apiTokenId ApiTokenId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteOrganizationTeam request
 	DeleteOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetOrganizationTeam request
 	GetOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateExternalTeamWithBody request with any body
+	CreateExternalTeamWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateExternalTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateExternalTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteExternalTeam request
+	DeleteExternalTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateExternalTeamWithBody request with any body
+	UpdateExternalTeamWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateExternalTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, body UpdateExternalTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTeamMembers request
+	ListTeamMembers(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListTeamMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateOrganizationTeamProjectWithBody request with any body
 	CreateOrganizationTeamProjectWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -6862,8 +8628,8 @@ func (c *Client) GetOrganization(ctx context.Context, organizationIdOrSlug Organ
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListOrganizationMonitors(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListOrganizationMonitorsRequest(c.Server, organizationIdOrSlug, params)
+func (c *Client) UpdateOrganizationWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6874,8 +8640,8 @@ func (c *Client) ListOrganizationMonitors(ctx context.Context, organizationIdOrS
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteProjectMonitorRequest(c.Server, organizationIdOrSlug, detectorId)
+func (c *Client) UpdateOrganization(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6886,8 +8652,8 @@ func (c *Client) DeleteProjectMonitor(ctx context.Context, organizationIdOrSlug
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectMonitorRequest(c.Server, organizationIdOrSlug, detectorId)
+func (c *Client) GetOrganizationCodeMappingCodeOwnersFile(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, codeMappingId CodeMappingId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationCodeMappingCodeOwnersFileRequest(c.Server, organizationIdOrSlug, codeMappingId)
 	if err != nil {
 		return nil, err
 	}
@@ -6898,8 +8664,8 @@ func (c *Client) GetProjectMonitor(ctx context.Context, organizationIdOrSlug Org
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProjectMonitorWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProjectMonitorRequestWithBody(c.Server, organizationIdOrSlug, detectorId, contentType, body)
+func (c *Client) CreateOrganizationDashboardWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationDashboardRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6910,8 +8676,8 @@ func (c *Client) UpdateProjectMonitorWithBody(ctx context.Context, organizationI
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProjectMonitorRequest(c.Server, organizationIdOrSlug, detectorId, body)
+func (c *Client) CreateOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationDashboardRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6922,8 +8688,8 @@ func (c *Client) UpdateProjectMonitor(ctx context.Context, organizationIdOrSlug
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListOrganizationIntegrations(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListOrganizationIntegrationsRequest(c.Server, organizationIdOrSlug, params)
+func (c *Client) DeleteOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationDashboardRequest(c.Server, organizationIdOrSlug, dashboardId)
 	if err != nil {
 		return nil, err
 	}
@@ -6934,8 +8700,8 @@ func (c *Client) ListOrganizationIntegrations(ctx context.Context, organizationI
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrganizationIntegration(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationIntegrationRequest(c.Server, organizationIdOrSlug, integrationId)
+func (c *Client) GetOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationDashboardRequest(c.Server, organizationIdOrSlug, dashboardId)
 	if err != nil {
 		return nil, err
 	}
@@ -6946,8 +8712,8 @@ func (c *Client) GetOrganizationIntegration(ctx context.Context, organizationIdO
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateOrganizationIntegrationWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateOrganizationIntegrationRequestWithBody(c.Server, organizationIdOrSlug, integrationId, contentType, body)
+func (c *Client) UpdateOrganizationDashboardWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationDashboardRequestWithBody(c.Server, organizationIdOrSlug, dashboardId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6958,8 +8724,8 @@ func (c *Client) UpdateOrganizationIntegrationWithBody(ctx context.Context, orga
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateOrganizationIntegration(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateOrganizationIntegrationRequest(c.Server, organizationIdOrSlug, integrationId, body)
+func (c *Client) UpdateOrganizationDashboard(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, body UpdateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationDashboardRequest(c.Server, organizationIdOrSlug, dashboardId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6970,8 +8736,8 @@ func (c *Client) UpdateOrganizationIntegration(ctx context.Context, organization
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListOrganizationMembers(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListOrganizationMembersRequest(c.Server, organizationIdOrSlug, params)
+func (c *Client) ListOrganizationDetectorWorkflows(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDetectorWorkflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationDetectorWorkflowsRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
@@ -6982,8 +8748,8 @@ func (c *Client) ListOrganizationMembers(ctx context.Context, organizationIdOrSl
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateOrganizationMemberWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateOrganizationMemberRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
+func (c *Client) CreateOrganizationDetectorWorkflowWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationDetectorWorkflowRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -6994,8 +8760,8 @@ func (c *Client) CreateOrganizationMemberWithBody(ctx context.Context, organizat
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateOrganizationMember(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateOrganizationMemberRequest(c.Server, organizationIdOrSlug, body)
+func (c *Client) CreateOrganizationDetectorWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDetectorWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationDetectorWorkflowRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7006,8 +8772,8 @@ func (c *Client) CreateOrganizationMember(ctx context.Context, organizationIdOrS
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteOrganizationMember(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteOrganizationMemberRequest(c.Server, organizationIdOrSlug, memberId)
+func (c *Client) DeleteOrganizationDetectorWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorWorkflowId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationDetectorWorkflowRequest(c.Server, organizationIdOrSlug, detectorWorkflowId)
 	if err != nil {
 		return nil, err
 	}
@@ -7018,8 +8784,8 @@ func (c *Client) DeleteOrganizationMember(ctx context.Context, organizationIdOrS
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrganizationMember(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationMemberRequest(c.Server, organizationIdOrSlug, memberId)
+func (c *Client) ListOrganizationMonitors(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationMonitorsRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
@@ -7030,8 +8796,8 @@ func (c *Client) GetOrganizationMember(ctx context.Context, organizationIdOrSlug
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateOrganizationMemberWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateOrganizationMemberRequestWithBody(c.Server, organizationIdOrSlug, memberId, contentType, body)
+func (c *Client) BulkUpdateOrganizationMonitorsWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationMonitorsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkUpdateOrganizationMonitorsRequestWithBody(c.Server, organizationIdOrSlug, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7042,8 +8808,8 @@ func (c *Client) UpdateOrganizationMemberWithBody(ctx context.Context, organizat
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateOrganizationMember(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateOrganizationMemberRequest(c.Server, organizationIdOrSlug, memberId, body)
+func (c *Client) BulkUpdateOrganizationMonitors(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationMonitorsParams, body BulkUpdateOrganizationMonitorsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkUpdateOrganizationMonitorsRequest(c.Server, organizationIdOrSlug, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7054,8 +8820,8 @@ func (c *Client) UpdateOrganizationMember(ctx context.Context, organizationIdOrS
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListOrganizationProjects(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListOrganizationProjectsRequest(c.Server, organizationIdOrSlug, params)
+func (c *Client) DeleteProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectMonitorRequest(c.Server, organizationIdOrSlug, detectorId)
 	if err != nil {
 		return nil, err
 	}
@@ -7066,8 +8832,8 @@ func (c *Client) ListOrganizationProjects(ctx context.Context, organizationIdOrS
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateProjectMonitorWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectMonitorRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
+func (c *Client) GetProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectMonitorRequest(c.Server, organizationIdOrSlug, detectorId)
 	if err != nil {
 		return nil, err
 	}
@@ -7078,8 +8844,8 @@ func (c *Client) CreateProjectMonitorWithBody(ctx context.Context, organizationI
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectMonitorRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
+func (c *Client) UpdateProjectMonitorWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectMonitorRequestWithBody(c.Server, organizationIdOrSlug, detectorId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7090,8 +8856,8 @@ func (c *Client) CreateProjectMonitor(ctx context.Context, organizationIdOrSlug
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListSentryAppInstallations(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListSentryAppInstallationsRequest(c.Server, organizationIdOrSlug, params)
+func (c *Client) UpdateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectMonitorRequest(c.Server, organizationIdOrSlug, detectorId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7102,8 +8868,8 @@ func (c *Client) ListSentryAppInstallations(ctx context.Context, organizationIdO
 	return c.Client.Do(req)
 }
 
-func (c *Client) DisableSpikeProtectionWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDisableSpikeProtectionRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
+func (c *Client) ListOrganizationDiscoverSavedQueries(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDiscoverSavedQueriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationDiscoverSavedQueriesRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
@@ -7114,8 +8880,8 @@ func (c *Client) DisableSpikeProtectionWithBody(ctx context.Context, organizatio
 	return c.Client.Do(req)
 }
 
-func (c *Client) DisableSpikeProtection(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDisableSpikeProtectionRequest(c.Server, organizationIdOrSlug, body)
+func (c *Client) CreateOrganizationDiscoverSavedQueryWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationDiscoverSavedQueryRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7126,8 +8892,8 @@ func (c *Client) DisableSpikeProtection(ctx context.Context, organizationIdOrSlu
 	return c.Client.Do(req)
 }
 
-func (c *Client) EnableSpikeProtectionWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEnableSpikeProtectionRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
+func (c *Client) CreateOrganizationDiscoverSavedQuery(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDiscoverSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationDiscoverSavedQueryRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7138,8 +8904,8 @@ func (c *Client) EnableSpikeProtectionWithBody(ctx context.Context, organization
 	return c.Client.Do(req)
 }
 
-func (c *Client) EnableSpikeProtection(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEnableSpikeProtectionRequest(c.Server, organizationIdOrSlug, body)
+func (c *Client) DeleteOrganizationDiscoverSavedQuery(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationDiscoverSavedQueryRequest(c.Server, organizationIdOrSlug, queryId)
 	if err != nil {
 		return nil, err
 	}
@@ -7150,8 +8916,8 @@ func (c *Client) EnableSpikeProtection(ctx context.Context, organizationIdOrSlug
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListOrganizationTeams(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListOrganizationTeamsRequest(c.Server, organizationIdOrSlug)
+func (c *Client) GetOrganizationDiscoverSavedQuery(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationDiscoverSavedQueryRequest(c.Server, organizationIdOrSlug, queryId)
 	if err != nil {
 		return nil, err
 	}
@@ -7162,8 +8928,8 @@ func (c *Client) ListOrganizationTeams(ctx context.Context, organizationIdOrSlug
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateOrganizationTeamWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateOrganizationTeamRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
+func (c *Client) UpdateOrganizationDiscoverSavedQueryWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationDiscoverSavedQueryRequestWithBody(c.Server, organizationIdOrSlug, queryId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7174,8 +8940,8 @@ func (c *Client) CreateOrganizationTeamWithBody(ctx context.Context, organizatio
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateOrganizationTeamRequest(c.Server, organizationIdOrSlug, body)
+func (c *Client) UpdateOrganizationDiscoverSavedQuery(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, body UpdateOrganizationDiscoverSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationDiscoverSavedQueryRequest(c.Server, organizationIdOrSlug, queryId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7186,8 +8952,8 @@ func (c *Client) CreateOrganizationTeam(ctx context.Context, organizationIdOrSlu
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListOrganizationWorkflows(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListOrganizationWorkflowsRequest(c.Server, organizationIdOrSlug, params)
+func (c *Client) GetOrganizationCustomDynamicSamplingRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationCustomDynamicSamplingRuleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationCustomDynamicSamplingRuleRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
@@ -7198,8 +8964,8 @@ func (c *Client) ListOrganizationWorkflows(ctx context.Context, organizationIdOr
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateOrganizationWorkflowWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateOrganizationWorkflowRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
+func (c *Client) CreateOrganizationCustomDynamicSamplingRuleWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationCustomDynamicSamplingRuleRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7210,8 +8976,8 @@ func (c *Client) CreateOrganizationWorkflowWithBody(ctx context.Context, organiz
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateOrganizationWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateOrganizationWorkflowRequest(c.Server, organizationIdOrSlug, body)
+func (c *Client) CreateOrganizationCustomDynamicSamplingRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationCustomDynamicSamplingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationCustomDynamicSamplingRuleRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7222,8 +8988,8 @@ func (c *Client) CreateOrganizationWorkflow(ctx context.Context, organizationIdO
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteOrganizationWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteOrganizationWorkflowRequest(c.Server, organizationIdOrSlug, workflowId)
+func (c *Client) CreateExternalUserWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateExternalUserRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7234,8 +9000,8 @@ func (c *Client) DeleteOrganizationWorkflow(ctx context.Context, organizationIdO
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrganizationWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationWorkflowRequest(c.Server, organizationIdOrSlug, workflowId)
+func (c *Client) CreateExternalUser(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateExternalUserRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7246,8 +9012,8 @@ func (c *Client) GetOrganizationWorkflow(ctx context.Context, organizationIdOrSl
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateOrganizationWorkflowWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateOrganizationWorkflowRequestWithBody(c.Server, organizationIdOrSlug, workflowId, contentType, body)
+func (c *Client) DeleteExternalUser(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteExternalUserRequest(c.Server, organizationIdOrSlug, externalUserId)
 	if err != nil {
 		return nil, err
 	}
@@ -7258,8 +9024,8 @@ func (c *Client) UpdateOrganizationWorkflowWithBody(ctx context.Context, organiz
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateOrganizationWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateOrganizationWorkflowRequest(c.Server, organizationIdOrSlug, workflowId, body)
+func (c *Client) UpdateExternalUserWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateExternalUserRequestWithBody(c.Server, organizationIdOrSlug, externalUserId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7270,8 +9036,8 @@ func (c *Client) UpdateOrganizationWorkflow(ctx context.Context, organizationIdO
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteOrganizationProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteOrganizationProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
+func (c *Client) UpdateExternalUser(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, body UpdateExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateExternalUserRequest(c.Server, organizationIdOrSlug, externalUserId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7282,8 +9048,8 @@ func (c *Client) DeleteOrganizationProject(ctx context.Context, organizationIdOr
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrganizationProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
+func (c *Client) ListOrganizationDataForwarders(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationDataForwardersRequest(c.Server, organizationIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
@@ -7294,8 +9060,8 @@ func (c *Client) GetOrganizationProject(ctx context.Context, organizationIdOrSlu
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateOrganizationProjectWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateOrganizationProjectRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
+func (c *Client) CreateOrganizationDataForwarderWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationDataForwarderRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7306,8 +9072,8 @@ func (c *Client) UpdateOrganizationProjectWithBody(ctx context.Context, organiza
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateOrganizationProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateOrganizationProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
+func (c *Client) CreateOrganizationDataForwarder(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDataForwarderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationDataForwarderRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7318,8 +9084,8 @@ func (c *Client) UpdateOrganizationProject(ctx context.Context, organizationIdOr
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListProjectClientKeys(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListProjectClientKeysRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, params)
+func (c *Client) DeleteOrganizationDataForwarder(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationDataForwarderRequest(c.Server, organizationIdOrSlug, dataForwarderId)
 	if err != nil {
 		return nil, err
 	}
@@ -7330,8 +9096,8 @@ func (c *Client) ListProjectClientKeys(ctx context.Context, organizationIdOrSlug
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateProjectClientKeyWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectClientKeyRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
+func (c *Client) UpdateOrganizationDataForwarderWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationDataForwarderRequestWithBody(c.Server, organizationIdOrSlug, dataForwarderId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7342,8 +9108,8 @@ func (c *Client) CreateProjectClientKeyWithBody(ctx context.Context, organizatio
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateProjectClientKey(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectClientKeyRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
+func (c *Client) UpdateOrganizationDataForwarder(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, body UpdateOrganizationDataForwarderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationDataForwarderRequest(c.Server, organizationIdOrSlug, dataForwarderId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7354,8 +9120,8 @@ func (c *Client) CreateProjectClientKey(ctx context.Context, organizationIdOrSlu
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteProjectClientKey(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteProjectClientKeyRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, keyId)
+func (c *Client) CreateOrganizationGroupSearchViewWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationGroupSearchViewRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7366,8 +9132,8 @@ func (c *Client) DeleteProjectClientKey(ctx context.Context, organizationIdOrSlu
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProjectClientKey(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectClientKeyRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, keyId)
+func (c *Client) CreateOrganizationGroupSearchView(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationGroupSearchViewRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7378,8 +9144,8 @@ func (c *Client) GetProjectClientKey(ctx context.Context, organizationIdOrSlug O
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProjectClientKeyWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProjectClientKeyRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, keyId, contentType, body)
+func (c *Client) DeleteOrganizationGroupSearchView(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationGroupSearchViewRequest(c.Server, organizationIdOrSlug, viewId)
 	if err != nil {
 		return nil, err
 	}
@@ -7390,8 +9156,8 @@ func (c *Client) UpdateProjectClientKeyWithBody(ctx context.Context, organizatio
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProjectClientKey(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProjectClientKeyRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, keyId, body)
+func (c *Client) GetOrganizationGroupSearchView(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationGroupSearchViewRequest(c.Server, organizationIdOrSlug, viewId)
 	if err != nil {
 		return nil, err
 	}
@@ -7402,8 +9168,8 @@ func (c *Client) UpdateProjectClientKey(ctx context.Context, organizationIdOrSlu
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProjectOwnership(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectOwnershipRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
+func (c *Client) UpdateOrganizationGroupSearchViewWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationGroupSearchViewRequestWithBody(c.Server, organizationIdOrSlug, viewId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7414,8 +9180,8 @@ func (c *Client) GetProjectOwnership(ctx context.Context, organizationIdOrSlug O
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProjectOwnershipWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProjectOwnershipRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
+func (c *Client) UpdateOrganizationGroupSearchView(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, body UpdateOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationGroupSearchViewRequest(c.Server, organizationIdOrSlug, viewId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7426,8 +9192,8 @@ func (c *Client) UpdateProjectOwnershipWithBody(ctx context.Context, organizatio
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProjectOwnership(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProjectOwnershipRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
+func (c *Client) StarOrganizationGroupSearchViewWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStarOrganizationGroupSearchViewRequestWithBody(c.Server, organizationIdOrSlug, viewId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7438,8 +9204,8 @@ func (c *Client) UpdateProjectOwnership(ctx context.Context, organizationIdOrSlu
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateProjectRuleWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectRuleRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
+func (c *Client) StarOrganizationGroupSearchView(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, body StarOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStarOrganizationGroupSearchViewRequest(c.Server, organizationIdOrSlug, viewId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7450,8 +9216,8 @@ func (c *Client) CreateProjectRuleWithBody(ctx context.Context, organizationIdOr
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateProjectRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateProjectRuleRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
+func (c *Client) ListOrganizationIntegrations(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationIntegrationsRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
@@ -7462,8 +9228,8 @@ func (c *Client) CreateProjectRule(ctx context.Context, organizationIdOrSlug Org
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteProjectRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteProjectRuleRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId)
+func (c *Client) GetOrganizationIntegration(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationIntegrationRequest(c.Server, organizationIdOrSlug, integrationId)
 	if err != nil {
 		return nil, err
 	}
@@ -7474,8 +9240,8 @@ func (c *Client) DeleteProjectRule(ctx context.Context, organizationIdOrSlug Org
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProjectRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectRuleRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId)
+func (c *Client) UpdateOrganizationIntegrationWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationIntegrationRequestWithBody(c.Server, organizationIdOrSlug, integrationId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7486,8 +9252,8 @@ func (c *Client) GetProjectRule(ctx context.Context, organizationIdOrSlug Organi
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProjectRuleWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProjectRuleRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId, contentType, body)
+func (c *Client) UpdateOrganizationIntegration(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationIntegrationRequest(c.Server, organizationIdOrSlug, integrationId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7498,8 +9264,8 @@ func (c *Client) UpdateProjectRuleWithBody(ctx context.Context, organizationIdOr
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProjectRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProjectRuleRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId, body)
+func (c *Client) ListOrganizationMembers(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationMembersRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
@@ -7510,8 +9276,8 @@ func (c *Client) UpdateProjectRule(ctx context.Context, organizationIdOrSlug Org
 	return c.Client.Do(req)
 }
 
-func (c *Client) RemoveTeamFromProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemoveTeamFromProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug)
+func (c *Client) CreateOrganizationMemberWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationMemberRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7522,8 +9288,8 @@ func (c *Client) RemoveTeamFromProject(ctx context.Context, organizationIdOrSlug
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTeamToProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTeamToProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug)
+func (c *Client) CreateOrganizationMember(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationMemberRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7534,8 +9300,8 @@ func (c *Client) AddTeamToProject(ctx context.Context, organizationIdOrSlug Orga
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteOrganizationTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug)
+func (c *Client) DeleteOrganizationMember(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationMemberRequest(c.Server, organizationIdOrSlug, memberId)
 	if err != nil {
 		return nil, err
 	}
@@ -7546,8 +9312,8 @@ func (c *Client) DeleteOrganizationTeam(ctx context.Context, organizationIdOrSlu
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrganizationTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug)
+func (c *Client) GetOrganizationMember(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationMemberRequest(c.Server, organizationIdOrSlug, memberId)
 	if err != nil {
 		return nil, err
 	}
@@ -7558,8 +9324,8 @@ func (c *Client) GetOrganizationTeam(ctx context.Context, organizationIdOrSlug O
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateOrganizationTeamProjectWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateOrganizationTeamProjectRequestWithBody(c.Server, organizationIdOrSlug, teamIdOrSlug, contentType, body)
+func (c *Client) UpdateOrganizationMemberWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationMemberRequestWithBody(c.Server, organizationIdOrSlug, memberId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7570,8 +9336,8 @@ func (c *Client) CreateOrganizationTeamProjectWithBody(ctx context.Context, orga
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateOrganizationTeamProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateOrganizationTeamProjectRequest(c.Server, organizationIdOrSlug, teamIdOrSlug, body)
+func (c *Client) UpdateOrganizationMember(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationMemberRequest(c.Server, organizationIdOrSlug, memberId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -7582,1331 +9348,1408 @@ func (c *Client) CreateOrganizationTeamProject(ctx context.Context, organization
 	return c.Client.Do(req)
 }
 
-// NewHealthCheckRequest generates requests for HealthCheck
-func NewHealthCheckRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListOrganizationAuthTokens(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationAuthTokensRequest(c.Server, organizationIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/internal/health/")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateOrganizationAuthTokenWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationAuthTokenRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetOrganizationRequest generates requests for GetOrganization
-func NewGetOrganizationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) CreateOrganizationAuthToken(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationAuthTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationAuthTokenRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteOrganizationAuthToken(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationAuthTokenRequest(c.Server, organizationIdOrSlug, tokenId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListOrganizationMonitorsRequest generates requests for ListOrganizationMonitors
-func NewListOrganizationMonitorsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) GetOrganizationAuthToken(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationAuthTokenRequest(c.Server, organizationIdOrSlug, tokenId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateOrganizationAuthTokenWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationAuthTokenRequestWithBody(c.Server, organizationIdOrSlug, tokenId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateOrganizationAuthToken(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, body UpdateOrganizationAuthTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationAuthTokenRequest(c.Server, organizationIdOrSlug, tokenId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Project != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "project", *params.Project, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Query != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", *params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+func (c *Client) UnpinOrganizationSearchWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnpinOrganizationSearchRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteProjectMonitorRequest generates requests for DeleteProjectMonitor
-func NewDeleteProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) UnpinOrganizationSearch(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UnpinOrganizationSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnpinOrganizationSearchRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PinOrganizationSearchWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPinOrganizationSearchRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PinOrganizationSearch(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body PinOrganizationSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPinOrganizationSearchRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetProjectMonitorRequest generates requests for GetProjectMonitor
-func NewGetProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) ListOrganizationProjects(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationProjectsRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateProjectMonitorWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectMonitorRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateProjectMonitor(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectMonitorRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateProjectMonitorRequest calls the generic UpdateProjectMonitor builder with application/json body
-func NewUpdateProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListOrganizationProjectSampleRates(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectSampleRatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationProjectSampleRatesRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectMonitorRequestWithBody(server, organizationIdOrSlug, detectorId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateProjectMonitorRequestWithBody generates requests for UpdateProjectMonitor with any type of body
-func NewUpdateProjectMonitorRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) UpdateOrganizationProjectSampleRatesWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationProjectSampleRatesRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateOrganizationProjectSampleRates(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationProjectSampleRatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationProjectSampleRatesRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListOrganizationSavedSearches(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationSavedSearchesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationSavedSearchesRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+func (c *Client) CreateOrganizationSavedSearchWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationSavedSearchRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListOrganizationIntegrationsRequest generates requests for ListOrganizationIntegrations
-func NewListOrganizationIntegrationsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) CreateOrganizationSavedSearch(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationSavedSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationSavedSearchRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteOrganizationSavedSearch(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationSavedSearchRequest(c.Server, organizationIdOrSlug, searchId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateOrganizationSavedSearchWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationSavedSearchRequestWithBody(c.Server, organizationIdOrSlug, searchId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.ProviderKey != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "provider_key", *params.ProviderKey, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+func (c *Client) UpdateOrganizationSavedSearch(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, body UpdateOrganizationSavedSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationSavedSearchRequest(c.Server, organizationIdOrSlug, searchId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetOrganizationIntegrationRequest generates requests for GetOrganizationIntegration
-func NewGetOrganizationIntegrationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) ListSentryAppInstallations(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSentryAppInstallationsRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "integration_id", integrationId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DisableSpikeProtectionWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableSpikeProtectionRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DisableSpikeProtection(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableSpikeProtectionRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateOrganizationIntegrationRequest calls the generic UpdateOrganizationIntegration builder with application/json body
-func NewUpdateOrganizationIntegrationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) EnableSpikeProtectionWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnableSpikeProtectionRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateOrganizationIntegrationRequestWithBody(server, organizationIdOrSlug, integrationId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateOrganizationIntegrationRequestWithBody generates requests for UpdateOrganizationIntegration with any type of body
-func NewUpdateOrganizationIntegrationRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) EnableSpikeProtection(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnableSpikeProtectionRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "integration_id", integrationId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListOrganizationTeams(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationTeamsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationTeamsRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateOrganizationTeamWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationTeamRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListOrganizationMembersRequest generates requests for ListOrganizationMembers
-func NewListOrganizationMembersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) CreateOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationTeamRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListOrganizationWorkflows(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationWorkflowsRequest(c.Server, organizationIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/members/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateOrganizationWorkflowWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationWorkflowRequestWithBody(c.Server, organizationIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+func (c *Client) CreateOrganizationWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationWorkflowRequest(c.Server, organizationIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateOrganizationMemberRequest calls the generic CreateOrganizationMember builder with application/json body
-func NewCreateOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) BulkUpdateOrganizationWorkflowsWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationWorkflowsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkUpdateOrganizationWorkflowsRequestWithBody(c.Server, organizationIdOrSlug, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrganizationMemberRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
-}
-
-// NewCreateOrganizationMemberRequestWithBody generates requests for CreateOrganizationMember with any type of body
-func NewCreateOrganizationMemberRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) BulkUpdateOrganizationWorkflows(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationWorkflowsParams, body BulkUpdateOrganizationWorkflowsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkUpdateOrganizationWorkflowsRequest(c.Server, organizationIdOrSlug, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteOrganizationWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationWorkflowRequest(c.Server, organizationIdOrSlug, workflowId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/members/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetOrganizationWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationWorkflowRequest(c.Server, organizationIdOrSlug, workflowId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+func (c *Client) UpdateOrganizationWorkflowWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationWorkflowRequestWithBody(c.Server, organizationIdOrSlug, workflowId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteOrganizationMemberRequest generates requests for DeleteOrganizationMember
-func NewDeleteOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) UpdateOrganizationWorkflow(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationWorkflowRequest(c.Server, organizationIdOrSlug, workflowId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) DeleteOrganizationProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOrganizationProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateOrganizationProjectWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationProjectRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+func (c *Client) UpdateOrganizationProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetOrganizationMemberRequest generates requests for GetOrganizationMember
-func NewGetOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) ListProjectCodeOwners(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectCodeOwnersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectCodeOwnersRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) CreateProjectCodeOwnersWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectCodeOwnersRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateProjectCodeOwners(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectCodeOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectCodeOwnersRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteProjectCodeOwners(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectCodeOwnersRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, codeownersId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+func (c *Client) UpdateProjectCodeOwnersWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectCodeOwnersRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, codeownersId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateOrganizationMemberRequest calls the generic UpdateOrganizationMember builder with application/json body
-func NewUpdateOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateProjectCodeOwners(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, body UpdateProjectCodeOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectCodeOwnersRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, codeownersId, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateOrganizationMemberRequestWithBody(server, organizationIdOrSlug, memberId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateOrganizationMemberRequestWithBody generates requests for UpdateOrganizationMember with any type of body
-func NewUpdateOrganizationMemberRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) ListProjectEnvironments(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectEnvironmentsRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) GetProjectEnvironment(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectEnvironmentRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, environment)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateProjectEnvironmentWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectEnvironmentRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, environment, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateProjectEnvironment(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, body UpdateProjectEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectEnvironmentRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, environment, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+func (c *Client) ListProjectClientKeys(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectClientKeysRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListOrganizationProjectsRequest generates requests for ListOrganizationProjects
-func NewListOrganizationProjectsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) CreateProjectClientKeyWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectClientKeyRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateProjectClientKey(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectClientKeyRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/projects/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteProjectClientKey(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectClientKeyRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, keyId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Options != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "options", *params.Options, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+func (c *Client) GetProjectClientKey(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectClientKeyRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, keyId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateProjectMonitorRequest calls the generic CreateProjectMonitor builder with application/json body
-func NewCreateProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateProjectClientKeyWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectClientKeyRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, keyId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectMonitorRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateProjectMonitorRequestWithBody generates requests for CreateProjectMonitor with any type of body
-func NewCreateProjectMonitorRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) UpdateProjectClientKey(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectClientKeyRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, keyId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateProjectCronMonitorMuteWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectCronMonitorMuteRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, monitorIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/projects/%s/detectors/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateProjectCronMonitorMute(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, body UpdateProjectCronMonitorMuteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectCronMonitorMuteRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, monitorIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListSentryAppInstallationsRequest generates requests for ListSentryAppInstallations
-func NewListSentryAppInstallationsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) UpdateProjectCronMonitorEnvironmentMuteWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, environment string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectCronMonitorEnvironmentMuteRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, monitorIdOrSlug, environment, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateProjectCronMonitorEnvironmentMute(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, environment string, body UpdateProjectCronMonitorEnvironmentMuteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectCronMonitorEnvironmentMuteRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, monitorIdOrSlug, environment, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/sentry-app-installations/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetProjectOwnership(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectOwnershipRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+func (c *Client) UpdateProjectOwnershipWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectOwnershipRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDisableSpikeProtectionRequest calls the generic DisableSpikeProtection builder with application/json body
-func NewDisableSpikeProtectionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateProjectOwnership(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectOwnershipRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDisableSpikeProtectionRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDisableSpikeProtectionRequestWithBody generates requests for DisableSpikeProtection with any type of body
-func NewDisableSpikeProtectionRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) ResetProjectPerformanceIssueSettings(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetProjectPerformanceIssueSettingsRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetProjectPerformanceIssueSettings(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectPerformanceIssueSettingsRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/spike-protections/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateProjectPerformanceIssueSettingsWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectPerformanceIssueSettingsRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewEnableSpikeProtectionRequest calls the generic EnableSpikeProtection builder with application/json body
-func NewEnableSpikeProtectionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateProjectPerformanceIssueSettings(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectPerformanceIssueSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectPerformanceIssueSettingsRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewEnableSpikeProtectionRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewEnableSpikeProtectionRequestWithBody generates requests for EnableSpikeProtection with any type of body
-func NewEnableSpikeProtectionRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) DisableProjectPlugin(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableProjectPluginRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, pluginId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetProjectPlugin(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectPluginRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, pluginId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/spike-protections/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) EnableProjectPluginWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnableProjectPluginRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, pluginId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+func (c *Client) EnableProjectPlugin(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, body EnableProjectPluginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnableProjectPluginRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, pluginId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListOrganizationTeamsRequest generates requests for ListOrganizationTeams
-func NewListOrganizationTeamsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) UpdateProjectPluginWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectPluginRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, pluginId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateProjectPlugin(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, body UpdateProjectPluginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectPluginRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, pluginId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/teams/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListProjectReleaseThresholds(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectReleaseThresholdsRequest(c.Server, organizationIdOrSlug, projectIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateOrganizationTeamRequest calls the generic CreateOrganizationTeam builder with application/json body
-func NewCreateOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateProjectReleaseThresholdWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectReleaseThresholdRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrganizationTeamRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateOrganizationTeamRequestWithBody generates requests for CreateOrganizationTeam with any type of body
-func NewCreateOrganizationTeamRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) CreateProjectReleaseThreshold(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectReleaseThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectReleaseThresholdRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteProjectReleaseThreshold(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectReleaseThresholdRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, releaseThresholdId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/teams/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetProjectReleaseThreshold(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectReleaseThresholdRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, releaseThresholdId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListOrganizationWorkflowsRequest generates requests for ListOrganizationWorkflows
-func NewListOrganizationWorkflowsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) UpdateProjectReleaseThresholdWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectReleaseThresholdRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, releaseThresholdId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateProjectReleaseThreshold(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, body UpdateProjectReleaseThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectReleaseThresholdRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, releaseThresholdId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateProjectRuleWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRuleRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if params.Cursor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+func (c *Client) CreateProjectRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRuleRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateOrganizationWorkflowRequest calls the generic CreateOrganizationWorkflow builder with application/json body
-func NewCreateOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteProjectRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectRuleRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrganizationWorkflowRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateOrganizationWorkflowRequestWithBody generates requests for CreateOrganizationWorkflow with any type of body
-func NewCreateOrganizationWorkflowRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) GetProjectRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectRuleRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateProjectRuleWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRuleRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateProjectRule(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRuleRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteOrganizationWorkflowRequest generates requests for DeleteOrganizationWorkflow
-func NewDeleteOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) DeleteProjectRuleSnoozeWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectRuleSnoozeRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteProjectRuleSnooze(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body DeleteProjectRuleSnoozeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectRuleSnoozeRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateProjectRuleSnoozeWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRuleSnoozeRequestWithBody(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetOrganizationWorkflowRequest generates requests for GetOrganizationWorkflow
-func NewGetOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) CreateProjectRuleSnooze(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body CreateProjectRuleSnoozeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRuleSnoozeRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, ruleId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListProjectTagKeyValues(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, key string, params *ListProjectTagKeyValuesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectTagKeyValuesRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, key, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RemoveTeamFromProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTeamFromProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+func (c *Client) AddTeamToProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTeamToProjectRequest(c.Server, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) CreateSentryAppWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSentryAppRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateOrganizationWorkflowRequest calls the generic UpdateOrganizationWorkflow builder with application/json body
-func NewUpdateOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateSentryApp(ctx context.Context, body CreateSentryAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSentryAppRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateOrganizationWorkflowRequestWithBody(server, organizationIdOrSlug, workflowId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateOrganizationWorkflowRequestWithBody generates requests for UpdateOrganizationWorkflow with any type of body
-func NewUpdateOrganizationWorkflowRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) DeleteSentryApp(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSentryAppRequest(c.Server, sentryAppIdOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) GetSentryApp(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSentryAppRequest(c.Server, sentryAppIdOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) UpdateSentryAppWithBody(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSentryAppRequestWithBody(c.Server, sentryAppIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) UpdateSentryApp(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, body UpdateSentryAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSentryAppRequest(c.Server, sentryAppIdOrSlug, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) CreateSentryAppApiToken(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSentryAppApiTokenRequest(c.Server, sentryAppIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteSentryAppApiToken(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, apiTokenId ApiTokenId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSentryAppApiTokenRequest(c.Server, sentryAppIdOrSlug, apiTokenId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) DeleteOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetOrganizationTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+func (c *Client) CreateExternalTeamWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateExternalTeamRequestWithBody(c.Server, organizationIdOrSlug, teamIdOrSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) CreateExternalTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateExternalTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateExternalTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) DeleteExternalTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteExternalTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug, externalTeamId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteOrganizationProjectRequest generates requests for DeleteOrganizationProject
-func NewDeleteOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
-	var err error
+func (c *Client) UpdateExternalTeamWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateExternalTeamRequestWithBody(c.Server, organizationIdOrSlug, teamIdOrSlug, externalTeamId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) UpdateExternalTeam(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, body UpdateExternalTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateExternalTeamRequest(c.Server, organizationIdOrSlug, teamIdOrSlug, externalTeamId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) ListTeamMembers(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListTeamMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTeamMembersRequest(c.Server, organizationIdOrSlug, teamIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) CreateOrganizationTeamProjectWithBody(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationTeamProjectRequestWithBody(c.Server, organizationIdOrSlug, teamIdOrSlug, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+func (c *Client) CreateOrganizationTeamProject(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationTeamProjectRequest(c.Server, organizationIdOrSlug, teamIdOrSlug, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/internal/health/")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8916,7 +10759,7 @@ func NewDeleteOrganizationProjectRequest(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -8924,8 +10767,8 @@ func NewDeleteOrganizationProjectRequest(server string, organizationIdOrSlug Org
 	return req, nil
 }
 
-// NewGetOrganizationProjectRequest generates requests for GetOrganizationProject
-func NewGetOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+// NewGetOrganizationRequest generates requests for GetOrganization
+func NewGetOrganizationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8935,19 +10778,12 @@ func NewGetOrganizationProjectRequest(server string, organizationIdOrSlug Organi
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8965,19 +10801,19 @@ func NewGetOrganizationProjectRequest(server string, organizationIdOrSlug Organi
 	return req, nil
 }
 
-// NewUpdateOrganizationProjectRequest calls the generic UpdateOrganizationProject builder with application/json body
-func NewUpdateOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody) (*http.Request, error) {
+// NewUpdateOrganizationRequest calls the generic UpdateOrganization builder with application/json body
+func NewUpdateOrganizationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateOrganizationProjectRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+	return NewUpdateOrganizationRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-// NewUpdateOrganizationProjectRequestWithBody generates requests for UpdateOrganizationProject with any type of body
-func NewUpdateOrganizationProjectRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateOrganizationRequestWithBody generates requests for UpdateOrganization with any type of body
+func NewUpdateOrganizationRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8987,19 +10823,12 @@ func NewUpdateOrganizationProjectRequestWithBody(server string, organizationIdOr
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9019,8 +10848,8 @@ func NewUpdateOrganizationProjectRequestWithBody(server string, organizationIdOr
 	return req, nil
 }
 
-// NewListProjectClientKeysRequest generates requests for ListProjectClientKeys
-func NewListProjectClientKeysRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams) (*http.Request, error) {
+// NewGetOrganizationCodeMappingCodeOwnersFileRequest generates requests for GetOrganizationCodeMappingCodeOwnersFile
+func NewGetOrganizationCodeMappingCodeOwnersFileRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, codeMappingId CodeMappingId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9032,7 +10861,7 @@ func NewListProjectClientKeysRequest(server string, organizationIdOrSlug Organiz
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "code_mapping_id", codeMappingId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9042,7 +10871,7 @@ func NewListProjectClientKeysRequest(server string, organizationIdOrSlug Organiz
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/code-mappings/%s/codeowners/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9052,66 +10881,27 @@ func NewListProjectClientKeysRequest(server string, organizationIdOrSlug Organiz
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Cursor != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Status != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "status", *params.Status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewCreateProjectClientKeyRequest calls the generic CreateProjectClientKey builder with application/json body
-func NewCreateProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody) (*http.Request, error) {
+// NewCreateOrganizationDashboardRequest calls the generic CreateOrganizationDashboard builder with application/json body
+func NewCreateOrganizationDashboardRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDashboardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectClientKeyRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+	return NewCreateOrganizationDashboardRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-// NewCreateProjectClientKeyRequestWithBody generates requests for CreateProjectClientKey with any type of body
-func NewCreateProjectClientKeyRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateOrganizationDashboardRequestWithBody generates requests for CreateOrganizationDashboard with any type of body
+func NewCreateOrganizationDashboardRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9121,19 +10911,12 @@ func NewCreateProjectClientKeyRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dashboards/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9153,8 +10936,8 @@ func NewCreateProjectClientKeyRequestWithBody(server string, organizationIdOrSlu
 	return req, nil
 }
 
-// NewDeleteProjectClientKeyRequest generates requests for DeleteProjectClientKey
-func NewDeleteProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) (*http.Request, error) {
+// NewDeleteOrganizationDashboardRequest generates requests for DeleteOrganizationDashboard
+func NewDeleteOrganizationDashboardRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9166,14 +10949,7 @@ func NewDeleteProjectClientKeyRequest(server string, organizationIdOrSlug Organi
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "dashboard_id", dashboardId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9183,7 +10959,7 @@ func NewDeleteProjectClientKeyRequest(server string, organizationIdOrSlug Organi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dashboards/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9201,8 +10977,8 @@ func NewDeleteProjectClientKeyRequest(server string, organizationIdOrSlug Organi
 	return req, nil
 }
 
-// NewGetProjectClientKeyRequest generates requests for GetProjectClientKey
-func NewGetProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) (*http.Request, error) {
+// NewGetOrganizationDashboardRequest generates requests for GetOrganizationDashboard
+func NewGetOrganizationDashboardRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9214,14 +10990,7 @@ func NewGetProjectClientKeyRequest(server string, organizationIdOrSlug Organizat
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "dashboard_id", dashboardId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9231,7 +11000,7 @@ func NewGetProjectClientKeyRequest(server string, organizationIdOrSlug Organizat
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dashboards/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9249,19 +11018,19 @@ func NewGetProjectClientKeyRequest(server string, organizationIdOrSlug Organizat
 	return req, nil
 }
 
-// NewUpdateProjectClientKeyRequest calls the generic UpdateProjectClientKey builder with application/json body
-func NewUpdateProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody) (*http.Request, error) {
+// NewUpdateOrganizationDashboardRequest calls the generic UpdateOrganizationDashboard builder with application/json body
+func NewUpdateOrganizationDashboardRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, body UpdateOrganizationDashboardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectClientKeyRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, keyId, "application/json", bodyReader)
+	return NewUpdateOrganizationDashboardRequestWithBody(server, organizationIdOrSlug, dashboardId, "application/json", bodyReader)
 }
 
-// NewUpdateProjectClientKeyRequestWithBody generates requests for UpdateProjectClientKey with any type of body
-func NewUpdateProjectClientKeyRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateOrganizationDashboardRequestWithBody generates requests for UpdateOrganizationDashboard with any type of body
+func NewUpdateOrganizationDashboardRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9273,14 +11042,7 @@ func NewUpdateProjectClientKeyRequestWithBody(server string, organizationIdOrSlu
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "dashboard_id", dashboardId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9290,7 +11052,7 @@ func NewUpdateProjectClientKeyRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dashboards/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9310,8 +11072,8 @@ func NewUpdateProjectClientKeyRequestWithBody(server string, organizationIdOrSlu
 	return req, nil
 }
 
-// NewGetProjectOwnershipRequest generates requests for GetProjectOwnership
-func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+// NewListOrganizationDetectorWorkflowsRequest generates requests for ListOrganizationDetectorWorkflows
+func NewListOrganizationDetectorWorkflowsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDetectorWorkflowsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9321,19 +11083,12 @@ func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug Organizat
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/ownership", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detector-workflow/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9343,6 +11098,45 @@ func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug Organizat
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.DetectorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "detector_id", *params.DetectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.WorkflowId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "workflow_id", *params.WorkflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -9351,19 +11145,19 @@ func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug Organizat
 	return req, nil
 }
 
-// NewUpdateProjectOwnershipRequest calls the generic UpdateProjectOwnership builder with application/json body
-func NewUpdateProjectOwnershipRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody) (*http.Request, error) {
+// NewCreateOrganizationDetectorWorkflowRequest calls the generic CreateOrganizationDetectorWorkflow builder with application/json body
+func NewCreateOrganizationDetectorWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDetectorWorkflowJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectOwnershipRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+	return NewCreateOrganizationDetectorWorkflowRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-// NewUpdateProjectOwnershipRequestWithBody generates requests for UpdateProjectOwnership with any type of body
-func NewUpdateProjectOwnershipRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateOrganizationDetectorWorkflowRequestWithBody generates requests for CreateOrganizationDetectorWorkflow with any type of body
+func NewCreateOrganizationDetectorWorkflowRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9373,19 +11167,12 @@ func NewUpdateProjectOwnershipRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/ownership", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detector-workflow/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9395,7 +11182,7 @@ func NewUpdateProjectOwnershipRequestWithBody(server string, organizationIdOrSlu
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -9405,19 +11192,8 @@ func NewUpdateProjectOwnershipRequestWithBody(server string, organizationIdOrSlu
 	return req, nil
 }
 
-// NewCreateProjectRuleRequest calls the generic CreateProjectRule builder with application/json body
-func NewCreateProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectRuleRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
-}
-
-// NewCreateProjectRuleRequestWithBody generates requests for CreateProjectRule with any type of body
-func NewCreateProjectRuleRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteOrganizationDetectorWorkflowRequest generates requests for DeleteOrganizationDetectorWorkflow
+func NewDeleteOrganizationDetectorWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorWorkflowId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9429,7 +11205,7 @@ func NewCreateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_workflow_id", detectorWorkflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9439,7 +11215,7 @@ func NewCreateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detector-workflow/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9449,18 +11225,16 @@ func NewCreateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteProjectRuleRequest generates requests for DeleteProjectRule
-func NewDeleteProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) (*http.Request, error) {
+// NewListOrganizationMonitorsRequest generates requests for ListOrganizationMonitors
+func NewListOrganizationMonitorsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9470,26 +11244,12 @@ func NewDeleteProjectRuleRequest(server string, organizationIdOrSlug Organizatio
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9499,35 +11259,83 @@ func NewDeleteProjectRuleRequest(server string, organizationIdOrSlug Organizatio
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	return req, nil
-}
+		if params.Cursor != nil {
 
-// NewGetProjectRuleRequest generates requests for GetProjectRule
-func NewGetProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+		if params.Project != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "project", *params.Project, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", *params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	return req, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+// NewBulkUpdateOrganizationMonitorsRequest calls the generic BulkUpdateOrganizationMonitors builder with application/json body
+func NewBulkUpdateOrganizationMonitorsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationMonitorsParams, body BulkUpdateOrganizationMonitorsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkUpdateOrganizationMonitorsRequestWithBody(server, organizationIdOrSlug, params, "application/json", bodyReader)
+}
 
-	var pathParam2 string
+// NewBulkUpdateOrganizationMonitorsRequestWithBody generates requests for BulkUpdateOrganizationMonitors with any type of body
+func NewBulkUpdateOrganizationMonitorsRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationMonitorsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9537,7 +11345,7 @@ func NewGetProjectRuleRequest(server string, organizationIdOrSlug OrganizationId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9547,27 +11355,81 @@ func NewGetProjectRuleRequest(server string, organizationIdOrSlug OrganizationId
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	return req, nil
-}
+		if params.Id != nil {
 
-// NewUpdateProjectRuleRequest calls the generic UpdateProjectRule builder with application/json body
-func NewUpdateProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "id", params.Id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Project != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "project", *params.Project, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", *params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectRuleRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, ruleId, "application/json", bodyReader)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// NewUpdateProjectRuleRequestWithBody generates requests for UpdateProjectRule with any type of body
-func NewUpdateProjectRuleRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteProjectMonitorRequest generates requests for DeleteProjectMonitor
+func NewDeleteProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9579,14 +11441,7 @@ func NewUpdateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9596,7 +11451,7 @@ func NewUpdateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9606,18 +11461,16 @@ func NewUpdateProjectRuleRequestWithBody(server string, organizationIdOrSlug Org
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewRemoveTeamFromProjectRequest generates requests for RemoveTeamFromProject
-func NewRemoveTeamFromProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+// NewGetProjectMonitorRequest generates requests for GetProjectMonitor
+func NewGetProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9629,14 +11482,59 @@ func NewRemoveTeamFromProjectRequest(server string, organizationIdOrSlug Organiz
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectMonitorRequest calls the generic UpdateProjectMonitor builder with application/json body
+func NewUpdateProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectMonitorRequestWithBody(server, organizationIdOrSlug, detectorId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectMonitorRequestWithBody generates requests for UpdateProjectMonitor with any type of body
+func NewUpdateProjectMonitorRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "detector_id", detectorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9646,7 +11544,7 @@ func NewRemoveTeamFromProjectRequest(server string, organizationIdOrSlug Organiz
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/detectors/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9656,16 +11554,18 @@ func NewRemoveTeamFromProjectRequest(server string, organizationIdOrSlug Organiz
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewAddTeamToProjectRequest generates requests for AddTeamToProject
-func NewAddTeamToProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+// NewListOrganizationDiscoverSavedQueriesRequest generates requests for ListOrganizationDiscoverSavedQueries
+func NewListOrganizationDiscoverSavedQueriesRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDiscoverSavedQueriesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9675,16 +11575,86 @@ func NewAddTeamToProjectRequest(server string, organizationIdOrSlug Organization
 		return nil, err
 	}
 
-	var pathParam1 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	operationPath := fmt.Sprintf("/0/organizations/%s/discover/saved/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", *params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizationDiscoverSavedQueryRequest calls the generic CreateOrganizationDiscoverSavedQuery builder with application/json body
+func NewCreateOrganizationDiscoverSavedQueryRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDiscoverSavedQueryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationDiscoverSavedQueryRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateOrganizationDiscoverSavedQueryRequestWithBody generates requests for CreateOrganizationDiscoverSavedQuery with any type of body
+func NewCreateOrganizationDiscoverSavedQueryRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9694,7 +11664,7 @@ func NewAddTeamToProjectRequest(server string, organizationIdOrSlug Organization
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/projects/%s/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/0/organizations/%s/discover/saved/", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9704,16 +11674,18 @@ func NewAddTeamToProjectRequest(server string, organizationIdOrSlug Organization
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDeleteOrganizationTeamRequest generates requests for DeleteOrganizationTeam
-func NewDeleteOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+// NewDeleteOrganizationDiscoverSavedQueryRequest generates requests for DeleteOrganizationDiscoverSavedQuery
+func NewDeleteOrganizationDiscoverSavedQueryRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9725,7 +11697,7 @@ func NewDeleteOrganizationTeamRequest(server string, organizationIdOrSlug Organi
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "query_id", queryId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9735,7 +11707,7 @@ func NewDeleteOrganizationTeamRequest(server string, organizationIdOrSlug Organi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/teams/%s/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/discover/saved/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9753,8 +11725,8 @@ func NewDeleteOrganizationTeamRequest(server string, organizationIdOrSlug Organi
 	return req, nil
 }
 
-// NewGetOrganizationTeamRequest generates requests for GetOrganizationTeam
-func NewGetOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+// NewGetOrganizationDiscoverSavedQueryRequest generates requests for GetOrganizationDiscoverSavedQuery
+func NewGetOrganizationDiscoverSavedQueryRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9766,7 +11738,7 @@ func NewGetOrganizationTeamRequest(server string, organizationIdOrSlug Organizat
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "query_id", queryId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9776,7 +11748,7 @@ func NewGetOrganizationTeamRequest(server string, organizationIdOrSlug Organizat
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/teams/%s/%s/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/discover/saved/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9794,19 +11766,19 @@ func NewGetOrganizationTeamRequest(server string, organizationIdOrSlug Organizat
 	return req, nil
 }
 
-// NewCreateOrganizationTeamProjectRequest calls the generic CreateOrganizationTeamProject builder with application/json body
-func NewCreateOrganizationTeamProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody) (*http.Request, error) {
+// NewUpdateOrganizationDiscoverSavedQueryRequest calls the generic UpdateOrganizationDiscoverSavedQuery builder with application/json body
+func NewUpdateOrganizationDiscoverSavedQueryRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, body UpdateOrganizationDiscoverSavedQueryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrganizationTeamProjectRequestWithBody(server, organizationIdOrSlug, teamIdOrSlug, "application/json", bodyReader)
+	return NewUpdateOrganizationDiscoverSavedQueryRequestWithBody(server, organizationIdOrSlug, queryId, "application/json", bodyReader)
 }
 
-// NewCreateOrganizationTeamProjectRequestWithBody generates requests for CreateOrganizationTeamProject with any type of body
-func NewCreateOrganizationTeamProjectRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateOrganizationDiscoverSavedQueryRequestWithBody generates requests for UpdateOrganizationDiscoverSavedQuery with any type of body
+func NewUpdateOrganizationDiscoverSavedQueryRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -9818,7 +11790,7 @@ func NewCreateOrganizationTeamProjectRequestWithBody(server string, organization
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "query_id", queryId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -9828,7 +11800,7 @@ func NewCreateOrganizationTeamProjectRequestWithBody(server string, organization
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/0/teams/%s/%s/projects/", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/0/organizations/%s/discover/saved/%s/", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9838,7 +11810,7 @@ func NewCreateOrganizationTeamProjectRequestWithBody(server string, organization
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -9848,2135 +11820,12757 @@ func NewCreateOrganizationTeamProjectRequestWithBody(server string, organization
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewGetOrganizationCustomDynamicSamplingRuleRequest generates requests for GetOrganizationCustomDynamicSamplingRule
+func NewGetOrganizationCustomDynamicSamplingRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationCustomDynamicSamplingRuleParams) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// HealthCheckWithResponse request
-	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
 
-	// GetOrganizationWithResponse request
-	GetOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dynamic-sampling/custom-rules/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListOrganizationMonitorsWithResponse request
-	ListOrganizationMonitorsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*ListOrganizationMonitorsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteProjectMonitorWithResponse request
-	DeleteProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*DeleteProjectMonitorResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// GetProjectMonitorWithResponse request
-	GetProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*GetProjectMonitorResponse, error)
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
 
-	// UpdateProjectMonitorWithBodyWithResponse request with any body
-	UpdateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error)
+		if params.Project != nil {
 
-	UpdateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "project", *params.Project, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// ListOrganizationIntegrationsWithResponse request
-	ListOrganizationIntegrationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams, reqEditors ...RequestEditorFn) (*ListOrganizationIntegrationsResponse, error)
+		}
 
-	// GetOrganizationIntegrationWithResponse request
-	GetOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, reqEditors ...RequestEditorFn) (*GetOrganizationIntegrationResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// UpdateOrganizationIntegrationWithBodyWithResponse request with any body
-	UpdateOrganizationIntegrationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error)
+	return req, nil
+}
 
-	// ListOrganizationMembersWithResponse request
-	ListOrganizationMembersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams, reqEditors ...RequestEditorFn) (*ListOrganizationMembersResponse, error)
+// NewCreateOrganizationCustomDynamicSamplingRuleRequest calls the generic CreateOrganizationCustomDynamicSamplingRule builder with application/json body
+func NewCreateOrganizationCustomDynamicSamplingRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationCustomDynamicSamplingRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationCustomDynamicSamplingRuleRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
 
-	// CreateOrganizationMemberWithBodyWithResponse request with any body
-	CreateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error)
+// NewCreateOrganizationCustomDynamicSamplingRuleRequestWithBody generates requests for CreateOrganizationCustomDynamicSamplingRule with any type of body
+func NewCreateOrganizationCustomDynamicSamplingRuleRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	CreateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error)
+	var pathParam0 string
 
-	// DeleteOrganizationMemberWithResponse request
-	DeleteOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*DeleteOrganizationMemberResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOrganizationMemberWithResponse request
-	GetOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*GetOrganizationMemberResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateOrganizationMemberWithBodyWithResponse request with any body
-	UpdateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error)
+	operationPath := fmt.Sprintf("/0/organizations/%s/dynamic-sampling/custom-rules/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	UpdateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListOrganizationProjectsWithResponse request
-	ListOrganizationProjectsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*ListOrganizationProjectsResponse, error)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateProjectMonitorWithBodyWithResponse request with any body
-	CreateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	CreateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error)
+	return req, nil
+}
 
-	// ListSentryAppInstallationsWithResponse request
-	ListSentryAppInstallationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*ListSentryAppInstallationsResponse, error)
+// NewCreateExternalUserRequest calls the generic CreateExternalUser builder with application/json body
+func NewCreateExternalUserRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateExternalUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateExternalUserRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
 
-	// DisableSpikeProtectionWithBodyWithResponse request with any body
-	DisableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error)
+// NewCreateExternalUserRequestWithBody generates requests for CreateExternalUser with any type of body
+func NewCreateExternalUserRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	DisableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error)
+	var pathParam0 string
 
-	// EnableSpikeProtectionWithBodyWithResponse request with any body
-	EnableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	EnableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListOrganizationTeamsWithResponse request
-	ListOrganizationTeamsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*ListOrganizationTeamsResponse, error)
+	operationPath := fmt.Sprintf("/0/organizations/%s/external-users/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateOrganizationTeamWithBodyWithResponse request with any body
-	CreateOrganizationTeamWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListOrganizationWorkflowsWithResponse request
-	ListOrganizationWorkflowsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams, reqEditors ...RequestEditorFn) (*ListOrganizationWorkflowsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// CreateOrganizationWorkflowWithBodyWithResponse request with any body
-	CreateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error)
+	return req, nil
+}
 
-	CreateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error)
+// NewDeleteExternalUserRequest generates requests for DeleteExternalUser
+func NewDeleteExternalUserRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId) (*http.Request, error) {
+	var err error
 
-	// DeleteOrganizationWorkflowWithResponse request
-	DeleteOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationWorkflowResponse, error)
+	var pathParam0 string
 
-	// GetOrganizationWorkflowWithResponse request
-	GetOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*GetOrganizationWorkflowResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateOrganizationWorkflowWithBodyWithResponse request with any body
-	UpdateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error)
+	var pathParam1 string
 
-	UpdateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "external_user_id", externalUserId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteOrganizationProjectWithResponse request
-	DeleteOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationProjectResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOrganizationProjectWithResponse request
-	GetOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationProjectResponse, error)
+	operationPath := fmt.Sprintf("/0/organizations/%s/external-users/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateOrganizationProjectWithBodyWithResponse request with any body
-	UpdateOrganizationProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error)
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListProjectClientKeysWithResponse request
-	ListProjectClientKeysWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams, reqEditors ...RequestEditorFn) (*ListProjectClientKeysResponse, error)
+	return req, nil
+}
 
-	// CreateProjectClientKeyWithBodyWithResponse request with any body
-	CreateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error)
+// NewUpdateExternalUserRequest calls the generic UpdateExternalUser builder with application/json body
+func NewUpdateExternalUserRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, body UpdateExternalUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateExternalUserRequestWithBody(server, organizationIdOrSlug, externalUserId, "application/json", bodyReader)
+}
 
-	CreateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error)
+// NewUpdateExternalUserRequestWithBody generates requests for UpdateExternalUser with any type of body
+func NewUpdateExternalUserRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// DeleteProjectClientKeyWithResponse request
-	DeleteProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*DeleteProjectClientKeyResponse, error)
+	var pathParam0 string
 
-	// GetProjectClientKeyWithResponse request
-	GetProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*GetProjectClientKeyResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateProjectClientKeyWithBodyWithResponse request with any body
-	UpdateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error)
+	var pathParam1 string
 
-	UpdateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error)
-
-	// GetProjectOwnershipWithResponse request
-	GetProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetProjectOwnershipResponse, error)
-
-	// UpdateProjectOwnershipWithBodyWithResponse request with any body
-	UpdateProjectOwnershipWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error)
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "external_user_id", externalUserId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateProjectRuleWithBodyWithResponse request with any body
-	CreateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error)
+	operationPath := fmt.Sprintf("/0/organizations/%s/external-users/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteProjectRuleWithResponse request
-	DeleteProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*DeleteProjectRuleResponse, error)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetProjectRuleWithResponse request
-	GetProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*GetProjectRuleResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// UpdateProjectRuleWithBodyWithResponse request with any body
-	UpdateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error)
+	return req, nil
+}
 
-	UpdateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error)
+// NewListOrganizationDataForwardersRequest generates requests for ListOrganizationDataForwarders
+func NewListOrganizationDataForwardersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug) (*http.Request, error) {
+	var err error
 
-	// RemoveTeamFromProjectWithResponse request
-	RemoveTeamFromProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*RemoveTeamFromProjectResponse, error)
+	var pathParam0 string
 
-	// AddTeamToProjectWithResponse request
-	AddTeamToProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*AddTeamToProjectResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteOrganizationTeamWithResponse request
-	DeleteOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationTeamResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOrganizationTeamWithResponse request
-	GetOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationTeamResponse, error)
+	operationPath := fmt.Sprintf("/0/organizations/%s/forwarding/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateOrganizationTeamProjectWithBodyWithResponse request with any body
-	CreateOrganizationTeamProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateOrganizationTeamProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error)
-}
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-type HealthCheckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r HealthCheckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateOrganizationDataForwarderRequest calls the generic CreateOrganizationDataForwarder builder with application/json body
+func NewCreateOrganizationDataForwarderRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDataForwarderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationDataForwarderRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthCheckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateOrganizationDataForwarderRequestWithBody generates requests for CreateOrganizationDataForwarder with any type of body
+func NewCreateOrganizationDataForwarderRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r HealthCheckResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetOrganizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Organization
-}
+	operationPath := fmt.Sprintf("/0/organizations/%s/forwarding/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetOrganizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetOrganizationResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// NewDeleteOrganizationDataForwarderRequest generates requests for DeleteOrganizationDataForwarder
+func NewDeleteOrganizationDataForwarderRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type ListOrganizationMonitorsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]ProjectMonitor
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r ListOrganizationMonitorsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "data_forwarder_id", dataForwarderId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationMonitorsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListOrganizationMonitorsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	operationPath := fmt.Sprintf("/0/organizations/%s/forwarding/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ""
-}
 
-type DeleteProjectMonitorResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteProjectMonitorResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectMonitorResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUpdateOrganizationDataForwarderRequest calls the generic UpdateOrganizationDataForwarder builder with application/json body
+func NewUpdateOrganizationDataForwarderRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, body UpdateOrganizationDataForwarderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationDataForwarderRequestWithBody(server, organizationIdOrSlug, dataForwarderId, "application/json", bodyReader)
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteProjectMonitorResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// NewUpdateOrganizationDataForwarderRequestWithBody generates requests for UpdateOrganizationDataForwarder with any type of body
+func NewUpdateOrganizationDataForwarderRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetProjectMonitorResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectMonitor
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetProjectMonitorResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "data_forwarder_id", dataForwarderId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectMonitorResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetProjectMonitorResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	operationPath := fmt.Sprintf("/0/organizations/%s/forwarding/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ""
-}
 
-type UpdateProjectMonitorResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectMonitor
-}
-
-// Status returns HTTPResponse.Status
-func (r UpdateProjectMonitorResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProjectMonitorResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateProjectMonitorResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// NewCreateOrganizationGroupSearchViewRequest calls the generic CreateOrganizationGroupSearchView builder with application/json body
+func NewCreateOrganizationGroupSearchViewRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationGroupSearchViewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationGroupSearchViewRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
 }
 
-type ListOrganizationIntegrationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]OrganizationIntegration
-}
+// NewCreateOrganizationGroupSearchViewRequestWithBody generates requests for CreateOrganizationGroupSearchView with any type of body
+func NewCreateOrganizationGroupSearchViewRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ListOrganizationIntegrationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationIntegrationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListOrganizationIntegrationsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	operationPath := fmt.Sprintf("/0/organizations/%s/group-search-views/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ""
-}
 
-type GetOrganizationIntegrationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OrganizationIntegration
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetOrganizationIntegrationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationIntegrationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewDeleteOrganizationGroupSearchViewRequest generates requests for DeleteOrganizationGroupSearchView
+func NewDeleteOrganizationGroupSearchViewRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetOrganizationIntegrationResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "view_id", viewId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type UpdateOrganizationIntegrationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateOrganizationIntegrationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/0/organizations/%s/group-search-views/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateOrganizationIntegrationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateOrganizationIntegrationResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type ListOrganizationMembersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]OrganizationMember
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListOrganizationMembersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetOrganizationGroupSearchViewRequest generates requests for GetOrganizationGroupSearchView
+func NewGetOrganizationGroupSearchViewRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationMembersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "view_id", viewId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListOrganizationMembersResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type CreateOrganizationMemberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *OrganizationMember
-}
+	operationPath := fmt.Sprintf("/0/organizations/%s/group-search-views/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateOrganizationMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrganizationMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateOrganizationMemberResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// NewUpdateOrganizationGroupSearchViewRequest calls the generic UpdateOrganizationGroupSearchView builder with application/json body
+func NewUpdateOrganizationGroupSearchViewRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, body UpdateOrganizationGroupSearchViewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationGroupSearchViewRequestWithBody(server, organizationIdOrSlug, viewId, "application/json", bodyReader)
 }
 
-type DeleteOrganizationMemberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// NewUpdateOrganizationGroupSearchViewRequestWithBody generates requests for UpdateOrganizationGroupSearchView with any type of body
+func NewUpdateOrganizationGroupSearchViewRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r DeleteOrganizationMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteOrganizationMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "view_id", viewId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteOrganizationMemberResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetOrganizationMemberResponse struct {
+	operationPath := fmt.Sprintf("/0/organizations/%s/group-search-views/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStarOrganizationGroupSearchViewRequest calls the generic StarOrganizationGroupSearchView builder with application/json body
+func NewStarOrganizationGroupSearchViewRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, body StarOrganizationGroupSearchViewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStarOrganizationGroupSearchViewRequestWithBody(server, organizationIdOrSlug, viewId, "application/json", bodyReader)
+}
+
+// NewStarOrganizationGroupSearchViewRequestWithBody generates requests for StarOrganizationGroupSearchView with any type of body
+func NewStarOrganizationGroupSearchViewRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "view_id", viewId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/group-search-views/%s/starred/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationIntegrationsRequest generates requests for ListOrganizationIntegrations
+func NewListOrganizationIntegrationsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ProviderKey != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "provider_key", *params.ProviderKey, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationIntegrationRequest generates requests for GetOrganizationIntegration
+func NewGetOrganizationIntegrationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "integration_id", integrationId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationIntegrationRequest calls the generic UpdateOrganizationIntegration builder with application/json body
+func NewUpdateOrganizationIntegrationRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationIntegrationRequestWithBody(server, organizationIdOrSlug, integrationId, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationIntegrationRequestWithBody generates requests for UpdateOrganizationIntegration with any type of body
+func NewUpdateOrganizationIntegrationRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "integration_id", integrationId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/integrations/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationMembersRequest generates requests for ListOrganizationMembers
+func NewListOrganizationMembersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "expand", *params.Expand, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", *params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizationMemberRequest calls the generic CreateOrganizationMember builder with application/json body
+func NewCreateOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationMemberRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateOrganizationMemberRequestWithBody generates requests for CreateOrganizationMember with any type of body
+func NewCreateOrganizationMemberRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOrganizationMemberRequest generates requests for DeleteOrganizationMember
+func NewDeleteOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationMemberRequest generates requests for GetOrganizationMember
+func NewGetOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationMemberRequest calls the generic UpdateOrganizationMember builder with application/json body
+func NewUpdateOrganizationMemberRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationMemberRequestWithBody(server, organizationIdOrSlug, memberId, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationMemberRequestWithBody generates requests for UpdateOrganizationMember with any type of body
+func NewUpdateOrganizationMemberRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "member_id", memberId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/members/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationAuthTokensRequest generates requests for ListOrganizationAuthTokens
+func NewListOrganizationAuthTokensRequest(server string, organizationIdOrSlug OrganizationIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/org-auth-tokens/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizationAuthTokenRequest calls the generic CreateOrganizationAuthToken builder with application/json body
+func NewCreateOrganizationAuthTokenRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationAuthTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationAuthTokenRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateOrganizationAuthTokenRequestWithBody generates requests for CreateOrganizationAuthToken with any type of body
+func NewCreateOrganizationAuthTokenRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/org-auth-tokens/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOrganizationAuthTokenRequest generates requests for DeleteOrganizationAuthToken
+func NewDeleteOrganizationAuthTokenRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "token_id", tokenId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/org-auth-tokens/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationAuthTokenRequest generates requests for GetOrganizationAuthToken
+func NewGetOrganizationAuthTokenRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "token_id", tokenId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/org-auth-tokens/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationAuthTokenRequest calls the generic UpdateOrganizationAuthToken builder with application/json body
+func NewUpdateOrganizationAuthTokenRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, body UpdateOrganizationAuthTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationAuthTokenRequestWithBody(server, organizationIdOrSlug, tokenId, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationAuthTokenRequestWithBody generates requests for UpdateOrganizationAuthToken with any type of body
+func NewUpdateOrganizationAuthTokenRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "token_id", tokenId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/org-auth-tokens/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnpinOrganizationSearchRequest calls the generic UnpinOrganizationSearch builder with application/json body
+func NewUnpinOrganizationSearchRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body UnpinOrganizationSearchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUnpinOrganizationSearchRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewUnpinOrganizationSearchRequestWithBody generates requests for UnpinOrganizationSearch with any type of body
+func NewUnpinOrganizationSearchRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/pinned-searches/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPinOrganizationSearchRequest calls the generic PinOrganizationSearch builder with application/json body
+func NewPinOrganizationSearchRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body PinOrganizationSearchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPinOrganizationSearchRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewPinOrganizationSearchRequestWithBody generates requests for PinOrganizationSearch with any type of body
+func NewPinOrganizationSearchRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/pinned-searches/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationProjectsRequest generates requests for ListOrganizationProjects
+func NewListOrganizationProjectsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/projects/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Options != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "options", *params.Options, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectMonitorRequest calls the generic CreateProjectMonitor builder with application/json body
+func NewCreateProjectMonitorRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectMonitorRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateProjectMonitorRequestWithBody generates requests for CreateProjectMonitor with any type of body
+func NewCreateProjectMonitorRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/projects/%s/detectors/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationProjectSampleRatesRequest generates requests for ListOrganizationProjectSampleRates
+func NewListOrganizationProjectSampleRatesRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectSampleRatesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/sampling/project-rates/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationProjectSampleRatesRequest calls the generic UpdateOrganizationProjectSampleRates builder with application/json body
+func NewUpdateOrganizationProjectSampleRatesRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationProjectSampleRatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationProjectSampleRatesRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationProjectSampleRatesRequestWithBody generates requests for UpdateOrganizationProjectSampleRates with any type of body
+func NewUpdateOrganizationProjectSampleRatesRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/sampling/project-rates/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationSavedSearchesRequest generates requests for ListOrganizationSavedSearches
+func NewListOrganizationSavedSearchesRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationSavedSearchesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/searches/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "type", *params.Type, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: "int64"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizationSavedSearchRequest calls the generic CreateOrganizationSavedSearch builder with application/json body
+func NewCreateOrganizationSavedSearchRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationSavedSearchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationSavedSearchRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateOrganizationSavedSearchRequestWithBody generates requests for CreateOrganizationSavedSearch with any type of body
+func NewCreateOrganizationSavedSearchRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/searches/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOrganizationSavedSearchRequest generates requests for DeleteOrganizationSavedSearch
+func NewDeleteOrganizationSavedSearchRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "search_id", searchId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/searches/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationSavedSearchRequest calls the generic UpdateOrganizationSavedSearch builder with application/json body
+func NewUpdateOrganizationSavedSearchRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, body UpdateOrganizationSavedSearchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationSavedSearchRequestWithBody(server, organizationIdOrSlug, searchId, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationSavedSearchRequestWithBody generates requests for UpdateOrganizationSavedSearch with any type of body
+func NewUpdateOrganizationSavedSearchRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "search_id", searchId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/searches/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSentryAppInstallationsRequest generates requests for ListSentryAppInstallations
+func NewListSentryAppInstallationsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/sentry-app-installations/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDisableSpikeProtectionRequest calls the generic DisableSpikeProtection builder with application/json body
+func NewDisableSpikeProtectionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisableSpikeProtectionRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewDisableSpikeProtectionRequestWithBody generates requests for DisableSpikeProtection with any type of body
+func NewDisableSpikeProtectionRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/spike-protections/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEnableSpikeProtectionRequest calls the generic EnableSpikeProtection builder with application/json body
+func NewEnableSpikeProtectionRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEnableSpikeProtectionRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewEnableSpikeProtectionRequestWithBody generates requests for EnableSpikeProtection with any type of body
+func NewEnableSpikeProtectionRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/spike-protections/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationTeamsRequest generates requests for ListOrganizationTeams
+func NewListOrganizationTeamsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationTeamsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/teams/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "expand", *params.Expand, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", *params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizationTeamRequest calls the generic CreateOrganizationTeam builder with application/json body
+func NewCreateOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationTeamRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateOrganizationTeamRequestWithBody generates requests for CreateOrganizationTeam with any type of body
+func NewCreateOrganizationTeamRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/teams/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationWorkflowsRequest generates requests for ListOrganizationWorkflows
+func NewListOrganizationWorkflowsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizationWorkflowRequest calls the generic CreateOrganizationWorkflow builder with application/json body
+func NewCreateOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationWorkflowRequestWithBody(server, organizationIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateOrganizationWorkflowRequestWithBody generates requests for CreateOrganizationWorkflow with any type of body
+func NewCreateOrganizationWorkflowRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewBulkUpdateOrganizationWorkflowsRequest calls the generic BulkUpdateOrganizationWorkflows builder with application/json body
+func NewBulkUpdateOrganizationWorkflowsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationWorkflowsParams, body BulkUpdateOrganizationWorkflowsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkUpdateOrganizationWorkflowsRequestWithBody(server, organizationIdOrSlug, params, "application/json", bodyReader)
+}
+
+// NewBulkUpdateOrganizationWorkflowsRequestWithBody generates requests for BulkUpdateOrganizationWorkflows with any type of body
+func NewBulkUpdateOrganizationWorkflowsRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationWorkflowsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "id", params.Id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOrganizationWorkflowRequest generates requests for DeleteOrganizationWorkflow
+func NewDeleteOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationWorkflowRequest generates requests for GetOrganizationWorkflow
+func NewGetOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationWorkflowRequest calls the generic UpdateOrganizationWorkflow builder with application/json body
+func NewUpdateOrganizationWorkflowRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationWorkflowRequestWithBody(server, organizationIdOrSlug, workflowId, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationWorkflowRequestWithBody generates requests for UpdateOrganizationWorkflow with any type of body
+func NewUpdateOrganizationWorkflowRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "workflow_id", workflowId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/organizations/%s/workflows/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOrganizationProjectRequest generates requests for DeleteOrganizationProject
+func NewDeleteOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationProjectRequest generates requests for GetOrganizationProject
+func NewGetOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationProjectRequest calls the generic UpdateOrganizationProject builder with application/json body
+func NewUpdateOrganizationProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationProjectRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationProjectRequestWithBody generates requests for UpdateOrganizationProject with any type of body
+func NewUpdateOrganizationProjectRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListProjectCodeOwnersRequest generates requests for ListProjectCodeOwners
+func NewListProjectCodeOwnersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectCodeOwnersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/codeowners/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "expand", *params.Expand, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectCodeOwnersRequest calls the generic CreateProjectCodeOwners builder with application/json body
+func NewCreateProjectCodeOwnersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectCodeOwnersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectCodeOwnersRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateProjectCodeOwnersRequestWithBody generates requests for CreateProjectCodeOwners with any type of body
+func NewCreateProjectCodeOwnersRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/codeowners/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectCodeOwnersRequest generates requests for DeleteProjectCodeOwners
+func NewDeleteProjectCodeOwnersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "codeowners_id", codeownersId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/codeowners/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectCodeOwnersRequest calls the generic UpdateProjectCodeOwners builder with application/json body
+func NewUpdateProjectCodeOwnersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, body UpdateProjectCodeOwnersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectCodeOwnersRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, codeownersId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectCodeOwnersRequestWithBody generates requests for UpdateProjectCodeOwners with any type of body
+func NewUpdateProjectCodeOwnersRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "codeowners_id", codeownersId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/codeowners/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListProjectEnvironmentsRequest generates requests for ListProjectEnvironments
+func NewListProjectEnvironmentsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectEnvironmentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/environments/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Visibility != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "visibility", *params.Visibility, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectEnvironmentRequest generates requests for GetProjectEnvironment
+func NewGetProjectEnvironmentRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "environment", environment, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/environments/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectEnvironmentRequest calls the generic UpdateProjectEnvironment builder with application/json body
+func NewUpdateProjectEnvironmentRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, body UpdateProjectEnvironmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectEnvironmentRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, environment, "application/json", bodyReader)
+}
+
+// NewUpdateProjectEnvironmentRequestWithBody generates requests for UpdateProjectEnvironment with any type of body
+func NewUpdateProjectEnvironmentRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "environment", environment, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/environments/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListProjectClientKeysRequest generates requests for ListProjectClientKeys
+func NewListProjectClientKeysRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "status", *params.Status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectClientKeyRequest calls the generic CreateProjectClientKey builder with application/json body
+func NewCreateProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectClientKeyRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateProjectClientKeyRequestWithBody generates requests for CreateProjectClientKey with any type of body
+func NewCreateProjectClientKeyRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectClientKeyRequest generates requests for DeleteProjectClientKey
+func NewDeleteProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectClientKeyRequest generates requests for GetProjectClientKey
+func NewGetProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectClientKeyRequest calls the generic UpdateProjectClientKey builder with application/json body
+func NewUpdateProjectClientKeyRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectClientKeyRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, keyId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectClientKeyRequestWithBody generates requests for UpdateProjectClientKey with any type of body
+func NewUpdateProjectClientKeyRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key_id", keyId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/keys/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateProjectCronMonitorMuteRequest calls the generic UpdateProjectCronMonitorMute builder with application/json body
+func NewUpdateProjectCronMonitorMuteRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, body UpdateProjectCronMonitorMuteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectCronMonitorMuteRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, monitorIdOrSlug, "application/json", bodyReader)
+}
+
+// NewUpdateProjectCronMonitorMuteRequestWithBody generates requests for UpdateProjectCronMonitorMute with any type of body
+func NewUpdateProjectCronMonitorMuteRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "monitor_id_or_slug", monitorIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/monitors/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateProjectCronMonitorEnvironmentMuteRequest calls the generic UpdateProjectCronMonitorEnvironmentMute builder with application/json body
+func NewUpdateProjectCronMonitorEnvironmentMuteRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, environment string, body UpdateProjectCronMonitorEnvironmentMuteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectCronMonitorEnvironmentMuteRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, monitorIdOrSlug, environment, "application/json", bodyReader)
+}
+
+// NewUpdateProjectCronMonitorEnvironmentMuteRequestWithBody generates requests for UpdateProjectCronMonitorEnvironmentMute with any type of body
+func NewUpdateProjectCronMonitorEnvironmentMuteRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, environment string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "monitor_id_or_slug", monitorIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithOptions("simple", false, "environment", environment, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/monitors/%s/environments/%s/", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProjectOwnershipRequest generates requests for GetProjectOwnership
+func NewGetProjectOwnershipRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/ownership", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectOwnershipRequest calls the generic UpdateProjectOwnership builder with application/json body
+func NewUpdateProjectOwnershipRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectOwnershipRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewUpdateProjectOwnershipRequestWithBody generates requests for UpdateProjectOwnership with any type of body
+func NewUpdateProjectOwnershipRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/ownership", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewResetProjectPerformanceIssueSettingsRequest generates requests for ResetProjectPerformanceIssueSettings
+func NewResetProjectPerformanceIssueSettingsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/performance-issues/configure/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectPerformanceIssueSettingsRequest generates requests for GetProjectPerformanceIssueSettings
+func NewGetProjectPerformanceIssueSettingsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/performance-issues/configure/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectPerformanceIssueSettingsRequest calls the generic UpdateProjectPerformanceIssueSettings builder with application/json body
+func NewUpdateProjectPerformanceIssueSettingsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectPerformanceIssueSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectPerformanceIssueSettingsRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewUpdateProjectPerformanceIssueSettingsRequestWithBody generates requests for UpdateProjectPerformanceIssueSettings with any type of body
+func NewUpdateProjectPerformanceIssueSettingsRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/performance-issues/configure/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisableProjectPluginRequest generates requests for DisableProjectPlugin
+func NewDisableProjectPluginRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "plugin_id", pluginId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/plugins/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectPluginRequest generates requests for GetProjectPlugin
+func NewGetProjectPluginRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "plugin_id", pluginId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/plugins/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEnableProjectPluginRequest calls the generic EnableProjectPlugin builder with application/json body
+func NewEnableProjectPluginRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, body EnableProjectPluginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEnableProjectPluginRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, pluginId, "application/json", bodyReader)
+}
+
+// NewEnableProjectPluginRequestWithBody generates requests for EnableProjectPlugin with any type of body
+func NewEnableProjectPluginRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "plugin_id", pluginId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/plugins/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateProjectPluginRequest calls the generic UpdateProjectPlugin builder with application/json body
+func NewUpdateProjectPluginRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, body UpdateProjectPluginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectPluginRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, pluginId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectPluginRequestWithBody generates requests for UpdateProjectPlugin with any type of body
+func NewUpdateProjectPluginRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "plugin_id", pluginId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/plugins/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListProjectReleaseThresholdsRequest generates requests for ListProjectReleaseThresholds
+func NewListProjectReleaseThresholdsRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/release-thresholds/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectReleaseThresholdRequest calls the generic CreateProjectReleaseThreshold builder with application/json body
+func NewCreateProjectReleaseThresholdRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectReleaseThresholdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectReleaseThresholdRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateProjectReleaseThresholdRequestWithBody generates requests for CreateProjectReleaseThreshold with any type of body
+func NewCreateProjectReleaseThresholdRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/release-thresholds/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectReleaseThresholdRequest generates requests for DeleteProjectReleaseThreshold
+func NewDeleteProjectReleaseThresholdRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "release_threshold_id", releaseThresholdId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/release-thresholds/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectReleaseThresholdRequest generates requests for GetProjectReleaseThreshold
+func NewGetProjectReleaseThresholdRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "release_threshold_id", releaseThresholdId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/release-thresholds/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectReleaseThresholdRequest calls the generic UpdateProjectReleaseThreshold builder with application/json body
+func NewUpdateProjectReleaseThresholdRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, body UpdateProjectReleaseThresholdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectReleaseThresholdRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, releaseThresholdId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectReleaseThresholdRequestWithBody generates requests for UpdateProjectReleaseThreshold with any type of body
+func NewUpdateProjectReleaseThresholdRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "release_threshold_id", releaseThresholdId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/release-thresholds/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateProjectRuleRequest calls the generic CreateProjectRule builder with application/json body
+func NewCreateProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectRuleRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateProjectRuleRequestWithBody generates requests for CreateProjectRule with any type of body
+func NewCreateProjectRuleRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectRuleRequest generates requests for DeleteProjectRule
+func NewDeleteProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectRuleRequest generates requests for GetProjectRule
+func NewGetProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectRuleRequest calls the generic UpdateProjectRule builder with application/json body
+func NewUpdateProjectRuleRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectRuleRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, ruleId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectRuleRequestWithBody generates requests for UpdateProjectRule with any type of body
+func NewUpdateProjectRuleRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectRuleSnoozeRequest calls the generic DeleteProjectRuleSnooze builder with application/json body
+func NewDeleteProjectRuleSnoozeRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body DeleteProjectRuleSnoozeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteProjectRuleSnoozeRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, ruleId, "application/json", bodyReader)
+}
+
+// NewDeleteProjectRuleSnoozeRequestWithBody generates requests for DeleteProjectRuleSnooze with any type of body
+func NewDeleteProjectRuleSnoozeRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/snooze/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateProjectRuleSnoozeRequest calls the generic CreateProjectRuleSnooze builder with application/json body
+func NewCreateProjectRuleSnoozeRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body CreateProjectRuleSnoozeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectRuleSnoozeRequestWithBody(server, organizationIdOrSlug, projectIdOrSlug, ruleId, "application/json", bodyReader)
+}
+
+// NewCreateProjectRuleSnoozeRequestWithBody generates requests for CreateProjectRuleSnooze with any type of body
+func NewCreateProjectRuleSnoozeRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "rule_id", ruleId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/rules/%s/snooze/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListProjectTagKeyValuesRequest generates requests for ListProjectTagKeyValues
+func NewListProjectTagKeyValuesRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, key string, params *ListProjectTagKeyValuesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "key", key, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/tags/%s/values/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveTeamFromProjectRequest generates requests for RemoveTeamFromProject
+func NewRemoveTeamFromProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddTeamToProjectRequest generates requests for AddTeamToProject
+func NewAddTeamToProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "project_id_or_slug", projectIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/projects/%s/%s/teams/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSentryAppRequest calls the generic CreateSentryApp builder with application/json body
+func NewCreateSentryAppRequest(server string, body CreateSentryAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSentryAppRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSentryAppRequestWithBody generates requests for CreateSentryApp with any type of body
+func NewCreateSentryAppRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/sentry-apps/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSentryAppRequest generates requests for DeleteSentryApp
+func NewDeleteSentryAppRequest(server string, sentryAppIdOrSlug SentryAppIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sentry_app_id_or_slug", sentryAppIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/sentry-apps/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSentryAppRequest generates requests for GetSentryApp
+func NewGetSentryAppRequest(server string, sentryAppIdOrSlug SentryAppIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sentry_app_id_or_slug", sentryAppIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/sentry-apps/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSentryAppRequest calls the generic UpdateSentryApp builder with application/json body
+func NewUpdateSentryAppRequest(server string, sentryAppIdOrSlug SentryAppIdOrSlug, body UpdateSentryAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSentryAppRequestWithBody(server, sentryAppIdOrSlug, "application/json", bodyReader)
+}
+
+// NewUpdateSentryAppRequestWithBody generates requests for UpdateSentryApp with any type of body
+func NewUpdateSentryAppRequestWithBody(server string, sentryAppIdOrSlug SentryAppIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sentry_app_id_or_slug", sentryAppIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/sentry-apps/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateSentryAppApiTokenRequest generates requests for CreateSentryAppApiToken
+func NewCreateSentryAppApiTokenRequest(server string, sentryAppIdOrSlug SentryAppIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sentry_app_id_or_slug", sentryAppIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/sentry-apps/%s/api-tokens/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteSentryAppApiTokenRequest generates requests for DeleteSentryAppApiToken
+func NewDeleteSentryAppApiTokenRequest(server string, sentryAppIdOrSlug SentryAppIdOrSlug, apiTokenId ApiTokenId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sentry_app_id_or_slug", sentryAppIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "api_token_id", apiTokenId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/sentry-apps/%s/api-tokens/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteOrganizationTeamRequest generates requests for DeleteOrganizationTeam
+func NewDeleteOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationTeamRequest generates requests for GetOrganizationTeam
+func NewGetOrganizationTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateExternalTeamRequest calls the generic CreateExternalTeam builder with application/json body
+func NewCreateExternalTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateExternalTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateExternalTeamRequestWithBody(server, organizationIdOrSlug, teamIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateExternalTeamRequestWithBody generates requests for CreateExternalTeam with any type of body
+func NewCreateExternalTeamRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/external-teams/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteExternalTeamRequest generates requests for DeleteExternalTeam
+func NewDeleteExternalTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "external_team_id", externalTeamId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/external-teams/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateExternalTeamRequest calls the generic UpdateExternalTeam builder with application/json body
+func NewUpdateExternalTeamRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, body UpdateExternalTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateExternalTeamRequestWithBody(server, organizationIdOrSlug, teamIdOrSlug, externalTeamId, "application/json", bodyReader)
+}
+
+// NewUpdateExternalTeamRequestWithBody generates requests for UpdateExternalTeam with any type of body
+func NewUpdateExternalTeamRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "external_team_id", externalTeamId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/external-teams/%s/", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTeamMembersRequest generates requests for ListTeamMembers
+func NewListTeamMembersRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListTeamMembersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/members/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizationTeamProjectRequest calls the generic CreateOrganizationTeamProject builder with application/json body
+func NewCreateOrganizationTeamProjectRequest(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationTeamProjectRequestWithBody(server, organizationIdOrSlug, teamIdOrSlug, "application/json", bodyReader)
+}
+
+// NewCreateOrganizationTeamProjectRequestWithBody generates requests for CreateOrganizationTeamProject with any type of body
+func NewCreateOrganizationTeamProjectRequestWithBody(server string, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "organization_id_or_slug", organizationIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "team_id_or_slug", teamIdOrSlug, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/0/teams/%s/%s/projects/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// HealthCheckWithResponse request
+	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+
+	// GetOrganizationWithResponse request
+	GetOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error)
+
+	// UpdateOrganizationWithBodyWithResponse request with any body
+	UpdateOrganizationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error)
+
+	UpdateOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error)
+
+	// GetOrganizationCodeMappingCodeOwnersFileWithResponse request
+	GetOrganizationCodeMappingCodeOwnersFileWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, codeMappingId CodeMappingId, reqEditors ...RequestEditorFn) (*GetOrganizationCodeMappingCodeOwnersFileResponse, error)
+
+	// CreateOrganizationDashboardWithBodyWithResponse request with any body
+	CreateOrganizationDashboardWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationDashboardResponse, error)
+
+	CreateOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationDashboardResponse, error)
+
+	// DeleteOrganizationDashboardWithResponse request
+	DeleteOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*DeleteOrganizationDashboardResponse, error)
+
+	// GetOrganizationDashboardWithResponse request
+	GetOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*GetOrganizationDashboardResponse, error)
+
+	// UpdateOrganizationDashboardWithBodyWithResponse request with any body
+	UpdateOrganizationDashboardWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationDashboardResponse, error)
+
+	UpdateOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, body UpdateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationDashboardResponse, error)
+
+	// ListOrganizationDetectorWorkflowsWithResponse request
+	ListOrganizationDetectorWorkflowsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDetectorWorkflowsParams, reqEditors ...RequestEditorFn) (*ListOrganizationDetectorWorkflowsResponse, error)
+
+	// CreateOrganizationDetectorWorkflowWithBodyWithResponse request with any body
+	CreateOrganizationDetectorWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationDetectorWorkflowResponse, error)
+
+	CreateOrganizationDetectorWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDetectorWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationDetectorWorkflowResponse, error)
+
+	// DeleteOrganizationDetectorWorkflowWithResponse request
+	DeleteOrganizationDetectorWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorWorkflowId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationDetectorWorkflowResponse, error)
+
+	// ListOrganizationMonitorsWithResponse request
+	ListOrganizationMonitorsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*ListOrganizationMonitorsResponse, error)
+
+	// BulkUpdateOrganizationMonitorsWithBodyWithResponse request with any body
+	BulkUpdateOrganizationMonitorsWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationMonitorsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkUpdateOrganizationMonitorsResponse, error)
+
+	BulkUpdateOrganizationMonitorsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationMonitorsParams, body BulkUpdateOrganizationMonitorsJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkUpdateOrganizationMonitorsResponse, error)
+
+	// DeleteProjectMonitorWithResponse request
+	DeleteProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*DeleteProjectMonitorResponse, error)
+
+	// GetProjectMonitorWithResponse request
+	GetProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*GetProjectMonitorResponse, error)
+
+	// UpdateProjectMonitorWithBodyWithResponse request with any body
+	UpdateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error)
+
+	UpdateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error)
+
+	// ListOrganizationDiscoverSavedQueriesWithResponse request
+	ListOrganizationDiscoverSavedQueriesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDiscoverSavedQueriesParams, reqEditors ...RequestEditorFn) (*ListOrganizationDiscoverSavedQueriesResponse, error)
+
+	// CreateOrganizationDiscoverSavedQueryWithBodyWithResponse request with any body
+	CreateOrganizationDiscoverSavedQueryWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationDiscoverSavedQueryResponse, error)
+
+	CreateOrganizationDiscoverSavedQueryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDiscoverSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationDiscoverSavedQueryResponse, error)
+
+	// DeleteOrganizationDiscoverSavedQueryWithResponse request
+	DeleteOrganizationDiscoverSavedQueryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, reqEditors ...RequestEditorFn) (*DeleteOrganizationDiscoverSavedQueryResponse, error)
+
+	// GetOrganizationDiscoverSavedQueryWithResponse request
+	GetOrganizationDiscoverSavedQueryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, reqEditors ...RequestEditorFn) (*GetOrganizationDiscoverSavedQueryResponse, error)
+
+	// UpdateOrganizationDiscoverSavedQueryWithBodyWithResponse request with any body
+	UpdateOrganizationDiscoverSavedQueryWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationDiscoverSavedQueryResponse, error)
+
+	UpdateOrganizationDiscoverSavedQueryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, body UpdateOrganizationDiscoverSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationDiscoverSavedQueryResponse, error)
+
+	// GetOrganizationCustomDynamicSamplingRuleWithResponse request
+	GetOrganizationCustomDynamicSamplingRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationCustomDynamicSamplingRuleParams, reqEditors ...RequestEditorFn) (*GetOrganizationCustomDynamicSamplingRuleResponse, error)
+
+	// CreateOrganizationCustomDynamicSamplingRuleWithBodyWithResponse request with any body
+	CreateOrganizationCustomDynamicSamplingRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationCustomDynamicSamplingRuleResponse, error)
+
+	CreateOrganizationCustomDynamicSamplingRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationCustomDynamicSamplingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationCustomDynamicSamplingRuleResponse, error)
+
+	// CreateExternalUserWithBodyWithResponse request with any body
+	CreateExternalUserWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateExternalUserResponse, error)
+
+	CreateExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateExternalUserResponse, error)
+
+	// DeleteExternalUserWithResponse request
+	DeleteExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, reqEditors ...RequestEditorFn) (*DeleteExternalUserResponse, error)
+
+	// UpdateExternalUserWithBodyWithResponse request with any body
+	UpdateExternalUserWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateExternalUserResponse, error)
+
+	UpdateExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, body UpdateExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateExternalUserResponse, error)
+
+	// ListOrganizationDataForwardersWithResponse request
+	ListOrganizationDataForwardersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*ListOrganizationDataForwardersResponse, error)
+
+	// CreateOrganizationDataForwarderWithBodyWithResponse request with any body
+	CreateOrganizationDataForwarderWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationDataForwarderResponse, error)
+
+	CreateOrganizationDataForwarderWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDataForwarderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationDataForwarderResponse, error)
+
+	// DeleteOrganizationDataForwarderWithResponse request
+	DeleteOrganizationDataForwarderWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, reqEditors ...RequestEditorFn) (*DeleteOrganizationDataForwarderResponse, error)
+
+	// UpdateOrganizationDataForwarderWithBodyWithResponse request with any body
+	UpdateOrganizationDataForwarderWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationDataForwarderResponse, error)
+
+	UpdateOrganizationDataForwarderWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, body UpdateOrganizationDataForwarderJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationDataForwarderResponse, error)
+
+	// CreateOrganizationGroupSearchViewWithBodyWithResponse request with any body
+	CreateOrganizationGroupSearchViewWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationGroupSearchViewResponse, error)
+
+	CreateOrganizationGroupSearchViewWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationGroupSearchViewResponse, error)
+
+	// DeleteOrganizationGroupSearchViewWithResponse request
+	DeleteOrganizationGroupSearchViewWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, reqEditors ...RequestEditorFn) (*DeleteOrganizationGroupSearchViewResponse, error)
+
+	// GetOrganizationGroupSearchViewWithResponse request
+	GetOrganizationGroupSearchViewWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, reqEditors ...RequestEditorFn) (*GetOrganizationGroupSearchViewResponse, error)
+
+	// UpdateOrganizationGroupSearchViewWithBodyWithResponse request with any body
+	UpdateOrganizationGroupSearchViewWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationGroupSearchViewResponse, error)
+
+	UpdateOrganizationGroupSearchViewWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, body UpdateOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationGroupSearchViewResponse, error)
+
+	// StarOrganizationGroupSearchViewWithBodyWithResponse request with any body
+	StarOrganizationGroupSearchViewWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StarOrganizationGroupSearchViewResponse, error)
+
+	StarOrganizationGroupSearchViewWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, body StarOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*StarOrganizationGroupSearchViewResponse, error)
+
+	// ListOrganizationIntegrationsWithResponse request
+	ListOrganizationIntegrationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams, reqEditors ...RequestEditorFn) (*ListOrganizationIntegrationsResponse, error)
+
+	// GetOrganizationIntegrationWithResponse request
+	GetOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, reqEditors ...RequestEditorFn) (*GetOrganizationIntegrationResponse, error)
+
+	// UpdateOrganizationIntegrationWithBodyWithResponse request with any body
+	UpdateOrganizationIntegrationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error)
+
+	UpdateOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error)
+
+	// ListOrganizationMembersWithResponse request
+	ListOrganizationMembersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams, reqEditors ...RequestEditorFn) (*ListOrganizationMembersResponse, error)
+
+	// CreateOrganizationMemberWithBodyWithResponse request with any body
+	CreateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error)
+
+	CreateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error)
+
+	// DeleteOrganizationMemberWithResponse request
+	DeleteOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*DeleteOrganizationMemberResponse, error)
+
+	// GetOrganizationMemberWithResponse request
+	GetOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*GetOrganizationMemberResponse, error)
+
+	// UpdateOrganizationMemberWithBodyWithResponse request with any body
+	UpdateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error)
+
+	UpdateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error)
+
+	// ListOrganizationAuthTokensWithResponse request
+	ListOrganizationAuthTokensWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*ListOrganizationAuthTokensResponse, error)
+
+	// CreateOrganizationAuthTokenWithBodyWithResponse request with any body
+	CreateOrganizationAuthTokenWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationAuthTokenResponse, error)
+
+	CreateOrganizationAuthTokenWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationAuthTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationAuthTokenResponse, error)
+
+	// DeleteOrganizationAuthTokenWithResponse request
+	DeleteOrganizationAuthTokenWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, reqEditors ...RequestEditorFn) (*DeleteOrganizationAuthTokenResponse, error)
+
+	// GetOrganizationAuthTokenWithResponse request
+	GetOrganizationAuthTokenWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, reqEditors ...RequestEditorFn) (*GetOrganizationAuthTokenResponse, error)
+
+	// UpdateOrganizationAuthTokenWithBodyWithResponse request with any body
+	UpdateOrganizationAuthTokenWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationAuthTokenResponse, error)
+
+	UpdateOrganizationAuthTokenWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, body UpdateOrganizationAuthTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationAuthTokenResponse, error)
+
+	// UnpinOrganizationSearchWithBodyWithResponse request with any body
+	UnpinOrganizationSearchWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnpinOrganizationSearchResponse, error)
+
+	UnpinOrganizationSearchWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UnpinOrganizationSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*UnpinOrganizationSearchResponse, error)
+
+	// PinOrganizationSearchWithBodyWithResponse request with any body
+	PinOrganizationSearchWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PinOrganizationSearchResponse, error)
+
+	PinOrganizationSearchWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body PinOrganizationSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*PinOrganizationSearchResponse, error)
+
+	// ListOrganizationProjectsWithResponse request
+	ListOrganizationProjectsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*ListOrganizationProjectsResponse, error)
+
+	// CreateProjectMonitorWithBodyWithResponse request with any body
+	CreateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error)
+
+	CreateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error)
+
+	// ListOrganizationProjectSampleRatesWithResponse request
+	ListOrganizationProjectSampleRatesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectSampleRatesParams, reqEditors ...RequestEditorFn) (*ListOrganizationProjectSampleRatesResponse, error)
+
+	// UpdateOrganizationProjectSampleRatesWithBodyWithResponse request with any body
+	UpdateOrganizationProjectSampleRatesWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectSampleRatesResponse, error)
+
+	UpdateOrganizationProjectSampleRatesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationProjectSampleRatesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectSampleRatesResponse, error)
+
+	// ListOrganizationSavedSearchesWithResponse request
+	ListOrganizationSavedSearchesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationSavedSearchesParams, reqEditors ...RequestEditorFn) (*ListOrganizationSavedSearchesResponse, error)
+
+	// CreateOrganizationSavedSearchWithBodyWithResponse request with any body
+	CreateOrganizationSavedSearchWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationSavedSearchResponse, error)
+
+	CreateOrganizationSavedSearchWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationSavedSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationSavedSearchResponse, error)
+
+	// DeleteOrganizationSavedSearchWithResponse request
+	DeleteOrganizationSavedSearchWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, reqEditors ...RequestEditorFn) (*DeleteOrganizationSavedSearchResponse, error)
+
+	// UpdateOrganizationSavedSearchWithBodyWithResponse request with any body
+	UpdateOrganizationSavedSearchWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationSavedSearchResponse, error)
+
+	UpdateOrganizationSavedSearchWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, body UpdateOrganizationSavedSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationSavedSearchResponse, error)
+
+	// ListSentryAppInstallationsWithResponse request
+	ListSentryAppInstallationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*ListSentryAppInstallationsResponse, error)
+
+	// DisableSpikeProtectionWithBodyWithResponse request with any body
+	DisableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error)
+
+	DisableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error)
+
+	// EnableSpikeProtectionWithBodyWithResponse request with any body
+	EnableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error)
+
+	EnableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error)
+
+	// ListOrganizationTeamsWithResponse request
+	ListOrganizationTeamsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationTeamsParams, reqEditors ...RequestEditorFn) (*ListOrganizationTeamsResponse, error)
+
+	// CreateOrganizationTeamWithBodyWithResponse request with any body
+	CreateOrganizationTeamWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error)
+
+	CreateOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error)
+
+	// ListOrganizationWorkflowsWithResponse request
+	ListOrganizationWorkflowsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams, reqEditors ...RequestEditorFn) (*ListOrganizationWorkflowsResponse, error)
+
+	// CreateOrganizationWorkflowWithBodyWithResponse request with any body
+	CreateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error)
+
+	CreateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error)
+
+	// BulkUpdateOrganizationWorkflowsWithBodyWithResponse request with any body
+	BulkUpdateOrganizationWorkflowsWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationWorkflowsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkUpdateOrganizationWorkflowsResponse, error)
+
+	BulkUpdateOrganizationWorkflowsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationWorkflowsParams, body BulkUpdateOrganizationWorkflowsJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkUpdateOrganizationWorkflowsResponse, error)
+
+	// DeleteOrganizationWorkflowWithResponse request
+	DeleteOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationWorkflowResponse, error)
+
+	// GetOrganizationWorkflowWithResponse request
+	GetOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*GetOrganizationWorkflowResponse, error)
+
+	// UpdateOrganizationWorkflowWithBodyWithResponse request with any body
+	UpdateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error)
+
+	UpdateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error)
+
+	// DeleteOrganizationProjectWithResponse request
+	DeleteOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationProjectResponse, error)
+
+	// GetOrganizationProjectWithResponse request
+	GetOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationProjectResponse, error)
+
+	// UpdateOrganizationProjectWithBodyWithResponse request with any body
+	UpdateOrganizationProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error)
+
+	UpdateOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error)
+
+	// ListProjectCodeOwnersWithResponse request
+	ListProjectCodeOwnersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectCodeOwnersParams, reqEditors ...RequestEditorFn) (*ListProjectCodeOwnersResponse, error)
+
+	// CreateProjectCodeOwnersWithBodyWithResponse request with any body
+	CreateProjectCodeOwnersWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectCodeOwnersResponse, error)
+
+	CreateProjectCodeOwnersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectCodeOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectCodeOwnersResponse, error)
+
+	// DeleteProjectCodeOwnersWithResponse request
+	DeleteProjectCodeOwnersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, reqEditors ...RequestEditorFn) (*DeleteProjectCodeOwnersResponse, error)
+
+	// UpdateProjectCodeOwnersWithBodyWithResponse request with any body
+	UpdateProjectCodeOwnersWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectCodeOwnersResponse, error)
+
+	UpdateProjectCodeOwnersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, body UpdateProjectCodeOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectCodeOwnersResponse, error)
+
+	// ListProjectEnvironmentsWithResponse request
+	ListProjectEnvironmentsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectEnvironmentsParams, reqEditors ...RequestEditorFn) (*ListProjectEnvironmentsResponse, error)
+
+	// GetProjectEnvironmentWithResponse request
+	GetProjectEnvironmentWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, reqEditors ...RequestEditorFn) (*GetProjectEnvironmentResponse, error)
+
+	// UpdateProjectEnvironmentWithBodyWithResponse request with any body
+	UpdateProjectEnvironmentWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentResponse, error)
+
+	UpdateProjectEnvironmentWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, body UpdateProjectEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentResponse, error)
+
+	// ListProjectClientKeysWithResponse request
+	ListProjectClientKeysWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams, reqEditors ...RequestEditorFn) (*ListProjectClientKeysResponse, error)
+
+	// CreateProjectClientKeyWithBodyWithResponse request with any body
+	CreateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error)
+
+	CreateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error)
+
+	// DeleteProjectClientKeyWithResponse request
+	DeleteProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*DeleteProjectClientKeyResponse, error)
+
+	// GetProjectClientKeyWithResponse request
+	GetProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*GetProjectClientKeyResponse, error)
+
+	// UpdateProjectClientKeyWithBodyWithResponse request with any body
+	UpdateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error)
+
+	UpdateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error)
+
+	// UpdateProjectCronMonitorMuteWithBodyWithResponse request with any body
+	UpdateProjectCronMonitorMuteWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectCronMonitorMuteResponse, error)
+
+	UpdateProjectCronMonitorMuteWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, body UpdateProjectCronMonitorMuteJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectCronMonitorMuteResponse, error)
+
+	// UpdateProjectCronMonitorEnvironmentMuteWithBodyWithResponse request with any body
+	UpdateProjectCronMonitorEnvironmentMuteWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, environment string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectCronMonitorEnvironmentMuteResponse, error)
+
+	UpdateProjectCronMonitorEnvironmentMuteWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, environment string, body UpdateProjectCronMonitorEnvironmentMuteJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectCronMonitorEnvironmentMuteResponse, error)
+
+	// GetProjectOwnershipWithResponse request
+	GetProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetProjectOwnershipResponse, error)
+
+	// UpdateProjectOwnershipWithBodyWithResponse request with any body
+	UpdateProjectOwnershipWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error)
+
+	UpdateProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error)
+
+	// ResetProjectPerformanceIssueSettingsWithResponse request
+	ResetProjectPerformanceIssueSettingsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*ResetProjectPerformanceIssueSettingsResponse, error)
+
+	// GetProjectPerformanceIssueSettingsWithResponse request
+	GetProjectPerformanceIssueSettingsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetProjectPerformanceIssueSettingsResponse, error)
+
+	// UpdateProjectPerformanceIssueSettingsWithBodyWithResponse request with any body
+	UpdateProjectPerformanceIssueSettingsWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectPerformanceIssueSettingsResponse, error)
+
+	UpdateProjectPerformanceIssueSettingsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectPerformanceIssueSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectPerformanceIssueSettingsResponse, error)
+
+	// DisableProjectPluginWithResponse request
+	DisableProjectPluginWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, reqEditors ...RequestEditorFn) (*DisableProjectPluginResponse, error)
+
+	// GetProjectPluginWithResponse request
+	GetProjectPluginWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, reqEditors ...RequestEditorFn) (*GetProjectPluginResponse, error)
+
+	// EnableProjectPluginWithBodyWithResponse request with any body
+	EnableProjectPluginWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnableProjectPluginResponse, error)
+
+	EnableProjectPluginWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, body EnableProjectPluginJSONRequestBody, reqEditors ...RequestEditorFn) (*EnableProjectPluginResponse, error)
+
+	// UpdateProjectPluginWithBodyWithResponse request with any body
+	UpdateProjectPluginWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectPluginResponse, error)
+
+	UpdateProjectPluginWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, body UpdateProjectPluginJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectPluginResponse, error)
+
+	// ListProjectReleaseThresholdsWithResponse request
+	ListProjectReleaseThresholdsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*ListProjectReleaseThresholdsResponse, error)
+
+	// CreateProjectReleaseThresholdWithBodyWithResponse request with any body
+	CreateProjectReleaseThresholdWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectReleaseThresholdResponse, error)
+
+	CreateProjectReleaseThresholdWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectReleaseThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectReleaseThresholdResponse, error)
+
+	// DeleteProjectReleaseThresholdWithResponse request
+	DeleteProjectReleaseThresholdWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, reqEditors ...RequestEditorFn) (*DeleteProjectReleaseThresholdResponse, error)
+
+	// GetProjectReleaseThresholdWithResponse request
+	GetProjectReleaseThresholdWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, reqEditors ...RequestEditorFn) (*GetProjectReleaseThresholdResponse, error)
+
+	// UpdateProjectReleaseThresholdWithBodyWithResponse request with any body
+	UpdateProjectReleaseThresholdWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectReleaseThresholdResponse, error)
+
+	UpdateProjectReleaseThresholdWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, body UpdateProjectReleaseThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectReleaseThresholdResponse, error)
+
+	// CreateProjectRuleWithBodyWithResponse request with any body
+	CreateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error)
+
+	CreateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error)
+
+	// DeleteProjectRuleWithResponse request
+	DeleteProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*DeleteProjectRuleResponse, error)
+
+	// GetProjectRuleWithResponse request
+	GetProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*GetProjectRuleResponse, error)
+
+	// UpdateProjectRuleWithBodyWithResponse request with any body
+	UpdateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error)
+
+	UpdateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error)
+
+	// DeleteProjectRuleSnoozeWithBodyWithResponse request with any body
+	DeleteProjectRuleSnoozeWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteProjectRuleSnoozeResponse, error)
+
+	DeleteProjectRuleSnoozeWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body DeleteProjectRuleSnoozeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteProjectRuleSnoozeResponse, error)
+
+	// CreateProjectRuleSnoozeWithBodyWithResponse request with any body
+	CreateProjectRuleSnoozeWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRuleSnoozeResponse, error)
+
+	CreateProjectRuleSnoozeWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body CreateProjectRuleSnoozeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRuleSnoozeResponse, error)
+
+	// ListProjectTagKeyValuesWithResponse request
+	ListProjectTagKeyValuesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, key string, params *ListProjectTagKeyValuesParams, reqEditors ...RequestEditorFn) (*ListProjectTagKeyValuesResponse, error)
+
+	// RemoveTeamFromProjectWithResponse request
+	RemoveTeamFromProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*RemoveTeamFromProjectResponse, error)
+
+	// AddTeamToProjectWithResponse request
+	AddTeamToProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*AddTeamToProjectResponse, error)
+
+	// CreateSentryAppWithBodyWithResponse request with any body
+	CreateSentryAppWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSentryAppResponse, error)
+
+	CreateSentryAppWithResponse(ctx context.Context, body CreateSentryAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSentryAppResponse, error)
+
+	// DeleteSentryAppWithResponse request
+	DeleteSentryAppWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteSentryAppResponse, error)
+
+	// GetSentryAppWithResponse request
+	GetSentryAppWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*GetSentryAppResponse, error)
+
+	// UpdateSentryAppWithBodyWithResponse request with any body
+	UpdateSentryAppWithBodyWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSentryAppResponse, error)
+
+	UpdateSentryAppWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, body UpdateSentryAppJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSentryAppResponse, error)
+
+	// CreateSentryAppApiTokenWithResponse request
+	CreateSentryAppApiTokenWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*CreateSentryAppApiTokenResponse, error)
+
+	// DeleteSentryAppApiTokenWithResponse request
+	DeleteSentryAppApiTokenWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, apiTokenId ApiTokenId, reqEditors ...RequestEditorFn) (*DeleteSentryAppApiTokenResponse, error)
+
+	// DeleteOrganizationTeamWithResponse request
+	DeleteOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationTeamResponse, error)
+
+	// GetOrganizationTeamWithResponse request
+	GetOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationTeamResponse, error)
+
+	// CreateExternalTeamWithBodyWithResponse request with any body
+	CreateExternalTeamWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateExternalTeamResponse, error)
+
+	CreateExternalTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateExternalTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateExternalTeamResponse, error)
+
+	// DeleteExternalTeamWithResponse request
+	DeleteExternalTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, reqEditors ...RequestEditorFn) (*DeleteExternalTeamResponse, error)
+
+	// UpdateExternalTeamWithBodyWithResponse request with any body
+	UpdateExternalTeamWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateExternalTeamResponse, error)
+
+	UpdateExternalTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, body UpdateExternalTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateExternalTeamResponse, error)
+
+	// ListTeamMembersWithResponse request
+	ListTeamMembersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListTeamMembersParams, reqEditors ...RequestEditorFn) (*ListTeamMembersResponse, error)
+
+	// CreateOrganizationTeamProjectWithBodyWithResponse request with any body
+	CreateOrganizationTeamProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error)
+
+	CreateOrganizationTeamProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error)
+}
+
+type HealthCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r HealthCheckResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Organization
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Organization
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationCodeMappingCodeOwnersFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Filepath string  `json:"filepath"`
+		HtmlUrl  *string `json:"html_url,omitempty"`
+		Raw      string  `json:"raw"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationCodeMappingCodeOwnersFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationCodeMappingCodeOwnersFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationCodeMappingCodeOwnersFileResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationDashboardResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationDashboardResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationDashboardResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationDashboardResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationDetectorWorkflowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OrganizationDetectorWorkflow
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationDetectorWorkflowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationDetectorWorkflowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationDetectorWorkflowsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationDetectorWorkflowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OrganizationDetectorWorkflow
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationDetectorWorkflowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationDetectorWorkflowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationDetectorWorkflowResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationDetectorWorkflowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationDetectorWorkflowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationDetectorWorkflowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationDetectorWorkflowResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationMonitorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProjectMonitor
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationMonitorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationMonitorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationMonitorsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type BulkUpdateOrganizationMonitorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProjectMonitor
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkUpdateOrganizationMonitorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkUpdateOrganizationMonitorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r BulkUpdateOrganizationMonitorsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteProjectMonitorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectMonitorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectMonitorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteProjectMonitorResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetProjectMonitorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectMonitor
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectMonitorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectMonitorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetProjectMonitorResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectMonitorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectMonitor
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectMonitorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectMonitorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectMonitorResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationDiscoverSavedQueriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DiscoverSavedQuery
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationDiscoverSavedQueriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationDiscoverSavedQueriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationDiscoverSavedQueriesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationDiscoverSavedQueryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *DiscoverSavedQuery
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationDiscoverSavedQueryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationDiscoverSavedQueryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationDiscoverSavedQueryResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationDiscoverSavedQueryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationDiscoverSavedQueryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationDiscoverSavedQueryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationDiscoverSavedQueryResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationDiscoverSavedQueryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DiscoverSavedQuery
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationDiscoverSavedQueryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationDiscoverSavedQueryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationDiscoverSavedQueryResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationDiscoverSavedQueryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DiscoverSavedQuery
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationDiscoverSavedQueryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationDiscoverSavedQueryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationDiscoverSavedQueryResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationCustomDynamicSamplingRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomDynamicSamplingRule
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationCustomDynamicSamplingRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationCustomDynamicSamplingRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationCustomDynamicSamplingRuleResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationCustomDynamicSamplingRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomDynamicSamplingRule
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationCustomDynamicSamplingRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationCustomDynamicSamplingRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationCustomDynamicSamplingRuleResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateExternalUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExternalActor
+	JSON201      *ExternalActor
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateExternalUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateExternalUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateExternalUserResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteExternalUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteExternalUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteExternalUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteExternalUserResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateExternalUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExternalActor
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateExternalUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateExternalUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateExternalUserResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationDataForwardersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DataForwarder
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationDataForwardersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationDataForwardersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationDataForwardersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationDataForwarderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *DataForwarder
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationDataForwarderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationDataForwarderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationDataForwarderResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationDataForwarderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationDataForwarderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationDataForwarderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationDataForwarderResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationDataForwarderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DataForwarder
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationDataForwarderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationDataForwarderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationDataForwarderResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationGroupSearchViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *GroupSearchView
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationGroupSearchViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationGroupSearchViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationGroupSearchViewResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationGroupSearchViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationGroupSearchViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationGroupSearchViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationGroupSearchViewResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationGroupSearchViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GroupSearchView
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationGroupSearchViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationGroupSearchViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationGroupSearchViewResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationGroupSearchViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GroupSearchView
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationGroupSearchViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationGroupSearchViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationGroupSearchViewResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type StarOrganizationGroupSearchViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StarOrganizationGroupSearchViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StarOrganizationGroupSearchViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r StarOrganizationGroupSearchViewResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationIntegrationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OrganizationIntegration
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationIntegrationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationIntegrationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationIntegrationsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationIntegrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationIntegration
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationIntegrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationIntegrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationIntegrationResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationIntegrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationIntegrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationIntegrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationIntegrationResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OrganizationMember
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationMembersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OrganizationMember
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationMemberResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationMemberResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationMemberWithRoles
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationMemberResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationMemberWithRoles
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationMemberResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationAuthTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OrganizationAuthToken
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationAuthTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationAuthTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationAuthTokensResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationAuthTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OrganizationAuthTokenWithToken
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationAuthTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationAuthTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationAuthTokenResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationAuthTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationAuthTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationAuthTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationAuthTokenResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationAuthTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationAuthToken
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationAuthTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationAuthTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationAuthTokenResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationAuthTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationAuthTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationAuthTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationAuthTokenResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UnpinOrganizationSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UnpinOrganizationSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnpinOrganizationSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UnpinOrganizationSearchResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PinOrganizationSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SavedSearch
+	JSON201      *SavedSearch
+}
+
+// Status returns HTTPResponse.Status
+func (r PinOrganizationSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PinOrganizationSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PinOrganizationSearchResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Project
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationProjectsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateProjectMonitorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ProjectMonitor
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectMonitorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectMonitorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateProjectMonitorResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationProjectSampleRatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProjectSampleRate
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationProjectSampleRatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationProjectSampleRatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationProjectSampleRatesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationProjectSampleRatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProjectSampleRate
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationProjectSampleRatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationProjectSampleRatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationProjectSampleRatesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationSavedSearchesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SavedSearch
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationSavedSearchesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationSavedSearchesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationSavedSearchesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationSavedSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SavedSearch
+	JSON201      *SavedSearch
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationSavedSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationSavedSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationSavedSearchResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationSavedSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationSavedSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationSavedSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationSavedSearchResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationSavedSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SavedSearch
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationSavedSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationSavedSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationSavedSearchResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListSentryAppInstallationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SentryAppInstallation
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSentryAppInstallationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSentryAppInstallationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListSentryAppInstallationsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DisableSpikeProtectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableSpikeProtectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableSpikeProtectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DisableSpikeProtectionResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type EnableSpikeProtectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r EnableSpikeProtectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnableSpikeProtectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r EnableSpikeProtectionResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationTeamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Team
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationTeamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationTeamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationTeamsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Team
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListOrganizationWorkflowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OrganizationWorkflow
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationWorkflowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationWorkflowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListOrganizationWorkflowsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationWorkflowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OrganizationWorkflow
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationWorkflowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationWorkflowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationWorkflowResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type BulkUpdateOrganizationWorkflowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OrganizationWorkflow
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkUpdateOrganizationWorkflowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkUpdateOrganizationWorkflowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r BulkUpdateOrganizationWorkflowsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationWorkflowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationWorkflowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationWorkflowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationWorkflowResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationWorkflowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationWorkflow
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationWorkflowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationWorkflowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationWorkflowResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationWorkflowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationWorkflow
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationWorkflowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationWorkflowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationWorkflowResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationProjectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationProjectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateOrganizationProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateOrganizationProjectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListProjectCodeOwnersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProjectCodeOwners
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectCodeOwnersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectCodeOwnersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListProjectCodeOwnersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateProjectCodeOwnersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ProjectCodeOwners
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectCodeOwnersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectCodeOwnersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateProjectCodeOwnersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteProjectCodeOwnersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectCodeOwnersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectCodeOwnersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteProjectCodeOwnersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectCodeOwnersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectCodeOwners
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectCodeOwnersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectCodeOwnersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectCodeOwnersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListProjectEnvironmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProjectEnvironment
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectEnvironmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectEnvironmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListProjectEnvironmentsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetProjectEnvironmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectEnvironment
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetProjectEnvironmentResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectEnvironmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectEnvironment
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectEnvironmentResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListProjectClientKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProjectKey
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectClientKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectClientKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListProjectClientKeysResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateProjectClientKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ProjectKey
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectClientKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectClientKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateProjectClientKeyResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteProjectClientKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectClientKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectClientKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteProjectClientKeyResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetProjectClientKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectKey
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectClientKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectClientKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetProjectClientKeyResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectClientKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectKey
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectClientKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectClientKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectClientKeyResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectCronMonitorMuteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectCronMonitorMuteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectCronMonitorMuteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectCronMonitorMuteResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectCronMonitorEnvironmentMuteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectCronMonitorEnvironmentMuteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectCronMonitorEnvironmentMuteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectCronMonitorEnvironmentMuteResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetProjectOwnershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectOwnership
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectOwnershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectOwnershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetProjectOwnershipResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectOwnershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectRule
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectOwnershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectOwnershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectOwnershipResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ResetProjectPerformanceIssueSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetProjectPerformanceIssueSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetProjectPerformanceIssueSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ResetProjectPerformanceIssueSettingsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetProjectPerformanceIssueSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectPerformanceIssueSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectPerformanceIssueSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectPerformanceIssueSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetProjectPerformanceIssueSettingsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectPerformanceIssueSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectPerformanceIssueSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectPerformanceIssueSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectPerformanceIssueSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectPerformanceIssueSettingsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DisableProjectPluginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableProjectPluginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableProjectPluginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DisableProjectPluginResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetProjectPluginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectPlugin
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectPluginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectPluginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetProjectPluginResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type EnableProjectPluginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r EnableProjectPluginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnableProjectPluginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r EnableProjectPluginResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectPluginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectPlugin
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectPluginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectPluginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectPluginResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListProjectReleaseThresholdsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ReleaseThreshold
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectReleaseThresholdsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectReleaseThresholdsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListProjectReleaseThresholdsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateProjectReleaseThresholdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ReleaseThreshold
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectReleaseThresholdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectReleaseThresholdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateProjectReleaseThresholdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteProjectReleaseThresholdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectReleaseThresholdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectReleaseThresholdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteProjectReleaseThresholdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetProjectReleaseThresholdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReleaseThreshold
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectReleaseThresholdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectReleaseThresholdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetProjectReleaseThresholdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectReleaseThresholdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReleaseThreshold
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectReleaseThresholdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectReleaseThresholdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectReleaseThresholdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateProjectRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectRule
+	JSON201      *ProjectRule
+	JSON202      *struct {
+		Uuid string `json:"uuid"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateProjectRuleResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteProjectRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteProjectRuleResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetProjectRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectRule
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetProjectRuleResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateProjectRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectRule
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateProjectRuleResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteProjectRuleSnoozeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectRuleSnoozeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectRuleSnoozeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteProjectRuleSnoozeResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateProjectRuleSnoozeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RuleSnooze
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectRuleSnoozeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectRuleSnoozeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateProjectRuleSnoozeResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListProjectTagKeyValuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProjectTagValue
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectTagKeyValuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectTagKeyValuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListProjectTagKeyValuesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RemoveTeamFromProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveTeamFromProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveTeamFromProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RemoveTeamFromProjectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type AddTeamToProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTeamToProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTeamToProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r AddTeamToProjectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateSentryAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SentryApp
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSentryAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSentryAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateSentryAppResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteSentryAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSentryAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSentryAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteSentryAppResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetSentryAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SentryApp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSentryAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSentryAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetSentryAppResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateSentryAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SentryApp
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSentryAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSentryAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateSentryAppResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateSentryAppApiTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SentryAppApiToken
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSentryAppApiTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSentryAppApiTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateSentryAppApiTokenResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteSentryAppApiTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSentryAppApiTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSentryAppApiTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteSentryAppApiTokenResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteOrganizationTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteOrganizationTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetOrganizationTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Team
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetOrganizationTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateExternalTeamResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OrganizationMemberWithRoles
+	JSON200      *ExternalActor
+	JSON201      *ExternalActor
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateExternalTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateExternalTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateExternalTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteExternalTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteExternalTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteExternalTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteExternalTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type UpdateExternalTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExternalActor
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateExternalTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateExternalTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateExternalTeamResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListTeamMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TeamMember
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTeamMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTeamMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListTeamMembersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateOrganizationTeamProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationTeamProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationTeamProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateOrganizationTeamProjectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// HealthCheckWithResponse request returning *HealthCheckResponse
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthCheckResponse(rsp)
+}
+
+// GetOrganizationWithResponse request returning *GetOrganizationResponse
+func (c *ClientWithResponses) GetOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error) {
+	rsp, err := c.GetOrganization(ctx, organizationIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationResponse(rsp)
+}
+
+// UpdateOrganizationWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationResponse
+func (c *ClientWithResponses) UpdateOrganizationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error) {
+	rsp, err := c.UpdateOrganizationWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationResponse, error) {
+	rsp, err := c.UpdateOrganization(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationResponse(rsp)
+}
+
+// GetOrganizationCodeMappingCodeOwnersFileWithResponse request returning *GetOrganizationCodeMappingCodeOwnersFileResponse
+func (c *ClientWithResponses) GetOrganizationCodeMappingCodeOwnersFileWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, codeMappingId CodeMappingId, reqEditors ...RequestEditorFn) (*GetOrganizationCodeMappingCodeOwnersFileResponse, error) {
+	rsp, err := c.GetOrganizationCodeMappingCodeOwnersFile(ctx, organizationIdOrSlug, codeMappingId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationCodeMappingCodeOwnersFileResponse(rsp)
+}
+
+// CreateOrganizationDashboardWithBodyWithResponse request with arbitrary body returning *CreateOrganizationDashboardResponse
+func (c *ClientWithResponses) CreateOrganizationDashboardWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationDashboardResponse, error) {
+	rsp, err := c.CreateOrganizationDashboardWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationDashboardResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationDashboardResponse, error) {
+	rsp, err := c.CreateOrganizationDashboard(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationDashboardResponse(rsp)
+}
+
+// DeleteOrganizationDashboardWithResponse request returning *DeleteOrganizationDashboardResponse
+func (c *ClientWithResponses) DeleteOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*DeleteOrganizationDashboardResponse, error) {
+	rsp, err := c.DeleteOrganizationDashboard(ctx, organizationIdOrSlug, dashboardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationDashboardResponse(rsp)
+}
+
+// GetOrganizationDashboardWithResponse request returning *GetOrganizationDashboardResponse
+func (c *ClientWithResponses) GetOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, reqEditors ...RequestEditorFn) (*GetOrganizationDashboardResponse, error) {
+	rsp, err := c.GetOrganizationDashboard(ctx, organizationIdOrSlug, dashboardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationDashboardResponse(rsp)
+}
+
+// UpdateOrganizationDashboardWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationDashboardResponse
+func (c *ClientWithResponses) UpdateOrganizationDashboardWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationDashboardResponse, error) {
+	rsp, err := c.UpdateOrganizationDashboardWithBody(ctx, organizationIdOrSlug, dashboardId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationDashboardResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationDashboardWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dashboardId DashboardId, body UpdateOrganizationDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationDashboardResponse, error) {
+	rsp, err := c.UpdateOrganizationDashboard(ctx, organizationIdOrSlug, dashboardId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationDashboardResponse(rsp)
+}
+
+// ListOrganizationDetectorWorkflowsWithResponse request returning *ListOrganizationDetectorWorkflowsResponse
+func (c *ClientWithResponses) ListOrganizationDetectorWorkflowsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDetectorWorkflowsParams, reqEditors ...RequestEditorFn) (*ListOrganizationDetectorWorkflowsResponse, error) {
+	rsp, err := c.ListOrganizationDetectorWorkflows(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationDetectorWorkflowsResponse(rsp)
+}
+
+// CreateOrganizationDetectorWorkflowWithBodyWithResponse request with arbitrary body returning *CreateOrganizationDetectorWorkflowResponse
+func (c *ClientWithResponses) CreateOrganizationDetectorWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationDetectorWorkflowResponse, error) {
+	rsp, err := c.CreateOrganizationDetectorWorkflowWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationDetectorWorkflowResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationDetectorWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDetectorWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationDetectorWorkflowResponse, error) {
+	rsp, err := c.CreateOrganizationDetectorWorkflow(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationDetectorWorkflowResponse(rsp)
+}
+
+// DeleteOrganizationDetectorWorkflowWithResponse request returning *DeleteOrganizationDetectorWorkflowResponse
+func (c *ClientWithResponses) DeleteOrganizationDetectorWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorWorkflowId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationDetectorWorkflowResponse, error) {
+	rsp, err := c.DeleteOrganizationDetectorWorkflow(ctx, organizationIdOrSlug, detectorWorkflowId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationDetectorWorkflowResponse(rsp)
+}
+
+// ListOrganizationMonitorsWithResponse request returning *ListOrganizationMonitorsResponse
+func (c *ClientWithResponses) ListOrganizationMonitorsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*ListOrganizationMonitorsResponse, error) {
+	rsp, err := c.ListOrganizationMonitors(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationMonitorsResponse(rsp)
+}
+
+// BulkUpdateOrganizationMonitorsWithBodyWithResponse request with arbitrary body returning *BulkUpdateOrganizationMonitorsResponse
+func (c *ClientWithResponses) BulkUpdateOrganizationMonitorsWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationMonitorsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkUpdateOrganizationMonitorsResponse, error) {
+	rsp, err := c.BulkUpdateOrganizationMonitorsWithBody(ctx, organizationIdOrSlug, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkUpdateOrganizationMonitorsResponse(rsp)
+}
+
+func (c *ClientWithResponses) BulkUpdateOrganizationMonitorsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationMonitorsParams, body BulkUpdateOrganizationMonitorsJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkUpdateOrganizationMonitorsResponse, error) {
+	rsp, err := c.BulkUpdateOrganizationMonitors(ctx, organizationIdOrSlug, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkUpdateOrganizationMonitorsResponse(rsp)
+}
+
+// DeleteProjectMonitorWithResponse request returning *DeleteProjectMonitorResponse
+func (c *ClientWithResponses) DeleteProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*DeleteProjectMonitorResponse, error) {
+	rsp, err := c.DeleteProjectMonitor(ctx, organizationIdOrSlug, detectorId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectMonitorResponse(rsp)
+}
+
+// GetProjectMonitorWithResponse request returning *GetProjectMonitorResponse
+func (c *ClientWithResponses) GetProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*GetProjectMonitorResponse, error) {
+	rsp, err := c.GetProjectMonitor(ctx, organizationIdOrSlug, detectorId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectMonitorResponse(rsp)
+}
+
+// UpdateProjectMonitorWithBodyWithResponse request with arbitrary body returning *UpdateProjectMonitorResponse
+func (c *ClientWithResponses) UpdateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error) {
+	rsp, err := c.UpdateProjectMonitorWithBody(ctx, organizationIdOrSlug, detectorId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectMonitorResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error) {
+	rsp, err := c.UpdateProjectMonitor(ctx, organizationIdOrSlug, detectorId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectMonitorResponse(rsp)
+}
+
+// ListOrganizationDiscoverSavedQueriesWithResponse request returning *ListOrganizationDiscoverSavedQueriesResponse
+func (c *ClientWithResponses) ListOrganizationDiscoverSavedQueriesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationDiscoverSavedQueriesParams, reqEditors ...RequestEditorFn) (*ListOrganizationDiscoverSavedQueriesResponse, error) {
+	rsp, err := c.ListOrganizationDiscoverSavedQueries(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationDiscoverSavedQueriesResponse(rsp)
+}
+
+// CreateOrganizationDiscoverSavedQueryWithBodyWithResponse request with arbitrary body returning *CreateOrganizationDiscoverSavedQueryResponse
+func (c *ClientWithResponses) CreateOrganizationDiscoverSavedQueryWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationDiscoverSavedQueryResponse, error) {
+	rsp, err := c.CreateOrganizationDiscoverSavedQueryWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationDiscoverSavedQueryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationDiscoverSavedQueryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDiscoverSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationDiscoverSavedQueryResponse, error) {
+	rsp, err := c.CreateOrganizationDiscoverSavedQuery(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationDiscoverSavedQueryResponse(rsp)
+}
+
+// DeleteOrganizationDiscoverSavedQueryWithResponse request returning *DeleteOrganizationDiscoverSavedQueryResponse
+func (c *ClientWithResponses) DeleteOrganizationDiscoverSavedQueryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, reqEditors ...RequestEditorFn) (*DeleteOrganizationDiscoverSavedQueryResponse, error) {
+	rsp, err := c.DeleteOrganizationDiscoverSavedQuery(ctx, organizationIdOrSlug, queryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationDiscoverSavedQueryResponse(rsp)
+}
+
+// GetOrganizationDiscoverSavedQueryWithResponse request returning *GetOrganizationDiscoverSavedQueryResponse
+func (c *ClientWithResponses) GetOrganizationDiscoverSavedQueryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, reqEditors ...RequestEditorFn) (*GetOrganizationDiscoverSavedQueryResponse, error) {
+	rsp, err := c.GetOrganizationDiscoverSavedQuery(ctx, organizationIdOrSlug, queryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationDiscoverSavedQueryResponse(rsp)
+}
+
+// UpdateOrganizationDiscoverSavedQueryWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationDiscoverSavedQueryResponse
+func (c *ClientWithResponses) UpdateOrganizationDiscoverSavedQueryWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationDiscoverSavedQueryResponse, error) {
+	rsp, err := c.UpdateOrganizationDiscoverSavedQueryWithBody(ctx, organizationIdOrSlug, queryId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationDiscoverSavedQueryResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationDiscoverSavedQueryWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, queryId QueryId, body UpdateOrganizationDiscoverSavedQueryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationDiscoverSavedQueryResponse, error) {
+	rsp, err := c.UpdateOrganizationDiscoverSavedQuery(ctx, organizationIdOrSlug, queryId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationDiscoverSavedQueryResponse(rsp)
+}
+
+// GetOrganizationCustomDynamicSamplingRuleWithResponse request returning *GetOrganizationCustomDynamicSamplingRuleResponse
+func (c *ClientWithResponses) GetOrganizationCustomDynamicSamplingRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *GetOrganizationCustomDynamicSamplingRuleParams, reqEditors ...RequestEditorFn) (*GetOrganizationCustomDynamicSamplingRuleResponse, error) {
+	rsp, err := c.GetOrganizationCustomDynamicSamplingRule(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationCustomDynamicSamplingRuleResponse(rsp)
+}
+
+// CreateOrganizationCustomDynamicSamplingRuleWithBodyWithResponse request with arbitrary body returning *CreateOrganizationCustomDynamicSamplingRuleResponse
+func (c *ClientWithResponses) CreateOrganizationCustomDynamicSamplingRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationCustomDynamicSamplingRuleResponse, error) {
+	rsp, err := c.CreateOrganizationCustomDynamicSamplingRuleWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationCustomDynamicSamplingRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationCustomDynamicSamplingRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationCustomDynamicSamplingRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationCustomDynamicSamplingRuleResponse, error) {
+	rsp, err := c.CreateOrganizationCustomDynamicSamplingRule(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationCustomDynamicSamplingRuleResponse(rsp)
+}
+
+// CreateExternalUserWithBodyWithResponse request with arbitrary body returning *CreateExternalUserResponse
+func (c *ClientWithResponses) CreateExternalUserWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateExternalUserResponse, error) {
+	rsp, err := c.CreateExternalUserWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateExternalUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateExternalUserResponse, error) {
+	rsp, err := c.CreateExternalUser(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateExternalUserResponse(rsp)
+}
+
+// DeleteExternalUserWithResponse request returning *DeleteExternalUserResponse
+func (c *ClientWithResponses) DeleteExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, reqEditors ...RequestEditorFn) (*DeleteExternalUserResponse, error) {
+	rsp, err := c.DeleteExternalUser(ctx, organizationIdOrSlug, externalUserId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteExternalUserResponse(rsp)
+}
+
+// UpdateExternalUserWithBodyWithResponse request with arbitrary body returning *UpdateExternalUserResponse
+func (c *ClientWithResponses) UpdateExternalUserWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateExternalUserResponse, error) {
+	rsp, err := c.UpdateExternalUserWithBody(ctx, organizationIdOrSlug, externalUserId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateExternalUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateExternalUserWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, externalUserId ExternalUserId, body UpdateExternalUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateExternalUserResponse, error) {
+	rsp, err := c.UpdateExternalUser(ctx, organizationIdOrSlug, externalUserId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateExternalUserResponse(rsp)
+}
+
+// ListOrganizationDataForwardersWithResponse request returning *ListOrganizationDataForwardersResponse
+func (c *ClientWithResponses) ListOrganizationDataForwardersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*ListOrganizationDataForwardersResponse, error) {
+	rsp, err := c.ListOrganizationDataForwarders(ctx, organizationIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationDataForwardersResponse(rsp)
+}
+
+// CreateOrganizationDataForwarderWithBodyWithResponse request with arbitrary body returning *CreateOrganizationDataForwarderResponse
+func (c *ClientWithResponses) CreateOrganizationDataForwarderWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationDataForwarderResponse, error) {
+	rsp, err := c.CreateOrganizationDataForwarderWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationDataForwarderResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationDataForwarderWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationDataForwarderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationDataForwarderResponse, error) {
+	rsp, err := c.CreateOrganizationDataForwarder(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationDataForwarderResponse(rsp)
+}
+
+// DeleteOrganizationDataForwarderWithResponse request returning *DeleteOrganizationDataForwarderResponse
+func (c *ClientWithResponses) DeleteOrganizationDataForwarderWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, reqEditors ...RequestEditorFn) (*DeleteOrganizationDataForwarderResponse, error) {
+	rsp, err := c.DeleteOrganizationDataForwarder(ctx, organizationIdOrSlug, dataForwarderId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationDataForwarderResponse(rsp)
+}
+
+// UpdateOrganizationDataForwarderWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationDataForwarderResponse
+func (c *ClientWithResponses) UpdateOrganizationDataForwarderWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationDataForwarderResponse, error) {
+	rsp, err := c.UpdateOrganizationDataForwarderWithBody(ctx, organizationIdOrSlug, dataForwarderId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationDataForwarderResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationDataForwarderWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, dataForwarderId DataForwarderId, body UpdateOrganizationDataForwarderJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationDataForwarderResponse, error) {
+	rsp, err := c.UpdateOrganizationDataForwarder(ctx, organizationIdOrSlug, dataForwarderId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationDataForwarderResponse(rsp)
+}
+
+// CreateOrganizationGroupSearchViewWithBodyWithResponse request with arbitrary body returning *CreateOrganizationGroupSearchViewResponse
+func (c *ClientWithResponses) CreateOrganizationGroupSearchViewWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationGroupSearchViewResponse, error) {
+	rsp, err := c.CreateOrganizationGroupSearchViewWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationGroupSearchViewResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationGroupSearchViewWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationGroupSearchViewResponse, error) {
+	rsp, err := c.CreateOrganizationGroupSearchView(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationGroupSearchViewResponse(rsp)
+}
+
+// DeleteOrganizationGroupSearchViewWithResponse request returning *DeleteOrganizationGroupSearchViewResponse
+func (c *ClientWithResponses) DeleteOrganizationGroupSearchViewWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, reqEditors ...RequestEditorFn) (*DeleteOrganizationGroupSearchViewResponse, error) {
+	rsp, err := c.DeleteOrganizationGroupSearchView(ctx, organizationIdOrSlug, viewId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationGroupSearchViewResponse(rsp)
+}
+
+// GetOrganizationGroupSearchViewWithResponse request returning *GetOrganizationGroupSearchViewResponse
+func (c *ClientWithResponses) GetOrganizationGroupSearchViewWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, reqEditors ...RequestEditorFn) (*GetOrganizationGroupSearchViewResponse, error) {
+	rsp, err := c.GetOrganizationGroupSearchView(ctx, organizationIdOrSlug, viewId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationGroupSearchViewResponse(rsp)
+}
+
+// UpdateOrganizationGroupSearchViewWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationGroupSearchViewResponse
+func (c *ClientWithResponses) UpdateOrganizationGroupSearchViewWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationGroupSearchViewResponse, error) {
+	rsp, err := c.UpdateOrganizationGroupSearchViewWithBody(ctx, organizationIdOrSlug, viewId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationGroupSearchViewResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationGroupSearchViewWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, body UpdateOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationGroupSearchViewResponse, error) {
+	rsp, err := c.UpdateOrganizationGroupSearchView(ctx, organizationIdOrSlug, viewId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationGroupSearchViewResponse(rsp)
+}
+
+// StarOrganizationGroupSearchViewWithBodyWithResponse request with arbitrary body returning *StarOrganizationGroupSearchViewResponse
+func (c *ClientWithResponses) StarOrganizationGroupSearchViewWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StarOrganizationGroupSearchViewResponse, error) {
+	rsp, err := c.StarOrganizationGroupSearchViewWithBody(ctx, organizationIdOrSlug, viewId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStarOrganizationGroupSearchViewResponse(rsp)
+}
+
+func (c *ClientWithResponses) StarOrganizationGroupSearchViewWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, viewId ViewId, body StarOrganizationGroupSearchViewJSONRequestBody, reqEditors ...RequestEditorFn) (*StarOrganizationGroupSearchViewResponse, error) {
+	rsp, err := c.StarOrganizationGroupSearchView(ctx, organizationIdOrSlug, viewId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStarOrganizationGroupSearchViewResponse(rsp)
+}
+
+// ListOrganizationIntegrationsWithResponse request returning *ListOrganizationIntegrationsResponse
+func (c *ClientWithResponses) ListOrganizationIntegrationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams, reqEditors ...RequestEditorFn) (*ListOrganizationIntegrationsResponse, error) {
+	rsp, err := c.ListOrganizationIntegrations(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationIntegrationsResponse(rsp)
+}
+
+// GetOrganizationIntegrationWithResponse request returning *GetOrganizationIntegrationResponse
+func (c *ClientWithResponses) GetOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, reqEditors ...RequestEditorFn) (*GetOrganizationIntegrationResponse, error) {
+	rsp, err := c.GetOrganizationIntegration(ctx, organizationIdOrSlug, integrationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationIntegrationResponse(rsp)
+}
+
+// UpdateOrganizationIntegrationWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationIntegrationResponse
+func (c *ClientWithResponses) UpdateOrganizationIntegrationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error) {
+	rsp, err := c.UpdateOrganizationIntegrationWithBody(ctx, organizationIdOrSlug, integrationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationIntegrationResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error) {
+	rsp, err := c.UpdateOrganizationIntegration(ctx, organizationIdOrSlug, integrationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationIntegrationResponse(rsp)
+}
+
+// ListOrganizationMembersWithResponse request returning *ListOrganizationMembersResponse
+func (c *ClientWithResponses) ListOrganizationMembersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams, reqEditors ...RequestEditorFn) (*ListOrganizationMembersResponse, error) {
+	rsp, err := c.ListOrganizationMembers(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationMembersResponse(rsp)
+}
+
+// CreateOrganizationMemberWithBodyWithResponse request with arbitrary body returning *CreateOrganizationMemberResponse
+func (c *ClientWithResponses) CreateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error) {
+	rsp, err := c.CreateOrganizationMemberWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error) {
+	rsp, err := c.CreateOrganizationMember(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationMemberResponse(rsp)
+}
+
+// DeleteOrganizationMemberWithResponse request returning *DeleteOrganizationMemberResponse
+func (c *ClientWithResponses) DeleteOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*DeleteOrganizationMemberResponse, error) {
+	rsp, err := c.DeleteOrganizationMember(ctx, organizationIdOrSlug, memberId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationMemberResponse(rsp)
+}
+
+// GetOrganizationMemberWithResponse request returning *GetOrganizationMemberResponse
+func (c *ClientWithResponses) GetOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*GetOrganizationMemberResponse, error) {
+	rsp, err := c.GetOrganizationMember(ctx, organizationIdOrSlug, memberId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationMemberResponse(rsp)
+}
+
+// UpdateOrganizationMemberWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationMemberResponse
+func (c *ClientWithResponses) UpdateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error) {
+	rsp, err := c.UpdateOrganizationMemberWithBody(ctx, organizationIdOrSlug, memberId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error) {
+	rsp, err := c.UpdateOrganizationMember(ctx, organizationIdOrSlug, memberId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationMemberResponse(rsp)
+}
+
+// ListOrganizationAuthTokensWithResponse request returning *ListOrganizationAuthTokensResponse
+func (c *ClientWithResponses) ListOrganizationAuthTokensWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*ListOrganizationAuthTokensResponse, error) {
+	rsp, err := c.ListOrganizationAuthTokens(ctx, organizationIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationAuthTokensResponse(rsp)
+}
+
+// CreateOrganizationAuthTokenWithBodyWithResponse request with arbitrary body returning *CreateOrganizationAuthTokenResponse
+func (c *ClientWithResponses) CreateOrganizationAuthTokenWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationAuthTokenResponse, error) {
+	rsp, err := c.CreateOrganizationAuthTokenWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationAuthTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationAuthTokenWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationAuthTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationAuthTokenResponse, error) {
+	rsp, err := c.CreateOrganizationAuthToken(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationAuthTokenResponse(rsp)
+}
+
+// DeleteOrganizationAuthTokenWithResponse request returning *DeleteOrganizationAuthTokenResponse
+func (c *ClientWithResponses) DeleteOrganizationAuthTokenWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, reqEditors ...RequestEditorFn) (*DeleteOrganizationAuthTokenResponse, error) {
+	rsp, err := c.DeleteOrganizationAuthToken(ctx, organizationIdOrSlug, tokenId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationAuthTokenResponse(rsp)
+}
+
+// GetOrganizationAuthTokenWithResponse request returning *GetOrganizationAuthTokenResponse
+func (c *ClientWithResponses) GetOrganizationAuthTokenWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, reqEditors ...RequestEditorFn) (*GetOrganizationAuthTokenResponse, error) {
+	rsp, err := c.GetOrganizationAuthToken(ctx, organizationIdOrSlug, tokenId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationAuthTokenResponse(rsp)
+}
+
+// UpdateOrganizationAuthTokenWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationAuthTokenResponse
+func (c *ClientWithResponses) UpdateOrganizationAuthTokenWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationAuthTokenResponse, error) {
+	rsp, err := c.UpdateOrganizationAuthTokenWithBody(ctx, organizationIdOrSlug, tokenId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationAuthTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationAuthTokenWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, tokenId TokenId, body UpdateOrganizationAuthTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationAuthTokenResponse, error) {
+	rsp, err := c.UpdateOrganizationAuthToken(ctx, organizationIdOrSlug, tokenId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationAuthTokenResponse(rsp)
+}
+
+// UnpinOrganizationSearchWithBodyWithResponse request with arbitrary body returning *UnpinOrganizationSearchResponse
+func (c *ClientWithResponses) UnpinOrganizationSearchWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnpinOrganizationSearchResponse, error) {
+	rsp, err := c.UnpinOrganizationSearchWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnpinOrganizationSearchResponse(rsp)
+}
+
+func (c *ClientWithResponses) UnpinOrganizationSearchWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UnpinOrganizationSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*UnpinOrganizationSearchResponse, error) {
+	rsp, err := c.UnpinOrganizationSearch(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnpinOrganizationSearchResponse(rsp)
+}
+
+// PinOrganizationSearchWithBodyWithResponse request with arbitrary body returning *PinOrganizationSearchResponse
+func (c *ClientWithResponses) PinOrganizationSearchWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PinOrganizationSearchResponse, error) {
+	rsp, err := c.PinOrganizationSearchWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePinOrganizationSearchResponse(rsp)
+}
+
+func (c *ClientWithResponses) PinOrganizationSearchWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body PinOrganizationSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*PinOrganizationSearchResponse, error) {
+	rsp, err := c.PinOrganizationSearch(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePinOrganizationSearchResponse(rsp)
+}
+
+// ListOrganizationProjectsWithResponse request returning *ListOrganizationProjectsResponse
+func (c *ClientWithResponses) ListOrganizationProjectsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*ListOrganizationProjectsResponse, error) {
+	rsp, err := c.ListOrganizationProjects(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationProjectsResponse(rsp)
+}
+
+// CreateProjectMonitorWithBodyWithResponse request with arbitrary body returning *CreateProjectMonitorResponse
+func (c *ClientWithResponses) CreateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error) {
+	rsp, err := c.CreateProjectMonitorWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectMonitorResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error) {
+	rsp, err := c.CreateProjectMonitor(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectMonitorResponse(rsp)
+}
+
+// ListOrganizationProjectSampleRatesWithResponse request returning *ListOrganizationProjectSampleRatesResponse
+func (c *ClientWithResponses) ListOrganizationProjectSampleRatesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectSampleRatesParams, reqEditors ...RequestEditorFn) (*ListOrganizationProjectSampleRatesResponse, error) {
+	rsp, err := c.ListOrganizationProjectSampleRates(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationProjectSampleRatesResponse(rsp)
+}
+
+// UpdateOrganizationProjectSampleRatesWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationProjectSampleRatesResponse
+func (c *ClientWithResponses) UpdateOrganizationProjectSampleRatesWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectSampleRatesResponse, error) {
+	rsp, err := c.UpdateOrganizationProjectSampleRatesWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationProjectSampleRatesResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationProjectSampleRatesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body UpdateOrganizationProjectSampleRatesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectSampleRatesResponse, error) {
+	rsp, err := c.UpdateOrganizationProjectSampleRates(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationProjectSampleRatesResponse(rsp)
+}
+
+// ListOrganizationSavedSearchesWithResponse request returning *ListOrganizationSavedSearchesResponse
+func (c *ClientWithResponses) ListOrganizationSavedSearchesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationSavedSearchesParams, reqEditors ...RequestEditorFn) (*ListOrganizationSavedSearchesResponse, error) {
+	rsp, err := c.ListOrganizationSavedSearches(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationSavedSearchesResponse(rsp)
+}
+
+// CreateOrganizationSavedSearchWithBodyWithResponse request with arbitrary body returning *CreateOrganizationSavedSearchResponse
+func (c *ClientWithResponses) CreateOrganizationSavedSearchWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationSavedSearchResponse, error) {
+	rsp, err := c.CreateOrganizationSavedSearchWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationSavedSearchResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationSavedSearchWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationSavedSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationSavedSearchResponse, error) {
+	rsp, err := c.CreateOrganizationSavedSearch(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationSavedSearchResponse(rsp)
+}
+
+// DeleteOrganizationSavedSearchWithResponse request returning *DeleteOrganizationSavedSearchResponse
+func (c *ClientWithResponses) DeleteOrganizationSavedSearchWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, reqEditors ...RequestEditorFn) (*DeleteOrganizationSavedSearchResponse, error) {
+	rsp, err := c.DeleteOrganizationSavedSearch(ctx, organizationIdOrSlug, searchId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationSavedSearchResponse(rsp)
+}
+
+// UpdateOrganizationSavedSearchWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationSavedSearchResponse
+func (c *ClientWithResponses) UpdateOrganizationSavedSearchWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationSavedSearchResponse, error) {
+	rsp, err := c.UpdateOrganizationSavedSearchWithBody(ctx, organizationIdOrSlug, searchId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationSavedSearchResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationSavedSearchWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, searchId SearchId, body UpdateOrganizationSavedSearchJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationSavedSearchResponse, error) {
+	rsp, err := c.UpdateOrganizationSavedSearch(ctx, organizationIdOrSlug, searchId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationSavedSearchResponse(rsp)
+}
+
+// ListSentryAppInstallationsWithResponse request returning *ListSentryAppInstallationsResponse
+func (c *ClientWithResponses) ListSentryAppInstallationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*ListSentryAppInstallationsResponse, error) {
+	rsp, err := c.ListSentryAppInstallations(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSentryAppInstallationsResponse(rsp)
+}
+
+// DisableSpikeProtectionWithBodyWithResponse request with arbitrary body returning *DisableSpikeProtectionResponse
+func (c *ClientWithResponses) DisableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error) {
+	rsp, err := c.DisableSpikeProtectionWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableSpikeProtectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) DisableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error) {
+	rsp, err := c.DisableSpikeProtection(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableSpikeProtectionResponse(rsp)
+}
+
+// EnableSpikeProtectionWithBodyWithResponse request with arbitrary body returning *EnableSpikeProtectionResponse
+func (c *ClientWithResponses) EnableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error) {
+	rsp, err := c.EnableSpikeProtectionWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnableSpikeProtectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) EnableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error) {
+	rsp, err := c.EnableSpikeProtection(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnableSpikeProtectionResponse(rsp)
+}
+
+// ListOrganizationTeamsWithResponse request returning *ListOrganizationTeamsResponse
+func (c *ClientWithResponses) ListOrganizationTeamsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationTeamsParams, reqEditors ...RequestEditorFn) (*ListOrganizationTeamsResponse, error) {
+	rsp, err := c.ListOrganizationTeams(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationTeamsResponse(rsp)
+}
+
+// CreateOrganizationTeamWithBodyWithResponse request with arbitrary body returning *CreateOrganizationTeamResponse
+func (c *ClientWithResponses) CreateOrganizationTeamWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error) {
+	rsp, err := c.CreateOrganizationTeamWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationTeamResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error) {
+	rsp, err := c.CreateOrganizationTeam(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationTeamResponse(rsp)
+}
+
+// ListOrganizationWorkflowsWithResponse request returning *ListOrganizationWorkflowsResponse
+func (c *ClientWithResponses) ListOrganizationWorkflowsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams, reqEditors ...RequestEditorFn) (*ListOrganizationWorkflowsResponse, error) {
+	rsp, err := c.ListOrganizationWorkflows(ctx, organizationIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationWorkflowsResponse(rsp)
+}
+
+// CreateOrganizationWorkflowWithBodyWithResponse request with arbitrary body returning *CreateOrganizationWorkflowResponse
+func (c *ClientWithResponses) CreateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error) {
+	rsp, err := c.CreateOrganizationWorkflowWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationWorkflowResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error) {
+	rsp, err := c.CreateOrganizationWorkflow(ctx, organizationIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationWorkflowResponse(rsp)
+}
+
+// BulkUpdateOrganizationWorkflowsWithBodyWithResponse request with arbitrary body returning *BulkUpdateOrganizationWorkflowsResponse
+func (c *ClientWithResponses) BulkUpdateOrganizationWorkflowsWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationWorkflowsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkUpdateOrganizationWorkflowsResponse, error) {
+	rsp, err := c.BulkUpdateOrganizationWorkflowsWithBody(ctx, organizationIdOrSlug, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkUpdateOrganizationWorkflowsResponse(rsp)
+}
+
+func (c *ClientWithResponses) BulkUpdateOrganizationWorkflowsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *BulkUpdateOrganizationWorkflowsParams, body BulkUpdateOrganizationWorkflowsJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkUpdateOrganizationWorkflowsResponse, error) {
+	rsp, err := c.BulkUpdateOrganizationWorkflows(ctx, organizationIdOrSlug, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkUpdateOrganizationWorkflowsResponse(rsp)
+}
+
+// DeleteOrganizationWorkflowWithResponse request returning *DeleteOrganizationWorkflowResponse
+func (c *ClientWithResponses) DeleteOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationWorkflowResponse, error) {
+	rsp, err := c.DeleteOrganizationWorkflow(ctx, organizationIdOrSlug, workflowId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationWorkflowResponse(rsp)
+}
+
+// GetOrganizationWorkflowWithResponse request returning *GetOrganizationWorkflowResponse
+func (c *ClientWithResponses) GetOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*GetOrganizationWorkflowResponse, error) {
+	rsp, err := c.GetOrganizationWorkflow(ctx, organizationIdOrSlug, workflowId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationWorkflowResponse(rsp)
+}
+
+// UpdateOrganizationWorkflowWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationWorkflowResponse
+func (c *ClientWithResponses) UpdateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error) {
+	rsp, err := c.UpdateOrganizationWorkflowWithBody(ctx, organizationIdOrSlug, workflowId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationWorkflowResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error) {
+	rsp, err := c.UpdateOrganizationWorkflow(ctx, organizationIdOrSlug, workflowId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationWorkflowResponse(rsp)
+}
+
+// DeleteOrganizationProjectWithResponse request returning *DeleteOrganizationProjectResponse
+func (c *ClientWithResponses) DeleteOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationProjectResponse, error) {
+	rsp, err := c.DeleteOrganizationProject(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationProjectResponse(rsp)
+}
+
+// GetOrganizationProjectWithResponse request returning *GetOrganizationProjectResponse
+func (c *ClientWithResponses) GetOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationProjectResponse, error) {
+	rsp, err := c.GetOrganizationProject(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationProjectResponse(rsp)
+}
+
+// UpdateOrganizationProjectWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationProjectResponse
+func (c *ClientWithResponses) UpdateOrganizationProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error) {
+	rsp, err := c.UpdateOrganizationProjectWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error) {
+	rsp, err := c.UpdateOrganizationProject(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationProjectResponse(rsp)
+}
+
+// ListProjectCodeOwnersWithResponse request returning *ListProjectCodeOwnersResponse
+func (c *ClientWithResponses) ListProjectCodeOwnersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectCodeOwnersParams, reqEditors ...RequestEditorFn) (*ListProjectCodeOwnersResponse, error) {
+	rsp, err := c.ListProjectCodeOwners(ctx, organizationIdOrSlug, projectIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectCodeOwnersResponse(rsp)
+}
+
+// CreateProjectCodeOwnersWithBodyWithResponse request with arbitrary body returning *CreateProjectCodeOwnersResponse
+func (c *ClientWithResponses) CreateProjectCodeOwnersWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectCodeOwnersResponse, error) {
+	rsp, err := c.CreateProjectCodeOwnersWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectCodeOwnersResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectCodeOwnersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectCodeOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectCodeOwnersResponse, error) {
+	rsp, err := c.CreateProjectCodeOwners(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectCodeOwnersResponse(rsp)
+}
+
+// DeleteProjectCodeOwnersWithResponse request returning *DeleteProjectCodeOwnersResponse
+func (c *ClientWithResponses) DeleteProjectCodeOwnersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, reqEditors ...RequestEditorFn) (*DeleteProjectCodeOwnersResponse, error) {
+	rsp, err := c.DeleteProjectCodeOwners(ctx, organizationIdOrSlug, projectIdOrSlug, codeownersId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectCodeOwnersResponse(rsp)
+}
+
+// UpdateProjectCodeOwnersWithBodyWithResponse request with arbitrary body returning *UpdateProjectCodeOwnersResponse
+func (c *ClientWithResponses) UpdateProjectCodeOwnersWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectCodeOwnersResponse, error) {
+	rsp, err := c.UpdateProjectCodeOwnersWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, codeownersId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectCodeOwnersResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectCodeOwnersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, codeownersId CodeownersId, body UpdateProjectCodeOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectCodeOwnersResponse, error) {
+	rsp, err := c.UpdateProjectCodeOwners(ctx, organizationIdOrSlug, projectIdOrSlug, codeownersId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectCodeOwnersResponse(rsp)
+}
+
+// ListProjectEnvironmentsWithResponse request returning *ListProjectEnvironmentsResponse
+func (c *ClientWithResponses) ListProjectEnvironmentsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectEnvironmentsParams, reqEditors ...RequestEditorFn) (*ListProjectEnvironmentsResponse, error) {
+	rsp, err := c.ListProjectEnvironments(ctx, organizationIdOrSlug, projectIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectEnvironmentsResponse(rsp)
+}
+
+// GetProjectEnvironmentWithResponse request returning *GetProjectEnvironmentResponse
+func (c *ClientWithResponses) GetProjectEnvironmentWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, reqEditors ...RequestEditorFn) (*GetProjectEnvironmentResponse, error) {
+	rsp, err := c.GetProjectEnvironment(ctx, organizationIdOrSlug, projectIdOrSlug, environment, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectEnvironmentResponse(rsp)
+}
+
+// UpdateProjectEnvironmentWithBodyWithResponse request with arbitrary body returning *UpdateProjectEnvironmentResponse
+func (c *ClientWithResponses) UpdateProjectEnvironmentWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentResponse, error) {
+	rsp, err := c.UpdateProjectEnvironmentWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, environment, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectEnvironmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectEnvironmentWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, environment Environment, body UpdateProjectEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentResponse, error) {
+	rsp, err := c.UpdateProjectEnvironment(ctx, organizationIdOrSlug, projectIdOrSlug, environment, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectEnvironmentResponse(rsp)
+}
+
+// ListProjectClientKeysWithResponse request returning *ListProjectClientKeysResponse
+func (c *ClientWithResponses) ListProjectClientKeysWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams, reqEditors ...RequestEditorFn) (*ListProjectClientKeysResponse, error) {
+	rsp, err := c.ListProjectClientKeys(ctx, organizationIdOrSlug, projectIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectClientKeysResponse(rsp)
+}
+
+// CreateProjectClientKeyWithBodyWithResponse request with arbitrary body returning *CreateProjectClientKeyResponse
+func (c *ClientWithResponses) CreateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error) {
+	rsp, err := c.CreateProjectClientKeyWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectClientKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error) {
+	rsp, err := c.CreateProjectClientKey(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectClientKeyResponse(rsp)
+}
+
+// DeleteProjectClientKeyWithResponse request returning *DeleteProjectClientKeyResponse
+func (c *ClientWithResponses) DeleteProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*DeleteProjectClientKeyResponse, error) {
+	rsp, err := c.DeleteProjectClientKey(ctx, organizationIdOrSlug, projectIdOrSlug, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectClientKeyResponse(rsp)
+}
+
+// GetProjectClientKeyWithResponse request returning *GetProjectClientKeyResponse
+func (c *ClientWithResponses) GetProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*GetProjectClientKeyResponse, error) {
+	rsp, err := c.GetProjectClientKey(ctx, organizationIdOrSlug, projectIdOrSlug, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectClientKeyResponse(rsp)
+}
+
+// UpdateProjectClientKeyWithBodyWithResponse request with arbitrary body returning *UpdateProjectClientKeyResponse
+func (c *ClientWithResponses) UpdateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error) {
+	rsp, err := c.UpdateProjectClientKeyWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, keyId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectClientKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error) {
+	rsp, err := c.UpdateProjectClientKey(ctx, organizationIdOrSlug, projectIdOrSlug, keyId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectClientKeyResponse(rsp)
+}
+
+// UpdateProjectCronMonitorMuteWithBodyWithResponse request with arbitrary body returning *UpdateProjectCronMonitorMuteResponse
+func (c *ClientWithResponses) UpdateProjectCronMonitorMuteWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectCronMonitorMuteResponse, error) {
+	rsp, err := c.UpdateProjectCronMonitorMuteWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, monitorIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectCronMonitorMuteResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectCronMonitorMuteWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, body UpdateProjectCronMonitorMuteJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectCronMonitorMuteResponse, error) {
+	rsp, err := c.UpdateProjectCronMonitorMute(ctx, organizationIdOrSlug, projectIdOrSlug, monitorIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectCronMonitorMuteResponse(rsp)
+}
+
+// UpdateProjectCronMonitorEnvironmentMuteWithBodyWithResponse request with arbitrary body returning *UpdateProjectCronMonitorEnvironmentMuteResponse
+func (c *ClientWithResponses) UpdateProjectCronMonitorEnvironmentMuteWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, environment string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectCronMonitorEnvironmentMuteResponse, error) {
+	rsp, err := c.UpdateProjectCronMonitorEnvironmentMuteWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, monitorIdOrSlug, environment, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectCronMonitorEnvironmentMuteResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectCronMonitorEnvironmentMuteWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, monitorIdOrSlug MonitorIdOrSlug, environment string, body UpdateProjectCronMonitorEnvironmentMuteJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectCronMonitorEnvironmentMuteResponse, error) {
+	rsp, err := c.UpdateProjectCronMonitorEnvironmentMute(ctx, organizationIdOrSlug, projectIdOrSlug, monitorIdOrSlug, environment, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectCronMonitorEnvironmentMuteResponse(rsp)
+}
+
+// GetProjectOwnershipWithResponse request returning *GetProjectOwnershipResponse
+func (c *ClientWithResponses) GetProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetProjectOwnershipResponse, error) {
+	rsp, err := c.GetProjectOwnership(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectOwnershipResponse(rsp)
+}
+
+// UpdateProjectOwnershipWithBodyWithResponse request with arbitrary body returning *UpdateProjectOwnershipResponse
+func (c *ClientWithResponses) UpdateProjectOwnershipWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error) {
+	rsp, err := c.UpdateProjectOwnershipWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectOwnershipResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error) {
+	rsp, err := c.UpdateProjectOwnership(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectOwnershipResponse(rsp)
+}
+
+// ResetProjectPerformanceIssueSettingsWithResponse request returning *ResetProjectPerformanceIssueSettingsResponse
+func (c *ClientWithResponses) ResetProjectPerformanceIssueSettingsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*ResetProjectPerformanceIssueSettingsResponse, error) {
+	rsp, err := c.ResetProjectPerformanceIssueSettings(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetProjectPerformanceIssueSettingsResponse(rsp)
+}
+
+// GetProjectPerformanceIssueSettingsWithResponse request returning *GetProjectPerformanceIssueSettingsResponse
+func (c *ClientWithResponses) GetProjectPerformanceIssueSettingsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetProjectPerformanceIssueSettingsResponse, error) {
+	rsp, err := c.GetProjectPerformanceIssueSettings(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectPerformanceIssueSettingsResponse(rsp)
+}
+
+// UpdateProjectPerformanceIssueSettingsWithBodyWithResponse request with arbitrary body returning *UpdateProjectPerformanceIssueSettingsResponse
+func (c *ClientWithResponses) UpdateProjectPerformanceIssueSettingsWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectPerformanceIssueSettingsResponse, error) {
+	rsp, err := c.UpdateProjectPerformanceIssueSettingsWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectPerformanceIssueSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectPerformanceIssueSettingsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectPerformanceIssueSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectPerformanceIssueSettingsResponse, error) {
+	rsp, err := c.UpdateProjectPerformanceIssueSettings(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectPerformanceIssueSettingsResponse(rsp)
+}
+
+// DisableProjectPluginWithResponse request returning *DisableProjectPluginResponse
+func (c *ClientWithResponses) DisableProjectPluginWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, reqEditors ...RequestEditorFn) (*DisableProjectPluginResponse, error) {
+	rsp, err := c.DisableProjectPlugin(ctx, organizationIdOrSlug, projectIdOrSlug, pluginId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableProjectPluginResponse(rsp)
+}
+
+// GetProjectPluginWithResponse request returning *GetProjectPluginResponse
+func (c *ClientWithResponses) GetProjectPluginWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, reqEditors ...RequestEditorFn) (*GetProjectPluginResponse, error) {
+	rsp, err := c.GetProjectPlugin(ctx, organizationIdOrSlug, projectIdOrSlug, pluginId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectPluginResponse(rsp)
+}
+
+// EnableProjectPluginWithBodyWithResponse request with arbitrary body returning *EnableProjectPluginResponse
+func (c *ClientWithResponses) EnableProjectPluginWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnableProjectPluginResponse, error) {
+	rsp, err := c.EnableProjectPluginWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, pluginId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnableProjectPluginResponse(rsp)
+}
+
+func (c *ClientWithResponses) EnableProjectPluginWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, body EnableProjectPluginJSONRequestBody, reqEditors ...RequestEditorFn) (*EnableProjectPluginResponse, error) {
+	rsp, err := c.EnableProjectPlugin(ctx, organizationIdOrSlug, projectIdOrSlug, pluginId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnableProjectPluginResponse(rsp)
+}
+
+// UpdateProjectPluginWithBodyWithResponse request with arbitrary body returning *UpdateProjectPluginResponse
+func (c *ClientWithResponses) UpdateProjectPluginWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectPluginResponse, error) {
+	rsp, err := c.UpdateProjectPluginWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, pluginId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectPluginResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectPluginWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, pluginId PluginId, body UpdateProjectPluginJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectPluginResponse, error) {
+	rsp, err := c.UpdateProjectPlugin(ctx, organizationIdOrSlug, projectIdOrSlug, pluginId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectPluginResponse(rsp)
+}
+
+// ListProjectReleaseThresholdsWithResponse request returning *ListProjectReleaseThresholdsResponse
+func (c *ClientWithResponses) ListProjectReleaseThresholdsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*ListProjectReleaseThresholdsResponse, error) {
+	rsp, err := c.ListProjectReleaseThresholds(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectReleaseThresholdsResponse(rsp)
+}
+
+// CreateProjectReleaseThresholdWithBodyWithResponse request with arbitrary body returning *CreateProjectReleaseThresholdResponse
+func (c *ClientWithResponses) CreateProjectReleaseThresholdWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectReleaseThresholdResponse, error) {
+	rsp, err := c.CreateProjectReleaseThresholdWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectReleaseThresholdResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectReleaseThresholdWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectReleaseThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectReleaseThresholdResponse, error) {
+	rsp, err := c.CreateProjectReleaseThreshold(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectReleaseThresholdResponse(rsp)
+}
+
+// DeleteProjectReleaseThresholdWithResponse request returning *DeleteProjectReleaseThresholdResponse
+func (c *ClientWithResponses) DeleteProjectReleaseThresholdWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, reqEditors ...RequestEditorFn) (*DeleteProjectReleaseThresholdResponse, error) {
+	rsp, err := c.DeleteProjectReleaseThreshold(ctx, organizationIdOrSlug, projectIdOrSlug, releaseThresholdId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectReleaseThresholdResponse(rsp)
+}
+
+// GetProjectReleaseThresholdWithResponse request returning *GetProjectReleaseThresholdResponse
+func (c *ClientWithResponses) GetProjectReleaseThresholdWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, reqEditors ...RequestEditorFn) (*GetProjectReleaseThresholdResponse, error) {
+	rsp, err := c.GetProjectReleaseThreshold(ctx, organizationIdOrSlug, projectIdOrSlug, releaseThresholdId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectReleaseThresholdResponse(rsp)
+}
+
+// UpdateProjectReleaseThresholdWithBodyWithResponse request with arbitrary body returning *UpdateProjectReleaseThresholdResponse
+func (c *ClientWithResponses) UpdateProjectReleaseThresholdWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectReleaseThresholdResponse, error) {
+	rsp, err := c.UpdateProjectReleaseThresholdWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, releaseThresholdId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectReleaseThresholdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectReleaseThresholdWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, releaseThresholdId ReleaseThresholdId, body UpdateProjectReleaseThresholdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectReleaseThresholdResponse, error) {
+	rsp, err := c.UpdateProjectReleaseThreshold(ctx, organizationIdOrSlug, projectIdOrSlug, releaseThresholdId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectReleaseThresholdResponse(rsp)
+}
+
+// CreateProjectRuleWithBodyWithResponse request with arbitrary body returning *CreateProjectRuleResponse
+func (c *ClientWithResponses) CreateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error) {
+	rsp, err := c.CreateProjectRuleWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error) {
+	rsp, err := c.CreateProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectRuleResponse(rsp)
+}
+
+// DeleteProjectRuleWithResponse request returning *DeleteProjectRuleResponse
+func (c *ClientWithResponses) DeleteProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*DeleteProjectRuleResponse, error) {
+	rsp, err := c.DeleteProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectRuleResponse(rsp)
+}
+
+// GetProjectRuleWithResponse request returning *GetProjectRuleResponse
+func (c *ClientWithResponses) GetProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*GetProjectRuleResponse, error) {
+	rsp, err := c.GetProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectRuleResponse(rsp)
+}
+
+// UpdateProjectRuleWithBodyWithResponse request with arbitrary body returning *UpdateProjectRuleResponse
+func (c *ClientWithResponses) UpdateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error) {
+	rsp, err := c.UpdateProjectRuleWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error) {
+	rsp, err := c.UpdateProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectRuleResponse(rsp)
+}
+
+// DeleteProjectRuleSnoozeWithBodyWithResponse request with arbitrary body returning *DeleteProjectRuleSnoozeResponse
+func (c *ClientWithResponses) DeleteProjectRuleSnoozeWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteProjectRuleSnoozeResponse, error) {
+	rsp, err := c.DeleteProjectRuleSnoozeWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectRuleSnoozeResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteProjectRuleSnoozeWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body DeleteProjectRuleSnoozeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteProjectRuleSnoozeResponse, error) {
+	rsp, err := c.DeleteProjectRuleSnooze(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectRuleSnoozeResponse(rsp)
+}
+
+// CreateProjectRuleSnoozeWithBodyWithResponse request with arbitrary body returning *CreateProjectRuleSnoozeResponse
+func (c *ClientWithResponses) CreateProjectRuleSnoozeWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRuleSnoozeResponse, error) {
+	rsp, err := c.CreateProjectRuleSnoozeWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectRuleSnoozeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectRuleSnoozeWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body CreateProjectRuleSnoozeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRuleSnoozeResponse, error) {
+	rsp, err := c.CreateProjectRuleSnooze(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectRuleSnoozeResponse(rsp)
+}
+
+// ListProjectTagKeyValuesWithResponse request returning *ListProjectTagKeyValuesResponse
+func (c *ClientWithResponses) ListProjectTagKeyValuesWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, key string, params *ListProjectTagKeyValuesParams, reqEditors ...RequestEditorFn) (*ListProjectTagKeyValuesResponse, error) {
+	rsp, err := c.ListProjectTagKeyValues(ctx, organizationIdOrSlug, projectIdOrSlug, key, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectTagKeyValuesResponse(rsp)
+}
+
+// RemoveTeamFromProjectWithResponse request returning *RemoveTeamFromProjectResponse
+func (c *ClientWithResponses) RemoveTeamFromProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*RemoveTeamFromProjectResponse, error) {
+	rsp, err := c.RemoveTeamFromProject(ctx, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTeamFromProjectResponse(rsp)
+}
+
+// AddTeamToProjectWithResponse request returning *AddTeamToProjectResponse
+func (c *ClientWithResponses) AddTeamToProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*AddTeamToProjectResponse, error) {
+	rsp, err := c.AddTeamToProject(ctx, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTeamToProjectResponse(rsp)
+}
+
+// CreateSentryAppWithBodyWithResponse request with arbitrary body returning *CreateSentryAppResponse
+func (c *ClientWithResponses) CreateSentryAppWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSentryAppResponse, error) {
+	rsp, err := c.CreateSentryAppWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSentryAppResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSentryAppWithResponse(ctx context.Context, body CreateSentryAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSentryAppResponse, error) {
+	rsp, err := c.CreateSentryApp(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSentryAppResponse(rsp)
+}
+
+// DeleteSentryAppWithResponse request returning *DeleteSentryAppResponse
+func (c *ClientWithResponses) DeleteSentryAppWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteSentryAppResponse, error) {
+	rsp, err := c.DeleteSentryApp(ctx, sentryAppIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSentryAppResponse(rsp)
+}
+
+// GetSentryAppWithResponse request returning *GetSentryAppResponse
+func (c *ClientWithResponses) GetSentryAppWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*GetSentryAppResponse, error) {
+	rsp, err := c.GetSentryApp(ctx, sentryAppIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSentryAppResponse(rsp)
+}
+
+// UpdateSentryAppWithBodyWithResponse request with arbitrary body returning *UpdateSentryAppResponse
+func (c *ClientWithResponses) UpdateSentryAppWithBodyWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSentryAppResponse, error) {
+	rsp, err := c.UpdateSentryAppWithBody(ctx, sentryAppIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSentryAppResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSentryAppWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, body UpdateSentryAppJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSentryAppResponse, error) {
+	rsp, err := c.UpdateSentryApp(ctx, sentryAppIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSentryAppResponse(rsp)
+}
+
+// CreateSentryAppApiTokenWithResponse request returning *CreateSentryAppApiTokenResponse
+func (c *ClientWithResponses) CreateSentryAppApiTokenWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, reqEditors ...RequestEditorFn) (*CreateSentryAppApiTokenResponse, error) {
+	rsp, err := c.CreateSentryAppApiToken(ctx, sentryAppIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSentryAppApiTokenResponse(rsp)
+}
+
+// DeleteSentryAppApiTokenWithResponse request returning *DeleteSentryAppApiTokenResponse
+func (c *ClientWithResponses) DeleteSentryAppApiTokenWithResponse(ctx context.Context, sentryAppIdOrSlug SentryAppIdOrSlug, apiTokenId ApiTokenId, reqEditors ...RequestEditorFn) (*DeleteSentryAppApiTokenResponse, error) {
+	rsp, err := c.DeleteSentryAppApiToken(ctx, sentryAppIdOrSlug, apiTokenId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSentryAppApiTokenResponse(rsp)
+}
+
+// DeleteOrganizationTeamWithResponse request returning *DeleteOrganizationTeamResponse
+func (c *ClientWithResponses) DeleteOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationTeamResponse, error) {
+	rsp, err := c.DeleteOrganizationTeam(ctx, organizationIdOrSlug, teamIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationTeamResponse(rsp)
+}
+
+// GetOrganizationTeamWithResponse request returning *GetOrganizationTeamResponse
+func (c *ClientWithResponses) GetOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationTeamResponse, error) {
+	rsp, err := c.GetOrganizationTeam(ctx, organizationIdOrSlug, teamIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationTeamResponse(rsp)
+}
+
+// CreateExternalTeamWithBodyWithResponse request with arbitrary body returning *CreateExternalTeamResponse
+func (c *ClientWithResponses) CreateExternalTeamWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateExternalTeamResponse, error) {
+	rsp, err := c.CreateExternalTeamWithBody(ctx, organizationIdOrSlug, teamIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateExternalTeamResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateExternalTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateExternalTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateExternalTeamResponse, error) {
+	rsp, err := c.CreateExternalTeam(ctx, organizationIdOrSlug, teamIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateExternalTeamResponse(rsp)
+}
+
+// DeleteExternalTeamWithResponse request returning *DeleteExternalTeamResponse
+func (c *ClientWithResponses) DeleteExternalTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, reqEditors ...RequestEditorFn) (*DeleteExternalTeamResponse, error) {
+	rsp, err := c.DeleteExternalTeam(ctx, organizationIdOrSlug, teamIdOrSlug, externalTeamId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteExternalTeamResponse(rsp)
+}
+
+// UpdateExternalTeamWithBodyWithResponse request with arbitrary body returning *UpdateExternalTeamResponse
+func (c *ClientWithResponses) UpdateExternalTeamWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateExternalTeamResponse, error) {
+	rsp, err := c.UpdateExternalTeamWithBody(ctx, organizationIdOrSlug, teamIdOrSlug, externalTeamId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateExternalTeamResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateExternalTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, externalTeamId ExternalTeamId, body UpdateExternalTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateExternalTeamResponse, error) {
+	rsp, err := c.UpdateExternalTeam(ctx, organizationIdOrSlug, teamIdOrSlug, externalTeamId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateExternalTeamResponse(rsp)
+}
+
+// ListTeamMembersWithResponse request returning *ListTeamMembersResponse
+func (c *ClientWithResponses) ListTeamMembersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, params *ListTeamMembersParams, reqEditors ...RequestEditorFn) (*ListTeamMembersResponse, error) {
+	rsp, err := c.ListTeamMembers(ctx, organizationIdOrSlug, teamIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTeamMembersResponse(rsp)
+}
+
+// CreateOrganizationTeamProjectWithBodyWithResponse request with arbitrary body returning *CreateOrganizationTeamProjectResponse
+func (c *ClientWithResponses) CreateOrganizationTeamProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error) {
+	rsp, err := c.CreateOrganizationTeamProjectWithBody(ctx, organizationIdOrSlug, teamIdOrSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationTeamProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationTeamProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error) {
+	rsp, err := c.CreateOrganizationTeamProject(ctx, organizationIdOrSlug, teamIdOrSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationTeamProjectResponse(rsp)
+}
+
+// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
+func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthCheckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationResponse parses an HTTP response from a GetOrganizationWithResponse call
+func ParseGetOrganizationResponse(rsp *http.Response) (*GetOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetOrganizationMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateOrganizationResponse parses an HTTP response from a UpdateOrganizationWithResponse call
+func ParseUpdateOrganizationResponse(rsp *http.Response) (*UpdateOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &UpdateOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetOrganizationCodeMappingCodeOwnersFileResponse parses an HTTP response from a GetOrganizationCodeMappingCodeOwnersFileWithResponse call
+func ParseGetOrganizationCodeMappingCodeOwnersFileResponse(rsp *http.Response) (*GetOrganizationCodeMappingCodeOwnersFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &GetOrganizationCodeMappingCodeOwnersFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Filepath string  `json:"filepath"`
+			HtmlUrl  *string `json:"html_url,omitempty"`
+			Raw      string  `json:"raw"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetOrganizationMemberResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// ParseCreateOrganizationDashboardResponse parses an HTTP response from a CreateOrganizationDashboardWithResponse call
+func ParseCreateOrganizationDashboardResponse(rsp *http.Response) (*CreateOrganizationDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return ""
+
+	response := &CreateOrganizationDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-type UpdateOrganizationMemberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OrganizationMemberWithRoles
+// ParseDeleteOrganizationDashboardResponse parses an HTTP response from a DeleteOrganizationDashboardWithResponse call
+func ParseDeleteOrganizationDashboardResponse(rsp *http.Response) (*DeleteOrganizationDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOrganizationDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateOrganizationMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetOrganizationDashboardResponse parses an HTTP response from a GetOrganizationDashboardWithResponse call
+func ParseGetOrganizationDashboardResponse(rsp *http.Response) (*GetOrganizationDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &GetOrganizationDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateOrganizationMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateOrganizationDashboardResponse parses an HTTP response from a UpdateOrganizationDashboardWithResponse call
+func ParseUpdateOrganizationDashboardResponse(rsp *http.Response) (*UpdateOrganizationDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &UpdateOrganizationDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateOrganizationMemberResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// ParseListOrganizationDetectorWorkflowsResponse parses an HTTP response from a ListOrganizationDetectorWorkflowsWithResponse call
+func ParseListOrganizationDetectorWorkflowsResponse(rsp *http.Response) (*ListOrganizationDetectorWorkflowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return ""
+
+	response := &ListOrganizationDetectorWorkflowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OrganizationDetectorWorkflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-type ListOrganizationProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Project
+// ParseCreateOrganizationDetectorWorkflowResponse parses an HTTP response from a CreateOrganizationDetectorWorkflowWithResponse call
+func ParseCreateOrganizationDetectorWorkflowResponse(rsp *http.Response) (*CreateOrganizationDetectorWorkflowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOrganizationDetectorWorkflowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OrganizationDetectorWorkflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListOrganizationProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteOrganizationDetectorWorkflowResponse parses an HTTP response from a DeleteOrganizationDetectorWorkflowWithResponse call
+func ParseDeleteOrganizationDetectorWorkflowResponse(rsp *http.Response) (*DeleteOrganizationDetectorWorkflowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &DeleteOrganizationDetectorWorkflowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseListOrganizationMonitorsResponse parses an HTTP response from a ListOrganizationMonitorsWithResponse call
+func ParseListOrganizationMonitorsResponse(rsp *http.Response) (*ListOrganizationMonitorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganizationMonitorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProjectMonitor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBulkUpdateOrganizationMonitorsResponse parses an HTTP response from a BulkUpdateOrganizationMonitorsWithResponse call
+func ParseBulkUpdateOrganizationMonitorsResponse(rsp *http.Response) (*BulkUpdateOrganizationMonitorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BulkUpdateOrganizationMonitorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListOrganizationProjectsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProjectMonitor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ""
-}
 
-type CreateProjectMonitorResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ProjectMonitor
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateProjectMonitorResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteProjectMonitorResponse parses an HTTP response from a DeleteProjectMonitorWithResponse call
+func ParseDeleteProjectMonitorResponse(rsp *http.Response) (*DeleteProjectMonitorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateProjectMonitorResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteProjectMonitorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
+
+	return response, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateProjectMonitorResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// ParseGetProjectMonitorResponse parses an HTTP response from a GetProjectMonitorWithResponse call
+func ParseGetProjectMonitorResponse(rsp *http.Response) (*GetProjectMonitorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type ListSentryAppInstallationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SentryAppInstallation
-}
+	response := &GetProjectMonitorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectMonitor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ListSentryAppInstallationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListSentryAppInstallationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateProjectMonitorResponse parses an HTTP response from a UpdateProjectMonitorWithResponse call
+func ParseUpdateProjectMonitorResponse(rsp *http.Response) (*UpdateProjectMonitorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListSentryAppInstallationsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &UpdateProjectMonitorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type DisableSpikeProtectionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectMonitor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DisableSpikeProtectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DisableSpikeProtectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseListOrganizationDiscoverSavedQueriesResponse parses an HTTP response from a ListOrganizationDiscoverSavedQueriesWithResponse call
+func ParseListOrganizationDiscoverSavedQueriesResponse(rsp *http.Response) (*ListOrganizationDiscoverSavedQueriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DisableSpikeProtectionResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &ListOrganizationDiscoverSavedQueriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type EnableSpikeProtectionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DiscoverSavedQuery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r EnableSpikeProtectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r EnableSpikeProtectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseCreateOrganizationDiscoverSavedQueryResponse parses an HTTP response from a CreateOrganizationDiscoverSavedQueryWithResponse call
+func ParseCreateOrganizationDiscoverSavedQueryResponse(rsp *http.Response) (*CreateOrganizationDiscoverSavedQueryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r EnableSpikeProtectionResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &CreateOrganizationDiscoverSavedQueryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type ListOrganizationTeamsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Team
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DiscoverSavedQuery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ListOrganizationTeamsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationTeamsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteOrganizationDiscoverSavedQueryResponse parses an HTTP response from a DeleteOrganizationDiscoverSavedQueryWithResponse call
+func ParseDeleteOrganizationDiscoverSavedQueryResponse(rsp *http.Response) (*DeleteOrganizationDiscoverSavedQueryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListOrganizationTeamsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &DeleteOrganizationDiscoverSavedQueryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type CreateOrganizationTeamResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Team
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateOrganizationTeamResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetOrganizationDiscoverSavedQueryResponse parses an HTTP response from a GetOrganizationDiscoverSavedQueryWithResponse call
+func ParseGetOrganizationDiscoverSavedQueryResponse(rsp *http.Response) (*GetOrganizationDiscoverSavedQueryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrganizationTeamResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetOrganizationDiscoverSavedQueryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateOrganizationTeamResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DiscoverSavedQuery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ""
-}
 
-type ListOrganizationWorkflowsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]OrganizationWorkflow
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListOrganizationWorkflowsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateOrganizationDiscoverSavedQueryResponse parses an HTTP response from a UpdateOrganizationDiscoverSavedQueryWithResponse call
+func ParseUpdateOrganizationDiscoverSavedQueryResponse(rsp *http.Response) (*UpdateOrganizationDiscoverSavedQueryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListOrganizationWorkflowsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateOrganizationDiscoverSavedQueryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListOrganizationWorkflowsResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DiscoverSavedQuery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ""
-}
 
-type CreateOrganizationWorkflowResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *OrganizationWorkflow
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateOrganizationWorkflowResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetOrganizationCustomDynamicSamplingRuleResponse parses an HTTP response from a GetOrganizationCustomDynamicSamplingRuleWithResponse call
+func ParseGetOrganizationCustomDynamicSamplingRuleResponse(rsp *http.Response) (*GetOrganizationCustomDynamicSamplingRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrganizationWorkflowResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetOrganizationCustomDynamicSamplingRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateOrganizationWorkflowResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomDynamicSamplingRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ""
-}
 
-type DeleteOrganizationWorkflowResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteOrganizationWorkflowResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateOrganizationCustomDynamicSamplingRuleResponse parses an HTTP response from a CreateOrganizationCustomDynamicSamplingRuleWithResponse call
+func ParseCreateOrganizationCustomDynamicSamplingRuleResponse(rsp *http.Response) (*CreateOrganizationCustomDynamicSamplingRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteOrganizationWorkflowResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateOrganizationCustomDynamicSamplingRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteOrganizationWorkflowResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomDynamicSamplingRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ""
-}
 
-type GetOrganizationWorkflowResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OrganizationWorkflow
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetOrganizationWorkflowResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateExternalUserResponse parses an HTTP response from a CreateExternalUserWithResponse call
+func ParseCreateExternalUserResponse(rsp *http.Response) (*CreateExternalUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationWorkflowResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateExternalUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetOrganizationWorkflowResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExternalActor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ExternalActor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ""
-}
 
-type UpdateOrganizationWorkflowResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OrganizationWorkflow
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateOrganizationWorkflowResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteExternalUserResponse parses an HTTP response from a DeleteExternalUserWithResponse call
+func ParseDeleteExternalUserResponse(rsp *http.Response) (*DeleteExternalUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateOrganizationWorkflowResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteExternalUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
+
+	return response, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateOrganizationWorkflowResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// ParseUpdateExternalUserResponse parses an HTTP response from a UpdateExternalUserWithResponse call
+func ParseUpdateExternalUserResponse(rsp *http.Response) (*UpdateExternalUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type DeleteOrganizationProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	response := &UpdateExternalUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExternalActor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteOrganizationProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteOrganizationProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseListOrganizationDataForwardersResponse parses an HTTP response from a ListOrganizationDataForwardersWithResponse call
+func ParseListOrganizationDataForwardersResponse(rsp *http.Response) (*ListOrganizationDataForwardersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteOrganizationProjectResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &ListOrganizationDataForwardersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type GetOrganizationProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Project
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DataForwarder
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetOrganizationProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseCreateOrganizationDataForwarderResponse parses an HTTP response from a CreateOrganizationDataForwarderWithResponse call
+func ParseCreateOrganizationDataForwarderResponse(rsp *http.Response) (*CreateOrganizationDataForwarderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetOrganizationProjectResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &CreateOrganizationDataForwarderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type UpdateOrganizationProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Project
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DataForwarder
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateOrganizationProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateOrganizationProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteOrganizationDataForwarderResponse parses an HTTP response from a DeleteOrganizationDataForwarderWithResponse call
+func ParseDeleteOrganizationDataForwarderResponse(rsp *http.Response) (*DeleteOrganizationDataForwarderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateOrganizationProjectResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &DeleteOrganizationDataForwarderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type ListProjectClientKeysResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]ProjectKey
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListProjectClientKeysResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateOrganizationDataForwarderResponse parses an HTTP response from a UpdateOrganizationDataForwarderWithResponse call
+func ParseUpdateOrganizationDataForwarderResponse(rsp *http.Response) (*UpdateOrganizationDataForwarderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListProjectClientKeysResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateOrganizationDataForwarderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListProjectClientKeysResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataForwarder
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ""
-}
 
-type CreateProjectClientKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ProjectKey
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateProjectClientKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateOrganizationGroupSearchViewResponse parses an HTTP response from a CreateOrganizationGroupSearchViewWithResponse call
+func ParseCreateOrganizationGroupSearchViewResponse(rsp *http.Response) (*CreateOrganizationGroupSearchViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateProjectClientKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateOrganizationGroupSearchViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateProjectClientKeyResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest GroupSearchView
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ""
-}
 
-type DeleteProjectClientKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteProjectClientKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteOrganizationGroupSearchViewResponse parses an HTTP response from a DeleteOrganizationGroupSearchViewWithResponse call
+func ParseDeleteOrganizationGroupSearchViewResponse(rsp *http.Response) (*DeleteOrganizationGroupSearchViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectClientKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteOrganizationGroupSearchViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
+
+	return response, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteProjectClientKeyResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// ParseGetOrganizationGroupSearchViewResponse parses an HTTP response from a GetOrganizationGroupSearchViewWithResponse call
+func ParseGetOrganizationGroupSearchViewResponse(rsp *http.Response) (*GetOrganizationGroupSearchViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type GetProjectClientKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectKey
-}
+	response := &GetOrganizationGroupSearchViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupSearchView
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetProjectClientKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectClientKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateOrganizationGroupSearchViewResponse parses an HTTP response from a UpdateOrganizationGroupSearchViewWithResponse call
+func ParseUpdateOrganizationGroupSearchViewResponse(rsp *http.Response) (*UpdateOrganizationGroupSearchViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetProjectClientKeyResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &UpdateOrganizationGroupSearchViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type UpdateProjectClientKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectKey
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupSearchView
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateProjectClientKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProjectClientKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseStarOrganizationGroupSearchViewResponse parses an HTTP response from a StarOrganizationGroupSearchViewWithResponse call
+func ParseStarOrganizationGroupSearchViewResponse(rsp *http.Response) (*StarOrganizationGroupSearchViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateProjectClientKeyResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &StarOrganizationGroupSearchViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type GetProjectOwnershipResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectOwnership
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetProjectOwnershipResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListOrganizationIntegrationsResponse parses an HTTP response from a ListOrganizationIntegrationsWithResponse call
+func ParseListOrganizationIntegrationsResponse(rsp *http.Response) (*ListOrganizationIntegrationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectOwnershipResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListOrganizationIntegrationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OrganizationIntegration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetProjectOwnershipResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// ParseGetOrganizationIntegrationResponse parses an HTTP response from a GetOrganizationIntegrationWithResponse call
+func ParseGetOrganizationIntegrationResponse(rsp *http.Response) (*GetOrganizationIntegrationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationIntegrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type UpdateProjectOwnershipResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectRule
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationIntegration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateProjectOwnershipResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProjectOwnershipResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateProjectOwnershipResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// ParseUpdateOrganizationIntegrationResponse parses an HTTP response from a UpdateOrganizationIntegrationWithResponse call
+func ParseUpdateOrganizationIntegrationResponse(rsp *http.Response) (*UpdateOrganizationIntegrationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type CreateProjectRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectRule
-	JSON201      *ProjectRule
-	JSON202      *struct {
-		Uuid string `json:"uuid"`
+	response := &UpdateOrganizationIntegrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateProjectRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListOrganizationMembersResponse parses an HTTP response from a ListOrganizationMembersWithResponse call
+func ParseListOrganizationMembersResponse(rsp *http.Response) (*ListOrganizationMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateProjectRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListOrganizationMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateProjectRuleResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OrganizationMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ""
-}
 
-type DeleteProjectRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteProjectRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateOrganizationMemberResponse parses an HTTP response from a CreateOrganizationMemberWithResponse call
+func ParseCreateOrganizationMemberResponse(rsp *http.Response) (*CreateOrganizationMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateOrganizationMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteProjectRuleResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OrganizationMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ""
-}
 
-type GetProjectRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectRule
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetProjectRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteOrganizationMemberResponse parses an HTTP response from a DeleteOrganizationMemberWithResponse call
+func ParseDeleteOrganizationMemberResponse(rsp *http.Response) (*DeleteOrganizationMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteOrganizationMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
+
+	return response, nil
 }
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetProjectRuleResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+// ParseGetOrganizationMemberResponse parses an HTTP response from a GetOrganizationMemberWithResponse call
+func ParseGetOrganizationMemberResponse(rsp *http.Response) (*GetOrganizationMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return ""
-}
 
-type UpdateProjectRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectRule
-}
+	response := &GetOrganizationMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationMemberWithRoles
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateProjectRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProjectRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateOrganizationMemberResponse parses an HTTP response from a UpdateOrganizationMemberWithResponse call
+func ParseUpdateOrganizationMemberResponse(rsp *http.Response) (*UpdateOrganizationMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateProjectRuleResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &UpdateOrganizationMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type RemoveTeamFromProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Project
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationMemberWithRoles
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r RemoveTeamFromProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemoveTeamFromProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseListOrganizationAuthTokensResponse parses an HTTP response from a ListOrganizationAuthTokensWithResponse call
+func ParseListOrganizationAuthTokensResponse(rsp *http.Response) (*ListOrganizationAuthTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r RemoveTeamFromProjectResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &ListOrganizationAuthTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type AddTeamToProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Project
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OrganizationAuthToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddTeamToProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddTeamToProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseCreateOrganizationAuthTokenResponse parses an HTTP response from a CreateOrganizationAuthTokenWithResponse call
+func ParseCreateOrganizationAuthTokenResponse(rsp *http.Response) (*CreateOrganizationAuthTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r AddTeamToProjectResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &CreateOrganizationAuthTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type DeleteOrganizationTeamResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OrganizationAuthTokenWithToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteOrganizationTeamResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteOrganizationTeamResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteOrganizationAuthTokenResponse parses an HTTP response from a DeleteOrganizationAuthTokenWithResponse call
+func ParseDeleteOrganizationAuthTokenResponse(rsp *http.Response) (*DeleteOrganizationAuthTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteOrganizationTeamResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	response := &DeleteOrganizationAuthTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ""
-}
 
-type GetOrganizationTeamResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Team
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetOrganizationTeamResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetOrganizationAuthTokenResponse parses an HTTP response from a GetOrganizationAuthTokenWithResponse call
+func ParseGetOrganizationAuthTokenResponse(rsp *http.Response) (*GetOrganizationAuthTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrganizationTeamResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetOrganizationAuthTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r GetOrganizationTeamResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationAuthToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ""
-}
 
-type CreateOrganizationTeamProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Project
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateOrganizationTeamProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateOrganizationAuthTokenResponse parses an HTTP response from a UpdateOrganizationAuthTokenWithResponse call
+func ParseUpdateOrganizationAuthTokenResponse(rsp *http.Response) (*UpdateOrganizationAuthTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrganizationTeamProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateOrganizationAuthTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateOrganizationTeamProjectResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
+	return response, nil
 }
 
-// HealthCheckWithResponse request returning *HealthCheckResponse
-func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
-	rsp, err := c.HealthCheck(ctx, reqEditors...)
+// ParseUnpinOrganizationSearchResponse parses an HTTP response from a UnpinOrganizationSearchWithResponse call
+func ParseUnpinOrganizationSearchResponse(rsp *http.Response) (*UnpinOrganizationSearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseHealthCheckResponse(rsp)
+
+	response := &UnpinOrganizationSearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// GetOrganizationWithResponse request returning *GetOrganizationResponse
-func (c *ClientWithResponses) GetOrganizationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationResponse, error) {
-	rsp, err := c.GetOrganization(ctx, organizationIdOrSlug, reqEditors...)
+// ParsePinOrganizationSearchResponse parses an HTTP response from a PinOrganizationSearchWithResponse call
+func ParsePinOrganizationSearchResponse(rsp *http.Response) (*PinOrganizationSearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetOrganizationResponse(rsp)
+
+	response := &PinOrganizationSearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SavedSearch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SavedSearch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ListOrganizationMonitorsWithResponse request returning *ListOrganizationMonitorsResponse
-func (c *ClientWithResponses) ListOrganizationMonitorsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMonitorsParams, reqEditors ...RequestEditorFn) (*ListOrganizationMonitorsResponse, error) {
-	rsp, err := c.ListOrganizationMonitors(ctx, organizationIdOrSlug, params, reqEditors...)
+// ParseListOrganizationProjectsResponse parses an HTTP response from a ListOrganizationProjectsWithResponse call
+func ParseListOrganizationProjectsResponse(rsp *http.Response) (*ListOrganizationProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListOrganizationMonitorsResponse(rsp)
+
+	response := &ListOrganizationProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeleteProjectMonitorWithResponse request returning *DeleteProjectMonitorResponse
-func (c *ClientWithResponses) DeleteProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*DeleteProjectMonitorResponse, error) {
-	rsp, err := c.DeleteProjectMonitor(ctx, organizationIdOrSlug, detectorId, reqEditors...)
+// ParseCreateProjectMonitorResponse parses an HTTP response from a CreateProjectMonitorWithResponse call
+func ParseCreateProjectMonitorResponse(rsp *http.Response) (*CreateProjectMonitorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectMonitorResponse(rsp)
+
+	response := &CreateProjectMonitorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProjectMonitor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetProjectMonitorWithResponse request returning *GetProjectMonitorResponse
-func (c *ClientWithResponses) GetProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, reqEditors ...RequestEditorFn) (*GetProjectMonitorResponse, error) {
-	rsp, err := c.GetProjectMonitor(ctx, organizationIdOrSlug, detectorId, reqEditors...)
+// ParseListOrganizationProjectSampleRatesResponse parses an HTTP response from a ListOrganizationProjectSampleRatesWithResponse call
+func ParseListOrganizationProjectSampleRatesResponse(rsp *http.Response) (*ListOrganizationProjectSampleRatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetProjectMonitorResponse(rsp)
+
+	response := &ListOrganizationProjectSampleRatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProjectSampleRate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// UpdateProjectMonitorWithBodyWithResponse request with arbitrary body returning *UpdateProjectMonitorResponse
-func (c *ClientWithResponses) UpdateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error) {
-	rsp, err := c.UpdateProjectMonitorWithBody(ctx, organizationIdOrSlug, detectorId, contentType, body, reqEditors...)
+// ParseUpdateOrganizationProjectSampleRatesResponse parses an HTTP response from a UpdateOrganizationProjectSampleRatesWithResponse call
+func ParseUpdateOrganizationProjectSampleRatesResponse(rsp *http.Response) (*UpdateOrganizationProjectSampleRatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectMonitorResponse(rsp)
+
+	response := &UpdateOrganizationProjectSampleRatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProjectSampleRate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, detectorId DetectorId, body UpdateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectMonitorResponse, error) {
-	rsp, err := c.UpdateProjectMonitor(ctx, organizationIdOrSlug, detectorId, body, reqEditors...)
+// ParseListOrganizationSavedSearchesResponse parses an HTTP response from a ListOrganizationSavedSearchesWithResponse call
+func ParseListOrganizationSavedSearchesResponse(rsp *http.Response) (*ListOrganizationSavedSearchesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectMonitorResponse(rsp)
-}
 
-// ListOrganizationIntegrationsWithResponse request returning *ListOrganizationIntegrationsResponse
-func (c *ClientWithResponses) ListOrganizationIntegrationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationIntegrationsParams, reqEditors ...RequestEditorFn) (*ListOrganizationIntegrationsResponse, error) {
-	rsp, err := c.ListOrganizationIntegrations(ctx, organizationIdOrSlug, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListOrganizationSavedSearchesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListOrganizationIntegrationsResponse(rsp)
-}
 
-// GetOrganizationIntegrationWithResponse request returning *GetOrganizationIntegrationResponse
-func (c *ClientWithResponses) GetOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, reqEditors ...RequestEditorFn) (*GetOrganizationIntegrationResponse, error) {
-	rsp, err := c.GetOrganizationIntegration(ctx, organizationIdOrSlug, integrationId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SavedSearch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetOrganizationIntegrationResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateOrganizationIntegrationWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationIntegrationResponse
-func (c *ClientWithResponses) UpdateOrganizationIntegrationWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error) {
-	rsp, err := c.UpdateOrganizationIntegrationWithBody(ctx, organizationIdOrSlug, integrationId, contentType, body, reqEditors...)
+// ParseCreateOrganizationSavedSearchResponse parses an HTTP response from a CreateOrganizationSavedSearchWithResponse call
+func ParseCreateOrganizationSavedSearchResponse(rsp *http.Response) (*CreateOrganizationSavedSearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateOrganizationIntegrationResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateOrganizationIntegrationWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, integrationId IntegrationId, body UpdateOrganizationIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationIntegrationResponse, error) {
-	rsp, err := c.UpdateOrganizationIntegration(ctx, organizationIdOrSlug, integrationId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateOrganizationSavedSearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateOrganizationIntegrationResponse(rsp)
-}
 
-// ListOrganizationMembersWithResponse request returning *ListOrganizationMembersResponse
-func (c *ClientWithResponses) ListOrganizationMembersWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationMembersParams, reqEditors ...RequestEditorFn) (*ListOrganizationMembersResponse, error) {
-	rsp, err := c.ListOrganizationMembers(ctx, organizationIdOrSlug, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SavedSearch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SavedSearch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseListOrganizationMembersResponse(rsp)
+
+	return response, nil
 }
 
-// CreateOrganizationMemberWithBodyWithResponse request with arbitrary body returning *CreateOrganizationMemberResponse
-func (c *ClientWithResponses) CreateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error) {
-	rsp, err := c.CreateOrganizationMemberWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+// ParseDeleteOrganizationSavedSearchResponse parses an HTTP response from a DeleteOrganizationSavedSearchWithResponse call
+func ParseDeleteOrganizationSavedSearchResponse(rsp *http.Response) (*DeleteOrganizationSavedSearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateOrganizationMemberResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationMemberResponse, error) {
-	rsp, err := c.CreateOrganizationMember(ctx, organizationIdOrSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteOrganizationSavedSearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateOrganizationMemberResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteOrganizationMemberWithResponse request returning *DeleteOrganizationMemberResponse
-func (c *ClientWithResponses) DeleteOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*DeleteOrganizationMemberResponse, error) {
-	rsp, err := c.DeleteOrganizationMember(ctx, organizationIdOrSlug, memberId, reqEditors...)
+// ParseUpdateOrganizationSavedSearchResponse parses an HTTP response from a UpdateOrganizationSavedSearchWithResponse call
+func ParseUpdateOrganizationSavedSearchResponse(rsp *http.Response) (*UpdateOrganizationSavedSearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteOrganizationMemberResponse(rsp)
-}
 
-// GetOrganizationMemberWithResponse request returning *GetOrganizationMemberResponse
-func (c *ClientWithResponses) GetOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, reqEditors ...RequestEditorFn) (*GetOrganizationMemberResponse, error) {
-	rsp, err := c.GetOrganizationMember(ctx, organizationIdOrSlug, memberId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateOrganizationSavedSearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetOrganizationMemberResponse(rsp)
-}
 
-// UpdateOrganizationMemberWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationMemberResponse
-func (c *ClientWithResponses) UpdateOrganizationMemberWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error) {
-	rsp, err := c.UpdateOrganizationMemberWithBody(ctx, organizationIdOrSlug, memberId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SavedSearch
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateOrganizationMemberResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateOrganizationMemberWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, memberId MemberId, body UpdateOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberResponse, error) {
-	rsp, err := c.UpdateOrganizationMember(ctx, organizationIdOrSlug, memberId, body, reqEditors...)
+// ParseListSentryAppInstallationsResponse parses an HTTP response from a ListSentryAppInstallationsWithResponse call
+func ParseListSentryAppInstallationsResponse(rsp *http.Response) (*ListSentryAppInstallationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateOrganizationMemberResponse(rsp)
-}
 
-// ListOrganizationProjectsWithResponse request returning *ListOrganizationProjectsResponse
-func (c *ClientWithResponses) ListOrganizationProjectsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationProjectsParams, reqEditors ...RequestEditorFn) (*ListOrganizationProjectsResponse, error) {
-	rsp, err := c.ListOrganizationProjects(ctx, organizationIdOrSlug, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListSentryAppInstallationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListOrganizationProjectsResponse(rsp)
-}
 
-// CreateProjectMonitorWithBodyWithResponse request with arbitrary body returning *CreateProjectMonitorResponse
-func (c *ClientWithResponses) CreateProjectMonitorWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error) {
-	rsp, err := c.CreateProjectMonitorWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SentryAppInstallation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateProjectMonitorResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateProjectMonitorWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectMonitorResponse, error) {
-	rsp, err := c.CreateProjectMonitor(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
+// ParseDisableSpikeProtectionResponse parses an HTTP response from a DisableSpikeProtectionWithResponse call
+func ParseDisableSpikeProtectionResponse(rsp *http.Response) (*DisableSpikeProtectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectMonitorResponse(rsp)
-}
 
-// ListSentryAppInstallationsWithResponse request returning *ListSentryAppInstallationsResponse
-func (c *ClientWithResponses) ListSentryAppInstallationsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListSentryAppInstallationsParams, reqEditors ...RequestEditorFn) (*ListSentryAppInstallationsResponse, error) {
-	rsp, err := c.ListSentryAppInstallations(ctx, organizationIdOrSlug, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DisableSpikeProtectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListSentryAppInstallationsResponse(rsp)
+
+	return response, nil
 }
 
-// DisableSpikeProtectionWithBodyWithResponse request with arbitrary body returning *DisableSpikeProtectionResponse
-func (c *ClientWithResponses) DisableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error) {
-	rsp, err := c.DisableSpikeProtectionWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+// ParseEnableSpikeProtectionResponse parses an HTTP response from a EnableSpikeProtectionWithResponse call
+func ParseEnableSpikeProtectionResponse(rsp *http.Response) (*EnableSpikeProtectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDisableSpikeProtectionResponse(rsp)
-}
 
-func (c *ClientWithResponses) DisableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body DisableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableSpikeProtectionResponse, error) {
-	rsp, err := c.DisableSpikeProtection(ctx, organizationIdOrSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EnableSpikeProtectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDisableSpikeProtectionResponse(rsp)
+
+	return response, nil
 }
 
-// EnableSpikeProtectionWithBodyWithResponse request with arbitrary body returning *EnableSpikeProtectionResponse
-func (c *ClientWithResponses) EnableSpikeProtectionWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error) {
-	rsp, err := c.EnableSpikeProtectionWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+// ParseListOrganizationTeamsResponse parses an HTTP response from a ListOrganizationTeamsWithResponse call
+func ParseListOrganizationTeamsResponse(rsp *http.Response) (*ListOrganizationTeamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseEnableSpikeProtectionResponse(rsp)
-}
 
-func (c *ClientWithResponses) EnableSpikeProtectionWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body EnableSpikeProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*EnableSpikeProtectionResponse, error) {
-	rsp, err := c.EnableSpikeProtection(ctx, organizationIdOrSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListOrganizationTeamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseEnableSpikeProtectionResponse(rsp)
-}
 
-// ListOrganizationTeamsWithResponse request returning *ListOrganizationTeamsResponse
-func (c *ClientWithResponses) ListOrganizationTeamsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, reqEditors ...RequestEditorFn) (*ListOrganizationTeamsResponse, error) {
-	rsp, err := c.ListOrganizationTeams(ctx, organizationIdOrSlug, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseListOrganizationTeamsResponse(rsp)
+
+	return response, nil
 }
 
-// CreateOrganizationTeamWithBodyWithResponse request with arbitrary body returning *CreateOrganizationTeamResponse
-func (c *ClientWithResponses) CreateOrganizationTeamWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error) {
-	rsp, err := c.CreateOrganizationTeamWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
+// ParseCreateOrganizationTeamResponse parses an HTTP response from a CreateOrganizationTeamWithResponse call
+func ParseCreateOrganizationTeamResponse(rsp *http.Response) (*CreateOrganizationTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateOrganizationTeamResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamResponse, error) {
-	rsp, err := c.CreateOrganizationTeam(ctx, organizationIdOrSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateOrganizationTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateOrganizationTeamResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ListOrganizationWorkflowsWithResponse request returning *ListOrganizationWorkflowsResponse
-func (c *ClientWithResponses) ListOrganizationWorkflowsWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, params *ListOrganizationWorkflowsParams, reqEditors ...RequestEditorFn) (*ListOrganizationWorkflowsResponse, error) {
-	rsp, err := c.ListOrganizationWorkflows(ctx, organizationIdOrSlug, params, reqEditors...)
+// ParseListOrganizationWorkflowsResponse parses an HTTP response from a ListOrganizationWorkflowsWithResponse call
+func ParseListOrganizationWorkflowsResponse(rsp *http.Response) (*ListOrganizationWorkflowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListOrganizationWorkflowsResponse(rsp)
-}
 
-// CreateOrganizationWorkflowWithBodyWithResponse request with arbitrary body returning *CreateOrganizationWorkflowResponse
-func (c *ClientWithResponses) CreateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error) {
-	rsp, err := c.CreateOrganizationWorkflowWithBody(ctx, organizationIdOrSlug, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListOrganizationWorkflowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateOrganizationWorkflowResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, body CreateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationWorkflowResponse, error) {
-	rsp, err := c.CreateOrganizationWorkflow(ctx, organizationIdOrSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OrganizationWorkflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateOrganizationWorkflowResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteOrganizationWorkflowWithResponse request returning *DeleteOrganizationWorkflowResponse
-func (c *ClientWithResponses) DeleteOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*DeleteOrganizationWorkflowResponse, error) {
-	rsp, err := c.DeleteOrganizationWorkflow(ctx, organizationIdOrSlug, workflowId, reqEditors...)
+// ParseCreateOrganizationWorkflowResponse parses an HTTP response from a CreateOrganizationWorkflowWithResponse call
+func ParseCreateOrganizationWorkflowResponse(rsp *http.Response) (*CreateOrganizationWorkflowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteOrganizationWorkflowResponse(rsp)
-}
 
-// GetOrganizationWorkflowWithResponse request returning *GetOrganizationWorkflowResponse
-func (c *ClientWithResponses) GetOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, reqEditors ...RequestEditorFn) (*GetOrganizationWorkflowResponse, error) {
-	rsp, err := c.GetOrganizationWorkflow(ctx, organizationIdOrSlug, workflowId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateOrganizationWorkflowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetOrganizationWorkflowResponse(rsp)
-}
 
-// UpdateOrganizationWorkflowWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationWorkflowResponse
-func (c *ClientWithResponses) UpdateOrganizationWorkflowWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error) {
-	rsp, err := c.UpdateOrganizationWorkflowWithBody(ctx, organizationIdOrSlug, workflowId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OrganizationWorkflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseUpdateOrganizationWorkflowResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateOrganizationWorkflowWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, workflowId string, body UpdateOrganizationWorkflowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationWorkflowResponse, error) {
-	rsp, err := c.UpdateOrganizationWorkflow(ctx, organizationIdOrSlug, workflowId, body, reqEditors...)
+// ParseBulkUpdateOrganizationWorkflowsResponse parses an HTTP response from a BulkUpdateOrganizationWorkflowsWithResponse call
+func ParseBulkUpdateOrganizationWorkflowsResponse(rsp *http.Response) (*BulkUpdateOrganizationWorkflowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateOrganizationWorkflowResponse(rsp)
-}
 
-// DeleteOrganizationProjectWithResponse request returning *DeleteOrganizationProjectResponse
-func (c *ClientWithResponses) DeleteOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationProjectResponse, error) {
-	rsp, err := c.DeleteOrganizationProject(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &BulkUpdateOrganizationWorkflowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteOrganizationProjectResponse(rsp)
-}
 
-// GetOrganizationProjectWithResponse request returning *GetOrganizationProjectResponse
-func (c *ClientWithResponses) GetOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationProjectResponse, error) {
-	rsp, err := c.GetOrganizationProject(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OrganizationWorkflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetOrganizationProjectResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateOrganizationProjectWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationProjectResponse
-func (c *ClientWithResponses) UpdateOrganizationProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error) {
-	rsp, err := c.UpdateOrganizationProjectWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+// ParseDeleteOrganizationWorkflowResponse parses an HTTP response from a DeleteOrganizationWorkflowWithResponse call
+func ParseDeleteOrganizationWorkflowResponse(rsp *http.Response) (*DeleteOrganizationWorkflowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateOrganizationProjectResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateOrganizationProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationProjectResponse, error) {
-	rsp, err := c.UpdateOrganizationProject(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteOrganizationWorkflowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateOrganizationProjectResponse(rsp)
+
+	return response, nil
 }
 
-// ListProjectClientKeysWithResponse request returning *ListProjectClientKeysResponse
-func (c *ClientWithResponses) ListProjectClientKeysWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, params *ListProjectClientKeysParams, reqEditors ...RequestEditorFn) (*ListProjectClientKeysResponse, error) {
-	rsp, err := c.ListProjectClientKeys(ctx, organizationIdOrSlug, projectIdOrSlug, params, reqEditors...)
+// ParseGetOrganizationWorkflowResponse parses an HTTP response from a GetOrganizationWorkflowWithResponse call
+func ParseGetOrganizationWorkflowResponse(rsp *http.Response) (*GetOrganizationWorkflowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectClientKeysResponse(rsp)
-}
 
-// CreateProjectClientKeyWithBodyWithResponse request with arbitrary body returning *CreateProjectClientKeyResponse
-func (c *ClientWithResponses) CreateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error) {
-	rsp, err := c.CreateProjectClientKeyWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetOrganizationWorkflowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateProjectClientKeyResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectClientKeyResponse, error) {
-	rsp, err := c.CreateProjectClientKey(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationWorkflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateProjectClientKeyResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteProjectClientKeyWithResponse request returning *DeleteProjectClientKeyResponse
-func (c *ClientWithResponses) DeleteProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*DeleteProjectClientKeyResponse, error) {
-	rsp, err := c.DeleteProjectClientKey(ctx, organizationIdOrSlug, projectIdOrSlug, keyId, reqEditors...)
+// ParseUpdateOrganizationWorkflowResponse parses an HTTP response from a UpdateOrganizationWorkflowWithResponse call
+func ParseUpdateOrganizationWorkflowResponse(rsp *http.Response) (*UpdateOrganizationWorkflowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectClientKeyResponse(rsp)
-}
 
-// GetProjectClientKeyWithResponse request returning *GetProjectClientKeyResponse
-func (c *ClientWithResponses) GetProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, reqEditors ...RequestEditorFn) (*GetProjectClientKeyResponse, error) {
-	rsp, err := c.GetProjectClientKey(ctx, organizationIdOrSlug, projectIdOrSlug, keyId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateOrganizationWorkflowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetProjectClientKeyResponse(rsp)
-}
 
-// UpdateProjectClientKeyWithBodyWithResponse request with arbitrary body returning *UpdateProjectClientKeyResponse
-func (c *ClientWithResponses) UpdateProjectClientKeyWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error) {
-	rsp, err := c.UpdateProjectClientKeyWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, keyId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationWorkflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateProjectClientKeyResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateProjectClientKeyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, keyId string, body UpdateProjectClientKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectClientKeyResponse, error) {
-	rsp, err := c.UpdateProjectClientKey(ctx, organizationIdOrSlug, projectIdOrSlug, keyId, body, reqEditors...)
+// ParseDeleteOrganizationProjectResponse parses an HTTP response from a DeleteOrganizationProjectWithResponse call
+func ParseDeleteOrganizationProjectResponse(rsp *http.Response) (*DeleteOrganizationProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectClientKeyResponse(rsp)
-}
 
-// GetProjectOwnershipWithResponse request returning *GetProjectOwnershipResponse
-func (c *ClientWithResponses) GetProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, reqEditors ...RequestEditorFn) (*GetProjectOwnershipResponse, error) {
-	rsp, err := c.GetProjectOwnership(ctx, organizationIdOrSlug, projectIdOrSlug, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteOrganizationProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetProjectOwnershipResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateProjectOwnershipWithBodyWithResponse request with arbitrary body returning *UpdateProjectOwnershipResponse
-func (c *ClientWithResponses) UpdateProjectOwnershipWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error) {
-	rsp, err := c.UpdateProjectOwnershipWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
+// ParseGetOrganizationProjectResponse parses an HTTP response from a GetOrganizationProjectWithResponse call
+func ParseGetOrganizationProjectResponse(rsp *http.Response) (*GetOrganizationProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectOwnershipResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateProjectOwnershipWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body UpdateProjectOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectOwnershipResponse, error) {
-	rsp, err := c.UpdateProjectOwnership(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetOrganizationProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateProjectOwnershipResponse(rsp)
-}
 
-// CreateProjectRuleWithBodyWithResponse request with arbitrary body returning *CreateProjectRuleResponse
-func (c *ClientWithResponses) CreateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error) {
-	rsp, err := c.CreateProjectRuleWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateProjectRuleResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, body CreateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRuleResponse, error) {
-	rsp, err := c.CreateProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, body, reqEditors...)
+// ParseUpdateOrganizationProjectResponse parses an HTTP response from a UpdateOrganizationProjectWithResponse call
+func ParseUpdateOrganizationProjectResponse(rsp *http.Response) (*UpdateOrganizationProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectRuleResponse(rsp)
-}
 
-// DeleteProjectRuleWithResponse request returning *DeleteProjectRuleResponse
-func (c *ClientWithResponses) DeleteProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*DeleteProjectRuleResponse, error) {
-	rsp, err := c.DeleteProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateOrganizationProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteProjectRuleResponse(rsp)
-}
 
-// GetProjectRuleWithResponse request returning *GetProjectRuleResponse
-func (c *ClientWithResponses) GetProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, reqEditors ...RequestEditorFn) (*GetProjectRuleResponse, error) {
-	rsp, err := c.GetProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetProjectRuleResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateProjectRuleWithBodyWithResponse request with arbitrary body returning *UpdateProjectRuleResponse
-func (c *ClientWithResponses) UpdateProjectRuleWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error) {
-	rsp, err := c.UpdateProjectRuleWithBody(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, contentType, body, reqEditors...)
+// ParseListProjectCodeOwnersResponse parses an HTTP response from a ListProjectCodeOwnersWithResponse call
+func ParseListProjectCodeOwnersResponse(rsp *http.Response) (*ListProjectCodeOwnersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectRuleResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateProjectRuleWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, ruleId string, body UpdateProjectRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRuleResponse, error) {
-	rsp, err := c.UpdateProjectRule(ctx, organizationIdOrSlug, projectIdOrSlug, ruleId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListProjectCodeOwnersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateProjectRuleResponse(rsp)
-}
 
-// RemoveTeamFromProjectWithResponse request returning *RemoveTeamFromProjectResponse
-func (c *ClientWithResponses) RemoveTeamFromProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*RemoveTeamFromProjectResponse, error) {
-	rsp, err := c.RemoveTeamFromProject(ctx, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProjectCodeOwners
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseRemoveTeamFromProjectResponse(rsp)
+
+	return response, nil
 }
 
-// AddTeamToProjectWithResponse request returning *AddTeamToProjectResponse
-func (c *ClientWithResponses) AddTeamToProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, projectIdOrSlug ProjectIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*AddTeamToProjectResponse, error) {
-	rsp, err := c.AddTeamToProject(ctx, organizationIdOrSlug, projectIdOrSlug, teamIdOrSlug, reqEditors...)
+// ParseCreateProjectCodeOwnersResponse parses an HTTP response from a CreateProjectCodeOwnersWithResponse call
+func ParseCreateProjectCodeOwnersResponse(rsp *http.Response) (*CreateProjectCodeOwnersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddTeamToProjectResponse(rsp)
-}
 
-// DeleteOrganizationTeamWithResponse request returning *DeleteOrganizationTeamResponse
-func (c *ClientWithResponses) DeleteOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteOrganizationTeamResponse, error) {
-	rsp, err := c.DeleteOrganizationTeam(ctx, organizationIdOrSlug, teamIdOrSlug, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateProjectCodeOwnersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteOrganizationTeamResponse(rsp)
-}
 
-// GetOrganizationTeamWithResponse request returning *GetOrganizationTeamResponse
-func (c *ClientWithResponses) GetOrganizationTeamWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, reqEditors ...RequestEditorFn) (*GetOrganizationTeamResponse, error) {
-	rsp, err := c.GetOrganizationTeam(ctx, organizationIdOrSlug, teamIdOrSlug, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProjectCodeOwners
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseGetOrganizationTeamResponse(rsp)
+
+	return response, nil
 }
 
-// CreateOrganizationTeamProjectWithBodyWithResponse request with arbitrary body returning *CreateOrganizationTeamProjectResponse
-func (c *ClientWithResponses) CreateOrganizationTeamProjectWithBodyWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error) {
-	rsp, err := c.CreateOrganizationTeamProjectWithBody(ctx, organizationIdOrSlug, teamIdOrSlug, contentType, body, reqEditors...)
+// ParseDeleteProjectCodeOwnersResponse parses an HTTP response from a DeleteProjectCodeOwnersWithResponse call
+func ParseDeleteProjectCodeOwnersResponse(rsp *http.Response) (*DeleteProjectCodeOwnersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateOrganizationTeamProjectResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateOrganizationTeamProjectWithResponse(ctx context.Context, organizationIdOrSlug OrganizationIdOrSlug, teamIdOrSlug TeamIdOrSlug, body CreateOrganizationTeamProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationTeamProjectResponse, error) {
-	rsp, err := c.CreateOrganizationTeamProject(ctx, organizationIdOrSlug, teamIdOrSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteProjectCodeOwnersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateOrganizationTeamProjectResponse(rsp)
+
+	return response, nil
 }
 
-// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
-func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
+// ParseUpdateProjectCodeOwnersResponse parses an HTTP response from a UpdateProjectCodeOwnersWithResponse call
+func ParseUpdateProjectCodeOwnersResponse(rsp *http.Response) (*UpdateProjectCodeOwnersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HealthCheckResponse{
+	response := &UpdateProjectCodeOwnersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectCodeOwners
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetOrganizationResponse parses an HTTP response from a GetOrganizationWithResponse call
-func ParseGetOrganizationResponse(rsp *http.Response) (*GetOrganizationResponse, error) {
+// ParseListProjectEnvironmentsResponse parses an HTTP response from a ListProjectEnvironmentsWithResponse call
+func ParseListProjectEnvironmentsResponse(rsp *http.Response) (*ListProjectEnvironmentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrganizationResponse{
+	response := &ListProjectEnvironmentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Organization
+		var dest []ProjectEnvironment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11987,22 +24581,22 @@ func ParseGetOrganizationResponse(rsp *http.Response) (*GetOrganizationResponse,
 	return response, nil
 }
 
-// ParseListOrganizationMonitorsResponse parses an HTTP response from a ListOrganizationMonitorsWithResponse call
-func ParseListOrganizationMonitorsResponse(rsp *http.Response) (*ListOrganizationMonitorsResponse, error) {
+// ParseGetProjectEnvironmentResponse parses an HTTP response from a GetProjectEnvironmentWithResponse call
+func ParseGetProjectEnvironmentResponse(rsp *http.Response) (*GetProjectEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListOrganizationMonitorsResponse{
+	response := &GetProjectEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ProjectMonitor
+		var dest ProjectEnvironment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12013,38 +24607,48 @@ func ParseListOrganizationMonitorsResponse(rsp *http.Response) (*ListOrganizatio
 	return response, nil
 }
 
-// ParseDeleteProjectMonitorResponse parses an HTTP response from a DeleteProjectMonitorWithResponse call
-func ParseDeleteProjectMonitorResponse(rsp *http.Response) (*DeleteProjectMonitorResponse, error) {
+// ParseUpdateProjectEnvironmentResponse parses an HTTP response from a UpdateProjectEnvironmentWithResponse call
+func ParseUpdateProjectEnvironmentResponse(rsp *http.Response) (*UpdateProjectEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectMonitorResponse{
+	response := &UpdateProjectEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectEnvironment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetProjectMonitorResponse parses an HTTP response from a GetProjectMonitorWithResponse call
-func ParseGetProjectMonitorResponse(rsp *http.Response) (*GetProjectMonitorResponse, error) {
+// ParseListProjectClientKeysResponse parses an HTTP response from a ListProjectClientKeysWithResponse call
+func ParseListProjectClientKeysResponse(rsp *http.Response) (*ListProjectClientKeysResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectMonitorResponse{
+	response := &ListProjectClientKeysResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectMonitor
+		var dest []ProjectKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12055,48 +24659,64 @@ func ParseGetProjectMonitorResponse(rsp *http.Response) (*GetProjectMonitorRespo
 	return response, nil
 }
 
-// ParseUpdateProjectMonitorResponse parses an HTTP response from a UpdateProjectMonitorWithResponse call
-func ParseUpdateProjectMonitorResponse(rsp *http.Response) (*UpdateProjectMonitorResponse, error) {
+// ParseCreateProjectClientKeyResponse parses an HTTP response from a CreateProjectClientKeyWithResponse call
+func ParseCreateProjectClientKeyResponse(rsp *http.Response) (*CreateProjectClientKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectMonitorResponse{
+	response := &CreateProjectClientKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectMonitor
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProjectKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListOrganizationIntegrationsResponse parses an HTTP response from a ListOrganizationIntegrationsWithResponse call
-func ParseListOrganizationIntegrationsResponse(rsp *http.Response) (*ListOrganizationIntegrationsResponse, error) {
+// ParseDeleteProjectClientKeyResponse parses an HTTP response from a DeleteProjectClientKeyWithResponse call
+func ParseDeleteProjectClientKeyResponse(rsp *http.Response) (*DeleteProjectClientKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListOrganizationIntegrationsResponse{
+	response := &DeleteProjectClientKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectClientKeyResponse parses an HTTP response from a GetProjectClientKeyWithResponse call
+func ParseGetProjectClientKeyResponse(rsp *http.Response) (*GetProjectClientKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectClientKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []OrganizationIntegration
+		var dest ProjectKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12107,22 +24727,22 @@ func ParseListOrganizationIntegrationsResponse(rsp *http.Response) (*ListOrganiz
 	return response, nil
 }
 
-// ParseGetOrganizationIntegrationResponse parses an HTTP response from a GetOrganizationIntegrationWithResponse call
-func ParseGetOrganizationIntegrationResponse(rsp *http.Response) (*GetOrganizationIntegrationResponse, error) {
+// ParseUpdateProjectClientKeyResponse parses an HTTP response from a UpdateProjectClientKeyWithResponse call
+func ParseUpdateProjectClientKeyResponse(rsp *http.Response) (*UpdateProjectClientKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrganizationIntegrationResponse{
+	response := &UpdateProjectClientKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrganizationIntegration
+		var dest ProjectKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12133,15 +24753,15 @@ func ParseGetOrganizationIntegrationResponse(rsp *http.Response) (*GetOrganizati
 	return response, nil
 }
 
-// ParseUpdateOrganizationIntegrationResponse parses an HTTP response from a UpdateOrganizationIntegrationWithResponse call
-func ParseUpdateOrganizationIntegrationResponse(rsp *http.Response) (*UpdateOrganizationIntegrationResponse, error) {
+// ParseUpdateProjectCronMonitorMuteResponse parses an HTTP response from a UpdateProjectCronMonitorMuteWithResponse call
+func ParseUpdateProjectCronMonitorMuteResponse(rsp *http.Response) (*UpdateProjectCronMonitorMuteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateOrganizationIntegrationResponse{
+	response := &UpdateProjectCronMonitorMuteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12149,22 +24769,38 @@ func ParseUpdateOrganizationIntegrationResponse(rsp *http.Response) (*UpdateOrga
 	return response, nil
 }
 
-// ParseListOrganizationMembersResponse parses an HTTP response from a ListOrganizationMembersWithResponse call
-func ParseListOrganizationMembersResponse(rsp *http.Response) (*ListOrganizationMembersResponse, error) {
+// ParseUpdateProjectCronMonitorEnvironmentMuteResponse parses an HTTP response from a UpdateProjectCronMonitorEnvironmentMuteWithResponse call
+func ParseUpdateProjectCronMonitorEnvironmentMuteResponse(rsp *http.Response) (*UpdateProjectCronMonitorEnvironmentMuteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListOrganizationMembersResponse{
+	response := &UpdateProjectCronMonitorEnvironmentMuteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectOwnershipResponse parses an HTTP response from a GetProjectOwnershipWithResponse call
+func ParseGetProjectOwnershipResponse(rsp *http.Response) (*GetProjectOwnershipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectOwnershipResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []OrganizationMember
+		var dest ProjectOwnership
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12175,41 +24811,41 @@ func ParseListOrganizationMembersResponse(rsp *http.Response) (*ListOrganization
 	return response, nil
 }
 
-// ParseCreateOrganizationMemberResponse parses an HTTP response from a CreateOrganizationMemberWithResponse call
-func ParseCreateOrganizationMemberResponse(rsp *http.Response) (*CreateOrganizationMemberResponse, error) {
+// ParseUpdateProjectOwnershipResponse parses an HTTP response from a UpdateProjectOwnershipWithResponse call
+func ParseUpdateProjectOwnershipResponse(rsp *http.Response) (*UpdateProjectOwnershipResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateOrganizationMemberResponse{
+	response := &UpdateProjectOwnershipResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest OrganizationMember
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteOrganizationMemberResponse parses an HTTP response from a DeleteOrganizationMemberWithResponse call
-func ParseDeleteOrganizationMemberResponse(rsp *http.Response) (*DeleteOrganizationMemberResponse, error) {
+// ParseResetProjectPerformanceIssueSettingsResponse parses an HTTP response from a ResetProjectPerformanceIssueSettingsWithResponse call
+func ParseResetProjectPerformanceIssueSettingsResponse(rsp *http.Response) (*ResetProjectPerformanceIssueSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteOrganizationMemberResponse{
+	response := &ResetProjectPerformanceIssueSettingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12217,22 +24853,22 @@ func ParseDeleteOrganizationMemberResponse(rsp *http.Response) (*DeleteOrganizat
 	return response, nil
 }
 
-// ParseGetOrganizationMemberResponse parses an HTTP response from a GetOrganizationMemberWithResponse call
-func ParseGetOrganizationMemberResponse(rsp *http.Response) (*GetOrganizationMemberResponse, error) {
+// ParseGetProjectPerformanceIssueSettingsResponse parses an HTTP response from a GetProjectPerformanceIssueSettingsWithResponse call
+func ParseGetProjectPerformanceIssueSettingsResponse(rsp *http.Response) (*GetProjectPerformanceIssueSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrganizationMemberResponse{
+	response := &GetProjectPerformanceIssueSettingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrganizationMemberWithRoles
+		var dest ProjectPerformanceIssueSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12243,22 +24879,22 @@ func ParseGetOrganizationMemberResponse(rsp *http.Response) (*GetOrganizationMem
 	return response, nil
 }
 
-// ParseUpdateOrganizationMemberResponse parses an HTTP response from a UpdateOrganizationMemberWithResponse call
-func ParseUpdateOrganizationMemberResponse(rsp *http.Response) (*UpdateOrganizationMemberResponse, error) {
+// ParseUpdateProjectPerformanceIssueSettingsResponse parses an HTTP response from a UpdateProjectPerformanceIssueSettingsWithResponse call
+func ParseUpdateProjectPerformanceIssueSettingsResponse(rsp *http.Response) (*UpdateProjectPerformanceIssueSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateOrganizationMemberResponse{
+	response := &UpdateProjectPerformanceIssueSettingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrganizationMemberWithRoles
+		var dest ProjectPerformanceIssueSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12269,22 +24905,38 @@ func ParseUpdateOrganizationMemberResponse(rsp *http.Response) (*UpdateOrganizat
 	return response, nil
 }
 
-// ParseListOrganizationProjectsResponse parses an HTTP response from a ListOrganizationProjectsWithResponse call
-func ParseListOrganizationProjectsResponse(rsp *http.Response) (*ListOrganizationProjectsResponse, error) {
+// ParseDisableProjectPluginResponse parses an HTTP response from a DisableProjectPluginWithResponse call
+func ParseDisableProjectPluginResponse(rsp *http.Response) (*DisableProjectPluginResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListOrganizationProjectsResponse{
+	response := &DisableProjectPluginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectPluginResponse parses an HTTP response from a GetProjectPluginWithResponse call
+func ParseGetProjectPluginResponse(rsp *http.Response) (*GetProjectPluginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectPluginResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Project
+		var dest ProjectPlugin
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12295,48 +24947,64 @@ func ParseListOrganizationProjectsResponse(rsp *http.Response) (*ListOrganizatio
 	return response, nil
 }
 
-// ParseCreateProjectMonitorResponse parses an HTTP response from a CreateProjectMonitorWithResponse call
-func ParseCreateProjectMonitorResponse(rsp *http.Response) (*CreateProjectMonitorResponse, error) {
+// ParseEnableProjectPluginResponse parses an HTTP response from a EnableProjectPluginWithResponse call
+func ParseEnableProjectPluginResponse(rsp *http.Response) (*EnableProjectPluginResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectMonitorResponse{
+	response := &EnableProjectPluginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProjectPluginResponse parses an HTTP response from a UpdateProjectPluginWithResponse call
+func ParseUpdateProjectPluginResponse(rsp *http.Response) (*UpdateProjectPluginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProjectPluginResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ProjectMonitor
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectPlugin
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListSentryAppInstallationsResponse parses an HTTP response from a ListSentryAppInstallationsWithResponse call
-func ParseListSentryAppInstallationsResponse(rsp *http.Response) (*ListSentryAppInstallationsResponse, error) {
+// ParseListProjectReleaseThresholdsResponse parses an HTTP response from a ListProjectReleaseThresholdsWithResponse call
+func ParseListProjectReleaseThresholdsResponse(rsp *http.Response) (*ListProjectReleaseThresholdsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListSentryAppInstallationsResponse{
+	response := &ListProjectReleaseThresholdsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []SentryAppInstallation
+		var dest []ReleaseThreshold
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12347,31 +25015,41 @@ func ParseListSentryAppInstallationsResponse(rsp *http.Response) (*ListSentryApp
 	return response, nil
 }
 
-// ParseDisableSpikeProtectionResponse parses an HTTP response from a DisableSpikeProtectionWithResponse call
-func ParseDisableSpikeProtectionResponse(rsp *http.Response) (*DisableSpikeProtectionResponse, error) {
+// ParseCreateProjectReleaseThresholdResponse parses an HTTP response from a CreateProjectReleaseThresholdWithResponse call
+func ParseCreateProjectReleaseThresholdResponse(rsp *http.Response) (*CreateProjectReleaseThresholdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DisableSpikeProtectionResponse{
+	response := &CreateProjectReleaseThresholdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ReleaseThreshold
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseEnableSpikeProtectionResponse parses an HTTP response from a EnableSpikeProtectionWithResponse call
-func ParseEnableSpikeProtectionResponse(rsp *http.Response) (*EnableSpikeProtectionResponse, error) {
+// ParseDeleteProjectReleaseThresholdResponse parses an HTTP response from a DeleteProjectReleaseThresholdWithResponse call
+func ParseDeleteProjectReleaseThresholdResponse(rsp *http.Response) (*DeleteProjectReleaseThresholdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EnableSpikeProtectionResponse{
+	response := &DeleteProjectReleaseThresholdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12379,22 +25057,22 @@ func ParseEnableSpikeProtectionResponse(rsp *http.Response) (*EnableSpikeProtect
 	return response, nil
 }
 
-// ParseListOrganizationTeamsResponse parses an HTTP response from a ListOrganizationTeamsWithResponse call
-func ParseListOrganizationTeamsResponse(rsp *http.Response) (*ListOrganizationTeamsResponse, error) {
+// ParseGetProjectReleaseThresholdResponse parses an HTTP response from a GetProjectReleaseThresholdWithResponse call
+func ParseGetProjectReleaseThresholdResponse(rsp *http.Response) (*GetProjectReleaseThresholdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListOrganizationTeamsResponse{
+	response := &GetProjectReleaseThresholdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Team
+		var dest ReleaseThreshold
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12405,93 +25083,83 @@ func ParseListOrganizationTeamsResponse(rsp *http.Response) (*ListOrganizationTe
 	return response, nil
 }
 
-// ParseCreateOrganizationTeamResponse parses an HTTP response from a CreateOrganizationTeamWithResponse call
-func ParseCreateOrganizationTeamResponse(rsp *http.Response) (*CreateOrganizationTeamResponse, error) {
+// ParseUpdateProjectReleaseThresholdResponse parses an HTTP response from a UpdateProjectReleaseThresholdWithResponse call
+func ParseUpdateProjectReleaseThresholdResponse(rsp *http.Response) (*UpdateProjectReleaseThresholdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateOrganizationTeamResponse{
+	response := &UpdateProjectReleaseThresholdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Team
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReleaseThreshold
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListOrganizationWorkflowsResponse parses an HTTP response from a ListOrganizationWorkflowsWithResponse call
-func ParseListOrganizationWorkflowsResponse(rsp *http.Response) (*ListOrganizationWorkflowsResponse, error) {
+// ParseCreateProjectRuleResponse parses an HTTP response from a CreateProjectRuleWithResponse call
+func ParseCreateProjectRuleResponse(rsp *http.Response) (*CreateProjectRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListOrganizationWorkflowsResponse{
+	response := &CreateProjectRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []OrganizationWorkflow
+		var dest ProjectRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseCreateOrganizationWorkflowResponse parses an HTTP response from a CreateOrganizationWorkflowWithResponse call
-func ParseCreateOrganizationWorkflowResponse(rsp *http.Response) (*CreateOrganizationWorkflowResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &CreateOrganizationWorkflowResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest OrganizationWorkflow
+		var dest ProjectRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON201 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest struct {
+			Uuid string `json:"uuid"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteOrganizationWorkflowResponse parses an HTTP response from a DeleteOrganizationWorkflowWithResponse call
-func ParseDeleteOrganizationWorkflowResponse(rsp *http.Response) (*DeleteOrganizationWorkflowResponse, error) {
+// ParseDeleteProjectRuleResponse parses an HTTP response from a DeleteProjectRuleWithResponse call
+func ParseDeleteProjectRuleResponse(rsp *http.Response) (*DeleteProjectRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteOrganizationWorkflowResponse{
+	response := &DeleteProjectRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12499,22 +25167,22 @@ func ParseDeleteOrganizationWorkflowResponse(rsp *http.Response) (*DeleteOrganiz
 	return response, nil
 }
 
-// ParseGetOrganizationWorkflowResponse parses an HTTP response from a GetOrganizationWorkflowWithResponse call
-func ParseGetOrganizationWorkflowResponse(rsp *http.Response) (*GetOrganizationWorkflowResponse, error) {
+// ParseGetProjectRuleResponse parses an HTTP response from a GetProjectRuleWithResponse call
+func ParseGetProjectRuleResponse(rsp *http.Response) (*GetProjectRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrganizationWorkflowResponse{
+	response := &GetProjectRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrganizationWorkflow
+		var dest ProjectRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12525,22 +25193,22 @@ func ParseGetOrganizationWorkflowResponse(rsp *http.Response) (*GetOrganizationW
 	return response, nil
 }
 
-// ParseUpdateOrganizationWorkflowResponse parses an HTTP response from a UpdateOrganizationWorkflowWithResponse call
-func ParseUpdateOrganizationWorkflowResponse(rsp *http.Response) (*UpdateOrganizationWorkflowResponse, error) {
+// ParseUpdateProjectRuleResponse parses an HTTP response from a UpdateProjectRuleWithResponse call
+func ParseUpdateProjectRuleResponse(rsp *http.Response) (*UpdateProjectRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateOrganizationWorkflowResponse{
+	response := &UpdateProjectRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OrganizationWorkflow
+		var dest ProjectRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12551,15 +25219,15 @@ func ParseUpdateOrganizationWorkflowResponse(rsp *http.Response) (*UpdateOrganiz
 	return response, nil
 }
 
-// ParseDeleteOrganizationProjectResponse parses an HTTP response from a DeleteOrganizationProjectWithResponse call
-func ParseDeleteOrganizationProjectResponse(rsp *http.Response) (*DeleteOrganizationProjectResponse, error) {
+// ParseDeleteProjectRuleSnoozeResponse parses an HTTP response from a DeleteProjectRuleSnoozeWithResponse call
+func ParseDeleteProjectRuleSnoozeResponse(rsp *http.Response) (*DeleteProjectRuleSnoozeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteOrganizationProjectResponse{
+	response := &DeleteProjectRuleSnoozeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12567,48 +25235,48 @@ func ParseDeleteOrganizationProjectResponse(rsp *http.Response) (*DeleteOrganiza
 	return response, nil
 }
 
-// ParseGetOrganizationProjectResponse parses an HTTP response from a GetOrganizationProjectWithResponse call
-func ParseGetOrganizationProjectResponse(rsp *http.Response) (*GetOrganizationProjectResponse, error) {
+// ParseCreateProjectRuleSnoozeResponse parses an HTTP response from a CreateProjectRuleSnoozeWithResponse call
+func ParseCreateProjectRuleSnoozeResponse(rsp *http.Response) (*CreateProjectRuleSnoozeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrganizationProjectResponse{
+	response := &CreateProjectRuleSnoozeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Project
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RuleSnooze
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpdateOrganizationProjectResponse parses an HTTP response from a UpdateOrganizationProjectWithResponse call
-func ParseUpdateOrganizationProjectResponse(rsp *http.Response) (*UpdateOrganizationProjectResponse, error) {
+// ParseListProjectTagKeyValuesResponse parses an HTTP response from a ListProjectTagKeyValuesWithResponse call
+func ParseListProjectTagKeyValuesResponse(rsp *http.Response) (*ListProjectTagKeyValuesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateOrganizationProjectResponse{
+	response := &ListProjectTagKeyValuesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Project
+		var dest []ProjectTagValue
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12619,22 +25287,22 @@ func ParseUpdateOrganizationProjectResponse(rsp *http.Response) (*UpdateOrganiza
 	return response, nil
 }
 
-// ParseListProjectClientKeysResponse parses an HTTP response from a ListProjectClientKeysWithResponse call
-func ParseListProjectClientKeysResponse(rsp *http.Response) (*ListProjectClientKeysResponse, error) {
+// ParseRemoveTeamFromProjectResponse parses an HTTP response from a RemoveTeamFromProjectWithResponse call
+func ParseRemoveTeamFromProjectResponse(rsp *http.Response) (*RemoveTeamFromProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectClientKeysResponse{
+	response := &RemoveTeamFromProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ProjectKey
+		var dest Project
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12645,22 +25313,22 @@ func ParseListProjectClientKeysResponse(rsp *http.Response) (*ListProjectClientK
 	return response, nil
 }
 
-// ParseCreateProjectClientKeyResponse parses an HTTP response from a CreateProjectClientKeyWithResponse call
-func ParseCreateProjectClientKeyResponse(rsp *http.Response) (*CreateProjectClientKeyResponse, error) {
+// ParseAddTeamToProjectResponse parses an HTTP response from a AddTeamToProjectWithResponse call
+func ParseAddTeamToProjectResponse(rsp *http.Response) (*AddTeamToProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectClientKeyResponse{
+	response := &AddTeamToProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ProjectKey
+		var dest Project
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12671,90 +25339,64 @@ func ParseCreateProjectClientKeyResponse(rsp *http.Response) (*CreateProjectClie
 	return response, nil
 }
 
-// ParseDeleteProjectClientKeyResponse parses an HTTP response from a DeleteProjectClientKeyWithResponse call
-func ParseDeleteProjectClientKeyResponse(rsp *http.Response) (*DeleteProjectClientKeyResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &DeleteProjectClientKeyResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseGetProjectClientKeyResponse parses an HTTP response from a GetProjectClientKeyWithResponse call
-func ParseGetProjectClientKeyResponse(rsp *http.Response) (*GetProjectClientKeyResponse, error) {
+// ParseCreateSentryAppResponse parses an HTTP response from a CreateSentryAppWithResponse call
+func ParseCreateSentryAppResponse(rsp *http.Response) (*CreateSentryAppResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectClientKeyResponse{
+	response := &CreateSentryAppResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectKey
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SentryApp
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpdateProjectClientKeyResponse parses an HTTP response from a UpdateProjectClientKeyWithResponse call
-func ParseUpdateProjectClientKeyResponse(rsp *http.Response) (*UpdateProjectClientKeyResponse, error) {
+// ParseDeleteSentryAppResponse parses an HTTP response from a DeleteSentryAppWithResponse call
+func ParseDeleteSentryAppResponse(rsp *http.Response) (*DeleteSentryAppResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectClientKeyResponse{
+	response := &DeleteSentryAppResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectKey
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseGetProjectOwnershipResponse parses an HTTP response from a GetProjectOwnershipWithResponse call
-func ParseGetProjectOwnershipResponse(rsp *http.Response) (*GetProjectOwnershipResponse, error) {
+// ParseGetSentryAppResponse parses an HTTP response from a GetSentryAppWithResponse call
+func ParseGetSentryAppResponse(rsp *http.Response) (*GetSentryAppResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectOwnershipResponse{
+	response := &GetSentryAppResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectOwnership
+		var dest SentryApp
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12765,22 +25407,22 @@ func ParseGetProjectOwnershipResponse(rsp *http.Response) (*GetProjectOwnershipR
 	return response, nil
 }
 
-// ParseUpdateProjectOwnershipResponse parses an HTTP response from a UpdateProjectOwnershipWithResponse call
-func ParseUpdateProjectOwnershipResponse(rsp *http.Response) (*UpdateProjectOwnershipResponse, error) {
+// ParseUpdateSentryAppResponse parses an HTTP response from a UpdateSentryAppWithResponse call
+func ParseUpdateSentryAppResponse(rsp *http.Response) (*UpdateSentryAppResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectOwnershipResponse{
+	response := &UpdateSentryAppResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRule
+		var dest SentryApp
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12791,57 +25433,41 @@ func ParseUpdateProjectOwnershipResponse(rsp *http.Response) (*UpdateProjectOwne
 	return response, nil
 }
 
-// ParseCreateProjectRuleResponse parses an HTTP response from a CreateProjectRuleWithResponse call
-func ParseCreateProjectRuleResponse(rsp *http.Response) (*CreateProjectRuleResponse, error) {
+// ParseCreateSentryAppApiTokenResponse parses an HTTP response from a CreateSentryAppApiTokenWithResponse call
+func ParseCreateSentryAppApiTokenResponse(rsp *http.Response) (*CreateSentryAppApiTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectRuleResponse{
+	response := &CreateSentryAppApiTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRule
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ProjectRule
+		var dest SentryAppApiToken
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest struct {
-			Uuid string `json:"uuid"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON202 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseDeleteProjectRuleResponse parses an HTTP response from a DeleteProjectRuleWithResponse call
-func ParseDeleteProjectRuleResponse(rsp *http.Response) (*DeleteProjectRuleResponse, error) {
+// ParseDeleteSentryAppApiTokenResponse parses an HTTP response from a DeleteSentryAppApiTokenWithResponse call
+func ParseDeleteSentryAppApiTokenResponse(rsp *http.Response) (*DeleteSentryAppApiTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectRuleResponse{
+	response := &DeleteSentryAppApiTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12849,48 +25475,38 @@ func ParseDeleteProjectRuleResponse(rsp *http.Response) (*DeleteProjectRuleRespo
 	return response, nil
 }
 
-// ParseGetProjectRuleResponse parses an HTTP response from a GetProjectRuleWithResponse call
-func ParseGetProjectRuleResponse(rsp *http.Response) (*GetProjectRuleResponse, error) {
+// ParseDeleteOrganizationTeamResponse parses an HTTP response from a DeleteOrganizationTeamWithResponse call
+func ParseDeleteOrganizationTeamResponse(rsp *http.Response) (*DeleteOrganizationTeamResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectRuleResponse{
+	response := &DeleteOrganizationTeamResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRule
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseUpdateProjectRuleResponse parses an HTTP response from a UpdateProjectRuleWithResponse call
-func ParseUpdateProjectRuleResponse(rsp *http.Response) (*UpdateProjectRuleResponse, error) {
+// ParseGetOrganizationTeamResponse parses an HTTP response from a GetOrganizationTeamWithResponse call
+func ParseGetOrganizationTeamResponse(rsp *http.Response) (*GetOrganizationTeamResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectRuleResponse{
+	response := &GetOrganizationTeamResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRule
+		var dest Team
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12901,90 +25517,97 @@ func ParseUpdateProjectRuleResponse(rsp *http.Response) (*UpdateProjectRuleRespo
 	return response, nil
 }
 
-// ParseRemoveTeamFromProjectResponse parses an HTTP response from a RemoveTeamFromProjectWithResponse call
-func ParseRemoveTeamFromProjectResponse(rsp *http.Response) (*RemoveTeamFromProjectResponse, error) {
+// ParseCreateExternalTeamResponse parses an HTTP response from a CreateExternalTeamWithResponse call
+func ParseCreateExternalTeamResponse(rsp *http.Response) (*CreateExternalTeamResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveTeamFromProjectResponse{
+	response := &CreateExternalTeamResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Project
+		var dest ExternalActor
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ExternalActor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAddTeamToProjectResponse parses an HTTP response from a AddTeamToProjectWithResponse call
-func ParseAddTeamToProjectResponse(rsp *http.Response) (*AddTeamToProjectResponse, error) {
+// ParseDeleteExternalTeamResponse parses an HTTP response from a DeleteExternalTeamWithResponse call
+func ParseDeleteExternalTeamResponse(rsp *http.Response) (*DeleteExternalTeamResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddTeamToProjectResponse{
+	response := &DeleteExternalTeamResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Project
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseDeleteOrganizationTeamResponse parses an HTTP response from a DeleteOrganizationTeamWithResponse call
-func ParseDeleteOrganizationTeamResponse(rsp *http.Response) (*DeleteOrganizationTeamResponse, error) {
+// ParseUpdateExternalTeamResponse parses an HTTP response from a UpdateExternalTeamWithResponse call
+func ParseUpdateExternalTeamResponse(rsp *http.Response) (*UpdateExternalTeamResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteOrganizationTeamResponse{
+	response := &UpdateExternalTeamResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExternalActor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetOrganizationTeamResponse parses an HTTP response from a GetOrganizationTeamWithResponse call
-func ParseGetOrganizationTeamResponse(rsp *http.Response) (*GetOrganizationTeamResponse, error) {
+// ParseListTeamMembersResponse parses an HTTP response from a ListTeamMembersWithResponse call
+func ParseListTeamMembersResponse(rsp *http.Response) (*ListTeamMembersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrganizationTeamResponse{
+	response := &ListTeamMembersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Team
+		var dest []TeamMember
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,344 @@
+// Package crontab parses the crontab schedules accepted by Sentry's cron
+// monitors, so that schedules can be validated at plan time and previewed.
+//
+// Sentry expands the nonstandard `@` macros and validates the result with
+// croniter, only allowing the standard five fields, see
+// https://github.com/getsentry/sentry/blob/master/src/sentry/monitors/validators.py
+package crontab
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	// Schedules are evaluated in Sentry's time zones, which must resolve on
+	// machines without a time zone database.
+	_ "time/tzdata"
+)
+
+// Macros are the nonstandard schedules and the crontab they expand to.
+var Macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var weekdayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField     = field{name: "minute", min: 0, max: 59}
+	hourField       = field{name: "hour", min: 0, max: 23}
+	dayOfMonthField = field{name: "day of month", min: 1, max: 31}
+	monthField      = field{name: "month", min: 1, max: 12, names: monthNames}
+	// Sunday is both 0 and 7.
+	dayOfWeekField = field{name: "day of week", min: 0, max: 7, names: weekdayNames}
+)
+
+// nthWeekday is a `weekday#nth` day of week, or the last weekday of the month
+// for `Lweekday` when nth is -1.
+type nthWeekday struct {
+	weekday time.Weekday
+	nth     int
+}
+
+// Schedule is a parsed crontab schedule.
+type Schedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+
+	// lastDayOfMonth is set for `L` in the day of month field.
+	lastDayOfMonth bool
+	// nthWeekdays are the `#` and `L` entries of the day of week field.
+	nthWeekdays []nthWeekday
+}
+
+// Parse parses a five field crontab schedule or one of the Macros.
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	if expanded, ok := Macros[spec]; ok {
+		spec = expanded
+	} else if strings.HasPrefix(spec, "@") {
+		return nil, fmt.Errorf("unknown schedule macro %q", spec)
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields (minute, hour, day of month, month and day of week), got %d", len(fields))
+	}
+
+	var s Schedule
+	var err error
+	if s.minute, err = parseField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if s.dayOfMonth, err = s.parseDayOfMonth(fields[2]); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek, err = s.parseDayOfWeek(fields[4]); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (s *Schedule) parseDayOfMonth(value string) (uint64, error) {
+	var rest []string
+	for _, term := range strings.Split(value, ",") {
+		if term == "l" {
+			s.lastDayOfMonth = true
+			continue
+		}
+		rest = append(rest, term)
+	}
+	if len(rest) == 0 {
+		return 0, nil
+	}
+	return parseField(strings.Join(rest, ","), dayOfMonthField)
+}
+
+func (s *Schedule) parseDayOfWeek(value string) (uint64, error) {
+	var rest []string
+	for _, term := range strings.Split(value, ",") {
+		switch {
+		case strings.Contains(term, "#"):
+			weekday, nth, _ := strings.Cut(term, "#")
+			w, err := parseValue(weekday, dayOfWeekField)
+			if err != nil {
+				return 0, err
+			}
+			n, err := strconv.Atoi(nth)
+			if err != nil || n < 1 || n > 5 {
+				return 0, fmt.Errorf("invalid %s %q: the occurrence after # must be between 1 and 5", dayOfWeekField.name, term)
+			}
+			s.nthWeekdays = append(s.nthWeekdays, nthWeekday{weekday: time.Weekday(w % 7), nth: n})
+		case strings.HasPrefix(term, "l") && len(term) > 1:
+			w, err := parseValue(term[1:], dayOfWeekField)
+			if err != nil {
+				return 0, err
+			}
+			s.nthWeekdays = append(s.nthWeekdays, nthWeekday{weekday: time.Weekday(w % 7), nth: -1})
+		default:
+			rest = append(rest, term)
+		}
+	}
+	if len(rest) == 0 {
+		return 0, nil
+	}
+
+	set, err := parseField(strings.Join(rest, ","), dayOfWeekField)
+	if err != nil {
+		return 0, err
+	}
+	if set&(1<<7) != 0 {
+		set = set&^(1<<7) | 1
+	}
+	return set, nil
+}
+
+// parseField parses a comma separated list of values, ranges and steps into a
+// bit set of the matching values.
+func parseField(value string, f field) (uint64, error) {
+	var out uint64
+	for _, term := range strings.Split(value, ",") {
+		if term == "" {
+			return 0, fmt.Errorf("invalid %s %q: empty list item", f.name, value)
+		}
+
+		base, stepValue, hasStep := strings.Cut(term, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepValue)
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid %s %q: step must be a positive number", f.name, term)
+			}
+		}
+
+		var start, end int
+		switch {
+		case base == "*" || base == "?":
+			start, end = f.min, f.max
+		case strings.Contains(base, "-"):
+			from, to, _ := strings.Cut(base, "-")
+			var err error
+			if start, err = parseValue(from, f); err != nil {
+				return 0, err
+			}
+			if end, err = parseValue(to, f); err != nil {
+				return 0, err
+			}
+		default:
+			var err error
+			if start, err = parseValue(base, f); err != nil {
+				return 0, err
+			}
+			end = start
+			// `5/15` means every 15 starting from 5.
+			if hasStep {
+				end = f.max
+			}
+		}
+
+		// Ranges such as `fri-mon` wrap around.
+		size := f.max - f.min + 1
+		span := (end - start + size) % size
+		for offset := 0; offset <= span; offset += step {
+			out |= 1 << (f.min + (start-f.min+offset)%size)
+		}
+	}
+	return out, nil
+}
+
+func parseValue(value string, f field) (int, error) {
+	if v, ok := f.names[value]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", f.name, value)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s %q: must be between %d and %d", f.name, value, f.min, f.max)
+	}
+	return v, nil
+}
+
+// restricted reports whether a field does not match every value, which
+// decides how the day of month and day of week fields are combined.
+func restricted(set uint64, f field) bool {
+	return bits.OnesCount64(set) < f.max-f.min+1
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	daysInMonth := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+
+	dayOfMonth := s.dayOfMonth&(1<<t.Day()) != 0 || (s.lastDayOfMonth && t.Day() == daysInMonth)
+	dayOfWeek := s.dayOfWeek&(1<<t.Weekday()) != 0
+	for _, w := range s.nthWeekdays {
+		if t.Weekday() != w.weekday {
+			continue
+		}
+		if (w.nth == -1 && t.Day()+7 > daysInMonth) || (w.nth > 0 && (t.Day()-1)/7+1 == w.nth) {
+			dayOfWeek = true
+		}
+	}
+
+	// Like croniter and Vixie cron, a day matches either field when both are
+	// restricted.
+	dayOfMonthRestricted := s.lastDayOfMonth || restricted(s.dayOfMonth, dayOfMonthField)
+	// Sunday is stored once, so 0-6 covers the whole week.
+	dayOfWeekRestricted := len(s.nthWeekdays) > 0 || bits.OnesCount64(s.dayOfWeek) < 7
+	switch {
+	case dayOfMonthRestricted && dayOfWeekRestricted:
+		return dayOfMonth || dayOfWeek
+	case dayOfMonthRestricted:
+		return dayOfMonth
+	case dayOfWeekRestricted:
+		return dayOfWeek
+	default:
+		return true
+	}
+}
+
+// Next returns the first time after t that matches the schedule, in the
+// location of t. It returns the zero time if the schedule never matches, e.g.
+// `0 0 30 2 *`.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<t.Month()) == 0 {
+			t = forward(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if s.hour&(1<<t.Hour()) == 0 {
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc))
+			continue
+		}
+		if s.minute&(1<<t.Minute()) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// forward returns next, unless next falls into a daylight saving time gap and
+// is normalized to a time that is not after t, in which case it returns the
+// start of the next hour.
+func forward(t, next time.Time) time.Time {
+	if next.After(t) {
+		return next
+	}
+	return t.Add(time.Duration(60-t.Minute()) * time.Minute)
+}
+
+// NextN returns up to n consecutive times after t that match the schedule.
+func (s *Schedule) NextN(t time.Time, n int) []time.Time {
+	out := make([]time.Time, 0, n)
+	for len(out) < n {
+		t = s.Next(t)
+		if t.IsZero() {
+			break
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+// shortestIntervalRuns bounds how many runs ShortestInterval looks at, which
+// is plenty for the minute and hour patterns to repeat.
+const shortestIntervalRuns = 10000
+
+// ShortestInterval returns the shortest time between two consecutive runs
+// within a year from t, or zero if the schedule runs less than twice.
+func (s *Schedule) ShortestInterval(t time.Time) time.Duration {
+	limit := t.AddDate(1, 0, 0)
+	var shortest time.Duration
+
+	prev := s.Next(t)
+	for runs := 0; runs < shortestIntervalRuns && !prev.IsZero() && prev.Before(limit); runs++ {
+		next := s.Next(prev)
+		if next.IsZero() {
+			break
+		}
+		if interval := next.Sub(prev); shortest == 0 || interval < shortest {
+			shortest = interval
+			if shortest <= time.Minute {
+				break
+			}
+		}
+		prev = next
+	}
+	return shortest
+}
@@ -0,0 +1,141 @@
+package crontab
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse_invalid(t *testing.T) {
+	testCases := []struct {
+		spec    string
+		wantErr string
+	}{
+		{"", "expected 5 fields"},
+		{"* * * *", "expected 5 fields"},
+		{"0 * * * * *", "expected 5 fields"},
+		{"@reboot", `unknown schedule macro "@reboot"`},
+		{"60 * * * *", `invalid minute "60": must be between 0 and 59`},
+		{"* 24 * * *", `invalid hour "24": must be between 0 and 23`},
+		{"* * 0 * *", `invalid day of month "0": must be between 1 and 31`},
+		{"* * * 13 *", `invalid month "13": must be between 1 and 12`},
+		{"* * * * 8", `invalid day of week "8": must be between 0 and 7`},
+		{"*/0 * * * *", `invalid minute "*/0": step must be a positive number`},
+		{"1,,2 * * * *", `invalid minute "1,,2": empty list item`},
+		{"* * * foo *", `invalid month "foo"`},
+		{"* * * * mon#6", `invalid day of week "mon#6": the occurrence after # must be between 1 and 5`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			_, err := Parse(tc.spec)
+			if err == nil {
+				t.Fatalf("Parse(%q) returned no error", tc.spec)
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("Parse(%q) error = %q, want it to contain %q", tc.spec, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchedule_NextN(t *testing.T) {
+	// A Wednesday.
+	from := time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		spec string
+		want []string
+	}{
+		{"*/15 * * * *", []string{"2025-01-01T10:45:00Z", "2025-01-01T11:00:00Z", "2025-01-01T11:15:00Z"}},
+		{"5/20 * * * *", []string{"2025-01-01T10:45:00Z", "2025-01-01T11:05:00Z", "2025-01-01T11:25:00Z"}},
+		{"@daily", []string{"2025-01-02T00:00:00Z", "2025-01-03T00:00:00Z", "2025-01-04T00:00:00Z"}},
+		{"@HOURLY", []string{"2025-01-01T11:00:00Z", "2025-01-01T12:00:00Z", "2025-01-01T13:00:00Z"}},
+		{"@weekly", []string{"2025-01-05T00:00:00Z", "2025-01-12T00:00:00Z", "2025-01-19T00:00:00Z"}},
+		{"@yearly", []string{"2026-01-01T00:00:00Z", "2027-01-01T00:00:00Z", "2028-01-01T00:00:00Z"}},
+		{"0 9 * * mon-fri", []string{"2025-01-02T09:00:00Z", "2025-01-03T09:00:00Z", "2025-01-06T09:00:00Z"}},
+		{"0 9 * * fri-mon", []string{"2025-01-03T09:00:00Z", "2025-01-04T09:00:00Z", "2025-01-05T09:00:00Z"}},
+		{"0 0 * * 7", []string{"2025-01-05T00:00:00Z", "2025-01-12T00:00:00Z", "2025-01-19T00:00:00Z"}},
+		{"0 0 * feb,apr *", []string{"2025-02-01T00:00:00Z", "2025-02-02T00:00:00Z", "2025-02-03T00:00:00Z"}},
+		// Restricting both day fields matches either of them.
+		{"0 0 13 * fri", []string{"2025-01-03T00:00:00Z", "2025-01-10T00:00:00Z", "2025-01-13T00:00:00Z"}},
+		{"0 0 L * *", []string{"2025-01-31T00:00:00Z", "2025-02-28T00:00:00Z", "2025-03-31T00:00:00Z"}},
+		{"0 0 * * sat#1", []string{"2025-01-04T00:00:00Z", "2025-02-01T00:00:00Z", "2025-03-01T00:00:00Z"}},
+		{"0 0 * * L5", []string{"2025-01-31T00:00:00Z", "2025-02-28T00:00:00Z", "2025-03-28T00:00:00Z"}},
+		{"0 0 29 2 *", []string{"2028-02-29T00:00:00Z", "2032-02-29T00:00:00Z", "2036-02-29T00:00:00Z"}},
+		{"0 0 30 2 *", []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			s, err := Parse(tc.spec)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tc.spec, err)
+			}
+
+			got := s.NextN(from, 3)
+			if len(got) != len(tc.want) {
+				t.Fatalf("NextN() = %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i].Format(time.RFC3339) != tc.want[i] {
+					t.Errorf("NextN()[%d] = %s, want %s", i, got[i].Format(time.RFC3339), tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSchedule_NextN_timezone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	s, err := Parse("30 2 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2:30 does not exist when daylight saving time starts on 9 March 2025, so
+	// that day is skipped.
+	got := s.NextN(time.Date(2025, 3, 8, 12, 0, 0, 0, loc), 3)
+	want := []string{"2025-03-10T02:30:00-04:00", "2025-03-11T02:30:00-04:00", "2025-03-12T02:30:00-04:00"}
+	if len(got) != len(want) {
+		t.Fatalf("NextN() = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i].Format(time.RFC3339) != want[i] {
+			t.Errorf("NextN()[%d] = %s, want %s", i, got[i].Format(time.RFC3339), want[i])
+		}
+	}
+}
+
+func TestSchedule_ShortestInterval(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		spec string
+		want time.Duration
+	}{
+		{"* * * * *", time.Minute},
+		{"*/5 * * * *", 5 * time.Minute},
+		{"0 0,1 * * *", time.Hour},
+		{"@daily", 24 * time.Hour},
+		{"0 0 * * mon,tue", 24 * time.Hour},
+		{"0 0 * * mon,thu", 72 * time.Hour},
+		{"0 0 29 2 *", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			s, err := Parse(tc.spec)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tc.spec, err)
+			}
+			if got := s.ShortestInterval(from); got != tc.want {
+				t.Errorf("ShortestInterval() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
+)
+
+type baseAction struct {
+	client    *sentry.Client
+	apiClient *apiclient.ClientWithResponses
+}
+
+func (a *baseAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*providerdata.ProviderData)
+
+	a.client = providerData.Client
+	a.apiClient = providerData.ApiClient
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+)
+
+var _ action.Action = &CronMonitorCheckInAction{}
+var _ action.ActionWithConfigure = &CronMonitorCheckInAction{}
+
+func NewCronMonitorCheckInAction() action.Action {
+	return &CronMonitorCheckInAction{
+		httpClient: http.DefaultClient,
+	}
+}
+
+type CronMonitorCheckInAction struct {
+	baseAction
+
+	// httpClient sends check-ins, which are authenticated by the DSN rather
+	// than the provider's token.
+	httpClient *http.Client
+}
+
+func (a *CronMonitorCheckInAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cron_monitor_checkin"
+}
+
+func (a *CronMonitorCheckInAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends a check-in to a cron monitor, e.g. to prove that a newly deployed job is wired up to its monitor. The check-in is sent with a project DSN, like an SDK would, and the response from Sentry is reported as progress.\n\n" +
+			"An `in_progress` check-in followed by an `ok` or `error` check-in for the same monitor and environment completes the in-progress check-in.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of the monitor.",
+				Required:            true,
+			},
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the cron monitor, e.g. `sentry_cron_monitor.default.id`.",
+				Required:            true,
+			},
+			"dsn": schema.StringAttribute{
+				MarkdownDescription: "The DSN of the monitor's project, e.g. `sentry_key.default.dsn[\"public\"]`.",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the check-in. Valid values are: `in_progress`, `ok`, and `error`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("in_progress", "ok", "error"),
+				},
+			},
+			"duration": schema.Float64Attribute{
+				MarkdownDescription: "The duration of the job in seconds.",
+				Optional:            true,
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "The environment of the check-in. Sentry uses `production` if not set.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *CronMonitorCheckInAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data CronMonitorCheckInActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check-ins address the monitor by its slug, which is not exposed by
+	// `sentry_cron_monitor`.
+	httpResp, err := a.apiClient.GetProjectMonitorWithResponse(ctx, data.Organization.ValueString(), data.MonitorId.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read monitor", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("monitor"))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read monitor", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	cron, err := cronMonitorDataSource(*httpResp.JSON200)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	} else if cron == nil || cron.Slug == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("monitor_id"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Monitor %s is not a cron monitor", data.MonitorId.ValueString()),
+		)
+		return
+	}
+
+	checkInURL, err := data.CheckInURL(*cron.Slug)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("dsn"), "Invalid Attribute Value", err.Error())
+		return
+	}
+
+	checkInReq, err := http.NewRequestWithContext(ctx, http.MethodPost, checkInURL, nil)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("send check-in", err))
+		return
+	}
+
+	checkInResp, err := a.httpClient.Do(checkInReq)
+	if err != nil {
+		// The URL contains the public key of the DSN, so only report the
+		// underlying error.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		resp.Diagnostics.Append(diagutils.NewClientError("send check-in", err))
+		return
+	}
+	defer checkInResp.Body.Close()
+
+	body, err := io.ReadAll(checkInResp.Body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("send check-in", err))
+		return
+	}
+	if checkInResp.StatusCode < 200 || checkInResp.StatusCode > 299 {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("send check-in", checkInResp.StatusCode, body))
+		return
+	}
+
+	message := fmt.Sprintf("Sent %s check-in to cron monitor %s, Sentry responded with %s", data.Status.ValueString(), *cron.Slug, checkInResp.Status)
+	if s := strings.TrimSpace(string(body)); s != "" {
+		message += ": " + s
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+)
+
+func TestCronMonitorCheckInActionModel_CheckInURL(t *testing.T) {
+	testCases := []struct {
+		name        string
+		dsn         string
+		environment types.String
+		duration    types.Float64
+		want        string
+		wantErr     string
+	}{
+		{
+			name:        "sentry.io",
+			dsn:         "https://abc123@o1.ingest.us.sentry.io/456",
+			environment: types.StringNull(),
+			duration:    types.Float64Null(),
+			want:        "https://o1.ingest.us.sentry.io/api/456/cron/nightly-job/abc123/?status=ok",
+		},
+		{
+			name:        "environment and duration",
+			dsn:         "https://abc123@o1.ingest.us.sentry.io/456",
+			environment: types.StringValue("staging"),
+			duration:    types.Float64Value(12.5),
+			want:        "https://o1.ingest.us.sentry.io/api/456/cron/nightly-job/abc123/?duration=12.5&environment=staging&status=ok",
+		},
+		{
+			name:        "self-hosted under a path",
+			dsn:         "http://abc123@sentry.example.com:9000/sentry/7",
+			environment: types.StringNull(),
+			duration:    types.Float64Null(),
+			want:        "http://sentry.example.com:9000/sentry/api/7/cron/nightly-job/abc123/?status=ok",
+		},
+		{
+			name:        "missing public key",
+			dsn:         "https://o1.ingest.us.sentry.io/456",
+			environment: types.StringNull(),
+			duration:    types.Float64Null(),
+			wantErr:     "invalid DSN: expected the form {protocol}://{public_key}@{host}/{project_id}",
+		},
+		{
+			name:        "missing project ID",
+			dsn:         "https://abc123@o1.ingest.us.sentry.io/",
+			environment: types.StringNull(),
+			duration:    types.Float64Null(),
+			wantErr:     "invalid DSN: missing project ID",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := CronMonitorCheckInActionModel{
+				Dsn:         types.StringValue(tc.dsn),
+				Status:      types.StringValue("ok"),
+				Environment: tc.environment,
+				Duration:    tc.duration,
+			}
+
+			got, err := m.CheckInURL("nightly-job")
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("CheckInURL() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CheckInURL() returned error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("CheckInURL() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCronMonitorCheckInAction_Invoke(t *testing.T) {
+	var checkIns []url.Values
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/0/organizations/my-org/detectors/1/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": "1",
+			"projectId": "456",
+			"name": "Nightly job",
+			"type": "monitor_check_in_failure",
+			"enabled": true,
+			"dataSources": [
+				{
+					"type": "cron_monitor",
+					"queryObj": {
+						"name": "Nightly job",
+						"slug": "nightly-job",
+						"config": {"schedule_type": "crontab", "schedule": "0 0 * * *", "checkin_margin": 1, "failure_issue_threshold": 1, "max_runtime": 1, "recovery_threshold": 1, "timezone": "UTC"}
+					}
+				}
+			]
+		}`))
+	})
+	mux.HandleFunc("GET /api/0/organizations/my-org/detectors/2/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "2", "projectId": "456", "name": "Checkout", "type": "uptime_domain_failure", "enabled": true, "dataSources": []}`))
+	})
+	mux.HandleFunc("POST /api/456/cron/nightly-job/abc123/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("status") == "error" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"detail":"rate limited"}`))
+			return
+		}
+		checkIns = append(checkIns, r.URL.Query())
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	apiClient, err := apiclient.NewClientWithResponses(server.URL+"/api/", apiclient.WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := &CronMonitorCheckInAction{
+		baseAction: baseAction{apiClient: apiClient},
+		httpClient: server.Client(),
+	}
+
+	ctx := context.Background()
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	dsn := strings.Replace(server.URL, "://", "://abc123@", 1) + "/456"
+
+	invoke := func(monitorId, status string) (action.InvokeResponse, []string) {
+		config := tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"organization": tftypes.NewValue(tftypes.String, "my-org"),
+				"monitor_id":   tftypes.NewValue(tftypes.String, monitorId),
+				"dsn":          tftypes.NewValue(tftypes.String, dsn),
+				"status":       tftypes.NewValue(tftypes.String, status),
+				"duration":     tftypes.NewValue(tftypes.Number, 1.5),
+				"environment":  tftypes.NewValue(tftypes.String, "staging"),
+			}),
+		}
+
+		var messages []string
+		resp := action.InvokeResponse{
+			SendProgress: func(event action.InvokeProgressEvent) {
+				messages = append(messages, event.Message)
+			},
+		}
+		a.Invoke(ctx, action.InvokeRequest{Config: config}, &resp)
+		return resp, messages
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		resp, messages := invoke("1", "ok")
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Invoke() returned errors: %v", resp.Diagnostics)
+		}
+
+		if len(checkIns) != 1 {
+			t.Fatalf("got %d check-ins, want 1", len(checkIns))
+		}
+		want := url.Values{"status": {"ok"}, "environment": {"staging"}, "duration": {"1.5"}}
+		if got := checkIns[0].Encode(); got != want.Encode() {
+			t.Errorf("check-in = %s, want %s", got, want.Encode())
+		}
+
+		wantMessage := "Sent ok check-in to cron monitor nightly-job, Sentry responded with 202 Accepted"
+		if len(messages) != 1 || messages[0] != wantMessage {
+			t.Errorf("progress = %q, want [%q]", messages, wantMessage)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		resp, _ := invoke("1", "error")
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("Invoke() returned no error")
+		}
+		want := `Unable to send check-in, got status 429: {"detail":"rate limited"}`
+		if got := resp.Diagnostics.Errors()[0].Detail(); got != want {
+			t.Errorf("error = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("not a cron monitor", func(t *testing.T) {
+		resp, _ := invoke("2", "ok")
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("Invoke() returned no error")
+		}
+		want := "Monitor 2 is not a cron monitor"
+		if got := resp.Diagnostics.Errors()[0].Detail(); got != want {
+			t.Errorf("error = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("unknown monitor", func(t *testing.T) {
+		resp, _ := invoke("3", "ok")
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("Invoke() returned no error")
+		}
+		want := "No matching monitor found"
+		if got := resp.Diagnostics.Errors()[0].Detail(); got != want {
+			t.Errorf("error = %q, want %q", got, want)
+		}
+	})
+}
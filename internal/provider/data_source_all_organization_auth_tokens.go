@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+type AllOrganizationAuthTokensDataSourceTokenModel struct {
+	Id                  types.String                  `tfsdk:"id"`
+	Name                types.String                  `tfsdk:"name"`
+	Scopes              supertypes.SetValueOf[string] `tfsdk:"scopes"`
+	TokenLastCharacters types.String                  `tfsdk:"token_last_characters"`
+	DateCreated         types.String                  `tfsdk:"date_created"`
+	DateLastUsed        types.String                  `tfsdk:"date_last_used"`
+	ProjectLastUsedId   types.String                  `tfsdk:"project_last_used_id"`
+}
+
+func (m *AllOrganizationAuthTokensDataSourceTokenModel) Fill(ctx context.Context, token apiclient.OrganizationAuthToken) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(token.Id)
+	m.Name = types.StringValue(token.Name)
+	m.Scopes = supertypes.NewSetValueOfSlice(ctx, token.Scopes)
+	m.TokenLastCharacters = types.StringNull()
+	if v, err := token.TokenLastCharacters.Get(); err == nil {
+		m.TokenLastCharacters = types.StringValue(v)
+	}
+	m.DateCreated = types.StringValue(token.DateCreated.Format(time.RFC3339))
+	m.DateLastUsed = types.StringNull()
+	if v, err := token.DateLastUsed.Get(); err == nil {
+		m.DateLastUsed = types.StringValue(v.Format(time.RFC3339))
+	}
+	m.ProjectLastUsedId = types.StringNull()
+	if v, err := token.ProjectLastUsedId.Get(); err == nil {
+		m.ProjectLastUsedId = types.StringValue(v)
+	}
+	return
+}
+
+type AllOrganizationAuthTokensDataSourceModel struct {
+	Organization types.String                                    `tfsdk:"organization"`
+	Tokens       []AllOrganizationAuthTokensDataSourceTokenModel `tfsdk:"tokens"`
+}
+
+func (m *AllOrganizationAuthTokensDataSourceModel) Fill(ctx context.Context, tokens []apiclient.OrganizationAuthToken) (diags diag.Diagnostics) {
+	m.Tokens = make([]AllOrganizationAuthTokensDataSourceTokenModel, len(tokens))
+	for i, token := range tokens {
+		diags.Append(m.Tokens[i].Fill(ctx, token)...)
+	}
+	return
+}
+
+var _ datasource.DataSource = &AllOrganizationAuthTokensDataSource{}
+var _ datasource.DataSourceWithConfigure = &AllOrganizationAuthTokensDataSource{}
+
+func NewAllOrganizationAuthTokensDataSource() datasource.DataSource {
+	return &AllOrganizationAuthTokensDataSource{}
+}
+
+type AllOrganizationAuthTokensDataSource struct {
+	baseDataSource
+}
+
+func (d *AllOrganizationAuthTokensDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_all_organization_auth_tokens"
+}
+
+func (d *AllOrganizationAuthTokensDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve all organization auth tokens. Token values are never returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": DataSourceOrganizationAttribute(),
+			"tokens": schema.SetNestedAttribute{
+				MarkdownDescription: "The list of organization auth tokens.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the token.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the token.",
+							Computed:            true,
+						},
+						"scopes": schema.SetAttribute{
+							MarkdownDescription: "The scopes granted to the token.",
+							Computed:            true,
+							CustomType:          supertypes.NewSetTypeOf[string](ctx),
+						},
+						"token_last_characters": schema.StringAttribute{
+							MarkdownDescription: "The last characters of the token, as displayed in Sentry.",
+							Computed:            true,
+						},
+						"date_created": schema.StringAttribute{
+							MarkdownDescription: "The date the token was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"date_last_used": schema.StringAttribute{
+							MarkdownDescription: "The date the token was last used, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"project_last_used_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project the token was last used with.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AllOrganizationAuthTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AllOrganizationAuthTokensDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := d.apiClient.ListOrganizationAuthTokensWithResponse(ctx, data.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccAllOrganizationAuthTokensDataSource(t *testing.T) {
+	rn := "data.sentry_all_organization_auth_tokens.test"
+	name := acctest.RandomWithPrefix("tf-token")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllOrganizationAuthTokensDataSourceConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("tokens"), knownvalue.SetPartial([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"id":   knownvalue.NotNull(),
+							"name": knownvalue.StringExact(name),
+							"scopes": knownvalue.SetExact([]knownvalue.Check{
+								knownvalue.StringExact("org:ci"),
+							}),
+							"token_last_characters": knownvalue.NotNull(),
+							"date_created":          knownvalue.NotNull(),
+							"date_last_used":        knownvalue.Null(),
+							"project_last_used_id":  knownvalue.Null(),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccAllOrganizationAuthTokensDataSourceConfig(name string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_organization_auth_token" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+}
+
+data "sentry_all_organization_auth_tokens" "test" {
+	organization = sentry_organization_auth_token.test.organization
+
+	depends_on = [sentry_organization_auth_token.test]
+}
+`, name)
+}
@@ -125,6 +125,40 @@ func (d *CronMonitorDataSource) Schema(ctx context.Context, req datasource.Schem
 				Computed:            true,
 				CustomType:          supertypes.StringType{},
 			},
+			"environments": schema.ListNestedAttribute{
+				MarkdownDescription: "The environments this monitor has received check-ins from.",
+				Computed:            true,
+				CustomType:          supertypes.NewListNestedObjectTypeOf[CronMonitorDataSourceModelEnvironmentsItem](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the environment.",
+							Computed:            true,
+							CustomType:          supertypes.StringType{},
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the environment, e.g. `active`, `ok`, `error`, `missed_checkin` or `timeout`.",
+							Computed:            true,
+							CustomType:          supertypes.StringType{},
+						},
+						"is_muted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the environment is muted.",
+							Computed:            true,
+							CustomType:          supertypes.BoolType{},
+						},
+						"last_check_in": schema.StringAttribute{
+							MarkdownDescription: "The RFC 3339 date of the last check-in, or `null` if there has been none.",
+							Computed:            true,
+							CustomType:          supertypes.StringType{},
+						},
+						"next_check_in": schema.StringAttribute{
+							MarkdownDescription: "The RFC 3339 date the next check-in is expected, or `null` if unknown.",
+							Computed:            true,
+							CustomType:          supertypes.StringType{},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -158,19 +192,20 @@ func (d *CronMonitorDataSource) Read(ctx context.Context, req datasource.ReadReq
 }
 
 type CronMonitorDataSourceModel struct {
-	Organization          supertypes.StringValue                                                   `tfsdk:"organization"`
-	Id                    supertypes.StringValue                                                   `tfsdk:"id"`
-	ProjectId             supertypes.StringValue                                                   `tfsdk:"project_id"`
-	Enabled               supertypes.BoolValue                                                     `tfsdk:"enabled"`
-	Name                  supertypes.StringValue                                                   `tfsdk:"name"`
-	Description           supertypes.StringValue                                                   `tfsdk:"description"`
-	Owner                 supertypes.SingleNestedObjectValueOf[CronMonitorDataSourceModelOwner]    `tfsdk:"owner"`
-	CheckinMarginMinutes  supertypes.Int64Value                                                    `tfsdk:"checkin_margin_minutes"`
-	FailureIssueThreshold supertypes.Int64Value                                                    `tfsdk:"failure_issue_threshold"`
-	MaxRuntimeMinutes     supertypes.Int64Value                                                    `tfsdk:"max_runtime_minutes"`
-	RecoveryThreshold     supertypes.Int64Value                                                    `tfsdk:"recovery_threshold"`
-	Schedule              supertypes.SingleNestedObjectValueOf[CronMonitorDataSourceModelSchedule] `tfsdk:"schedule"`
-	Timezone              supertypes.StringValue                                                   `tfsdk:"timezone"`
+	Organization          supertypes.StringValue                                                         `tfsdk:"organization"`
+	Id                    supertypes.StringValue                                                         `tfsdk:"id"`
+	ProjectId             supertypes.StringValue                                                         `tfsdk:"project_id"`
+	Enabled               supertypes.BoolValue                                                           `tfsdk:"enabled"`
+	Name                  supertypes.StringValue                                                         `tfsdk:"name"`
+	Description           supertypes.StringValue                                                         `tfsdk:"description"`
+	Owner                 supertypes.SingleNestedObjectValueOf[CronMonitorDataSourceModelOwner]          `tfsdk:"owner"`
+	CheckinMarginMinutes  supertypes.Int64Value                                                          `tfsdk:"checkin_margin_minutes"`
+	FailureIssueThreshold supertypes.Int64Value                                                          `tfsdk:"failure_issue_threshold"`
+	MaxRuntimeMinutes     supertypes.Int64Value                                                          `tfsdk:"max_runtime_minutes"`
+	RecoveryThreshold     supertypes.Int64Value                                                          `tfsdk:"recovery_threshold"`
+	Schedule              supertypes.SingleNestedObjectValueOf[CronMonitorDataSourceModelSchedule]       `tfsdk:"schedule"`
+	Timezone              supertypes.StringValue                                                         `tfsdk:"timezone"`
+	Environments          supertypes.ListNestedObjectValueOf[CronMonitorDataSourceModelEnvironmentsItem] `tfsdk:"environments"`
 }
 
 func (m *CronMonitorDataSourceModel) Fill(ctx context.Context, data apiclient.ProjectMonitor) (diags diag.Diagnostics) {
@@ -200,3 +235,11 @@ type CronMonitorDataSourceModelSchedule struct {
 	IntervalValue supertypes.Int64Value  `tfsdk:"interval_value"`
 	IntervalUnit  supertypes.StringValue `tfsdk:"interval_unit"`
 }
+
+type CronMonitorDataSourceModelEnvironmentsItem struct {
+	Name        supertypes.StringValue `tfsdk:"name"`
+	Status      supertypes.StringValue `tfsdk:"status"`
+	IsMuted     supertypes.BoolValue   `tfsdk:"is_muted"`
+	LastCheckIn supertypes.StringValue `tfsdk:"last_check_in"`
+	NextCheckIn supertypes.StringValue `tfsdk:"next_check_in"`
+}
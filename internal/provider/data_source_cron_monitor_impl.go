@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
 
 func (m *CronMonitorDataSourceModel) fill(ctx context.Context, data apiclient.ProjectMonitor) (diags diag.Diagnostics) {
@@ -100,5 +102,26 @@ func (m *CronMonitorDataSourceModel) fill(ctx context.Context, data apiclient.Pr
 
 	diags.Append(m.Schedule.Set(ctx, schedule)...)
 
+	outEnvironments := make([]*CronMonitorDataSourceModelEnvironmentsItem, 0)
+	if dataSource.QueryObj.Environments != nil {
+		for _, inEnvironment := range *dataSource.QueryObj.Environments {
+			outEnvironment := &CronMonitorDataSourceModelEnvironmentsItem{
+				Name:        supertypes.NewStringValue(inEnvironment.Name),
+				Status:      supertypes.NewStringPointerValue(inEnvironment.Status),
+				IsMuted:     supertypes.NewBoolValue(inEnvironment.IsMuted),
+				LastCheckIn: supertypes.NewStringNull(),
+				NextCheckIn: supertypes.NewStringNull(),
+			}
+			if v, err := inEnvironment.LastCheckIn.Get(); err == nil {
+				outEnvironment.LastCheckIn = supertypes.NewStringValue(v.Format(time.RFC3339))
+			}
+			if v, err := inEnvironment.NextCheckIn.Get(); err == nil {
+				outEnvironment.NextCheckIn = supertypes.NewStringValue(v.Format(time.RFC3339))
+			}
+			outEnvironments = append(outEnvironments, outEnvironment)
+		}
+	}
+	diags.Append(m.Environments.Set(ctx, outEnvironments)...)
+
 	return
 }
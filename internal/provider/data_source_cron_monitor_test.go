@@ -23,6 +23,7 @@ func TestAccCronMonitorDataSource_basic(t *testing.T) {
 			"user_id": knownvalue.Null(),
 			"team_id": knownvalue.NotNull(),
 		})),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("environments"), knownvalue.ListExact([]knownvalue.Check{})),
 	}
 
 	resource.Test(t, resource.TestCase{
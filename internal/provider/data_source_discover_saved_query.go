@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+var _ datasource.DataSource = &DiscoverSavedQueryDataSource{}
+var _ datasource.DataSourceWithConfigure = &DiscoverSavedQueryDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DiscoverSavedQueryDataSource{}
+
+func NewDiscoverSavedQueryDataSource() datasource.DataSource {
+	return &DiscoverSavedQueryDataSource{}
+}
+
+type DiscoverSavedQueryDataSource struct {
+	baseDataSource
+}
+
+func (d *DiscoverSavedQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discover_saved_query"
+}
+
+func (d *DiscoverSavedQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve a Discover saved query by ID or name.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the saved query belongs to.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the saved query. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the saved query. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"dataset": schema.StringAttribute{
+				MarkdownDescription: "The dataset of the query.",
+				Computed:            true,
+			},
+			"fields": schema.ListAttribute{
+				MarkdownDescription: "The columns of the query, in order.",
+				Computed:            true,
+				CustomType:          supertypes.NewListTypeOf[string](ctx),
+			},
+			"y_axis": schema.ListAttribute{
+				MarkdownDescription: "The aggregates plotted on the chart.",
+				Computed:            true,
+				CustomType:          supertypes.NewListTypeOf[string](ctx),
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The search conditions of the query.",
+				Computed:            true,
+			},
+			"order_by": schema.StringAttribute{
+				MarkdownDescription: "The field the results are sorted by.",
+				Computed:            true,
+			},
+			"projects": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects queried.",
+				Computed:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+			},
+			"environments": schema.SetAttribute{
+				MarkdownDescription: "The environments queried.",
+				Computed:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+			},
+			"range": schema.StringAttribute{
+				MarkdownDescription: "The relative time range of the query.",
+				Computed:            true,
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "The start of the absolute time range of the query.",
+				Computed:            true,
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "The end of the absolute time range of the query.",
+				Computed:            true,
+			},
+			"interval": schema.StringAttribute{
+				MarkdownDescription: "The interval of the chart.",
+				Computed:            true,
+			},
+			"display": schema.StringAttribute{
+				MarkdownDescription: "The display mode of the chart.",
+				Computed:            true,
+			},
+			"top_events": schema.Int64Attribute{
+				MarkdownDescription: "The number of top events plotted.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DiscoverSavedQueryDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *DiscoverSavedQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DiscoverSavedQueryModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var foundQuery *apiclient.DiscoverSavedQuery
+
+	if data.Id.IsNull() {
+		// The API filters by a case-insensitive substring match, so look for an
+		// exact match in the results.
+		params := &apiclient.ListOrganizationDiscoverSavedQueriesParams{
+			Query: data.Name.ValueStringPointer(),
+		}
+		for {
+			httpResp, err := d.apiClient.ListOrganizationDiscoverSavedQueriesWithResponse(ctx, data.Organization.ValueString(), params)
+			if err != nil {
+				resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+				return
+			} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+				resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+				return
+			}
+
+			for _, query := range *httpResp.JSON200 {
+				if query.Name != data.Name.ValueString() {
+					continue
+				}
+				if foundQuery != nil {
+					resp.Diagnostics.AddError("Client error", "Multiple saved queries found with the same name, please specify the saved query by `id`.")
+					return
+				}
+				foundQuery = new(query)
+			}
+
+			params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+			if params.Cursor == nil {
+				break
+			}
+		}
+	} else {
+		httpResp, err := d.apiClient.GetOrganizationDiscoverSavedQueryWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+			return
+		} else if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusNotFound {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+
+		foundQuery = httpResp.JSON200
+	}
+
+	if foundQuery == nil {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("discover saved query"))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *foundQuery)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccDiscoverSavedQueryDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-discover-query")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiscoverSavedQueryDataSourceConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("data.sentry_discover_saved_query.by_id", tfjsonpath.New("name"), "sentry_discover_saved_query.test", tfjsonpath.New("name"), compare.ValuesSame()),
+					statecheck.CompareValuePairs("data.sentry_discover_saved_query.by_name", tfjsonpath.New("id"), "sentry_discover_saved_query.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("data.sentry_discover_saved_query.by_name", tfjsonpath.New("fields"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("title"),
+						knownvalue.StringExact("count()"),
+					})),
+					statecheck.ExpectKnownValue("data.sentry_discover_saved_query.by_name", tfjsonpath.New("query"), knownvalue.StringExact("level:error")),
+					statecheck.ExpectKnownValue("data.sentry_discover_saved_query.by_name", tfjsonpath.New("range"), knownvalue.StringExact("24h")),
+				},
+			},
+		},
+	})
+}
+
+func testAccDiscoverSavedQueryDataSourceConfig(name string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_discover_saved_query" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = %[1]q
+	fields       = ["title", "count()"]
+	query        = "level:error"
+	range        = "24h"
+}
+
+data "sentry_discover_saved_query" "by_id" {
+	organization = sentry_discover_saved_query.test.organization
+	id           = sentry_discover_saved_query.test.id
+}
+
+data "sentry_discover_saved_query" "by_name" {
+	organization = sentry_discover_saved_query.test.organization
+	name         = sentry_discover_saved_query.test.name
+}
+`, name)
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+)
+
+var projectEnvironmentVisibilities = []string{
+	string(apiclient.ListProjectEnvironmentsParamsVisibilityAll),
+	string(apiclient.ListProjectEnvironmentsParamsVisibilityHidden),
+	string(apiclient.ListProjectEnvironmentsParamsVisibilityVisible),
+}
+
+// projectEnvironmentTagKey is the tag Sentry records the environment of an
+// event in. Its values carry the first and last seen times of each
+// environment, which the environments endpoint does not return.
+const projectEnvironmentTagKey = "environment"
+
+type ProjectEnvironmentsDataSourceEnvironmentModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	IsHidden  types.Bool   `tfsdk:"is_hidden"`
+	FirstSeen types.String `tfsdk:"first_seen"`
+	LastSeen  types.String `tfsdk:"last_seen"`
+}
+
+func (m *ProjectEnvironmentsDataSourceEnvironmentModel) Fill(environment apiclient.ProjectEnvironment, tagValue apiclient.ProjectTagValue) error {
+	m.Id = types.StringValue(environment.Id)
+	m.Name = types.StringValue(environment.Name)
+	m.IsHidden = types.BoolValue(environment.IsHidden)
+	m.FirstSeen = types.StringNull()
+	if v, err := tagValue.FirstSeen.Get(); err == nil {
+		m.FirstSeen = types.StringValue(v.Format(time.RFC3339))
+	}
+	m.LastSeen = types.StringNull()
+	if v, err := tagValue.LastSeen.Get(); err == nil {
+		m.LastSeen = types.StringValue(v.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+type ProjectEnvironmentsDataSourceModel struct {
+	Organization types.String                                    `tfsdk:"organization"`
+	Project      types.String                                    `tfsdk:"project"`
+	Visibility   types.String                                    `tfsdk:"visibility"`
+	Environments []ProjectEnvironmentsDataSourceEnvironmentModel `tfsdk:"environments"`
+}
+
+func (m *ProjectEnvironmentsDataSourceModel) Fill(environments []apiclient.ProjectEnvironment, tagValues []apiclient.ProjectTagValue) error {
+	tagValuesByName := make(map[string]apiclient.ProjectTagValue, len(tagValues))
+	for _, tagValue := range tagValues {
+		tagValuesByName[tagValue.Value] = tagValue
+	}
+
+	m.Environments = make([]ProjectEnvironmentsDataSourceEnvironmentModel, len(environments))
+	for i, environment := range environments {
+		if err := m.Environments[i].Fill(environment, tagValuesByName[environment.Name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ datasource.DataSource = &ProjectEnvironmentsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ProjectEnvironmentsDataSource{}
+
+func NewProjectEnvironmentsDataSource() datasource.DataSource {
+	return &ProjectEnvironmentsDataSource{}
+}
+
+type ProjectEnvironmentsDataSource struct {
+	baseDataSource
+}
+
+func (d *ProjectEnvironmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_environments"
+}
+
+func (d *ProjectEnvironmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the environments of a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": DataSourceOrganizationAttribute(),
+			"project":      DataSourceProjectAttribute(),
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "Filter environments by `all`, `hidden` or `visible`. Defaults to `visible` if not specified.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectEnvironmentVisibilities...),
+				},
+			},
+			"environments": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of environments.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the environment.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the environment.",
+							Computed:            true,
+						},
+						"is_hidden": schema.BoolAttribute{
+							MarkdownDescription: "Whether the environment is hidden.",
+							Computed:            true,
+						},
+						"first_seen": schema.StringAttribute{
+							MarkdownDescription: "The date an event was first seen in the environment, in RFC 3339 format. Only events within the data retention period are considered, and `null` if there are none.",
+							Computed:            true,
+						},
+						"last_seen": schema.StringAttribute{
+							MarkdownDescription: "The date an event was last seen in the environment, in RFC 3339 format. `null` if there are no events within the data retention period.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectEnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectEnvironmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &apiclient.ListProjectEnvironmentsParams{}
+	if !data.Visibility.IsNull() {
+		params.Visibility = new(apiclient.ListProjectEnvironmentsParamsVisibility(data.Visibility.ValueString()))
+	}
+
+	httpResp, err := d.apiClient.ListProjectEnvironmentsWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		params,
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("project"))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	var tagValues []apiclient.ProjectTagValue
+	tagValuesParams := &apiclient.ListProjectTagKeyValuesParams{}
+	for {
+		tagValuesHttpResp, err := d.apiClient.ListProjectTagKeyValuesWithResponse(
+			ctx,
+			data.Organization.ValueString(),
+			data.Project.ValueString(),
+			projectEnvironmentTagKey,
+			tagValuesParams,
+		)
+		if err != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+			return
+		} else if tagValuesHttpResp.StatusCode() == http.StatusNotFound {
+			// The project has no events with an environment yet.
+			break
+		} else if tagValuesHttpResp.StatusCode() != http.StatusOK || tagValuesHttpResp.JSON200 == nil {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("read", tagValuesHttpResp.StatusCode(), tagValuesHttpResp.Body))
+			return
+		}
+
+		tagValues = append(tagValues, *tagValuesHttpResp.JSON200...)
+
+		tagValuesParams.Cursor = sentryclient.ParseNextPaginationCursor(tagValuesHttpResp.HTTPResponse)
+		if tagValuesParams.Cursor == nil {
+			break
+		}
+	}
+
+	if err := data.Fill(*httpResp.JSON200, tagValues); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
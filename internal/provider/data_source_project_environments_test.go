@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+)
+
+func TestProjectEnvironmentsDataSourceModel_Fill(t *testing.T) {
+	var environments []apiclient.ProjectEnvironment
+	if err := json.Unmarshal([]byte(`[
+		{"id": "1", "name": "production", "isHidden": false},
+		{"id": "2", "name": "staging", "isHidden": true}
+	]`), &environments); err != nil {
+		t.Fatal(err)
+	}
+
+	var tagValues []apiclient.ProjectTagValue
+	if err := json.Unmarshal([]byte(`[
+		{"key": "environment", "name": "production", "value": "production", "count": 42, "firstSeen": "2025-01-01T00:00:00.123456Z", "lastSeen": "2025-02-01T12:30:00Z"}
+	]`), &tagValues); err != nil {
+		t.Fatal(err)
+	}
+
+	var data ProjectEnvironmentsDataSourceModel
+	if err := data.Fill(environments, tagValues); err != nil {
+		t.Fatalf("Fill() returned error: %v", err)
+	}
+
+	type environment struct {
+		Name      string
+		FirstSeen *string
+		LastSeen  *string
+	}
+	var got []environment
+	for _, e := range data.Environments {
+		got = append(got, environment{
+			Name:      e.Name.ValueString(),
+			FirstSeen: e.FirstSeen.ValueStringPointer(),
+			LastSeen:  e.LastSeen.ValueStringPointer(),
+		})
+	}
+	want := []environment{
+		{Name: "production", FirstSeen: new("2025-01-01T00:00:00Z"), LastSeen: new("2025-02-01T12:30:00Z")},
+		{Name: "staging"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("environments mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAccProjectEnvironmentsDataSource(t *testing.T) {
+	environment := strings.ReplaceAll(acctest.RandomWithPrefix("tf-env"), "_", "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { testAccEnsureProjectEnvironment(t, environment) },
+				Config:    testAccProjectEnvironmentsDataSourceConfig(environment),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.sentry_project_environments.visible", tfjsonpath.New("visibility"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("data.sentry_project_environments.hidden", tfjsonpath.New("visibility"), knownvalue.StringExact("hidden")),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.sentry_project_environments.hidden", "environments.*", map[string]string{
+						"name":      environment,
+						"is_hidden": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sentry_project_environments.all", "environments.*", map[string]string{
+						"name":      environment,
+						"is_hidden": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectEnvironmentsDataSourceConfig(environment string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_project_environment" "test" {
+	organization = data.sentry_organization.test.slug
+	project      = %[1]q
+	name         = %[2]q
+	is_hidden    = true
+}
+
+data "sentry_project_environments" "visible" {
+	organization = sentry_project_environment.test.organization
+	project      = sentry_project_environment.test.project
+}
+
+data "sentry_project_environments" "hidden" {
+	organization = sentry_project_environment.test.organization
+	project      = sentry_project_environment.test.project
+	visibility   = "hidden"
+}
+
+data "sentry_project_environments" "all" {
+	organization = sentry_project_environment.test.organization
+	project      = sentry_project_environment.test.project
+	visibility   = "all"
+}
+`, acctest.TestProject.Slug, environment)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+)
+
+type ReleaseThresholdsDataSourceThresholdModel struct {
+	Id              types.String `tfsdk:"id"`
+	Environment     types.String `tfsdk:"environment"`
+	ThresholdType   types.String `tfsdk:"threshold_type"`
+	TriggerType     types.String `tfsdk:"trigger_type"`
+	Value           types.Int64  `tfsdk:"value"`
+	WindowInSeconds types.Int64  `tfsdk:"window_in_seconds"`
+}
+
+func (m *ReleaseThresholdsDataSourceThresholdModel) Fill(threshold apiclient.ReleaseThreshold) error {
+	m.Id = types.StringValue(threshold.Id)
+	m.Environment = types.StringNull()
+	if environment, err := threshold.Environment.Get(); err == nil {
+		m.Environment = types.StringValue(environment.Name)
+	}
+	m.ThresholdType = types.StringValue(threshold.ThresholdType)
+	m.TriggerType = types.StringValue(threshold.TriggerType)
+	m.Value = types.Int64Value(threshold.Value)
+	m.WindowInSeconds = types.Int64Value(threshold.WindowInSeconds)
+
+	return nil
+}
+
+type ReleaseThresholdsDataSourceModel struct {
+	Organization      types.String                                `tfsdk:"organization"`
+	Project           types.String                                `tfsdk:"project"`
+	Environment       types.String                                `tfsdk:"environment"`
+	ReleaseThresholds []ReleaseThresholdsDataSourceThresholdModel `tfsdk:"release_thresholds"`
+}
+
+func (m *ReleaseThresholdsDataSourceModel) Fill(thresholds []apiclient.ReleaseThreshold) error {
+	m.ReleaseThresholds = []ReleaseThresholdsDataSourceThresholdModel{}
+	for _, threshold := range thresholds {
+		var item ReleaseThresholdsDataSourceThresholdModel
+		if err := item.Fill(threshold); err != nil {
+			return err
+		}
+
+		if !m.Environment.IsNull() && !item.Environment.Equal(m.Environment) {
+			continue
+		}
+
+		m.ReleaseThresholds = append(m.ReleaseThresholds, item)
+	}
+
+	return nil
+}
+
+var _ datasource.DataSource = &ReleaseThresholdsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ReleaseThresholdsDataSource{}
+
+func NewReleaseThresholdsDataSource() datasource.DataSource {
+	return &ReleaseThresholdsDataSource{}
+}
+
+type ReleaseThresholdsDataSource struct {
+	baseDataSource
+}
+
+func (d *ReleaseThresholdsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_release_thresholds"
+}
+
+func (d *ReleaseThresholdsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the release thresholds of a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": DataSourceOrganizationAttribute(),
+			"project":      DataSourceProjectAttribute(),
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Only return the thresholds of this environment.",
+				Optional:            true,
+			},
+			"release_thresholds": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of release thresholds.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the release threshold.",
+							Computed:            true,
+						},
+						"environment": schema.StringAttribute{
+							MarkdownDescription: "The name of the environment the threshold applies to, or null for all environments.",
+							Computed:            true,
+						},
+						"threshold_type": schema.StringAttribute{
+							MarkdownDescription: "The metric that is measured.",
+							Computed:            true,
+						},
+						"trigger_type": schema.StringAttribute{
+							MarkdownDescription: "How `value` is compared with the metric.",
+							Computed:            true,
+						},
+						"value": schema.Int64Attribute{
+							MarkdownDescription: "The value the metric is compared with.",
+							Computed:            true,
+						},
+						"window_in_seconds": schema.Int64Attribute{
+							MarkdownDescription: "The time window after a release is deployed during which the threshold is evaluated, in seconds.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ReleaseThresholdsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReleaseThresholdsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := d.apiClient.ListProjectReleaseThresholdsWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("project"))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccReleaseThresholdsDataSource(t *testing.T) {
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReleaseThresholdsDataSourceConfig(project),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.sentry_release_thresholds.all", tfjsonpath.New("release_thresholds"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("data.sentry_release_thresholds.production", tfjsonpath.New("release_thresholds"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"id":                knownvalue.NotNull(),
+							"environment":       knownvalue.StringExact("production"),
+							"threshold_type":    knownvalue.StringExact("crash_free_session_rate"),
+							"trigger_type":      knownvalue.StringExact("under"),
+							"value":             knownvalue.Int64Exact(99),
+							"window_in_seconds": knownvalue.Int64Exact(86400),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccReleaseThresholdsDataSourceConfig(project string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_project" "test" {
+	organization = data.sentry_organization.test.slug
+	teams        = [%[1]q]
+	name         = %[2]q
+}
+
+resource "sentry_project_environment" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.slug
+	name         = "production"
+}
+
+resource "sentry_release_threshold" "new_issues" {
+	organization      = sentry_project.test.organization
+	project           = sentry_project.test.slug
+	threshold_type    = "new_issue_count"
+	trigger_type      = "over"
+	value             = 10
+	window_in_seconds = 3600
+}
+
+resource "sentry_release_threshold" "crash_free" {
+	organization      = sentry_project.test.organization
+	project           = sentry_project_environment.test.project
+	environment       = sentry_project_environment.test.name
+	threshold_type    = "crash_free_session_rate"
+	trigger_type      = "under"
+	value             = 99
+	window_in_seconds = 86400
+}
+
+data "sentry_release_thresholds" "all" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.slug
+
+	depends_on = [sentry_release_threshold.new_issues, sentry_release_threshold.crash_free]
+}
+
+data "sentry_release_thresholds" "production" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.slug
+	environment  = "production"
+
+	depends_on = [sentry_release_threshold.new_issues, sentry_release_threshold.crash_free]
+}
+`, acctest.TestTeam.Slug, project)
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/jianyuan/go-sentry/v2/sentry"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/providerdata"
+)
+
+type baseEphemeralResource struct {
+	client    *sentry.Client
+	apiClient *apiclient.ClientWithResponses
+}
+
+func (r *baseEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData := req.ProviderData.(*providerdata.ProviderData)
+
+	r.client = providerData.Client
+	r.apiClient = providerData.ApiClient
+}
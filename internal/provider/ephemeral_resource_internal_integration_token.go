@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+const internalIntegrationTokenPrivateKey = "token"
+
+type InternalIntegrationTokenEphemeralResourceModel struct {
+	Integration types.String                  `tfsdk:"integration"`
+	Id          types.String                  `tfsdk:"id"`
+	Token       types.String                  `tfsdk:"token"`
+	Scopes      supertypes.SetValueOf[string] `tfsdk:"scopes"`
+	DateCreated types.String                  `tfsdk:"date_created"`
+}
+
+// internalIntegrationTokenPrivateData is kept in the ephemeral resource's
+// private data so that Close can revoke the token.
+type internalIntegrationTokenPrivateData struct {
+	Integration string `json:"integration"`
+	TokenId     string `json:"token_id"`
+}
+
+var _ ephemeral.EphemeralResource = &InternalIntegrationTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &InternalIntegrationTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &InternalIntegrationTokenEphemeralResource{}
+
+func NewInternalIntegrationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &InternalIntegrationTokenEphemeralResource{}
+}
+
+type InternalIntegrationTokenEphemeralResource struct {
+	baseEphemeralResource
+}
+
+func (r *InternalIntegrationTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_integration_token"
+}
+
+func (r *InternalIntegrationTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a short-lived API token for an internal integration. The token is never stored in the Terraform state or plan.\n\n" +
+			"**Note:** A new token is created every time Terraform opens this ephemeral resource, i.e. during every plan and every apply, and it is revoked as soon as that run ends. Only use it for things that are needed during the run, such as configuring another provider. Do not store it anywhere that outlives the run, e.g. as a secret managed by another provider, as it stops working once the run ends. Create a token in Sentry's internal integration settings for long-lived use instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"integration": schema.StringAttribute{
+				MarkdownDescription: "The slug of the internal integration.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the token.",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The token value.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "The scopes granted to the token.",
+				Computed:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+			},
+			"date_created": schema.StringAttribute{
+				MarkdownDescription: "The date the token was created, in RFC 3339 format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *InternalIntegrationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data InternalIntegrationTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.CreateSentryAppApiTokenWithResponse(ctx, data.Integration.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	token := httpResp.JSON201
+	data.Id = types.StringValue(token.Id)
+	data.Token = types.StringValue(token.Token)
+	data.Scopes = supertypes.NewSetValueOfSlice(ctx, token.Scopes)
+	data.DateCreated = types.StringValue(token.DateCreated.Format(time.RFC3339))
+
+	privateData, err := json.Marshal(internalIntegrationTokenPrivateData{
+		Integration: data.Integration.ValueString(),
+		TokenId:     token.Id,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, internalIntegrationTokenPrivateKey, privateData)...)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *InternalIntegrationTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, internalIntegrationTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var privateData internalIntegrationTokenPrivateData
+	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
+		resp.Diagnostics.AddError("Invalid private data", err.Error())
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteSentryAppApiTokenWithResponse(ctx, privateData.Integration, privateData.TokenId)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccInternalIntegrationTokenEphemeralResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-integration")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			acctest.ProviderName: testAccProtoV6ProviderFactories[acctest.ProviderName],
+			"echo":               echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInternalIntegrationTokenEphemeralResourceConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("scopes"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("project:read"),
+					})),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("date_created"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccInternalIntegrationTokenEphemeralResourceConfig(name string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_internal_integration" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+	scopes       = ["project:read"]
+}
+
+ephemeral "sentry_internal_integration_token" "test" {
+	integration = sentry_internal_integration.test.slug
+}
+
+provider "echo" {
+	data = ephemeral.sentry_internal_integration_token.test
+}
+
+resource "echo" "test" {}
+`, name)
+}
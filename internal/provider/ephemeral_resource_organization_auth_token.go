@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+const organizationAuthTokenPrivateKey = "token"
+
+type OrganizationAuthTokenEphemeralResourceModel struct {
+	Organization types.String                  `tfsdk:"organization"`
+	Name         types.String                  `tfsdk:"name"`
+	Id           types.String                  `tfsdk:"id"`
+	Token        types.String                  `tfsdk:"token"`
+	Scopes       supertypes.SetValueOf[string] `tfsdk:"scopes"`
+	DateCreated  types.String                  `tfsdk:"date_created"`
+}
+
+// organizationAuthTokenPrivateData is kept in the ephemeral resource's
+// private data so that Close can revoke the token.
+type organizationAuthTokenPrivateData struct {
+	Organization string `json:"organization"`
+	TokenId      string `json:"token_id"`
+}
+
+var _ ephemeral.EphemeralResource = &OrganizationAuthTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &OrganizationAuthTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &OrganizationAuthTokenEphemeralResource{}
+
+func NewOrganizationAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &OrganizationAuthTokenEphemeralResource{}
+}
+
+type OrganizationAuthTokenEphemeralResource struct {
+	baseEphemeralResource
+}
+
+func (r *OrganizationAuthTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_auth_token"
+}
+
+func (r *OrganizationAuthTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a short-lived organization auth token, e.g. for uploading source maps and debug files during a Terraform run. The token is never stored in the Terraform state or plan.\n\n" +
+			"**Note:** A new token is created every time Terraform opens this ephemeral resource, i.e. during every plan and every apply, and it is revoked as soon as that run ends. Only use it for things that are needed during the run. Do not store it anywhere that outlives the run, e.g. as a CI secret managed by another provider, as it stops working once the run ends.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of the token.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the token.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the token.",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The token value.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "The scopes granted to the token.",
+				Computed:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+			},
+			"date_created": schema.StringAttribute{
+				MarkdownDescription: "The date the token was created, in RFC 3339 format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationAuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data OrganizationAuthTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.CreateOrganizationAuthTokenWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		apiclient.CreateOrganizationAuthTokenJSONRequestBody{
+			Name: data.Name.ValueString(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	token := httpResp.JSON201
+	data.Id = types.StringValue(token.Id)
+	data.Token = types.StringValue(token.Token)
+	data.Scopes = supertypes.NewSetValueOfSlice(ctx, token.Scopes)
+	data.DateCreated = types.StringValue(token.DateCreated.Format(time.RFC3339))
+
+	privateData, err := json.Marshal(organizationAuthTokenPrivateData{
+		Organization: data.Organization.ValueString(),
+		TokenId:      token.Id,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, organizationAuthTokenPrivateKey, privateData)...)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *OrganizationAuthTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, organizationAuthTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var privateData organizationAuthTokenPrivateData
+	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
+		resp.Diagnostics.AddError("Invalid private data", err.Error())
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteOrganizationAuthTokenWithResponse(ctx, privateData.Organization, privateData.TokenId)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccOrganizationAuthTokenEphemeralResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-token")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			acctest.ProviderName: testAccProtoV6ProviderFactories[acctest.ProviderName],
+			"echo":               echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAuthTokenEphemeralResourceConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.StringRegexp(regexp.MustCompile(`^sntrys_`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("scopes"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("org:ci"),
+					})),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("date_created"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccOrganizationAuthTokenEphemeralResourceConfig(name string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+ephemeral "sentry_organization_auth_token" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+}
+
+provider "echo" {
+	data = ephemeral.sentry_organization_auth_token.test
+}
+
+resource "echo" "test" {}
+`, name)
+}
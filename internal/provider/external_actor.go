@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+)
+
+// externalActorProviders are the integration providers that external teams
+// and users can be linked to.
+var externalActorProviders = []string{
+	"github",
+	"github_enterprise",
+	"gitlab",
+	"slack",
+	"msteams",
+}
+
+// validateExternalActorIntegration checks that the integration exists in the
+// organization and belongs to the given provider. Validation is skipped while
+// any of the values are unknown.
+func validateExternalActorIntegration(ctx context.Context, apiClient *apiclient.ClientWithResponses, organization types.String, integrationId types.String, provider types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiClient == nil {
+		return diags
+	}
+	for _, v := range []types.String{organization, integrationId, provider} {
+		if v.IsNull() || v.IsUnknown() {
+			return diags
+		}
+	}
+
+	httpResp, err := apiClient.GetOrganizationIntegrationWithResponse(ctx, organization.ValueString(), integrationId.ValueString())
+	if err != nil {
+		diags.Append(diagutils.NewClientError("read", err))
+		return diags
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		diags.AddAttributeError(
+			path.Root("integration_id"),
+			"Integration not found",
+			fmt.Sprintf("Integration %q does not exist in organization %q.", integrationId.ValueString(), organization.ValueString()),
+		)
+		return diags
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return diags
+	}
+
+	if key := httpResp.JSON200.Provider.Key; key != provider.ValueString() {
+		diags.AddAttributeError(
+			path.Root("provider_key"),
+			"Invalid provider",
+			fmt.Sprintf("Integration %q is a %q integration, not %q.", integrationId.ValueString(), key, provider.ValueString()),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/uptimeassertion"
+)
+
+var assertionEvaluateResponseAttributeTypes = map[string]attr.Type{
+	"status_code": types.Int64Type,
+	"headers":     types.MapType{ElemType: types.StringType},
+	"body":        types.StringType,
+}
+
+var assertionEvaluateResultAttributeTypes = map[string]attr.Type{
+	"passed":      types.BoolType,
+	"explanation": types.StringType,
+}
+
+type assertionEvaluateResponseModel struct {
+	StatusCode types.Int64  `tfsdk:"status_code"`
+	Headers    types.Map    `tfsdk:"headers"`
+	Body       types.String `tfsdk:"body"`
+}
+
+var _ function.Function = &AssertionEvaluateFunction{}
+
+func NewAssertionEvaluateFunction() function.Function {
+	return &AssertionEvaluateFunction{}
+}
+
+type AssertionEvaluateFunction struct {
+}
+
+func (f AssertionEvaluateFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "assertion_evaluate"
+}
+
+func (f AssertionEvaluateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Evaluates an uptime assertion against a sample HTTP response, so that assertions can be tested with `terraform test` before Sentry's uptime checker runs them. Returns an object with `passed`, whether the assertion passed, and `explanation`, the outcome of each operation, one per line.\n\n" +
+			"Comparisons are numeric if both values are numbers, and lexicographic otherwise. Glob patterns support `*`, `?`, `[...]` and `\\` escapes, and can only be compared with `equals`, `not_equal`, `always` and `never`. `op_jsonpath` passes if any of the matched values passes. `op_header_check` passes if any header passes both comparisons, and header keys are compared case-insensitively.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "assertion",
+				MarkdownDescription: "The assertion, as returned by the `assertion` function.",
+				CustomType:          jsontypes.NormalizedType{},
+			},
+			function.ObjectParameter{
+				Name:                "response",
+				MarkdownDescription: "The sample response, an object with `status_code` (Number), `headers` (Map of String) and `body` (String). `headers` and `body` may be null.",
+				AttributeTypes:      assertionEvaluateResponseAttributeTypes,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: assertionEvaluateResultAttributeTypes,
+		},
+	}
+}
+
+func (f AssertionEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var assertion string
+	var response assertionEvaluateResponseModel
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &assertion, &response))
+	if resp.Error != nil {
+		return
+	}
+
+	if response.StatusCode.IsNull() {
+		resp.Error = function.NewArgumentFuncError(1, "status_code must not be null")
+		return
+	}
+
+	headers := map[string]string{}
+	if !response.Headers.IsNull() {
+		if diags := response.Headers.ElementsAs(ctx, &headers, false); diags.HasError() {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("headers must be a map of strings: %s", diags.Errors()[0].Detail()))
+			return
+		}
+	}
+
+	result, err := uptimeassertion.Evaluate([]byte(assertion), uptimeassertion.Response{
+		StatusCode: response.StatusCode.ValueInt64(),
+		Headers:    headers,
+		Body:       response.Body.ValueString(),
+	})
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	out, diags := types.ObjectValue(assertionEvaluateResultAttributeTypes, map[string]attr.Value{
+		"passed":      types.BoolValue(result.Passed),
+		"explanation": types.StringValue(result.Explanation),
+	})
+	resp.Error = function.ConcatFuncErrors(function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, out))
+}
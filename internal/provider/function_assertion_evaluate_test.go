@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAssertionEvaluateFunction_known(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					locals {
+						assertion = provider::sentry::assertion(
+							provider::sentry::op_and(
+								provider::sentry::op_status_code_check("equals", 200),
+								provider::sentry::op_jsonpath(provider::sentry::op_jsonpath_operand_glob("healthy*"), "equals", "$.status"),
+								provider::sentry::op_header_check(
+									"equals", provider::sentry::op_header_operand_literal("content-type"),
+									"equals", provider::sentry::op_header_operand_literal("application/json"),
+								),
+							)
+						)
+					}
+
+					output "test" {
+						value = provider::sentry::assertion_evaluate(local.assertion, {
+							status_code = 200
+							headers     = { "Content-Type" = "application/json" }
+							body        = jsonencode({ status = "healthy" })
+						})
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"passed": knownvalue.Bool(true),
+						"explanation": knownvalue.StringExact(`and: passed
+  status_code_check: 200 equals 200: passed
+  json_path $.status: [healthy] equals glob "healthy*": passed
+  header_check: key equals "content-type", value equals "application/json": matched Content-Type: passed`),
+					})),
+				},
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::assertion_evaluate(
+							provider::sentry::assertion(provider::sentry::op_status_code_check("less_than", 300)),
+							{
+								status_code = 503
+								headers     = null
+								body        = null
+							},
+						)
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"passed":      knownvalue.Bool(false),
+						"explanation": knownvalue.StringExact("status_code_check: 503 less_than 300: failed"),
+					})),
+				},
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::assertion_evaluate(
+							provider::sentry::assertion(provider::sentry::op_jsonpath(provider::sentry::op_jsonpath_operand_glob("1*"), "less_than", "$.version")),
+							{
+								status_code = 200
+								headers     = {}
+								body        = "{}"
+							},
+						)
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "assertion" parameter: comparison "less_than" is not supported for glob pattern "1*".`),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::assertion_evaluate(
+							provider::sentry::op_status_code_check("equals", 200),
+							{
+								status_code = 200
+								headers     = {}
+								body        = ""
+							},
+						)
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "assertion" parameter: validating root: validating /$defs/Assertion: unexpected additional properties ["op" "operator" "value"].`),
+			},
+		},
+	})
+}
+
+func TestAssertionEvaluateFunction_null(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::assertion_evaluate(null, {
+							status_code = 200
+							headers     = {}
+							body        = ""
+						})
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "assertion" parameter: argument must not be null.`),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::assertion_evaluate(
+							provider::sentry::assertion(provider::sentry::op_status_code_check("equals", 200)),
+							{
+								status_code = null
+								headers     = {}
+								body        = ""
+							},
+						)
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "response" parameter: status_code must not be null.`),
+			},
+		},
+	})
+}
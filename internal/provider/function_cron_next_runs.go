@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/crontab"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+)
+
+// cronNextRunsMax bounds the number of runs a single call returns.
+const cronNextRunsMax = 1000
+
+var _ function.Function = &CronNextRunsFunction{}
+
+func NewCronNextRunsFunction() function.Function {
+	return &CronNextRunsFunction{}
+}
+
+type CronNextRunsFunction struct {
+}
+
+func (f CronNextRunsFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_next_runs"
+}
+
+func (f CronNextRunsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Returns the next `n` run times of a cron monitor schedule as RFC 3339 dates in the given timezone. The schedule is parsed the same way as `schedule.crontab` of `sentry_cron_monitor`, including `@daily`-style macros.\n\n" +
+			"The runs start after `from`. Pass `plantimestamp()` to start from the time of the plan.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "crontab",
+				MarkdownDescription: "The crontab schedule, e.g. `0 0 * * *` or `@daily`.",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "The timezone of the schedule, e.g. `UTC` or `Europe/Vienna`.",
+			},
+			function.Int64Parameter{
+				Name:                "n",
+				MarkdownDescription: fmt.Sprintf("The number of runs to return, between 1 and %d.", cronNextRunsMax),
+			},
+			function.StringParameter{
+				Name:                "from",
+				MarkdownDescription: "The RFC 3339 date to start from, e.g. `plantimestamp()`.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f CronNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var spec string
+	var timezone string
+	var n int64
+	var from string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &spec, &timezone, &n, &from))
+	if resp.Error != nil {
+		return
+	}
+
+	schedule, err := crontab.Parse(spec)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if !slices.Contains(sentrydata.Timezones, timezone) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("unsupported timezone %q", timezone))
+		return
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	if n < 1 || n > cronNextRunsMax {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("must be between 1 and %d, got: %d", cronNextRunsMax, n))
+		return
+	}
+
+	start, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("must be an RFC 3339 date, got: %s", from))
+		return
+	}
+
+	runs := schedule.NextN(start.In(loc), int(n))
+	out := make([]string, len(runs))
+	for i, run := range runs {
+		out[i] = run.Format(time.RFC3339)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, out))
+}
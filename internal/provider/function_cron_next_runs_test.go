@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestCronNextRunsFunction_known(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next_runs("0 9 * * mon-fri", "Europe/Vienna", 3, "2025-01-03T12:00:00Z")
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("2025-01-06T09:00:00+01:00"),
+						knownvalue.StringExact("2025-01-07T09:00:00+01:00"),
+						knownvalue.StringExact("2025-01-08T09:00:00+01:00"),
+					})),
+				},
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next_runs("@daily", "UTC", 2, "2025-01-01T00:00:00Z")
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("2025-01-02T00:00:00Z"),
+						knownvalue.StringExact("2025-01-03T00:00:00Z"),
+					})),
+				},
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next_runs("@hourly", "UTC", 5, plantimestamp())
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListSizeExact(5)),
+				},
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next_runs("0 25 * * *", "UTC", 1, "2025-01-01T00:00:00Z")
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "crontab" parameter: invalid hour "25": must be between 0 and 23.`),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next_runs("@daily", "Mars/Olympus_Mons", 1, "2025-01-01T00:00:00Z")
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "timezone" parameter: unsupported timezone "Mars/Olympus_Mons".`),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next_runs("@daily", "UTC", 0, "2025-01-01T00:00:00Z")
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "n" parameter: must be between 1 and 1000, got: 0.`),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next_runs("@daily", "UTC", 1, "tomorrow")
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "from" parameter: must be an RFC 3339 date, got: tomorrow.`),
+			},
+		},
+	})
+}
+
+func TestCronNextRunsFunction_null(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next_runs(null, "UTC", 1, "2025-01-01T00:00:00Z")
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "crontab" parameter: argument must not be null.`),
+			},
+			{
+				Config: `
+					output "test" {
+						value = provider::sentry::cron_next_runs("@daily", "UTC", 1, null)
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Invalid value for "from" parameter: argument must not be null.`),
+			},
+		},
+	})
+}
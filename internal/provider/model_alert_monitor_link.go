@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+)
+
+type AlertMonitorLinkResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Organization types.String `tfsdk:"organization"`
+	AlertId      types.String `tfsdk:"alert_id"`
+	MonitorId    types.String `tfsdk:"monitor_id"`
+}
+
+func (m *AlertMonitorLinkResourceModel) Fill(link apiclient.OrganizationDetectorWorkflow) error {
+	m.Id = types.StringValue(link.Id)
+	m.AlertId = types.StringValue(link.WorkflowId)
+	m.MonitorId = types.StringValue(link.DetectorId)
+
+	return nil
+}
+
+func (m AlertMonitorLinkResourceModel) ToRequestBody() apiclient.OrganizationDetectorWorkflowRequest {
+	return apiclient.OrganizationDetectorWorkflowRequest{
+		DetectorId: m.MonitorId.ValueString(),
+		WorkflowId: m.AlertId.ValueString(),
+	}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+)
+
+type AlertSnoozeResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	IssueAlertId types.String `tfsdk:"issue_alert_id"`
+	AlertId      types.String `tfsdk:"alert_id"`
+	Target       types.String `tfsdk:"target"`
+	Until        types.String `tfsdk:"until"`
+	Active       types.Bool   `tfsdk:"active"`
+}
+
+// isExpired reports whether the snooze has passed its `until` date.
+func (m *AlertSnoozeResourceModel) isExpired(now time.Time) bool {
+	return isUntilExpired(m.Until, now)
+}
+
+// isSnoozed reports whether the issue alert rule is snoozed for the target.
+// Sentry only reports snoozes for everyone or for the requesting user.
+func (m *AlertSnoozeResourceModel) isSnoozed(rule apiclient.ProjectRule) bool {
+	if rule.Snooze == nil || !*rule.Snooze {
+		return false
+	}
+	forEveryone := rule.SnoozeForEveryone != nil && *rule.SnoozeForEveryone
+	return forEveryone == (m.Target.ValueString() == "everyone")
+}
+
+func (m AlertSnoozeResourceModel) ToRequestBody() (apiclient.RuleSnoozeRequest, error) {
+	body := apiclient.RuleSnoozeRequest{
+		Target: apiclient.RuleSnoozeRequestTarget(m.Target.ValueString()),
+	}
+	if !m.Until.IsNull() {
+		until, err := time.Parse(time.RFC3339, m.Until.ValueString())
+		if err != nil {
+			return body, err
+		}
+		body.Until.Set(until)
+	}
+	return body, nil
+}
+
+// isUntilExpired reports whether an RFC 3339 `until` date is in the past. A
+// null `until` never expires.
+func isUntilExpired(until types.String, now time.Time) bool {
+	if until.IsNull() || until.IsUnknown() {
+		return false
+	}
+
+	t, err := time.Parse(time.RFC3339, until.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return !t.After(now)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CronMonitorCheckInActionModel struct {
+	Organization types.String  `tfsdk:"organization"`
+	MonitorId    types.String  `tfsdk:"monitor_id"`
+	Dsn          types.String  `tfsdk:"dsn"`
+	Status       types.String  `tfsdk:"status"`
+	Duration     types.Float64 `tfsdk:"duration"`
+	Environment  types.String  `tfsdk:"environment"`
+}
+
+// CheckInURL returns the check-in endpoint of a monitor for the project of the
+// DSN, with the check-in sent as query parameters.
+//
+// https://docs.sentry.io/product/crons/getting-started/http/
+func (m CronMonitorCheckInActionModel) CheckInURL(monitorSlug string) (string, error) {
+	dsn, err := url.Parse(m.Dsn.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("invalid DSN: %w", err)
+	}
+	if dsn.Scheme == "" || dsn.Host == "" || dsn.User == nil || dsn.User.Username() == "" {
+		return "", errors.New("invalid DSN: expected the form {protocol}://{public_key}@{host}/{project_id}")
+	}
+
+	// Self-hosted Sentry may be served under a path, e.g.
+	// `https://key@example.com/sentry/1`.
+	prefix, projectId := "", strings.Trim(dsn.Path, "/")
+	if i := strings.LastIndex(projectId, "/"); i >= 0 {
+		prefix, projectId = projectId[:i], projectId[i+1:]
+	}
+	if projectId == "" {
+		return "", errors.New("invalid DSN: missing project ID")
+	}
+
+	query := url.Values{}
+	query.Set("status", m.Status.ValueString())
+	if !m.Environment.IsNull() {
+		query.Set("environment", m.Environment.ValueString())
+	}
+	if !m.Duration.IsNull() {
+		query.Set("duration", strconv.FormatFloat(m.Duration.ValueFloat64(), 'f', -1, 64))
+	}
+
+	out := url.URL{
+		Scheme:   dsn.Scheme,
+		Host:     dsn.Host,
+		Path:     "/",
+		RawQuery: query.Encode(),
+	}
+	out = *out.JoinPath(prefix, "api", projectId, "cron", monitorSlug, dsn.User.Username())
+	out.Path += "/"
+	return out.String(), nil
+}
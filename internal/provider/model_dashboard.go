@@ -0,0 +1,768 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
+	"github.com/oapi-codegen/nullable"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+	"github.com/samber/lo"
+)
+
+type DashboardWidgetLayoutModel struct {
+	X    types.Int64 `tfsdk:"x"`
+	Y    types.Int64 `tfsdk:"y"`
+	W    types.Int64 `tfsdk:"w"`
+	H    types.Int64 `tfsdk:"h"`
+	MinH types.Int64 `tfsdk:"min_h"`
+}
+
+func (m *DashboardWidgetLayoutModel) Fill(layout apiclient.DashboardWidgetLayout) {
+	m.X = types.Int64Value(layout.X)
+	m.Y = types.Int64Value(layout.Y)
+	m.W = types.Int64Value(layout.W)
+	m.H = types.Int64Value(layout.H)
+	m.MinH = types.Int64Value(layout.MinH)
+}
+
+func (m DashboardWidgetLayoutModel) ToApi() apiclient.DashboardWidgetLayout {
+	return apiclient.DashboardWidgetLayout{
+		X:    m.X.ValueInt64(),
+		Y:    m.Y.ValueInt64(),
+		W:    m.W.ValueInt64(),
+		H:    m.H.ValueInt64(),
+		MinH: m.MinH.ValueInt64(),
+	}
+}
+
+type DashboardWidgetThresholdsModel struct {
+	Max1 types.Float64 `tfsdk:"max1"`
+	Max2 types.Float64 `tfsdk:"max2"`
+	Unit types.String  `tfsdk:"unit"`
+}
+
+func (m *DashboardWidgetThresholdsModel) Fill(thresholds apiclient.DashboardWidgetThresholds) {
+	m.Max1 = types.Float64PointerValue(thresholds.MaxValues.Max1)
+	m.Max2 = types.Float64PointerValue(thresholds.MaxValues.Max2)
+	m.Unit = types.StringNull()
+	if v, err := thresholds.Unit.Get(); err == nil {
+		m.Unit = types.StringValue(v)
+	}
+}
+
+func (m DashboardWidgetThresholdsModel) ToApi() apiclient.DashboardWidgetThresholds {
+	var thresholds apiclient.DashboardWidgetThresholds
+	thresholds.MaxValues.Max1 = m.Max1.ValueFloat64Pointer()
+	thresholds.MaxValues.Max2 = m.Max2.ValueFloat64Pointer()
+	thresholds.Unit = nullableFromPtr(m.Unit.ValueStringPointer())
+	return thresholds
+}
+
+type DashboardWidgetQueryModel struct {
+	Id           types.String                   `tfsdk:"id"`
+	Name         types.String                   `tfsdk:"name"`
+	Fields       supertypes.ListValueOf[string] `tfsdk:"fields"`
+	Aggregates   supertypes.ListValueOf[string] `tfsdk:"aggregates"`
+	Columns      supertypes.ListValueOf[string] `tfsdk:"columns"`
+	FieldAliases supertypes.ListValueOf[string] `tfsdk:"field_aliases"`
+	Conditions   types.String                   `tfsdk:"conditions"`
+	OrderBy      types.String                   `tfsdk:"order_by"`
+	IsHidden     types.Bool                     `tfsdk:"is_hidden"`
+}
+
+func (m *DashboardWidgetQueryModel) Fill(ctx context.Context, query apiclient.DashboardWidgetQuery) (diags diag.Diagnostics) {
+	m.Id = types.StringPointerValue(query.Id)
+	m.Name = types.StringValue(lo.FromPtr(query.Name))
+	m.Fields = supertypes.NewListValueOfSlice(ctx, append([]string{}, lo.FromPtr(query.Fields)...))
+	m.Aggregates = supertypes.NewListValueOfSlice(ctx, append([]string{}, lo.FromPtr(query.Aggregates)...))
+	m.Columns = supertypes.NewListValueOfSlice(ctx, append([]string{}, lo.FromPtr(query.Columns)...))
+	m.FieldAliases = supertypes.NewListValueOfSlice(ctx, append([]string{}, lo.FromPtr(query.FieldAliases)...))
+	m.Conditions = types.StringValue(lo.FromPtr(query.Conditions))
+	m.OrderBy = types.StringValue(lo.FromPtr(query.Orderby))
+	m.IsHidden = types.BoolValue(lo.FromPtr(query.IsHidden))
+	return
+}
+
+func (m DashboardWidgetQueryModel) ToApi(ctx context.Context) (query apiclient.DashboardWidgetQuery, diags diag.Diagnostics) {
+	if !m.Id.IsNull() && !m.Id.IsUnknown() {
+		query.Id = m.Id.ValueStringPointer()
+	}
+	query.Name = m.Name.ValueStringPointer()
+	query.Conditions = m.Conditions.ValueStringPointer()
+	query.Orderby = m.OrderBy.ValueStringPointer()
+	query.IsHidden = m.IsHidden.ValueBoolPointer()
+
+	for _, item := range []struct {
+		value supertypes.ListValueOf[string]
+		dest  **[]string
+	}{
+		{m.Fields, &query.Fields},
+		{m.Aggregates, &query.Aggregates},
+		{m.Columns, &query.Columns},
+		{m.FieldAliases, &query.FieldAliases},
+	} {
+		if item.value.IsNull() || item.value.IsUnknown() {
+			continue
+		}
+		values, d := item.value.Get(ctx)
+		diags.Append(d...)
+		*item.dest = &values
+	}
+
+	return
+}
+
+type DashboardWidgetModel struct {
+	Id            types.String                                                         `tfsdk:"id"`
+	Title         types.String                                                         `tfsdk:"title"`
+	Description   types.String                                                         `tfsdk:"description"`
+	DisplayType   types.String                                                         `tfsdk:"display_type"`
+	WidgetType    types.String                                                         `tfsdk:"widget_type"`
+	Interval      types.String                                                         `tfsdk:"interval"`
+	Limit         types.Int64                                                          `tfsdk:"limit"`
+	DatasetSource types.String                                                         `tfsdk:"dataset_source"`
+	Thresholds    supertypes.SingleNestedObjectValueOf[DashboardWidgetThresholdsModel] `tfsdk:"thresholds"`
+	Layout        supertypes.SingleNestedObjectValueOf[DashboardWidgetLayoutModel]     `tfsdk:"layout"`
+	Queries       supertypes.ListNestedObjectValueOf[DashboardWidgetQueryModel]        `tfsdk:"queries"`
+}
+
+func (m *DashboardWidgetModel) Fill(ctx context.Context, widget apiclient.DashboardWidget) (diags diag.Diagnostics) {
+	m.Id = types.StringPointerValue(widget.Id)
+	m.Title = types.StringValue(widget.Title)
+	m.DisplayType = types.StringValue(widget.DisplayType)
+	m.WidgetType = types.StringPointerValue(widget.WidgetType)
+	m.Interval = types.StringPointerValue(widget.Interval)
+	m.DatasetSource = types.StringPointerValue(widget.DatasetSource)
+
+	m.Description = types.StringNull()
+	if v, err := widget.Description.Get(); err == nil && v != "" {
+		m.Description = types.StringValue(v)
+	}
+
+	m.Limit = types.Int64Null()
+	if v, err := widget.Limit.Get(); err == nil {
+		m.Limit = types.Int64Value(v)
+	}
+
+	m.Thresholds = supertypes.NewSingleNestedObjectValueOfNull[DashboardWidgetThresholdsModel](ctx)
+	if v, err := widget.Thresholds.Get(); err == nil {
+		var thresholds DashboardWidgetThresholdsModel
+		thresholds.Fill(v)
+		diags.Append(m.Thresholds.Set(ctx, &thresholds)...)
+	}
+
+	m.Layout = supertypes.NewSingleNestedObjectValueOfNull[DashboardWidgetLayoutModel](ctx)
+	if v, err := widget.Layout.Get(); err == nil {
+		var layout DashboardWidgetLayoutModel
+		layout.Fill(v)
+		diags.Append(m.Layout.Set(ctx, &layout)...)
+	}
+
+	queries := make([]DashboardWidgetQueryModel, len(widget.Queries))
+	for i, query := range widget.Queries {
+		diags.Append(queries[i].Fill(ctx, query)...)
+	}
+	m.Queries = supertypes.NewListNestedObjectValueOfValueSlice(ctx, queries)
+
+	return
+}
+
+func (m DashboardWidgetModel) ToApi(ctx context.Context) (widget apiclient.DashboardWidget, diags diag.Diagnostics) {
+	widget = apiclient.DashboardWidget{
+		Title:       m.Title.ValueString(),
+		DisplayType: m.DisplayType.ValueString(),
+		Description: nullableFromPtr(m.Description.ValueStringPointer()),
+		Limit:       nullableFromPtr(m.Limit.ValueInt64Pointer()),
+		Thresholds:  nullable.NewNullNullable[apiclient.DashboardWidgetThresholds](),
+		Layout:      nullable.NewNullNullable[apiclient.DashboardWidgetLayout](),
+		Queries:     []apiclient.DashboardWidgetQuery{},
+	}
+
+	if !m.Id.IsNull() && !m.Id.IsUnknown() {
+		widget.Id = m.Id.ValueStringPointer()
+	}
+	if !m.WidgetType.IsNull() && !m.WidgetType.IsUnknown() {
+		widget.WidgetType = m.WidgetType.ValueStringPointer()
+	}
+	if !m.Interval.IsNull() && !m.Interval.IsUnknown() {
+		widget.Interval = m.Interval.ValueStringPointer()
+	}
+	if !m.DatasetSource.IsNull() && !m.DatasetSource.IsUnknown() {
+		widget.DatasetSource = m.DatasetSource.ValueStringPointer()
+	}
+
+	if !m.Thresholds.IsNull() && !m.Thresholds.IsUnknown() {
+		thresholds, d := m.Thresholds.Get(ctx)
+		diags.Append(d...)
+		if thresholds != nil {
+			widget.Thresholds = nullable.NewNullableWithValue(thresholds.ToApi())
+		}
+	}
+
+	if !m.Layout.IsNull() && !m.Layout.IsUnknown() {
+		layout, d := m.Layout.Get(ctx)
+		diags.Append(d...)
+		if layout != nil {
+			widget.Layout = nullable.NewNullableWithValue(layout.ToApi())
+		}
+	}
+
+	queries, d := m.Queries.Get(ctx)
+	diags.Append(d...)
+	for _, query := range queries {
+		apiQuery, d := query.ToApi(ctx)
+		diags.Append(d...)
+		widget.Queries = append(widget.Queries, apiQuery)
+	}
+
+	return
+}
+
+// dashboardWidgetModelKey identifies a widget across plans and API responses,
+// where its ID is not known yet.
+func dashboardWidgetModelKey(m DashboardWidgetModel) string {
+	return m.Title.ValueString() + "\x00" + m.DisplayType.ValueString()
+}
+
+type DashboardGlobalFilterModel struct {
+	Dataset types.String `tfsdk:"dataset"`
+	Key     types.String `tfsdk:"key"`
+	Value   types.String `tfsdk:"value"`
+}
+
+type DashboardFiltersModel struct {
+	Releases      supertypes.SetValueOf[string]                                  `tfsdk:"releases"`
+	GlobalFilters supertypes.ListNestedObjectValueOf[DashboardGlobalFilterModel] `tfsdk:"global_filters"`
+}
+
+func (m *DashboardFiltersModel) Fill(ctx context.Context, filters apiclient.DashboardFilters) (diags diag.Diagnostics) {
+	if releases := lo.FromPtr(filters.Release); len(releases) > 0 {
+		m.Releases = supertypes.NewSetValueOfSlice(ctx, releases)
+	} else {
+		m.Releases = supertypes.NewSetValueOfNull[string](ctx)
+	}
+
+	if globalFilters := lo.FromPtr(filters.GlobalFilter); len(globalFilters) > 0 {
+		m.GlobalFilters = supertypes.NewListNestedObjectValueOfValueSlice(ctx, lo.Map(globalFilters, func(f apiclient.DashboardGlobalFilter, _ int) DashboardGlobalFilterModel {
+			return DashboardGlobalFilterModel{
+				Dataset: types.StringValue(f.Dataset),
+				Key:     types.StringValue(f.Tag.Key),
+				Value:   types.StringValue(f.Value),
+			}
+		}))
+	} else {
+		m.GlobalFilters = supertypes.NewListNestedObjectValueOfNull[DashboardGlobalFilterModel](ctx)
+	}
+
+	return
+}
+
+func (m DashboardFiltersModel) ToApi(ctx context.Context) (filters apiclient.DashboardFilters, diags diag.Diagnostics) {
+	releases := []string{}
+	if !m.Releases.IsNull() && !m.Releases.IsUnknown() {
+		var d diag.Diagnostics
+		releases, d = m.Releases.Get(ctx)
+		diags.Append(d...)
+	}
+	filters.Release = &releases
+
+	globalFilters := []apiclient.DashboardGlobalFilter{}
+	if !m.GlobalFilters.IsNull() && !m.GlobalFilters.IsUnknown() {
+		values, d := m.GlobalFilters.Get(ctx)
+		diags.Append(d...)
+		for _, value := range values {
+			var globalFilter apiclient.DashboardGlobalFilter
+			globalFilter.Dataset = value.Dataset.ValueString()
+			globalFilter.Tag.Key = value.Key.ValueString()
+			globalFilter.Tag.Name = value.Key.ValueStringPointer()
+			globalFilter.Tag.Kind = new("tag")
+			globalFilter.Value = value.Value.ValueString()
+			globalFilters = append(globalFilters, globalFilter)
+		}
+	}
+	filters.GlobalFilter = &globalFilters
+
+	return
+}
+
+type DashboardPermissionsModel struct {
+	IsEditableByEveryone types.Bool                    `tfsdk:"is_editable_by_everyone"`
+	TeamsWithEditAccess  supertypes.SetValueOf[string] `tfsdk:"teams_with_edit_access"`
+}
+
+func (m *DashboardPermissionsModel) Fill(ctx context.Context, permissions apiclient.DashboardPermissions) (diags diag.Diagnostics) {
+	m.IsEditableByEveryone = types.BoolValue(permissions.IsEditableByEveryone)
+	if teams := lo.FromPtr(permissions.TeamsWithEditAccess); len(teams) > 0 {
+		m.TeamsWithEditAccess = supertypes.NewSetValueOfSlice(ctx, lo.Map(teams, func(id int64, _ int) string {
+			return strconv.FormatInt(id, 10)
+		}))
+	} else {
+		m.TeamsWithEditAccess = supertypes.NewSetValueOfNull[string](ctx)
+	}
+	return
+}
+
+func (m DashboardPermissionsModel) ToApi(ctx context.Context) (permissions apiclient.DashboardPermissions, diags diag.Diagnostics) {
+	permissions.IsEditableByEveryone = m.IsEditableByEveryone.ValueBool()
+
+	teams := []int64{}
+	if !m.TeamsWithEditAccess.IsNull() && !m.TeamsWithEditAccess.IsUnknown() {
+		values, d := m.TeamsWithEditAccess.Get(ctx)
+		diags.Append(d...)
+		for _, value := range values {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				diags.AddAttributeError(path.Root("permissions").AtName("teams_with_edit_access"), "Invalid team ID", err.Error())
+				continue
+			}
+			teams = append(teams, id)
+		}
+	}
+	permissions.TeamsWithEditAccess = &teams
+
+	return
+}
+
+type DashboardResourceModel struct {
+	Id             types.String                                                    `tfsdk:"id"`
+	Organization   types.String                                                    `tfsdk:"organization"`
+	Title          types.String                                                    `tfsdk:"title"`
+	Projects       supertypes.SetValueOf[string]                                   `tfsdk:"projects"`
+	Environments   supertypes.SetValueOf[string]                                   `tfsdk:"environments"`
+	Period         types.String                                                    `tfsdk:"period"`
+	Start          types.String                                                    `tfsdk:"start"`
+	End            types.String                                                    `tfsdk:"end"`
+	Utc            types.Bool                                                      `tfsdk:"utc"`
+	Filters        supertypes.SingleNestedObjectValueOf[DashboardFiltersModel]     `tfsdk:"filters"`
+	Permissions    supertypes.SingleNestedObjectValueOf[DashboardPermissionsModel] `tfsdk:"permissions"`
+	Widgets        supertypes.ListNestedObjectValueOf[DashboardWidgetModel]        `tfsdk:"widgets"`
+	DefinitionJson sentrytypes.LossyJson                                           `tfsdk:"definition_json"`
+	InternalId     types.String                                                    `tfsdk:"internal_id"`
+}
+
+func (m *DashboardResourceModel) Fill(ctx context.Context, dashboard apiclient.Dashboard) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(dashboard.Id)
+	m.InternalId = types.StringValue(dashboard.Id)
+	m.Title = types.StringValue(dashboard.Title)
+
+	m.Utc = types.BoolValue(false)
+	if v, err := dashboard.Utc.Get(); err == nil {
+		m.Utc = types.BoolValue(v)
+	}
+
+	// Dashboards without explicit permissions are editable by everyone.
+	permissions := DashboardPermissionsModel{
+		IsEditableByEveryone: types.BoolValue(true),
+		TeamsWithEditAccess:  supertypes.NewSetValueOfNull[string](ctx),
+	}
+	if v, err := dashboard.Permissions.Get(); err == nil {
+		diags.Append(permissions.Fill(ctx, v)...)
+	}
+	m.Permissions = supertypes.NewSingleNestedObjectValueOf(ctx, &permissions)
+
+	// The rest of the dashboard is managed by `definition_json`, which is
+	// filled from the raw response by FillDefinitionJson.
+	if !m.DefinitionJson.IsNull() {
+		m.Projects = supertypes.NewSetValueOfNull[string](ctx)
+		m.Environments = supertypes.NewSetValueOfNull[string](ctx)
+		m.Period = types.StringNull()
+		m.Start = types.StringNull()
+		m.End = types.StringNull()
+		m.Filters = supertypes.NewSingleNestedObjectValueOfNull[DashboardFiltersModel](ctx)
+		m.Widgets = supertypes.NewListNestedObjectValueOfNull[DashboardWidgetModel](ctx)
+		return
+	}
+
+	if len(dashboard.Projects) > 0 {
+		m.Projects = supertypes.NewSetValueOfSlice(ctx, lo.Map(dashboard.Projects, func(id int64, _ int) string {
+			return strconv.FormatInt(id, 10)
+		}))
+	} else {
+		m.Projects = supertypes.NewSetValueOfNull[string](ctx)
+	}
+
+	if environments := lo.FromPtr(dashboard.Environment); len(environments) > 0 {
+		m.Environments = supertypes.NewSetValueOfSlice(ctx, environments)
+	} else {
+		m.Environments = supertypes.NewSetValueOfNull[string](ctx)
+	}
+
+	m.Period = types.StringNull()
+	if v, err := dashboard.Period.Get(); err == nil && v != "" {
+		m.Period = types.StringValue(v)
+	}
+
+	priorStart := m.Start
+	m.Start = types.StringNull()
+	if v, err := dashboard.Start.Get(); err == nil && v != "" {
+		m.Start = sameInstantStringValue(priorStart, v)
+	}
+
+	priorEnd := m.End
+	m.End = types.StringNull()
+	if v, err := dashboard.End.Get(); err == nil && v != "" {
+		m.End = sameInstantStringValue(priorEnd, v)
+	}
+
+	var filters DashboardFiltersModel
+	diags.Append(filters.Fill(ctx, dashboard.Filters)...)
+	if filters.Releases.IsNull() && filters.GlobalFilters.IsNull() {
+		m.Filters = supertypes.NewSingleNestedObjectValueOfNull[DashboardFiltersModel](ctx)
+	} else {
+		m.Filters = supertypes.NewSingleNestedObjectValueOf(ctx, &filters)
+	}
+
+	var priorWidgets []DashboardWidgetModel
+	if !m.Widgets.IsNull() && !m.Widgets.IsUnknown() {
+		diags.Append(m.Widgets.ElementsAs(ctx, &priorWidgets, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if len(dashboard.Widgets) > 0 {
+		widgets := make([]DashboardWidgetModel, len(dashboard.Widgets))
+		for i, widget := range dashboard.Widgets {
+			diags.Append(widgets[i].Fill(ctx, widget)...)
+		}
+		if diags.HasError() {
+			return
+		}
+
+		widgets = reorderToMatchPrior(priorWidgets, widgets, dashboardWidgetModelKey)
+
+		m.Widgets = supertypes.NewListNestedObjectValueOfValueSlice(ctx, widgets)
+	} else {
+		m.Widgets = supertypes.NewListNestedObjectValueOfNull[DashboardWidgetModel](ctx)
+	}
+
+	return
+}
+
+// ToRequestBody builds the request body for the dashboard. The IDs of the prior
+// widgets and their queries are carried over to the matching planned widgets,
+// so that Sentry updates them in place instead of replacing them.
+func (m DashboardResourceModel) ToRequestBody(ctx context.Context, priorWidgets []DashboardWidgetModel) (body apiclient.DashboardRequest, diags diag.Diagnostics) {
+	body = apiclient.DashboardRequest{
+		Title:    m.Title.ValueString(),
+		Projects: []int64{},
+		Widgets:  []apiclient.DashboardWidget{},
+		Period:   m.Period.ValueStringPointer(),
+		Start:    m.Start.ValueStringPointer(),
+		End:      m.End.ValueStringPointer(),
+	}
+
+	if !m.Utc.IsUnknown() {
+		body.Utc = m.Utc.ValueBoolPointer()
+	}
+
+	if !m.Projects.IsNull() && !m.Projects.IsUnknown() {
+		projects, d := m.Projects.Get(ctx)
+		diags.Append(d...)
+		for _, project := range projects {
+			id, err := strconv.ParseInt(project, 10, 64)
+			if err != nil {
+				diags.AddAttributeError(path.Root("projects"), "Invalid project ID", err.Error())
+				continue
+			}
+			body.Projects = append(body.Projects, id)
+		}
+	}
+
+	environments := []string{}
+	if !m.Environments.IsNull() && !m.Environments.IsUnknown() {
+		var d diag.Diagnostics
+		environments, d = m.Environments.Get(ctx)
+		diags.Append(d...)
+	}
+	body.Environment = &environments
+
+	filters := DashboardFiltersModel{
+		Releases:      supertypes.NewSetValueOfNull[string](ctx),
+		GlobalFilters: supertypes.NewListNestedObjectValueOfNull[DashboardGlobalFilterModel](ctx),
+	}
+	if !m.Filters.IsNull() && !m.Filters.IsUnknown() {
+		v, d := m.Filters.Get(ctx)
+		diags.Append(d...)
+		if v != nil {
+			filters = *v
+		}
+	}
+	apiFilters, d := filters.ToApi(ctx)
+	diags.Append(d...)
+	body.Filters = &apiFilters
+
+	if !m.Permissions.IsNull() && !m.Permissions.IsUnknown() {
+		permissions, d := m.Permissions.Get(ctx)
+		diags.Append(d...)
+		if permissions != nil {
+			apiPermissions, d := permissions.ToApi(ctx)
+			diags.Append(d...)
+			body.Permissions = &apiPermissions
+		}
+	}
+
+	var widgets []DashboardWidgetModel
+	if !m.Widgets.IsNull() && !m.Widgets.IsUnknown() {
+		diags.Append(m.Widgets.ElementsAs(ctx, &widgets, false)...)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	widgets = withPriorDashboardWidgetIds(ctx, priorWidgets, widgets)
+	for _, widget := range widgets {
+		apiWidget, d := widget.ToApi(ctx)
+		diags.Append(d...)
+		body.Widgets = append(body.Widgets, apiWidget)
+	}
+
+	return
+}
+
+// withPriorDashboardWidgetIds copies the IDs of the prior widgets onto the
+// planned widgets with the same title and display type. Query IDs are matched
+// by position within the widget.
+func withPriorDashboardWidgetIds(ctx context.Context, prior, planned []DashboardWidgetModel) []DashboardWidgetModel {
+	pool := make(map[string][]*DashboardWidgetModel)
+	for i := range prior {
+		k := dashboardWidgetModelKey(prior[i])
+		pool[k] = append(pool[k], &prior[i])
+	}
+
+	result := make([]DashboardWidgetModel, len(planned))
+	for i, widget := range planned {
+		result[i] = widget
+
+		k := dashboardWidgetModelKey(widget)
+		if len(pool[k]) == 0 {
+			continue
+		}
+		match := pool[k][0]
+		pool[k] = pool[k][1:]
+
+		if !widget.Id.IsUnknown() && !widget.Id.IsNull() {
+			continue
+		}
+		result[i].Id = match.Id
+
+		priorQueries, diags := match.Queries.Get(ctx)
+		if diags.HasError() {
+			continue
+		}
+		queries, diags := widget.Queries.Get(ctx)
+		if diags.HasError() {
+			continue
+		}
+		for j := range queries {
+			if j < len(priorQueries) && (queries[j].Id.IsUnknown() || queries[j].Id.IsNull()) {
+				queries[j].Id = priorQueries[j].Id
+			}
+		}
+		result[i].Queries = supertypes.NewListNestedObjectValueOfSlice(ctx, queries)
+	}
+
+	return result
+}
+
+// sameInstantStringValue returns prior when it denotes the same instant as the
+// timestamp returned by the API, which may be formatted differently.
+func sameInstantStringValue(prior types.String, value string) types.String {
+	if prior.IsNull() || prior.IsUnknown() || !sameInstant(prior.ValueString(), value) {
+		return types.StringValue(value)
+	}
+	return prior
+}
+
+func sameInstant(a, b string) bool {
+	aTime, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return false
+	}
+	bTime, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return false
+	}
+	return aTime.Equal(bTime)
+}
+
+// dashboardDefinitionIgnoreKeys are the keys of a dashboard exported from
+// Sentry that are set by the server rather than being part of the definition.
+var dashboardDefinitionIgnoreKeys = []string{
+	"id",
+	"dashboardId",
+	"widgetId",
+	"dateCreated",
+	"createdBy",
+	"lastVisited",
+	"isFavorited",
+}
+
+func decodeDashboardDefinition(s string) (map[string]any, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	definition, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("the dashboard definition must be a JSON object")
+	}
+	return definition, nil
+}
+
+// FillDefinitionJson sets `definition_json` from the raw API response.
+func (m *DashboardResourceModel) FillDefinitionJson(body []byte) (diags diag.Diagnostics) {
+	current, err := decodeDashboardDefinition(string(body))
+	if err != nil {
+		diags.AddError("Client error", fmt.Sprintf("Unable to parse dashboard response: %s", err))
+		return
+	}
+
+	if !m.DefinitionJson.IsUnknown() {
+		if prior, err := decodeDashboardDefinition(m.DefinitionJson.ValueString()); err == nil {
+			mergeDashboardDefinition(prior, current)
+		}
+	}
+
+	b, err := json.Marshal(current)
+	if err != nil {
+		diags.AddError("Client error", fmt.Sprintf("Unable to encode dashboard definition: %s", err))
+		return
+	}
+	m.DefinitionJson = sentrytypes.NewLossyJsonValue(string(b))
+
+	return
+}
+
+// mergeDashboardDefinition normalizes the current definition against the prior
+// one, so that values filled in by Sentry do not show up as changes: values the
+// prior definition leaves null, such as a widget layout, stay null, and
+// timestamps denoting the same instant keep their prior format.
+func mergeDashboardDefinition(prior, current any) any {
+	switch prior := prior.(type) {
+	case map[string]any:
+		current, ok := current.(map[string]any)
+		if !ok {
+			return current
+		}
+		for k, priorValue := range prior {
+			currentValue, ok := current[k]
+			if !ok {
+				continue
+			}
+			if priorValue == nil {
+				current[k] = nil
+			} else {
+				current[k] = mergeDashboardDefinition(priorValue, currentValue)
+			}
+		}
+		return current
+	case []any:
+		current, ok := current.([]any)
+		if !ok || len(current) != len(prior) {
+			return current
+		}
+		for i := range current {
+			current[i] = mergeDashboardDefinition(prior[i], current[i])
+		}
+		return current
+	case string:
+		if current, ok := current.(string); ok && sameInstant(prior, current) {
+			return prior
+		}
+	}
+	return current
+}
+
+// DefinitionRequestBody builds the request body from `definition_json`. The
+// title, time zone and permissions of the resource take precedence over the
+// definition. Widgets are matched to the current widgets of the dashboard by
+// title and display type to keep their IDs, and queries by position.
+func (m DashboardResourceModel) DefinitionRequestBody(ctx context.Context, currentWidgets []apiclient.DashboardWidget) (body []byte, diags diag.Diagnostics) {
+	definition, err := decodeDashboardDefinition(m.DefinitionJson.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("definition_json"), "Invalid dashboard definition", err.Error())
+		return
+	}
+
+	for _, k := range dashboardDefinitionIgnoreKeys {
+		delete(definition, k)
+	}
+
+	definition["title"] = m.Title.ValueString()
+	if !m.Utc.IsNull() && !m.Utc.IsUnknown() {
+		definition["utc"] = m.Utc.ValueBool()
+	}
+	if !m.Permissions.IsNull() && !m.Permissions.IsUnknown() {
+		permissions, d := m.Permissions.Get(ctx)
+		diags.Append(d...)
+		if permissions != nil {
+			apiPermissions, d := permissions.ToApi(ctx)
+			diags.Append(d...)
+			definition["permissions"] = apiPermissions
+		}
+	}
+
+	pool := make(map[string][]apiclient.DashboardWidget)
+	for _, widget := range currentWidgets {
+		k := widget.Title + "\x00" + widget.DisplayType
+		pool[k] = append(pool[k], widget)
+	}
+
+	widgets, _ := definition["widgets"].([]any)
+	for _, widget := range widgets {
+		widget, ok := widget.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, k := range dashboardDefinitionIgnoreKeys {
+			delete(widget, k)
+		}
+
+		title, _ := widget["title"].(string)
+		displayType, _ := widget["displayType"].(string)
+		k := title + "\x00" + displayType
+
+		var match *apiclient.DashboardWidget
+		if len(pool[k]) > 0 {
+			match = &pool[k][0]
+			pool[k] = pool[k][1:]
+			widget["id"] = match.Id
+		}
+
+		queries, _ := widget["queries"].([]any)
+		for i, query := range queries {
+			query, ok := query.(map[string]any)
+			if !ok {
+				continue
+			}
+			for _, k := range dashboardDefinitionIgnoreKeys {
+				delete(query, k)
+			}
+			if match != nil && i < len(match.Queries) {
+				query["id"] = match.Queries[i].Id
+			}
+		}
+	}
+
+	body, err = json.Marshal(definition)
+	if err != nil {
+		diags.AddAttributeError(path.Root("definition_json"), "Invalid dashboard definition", err.Error())
+	}
+
+	return
+}
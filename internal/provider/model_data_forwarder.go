@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+	"github.com/samber/lo"
+)
+
+// dataForwarderProvider describes a data forwarding provider, both in the data
+// forwarding API and as a legacy plugin.
+type dataForwarderProvider struct {
+	Name     string
+	PluginId string
+
+	// PluginKeys maps data forwarding API config keys to plugin config keys
+	// where they differ.
+	PluginKeys map[string]string
+}
+
+var (
+	dataForwarderProviderSqs = dataForwarderProvider{
+		Name:     "sqs",
+		PluginId: "amazon-sqs",
+	}
+	dataForwarderProviderSegment = dataForwarderProvider{
+		Name:     "segment",
+		PluginId: "segment",
+	}
+	dataForwarderProviderSplunk = dataForwarderProvider{
+		Name:     "splunk",
+		PluginId: "splunk",
+		PluginKeys: map[string]string{
+			"instance_url": "instance",
+		},
+	}
+)
+
+func (p dataForwarderProvider) PluginConfig(config map[string]string) map[string]string {
+	pluginConfig := make(map[string]string, len(config))
+	for k, v := range config {
+		if pluginKey, ok := p.PluginKeys[k]; ok {
+			k = pluginKey
+		}
+		pluginConfig[k] = v
+	}
+	return pluginConfig
+}
+
+func (p dataForwarderProvider) ConfigFromPlugin(plugin apiclient.ProjectPlugin) map[string]string {
+	pluginKeys := lo.Invert(p.PluginKeys)
+
+	config := make(map[string]string, len(plugin.Config))
+	for _, entry := range plugin.Config {
+		v, err := entry.Value.Get()
+		if err != nil {
+			continue
+		}
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		k := entry.Name
+		if key, ok := pluginKeys[k]; ok {
+			k = key
+		}
+		config[k] = s
+	}
+	return config
+}
+
+type DataForwarderSqsModel struct {
+	QueueUrl       types.String `tfsdk:"queue_url"`
+	Region         types.String `tfsdk:"region"`
+	AccessKey      types.String `tfsdk:"access_key"`
+	SecretKey      types.String `tfsdk:"secret_key"`
+	SecretKeyWo    types.String `tfsdk:"secret_key_wo"`
+	MessageGroupId types.String `tfsdk:"message_group_id"`
+	S3Bucket       types.String `tfsdk:"s3_bucket"`
+}
+
+type DataForwarderSegmentModel struct {
+	WriteKey   types.String `tfsdk:"write_key"`
+	WriteKeyWo types.String `tfsdk:"write_key_wo"`
+}
+
+type DataForwarderSplunkModel struct {
+	InstanceUrl types.String `tfsdk:"instance_url"`
+	Index       types.String `tfsdk:"index"`
+	Source      types.String `tfsdk:"source"`
+	Token       types.String `tfsdk:"token"`
+	TokenWo     types.String `tfsdk:"token_wo"`
+}
+
+type DataForwarderResourceModel struct {
+	Id                types.String                                                    `tfsdk:"id"`
+	Organization      types.String                                                    `tfsdk:"organization"`
+	Projects          supertypes.SetValueOf[string]                                   `tfsdk:"projects"`
+	Enabled           types.Bool                                                      `tfsdk:"enabled"`
+	EnrollNewProjects types.Bool                                                      `tfsdk:"enroll_new_projects"`
+	SecretsWoVersion  types.Int64                                                     `tfsdk:"secrets_wo_version"`
+	Sqs               supertypes.SingleNestedObjectValueOf[DataForwarderSqsModel]     `tfsdk:"sqs"`
+	Segment           supertypes.SingleNestedObjectValueOf[DataForwarderSegmentModel] `tfsdk:"segment"`
+	Splunk            supertypes.SingleNestedObjectValueOf[DataForwarderSplunkModel]  `tfsdk:"splunk"`
+}
+
+// UsesPlugin reports whether the forwarder is backed by legacy plugins, whose
+// ID is the plugin ID rather than a numeric data forwarder ID.
+func (m DataForwarderResourceModel) UsesPlugin() bool {
+	_, err := strconv.ParseInt(m.Id.ValueString(), 10, 64)
+	return err != nil
+}
+
+func (m DataForwarderResourceModel) Provider() dataForwarderProvider {
+	switch {
+	case !m.Sqs.IsNull():
+		return dataForwarderProviderSqs
+	case !m.Segment.IsNull():
+		return dataForwarderProviderSegment
+	default:
+		return dataForwarderProviderSplunk
+	}
+}
+
+// secretValue returns the secret from the plan, or from its write-only
+// counterpart in the configuration when sendWriteOnly is set. Otherwise the
+// secret is omitted and Sentry keeps its current value.
+func secretValue(value, writeOnlyValue types.String, sendWriteOnly bool) types.String {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value
+	}
+	if !sendWriteOnly {
+		return types.StringNull()
+	}
+	return writeOnlyValue
+}
+
+func setConfigValue(config map[string]string, k string, v types.String) {
+	if !v.IsNull() && !v.IsUnknown() && v.ValueString() != "" {
+		config[k] = v.ValueString()
+	}
+}
+
+// ToConfig builds the provider configuration in the data forwarding API format.
+// Write-only secrets are only included when sendWriteOnly is set.
+// Write-only secrets are only available in the configuration, so it is passed
+// alongside the plan.
+func (m DataForwarderResourceModel) ToConfig(ctx context.Context, config DataForwarderResourceModel, sendWriteOnly bool) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make(map[string]string)
+
+	switch {
+	case !m.Sqs.IsNull():
+		plan, d := m.Sqs.Get(ctx)
+		diags.Append(d...)
+		cfg, d := config.Sqs.Get(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		setConfigValue(result, "queue_url", plan.QueueUrl)
+		setConfigValue(result, "region", plan.Region)
+		setConfigValue(result, "access_key", plan.AccessKey)
+		setConfigValue(result, "secret_key", secretValue(plan.SecretKey, cfg.SecretKeyWo, sendWriteOnly))
+		setConfigValue(result, "message_group_id", plan.MessageGroupId)
+		setConfigValue(result, "s3_bucket", plan.S3Bucket)
+	case !m.Segment.IsNull():
+		plan, d := m.Segment.Get(ctx)
+		diags.Append(d...)
+		cfg, d := config.Segment.Get(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		setConfigValue(result, "write_key", secretValue(plan.WriteKey, cfg.WriteKeyWo, sendWriteOnly))
+	case !m.Splunk.IsNull():
+		plan, d := m.Splunk.Get(ctx)
+		diags.Append(d...)
+		cfg, d := config.Splunk.Get(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		setConfigValue(result, "instance_url", plan.InstanceUrl)
+		setConfigValue(result, "index", plan.Index)
+		setConfigValue(result, "source", plan.Source)
+		setConfigValue(result, "token", secretValue(plan.Token, cfg.TokenWo, sendWriteOnly))
+	}
+
+	return result, diags
+}
+
+// FillConfig populates the provider block from the configuration returned by
+// Sentry. Secrets are never read back, so their current values are kept.
+func (m *DataForwarderResourceModel) FillConfig(ctx context.Context, providerName string, config map[string]string) (diags diag.Diagnostics) {
+	stringValue := func(k string) types.String {
+		if v, ok := config[k]; ok && v != "" {
+			return types.StringValue(v)
+		}
+		return types.StringNull()
+	}
+
+	priorSqs, d := m.priorSqs(ctx)
+	diags.Append(d...)
+	priorSegment, d := m.priorSegment(ctx)
+	diags.Append(d...)
+	priorSplunk, d := m.priorSplunk(ctx)
+	diags.Append(d...)
+
+	m.Sqs = supertypes.NewSingleNestedObjectValueOfNull[DataForwarderSqsModel](ctx)
+	m.Segment = supertypes.NewSingleNestedObjectValueOfNull[DataForwarderSegmentModel](ctx)
+	m.Splunk = supertypes.NewSingleNestedObjectValueOfNull[DataForwarderSplunkModel](ctx)
+
+	switch providerName {
+	case dataForwarderProviderSqs.Name:
+		sqs := DataForwarderSqsModel{SecretKey: types.StringNull(), SecretKeyWo: types.StringNull()}
+		if priorSqs != nil {
+			sqs.SecretKey = priorSqs.SecretKey
+		}
+		sqs.QueueUrl = stringValue("queue_url")
+		sqs.Region = stringValue("region")
+		sqs.AccessKey = stringValue("access_key")
+		sqs.MessageGroupId = stringValue("message_group_id")
+		sqs.S3Bucket = stringValue("s3_bucket")
+		m.Sqs = supertypes.NewSingleNestedObjectValueOf(ctx, &sqs)
+	case dataForwarderProviderSegment.Name:
+		segment := DataForwarderSegmentModel{WriteKey: types.StringNull(), WriteKeyWo: types.StringNull()}
+		if priorSegment != nil {
+			segment.WriteKey = priorSegment.WriteKey
+		}
+		m.Segment = supertypes.NewSingleNestedObjectValueOf(ctx, &segment)
+	case dataForwarderProviderSplunk.Name:
+		splunk := DataForwarderSplunkModel{Token: types.StringNull(), TokenWo: types.StringNull()}
+		if priorSplunk != nil {
+			splunk.Token = priorSplunk.Token
+		}
+		splunk.InstanceUrl = stringValue("instance_url")
+		splunk.Index = stringValue("index")
+		splunk.Source = stringValue("source")
+		m.Splunk = supertypes.NewSingleNestedObjectValueOf(ctx, &splunk)
+	default:
+		diags.AddError("Unsupported data forwarding provider", "The data forwarding provider "+strconv.Quote(providerName)+" is not supported.")
+	}
+
+	return
+}
+
+func (m DataForwarderResourceModel) priorSqs(ctx context.Context) (*DataForwarderSqsModel, diag.Diagnostics) {
+	if m.Sqs.IsNull() || m.Sqs.IsUnknown() {
+		return nil, nil
+	}
+	return m.Sqs.Get(ctx)
+}
+
+func (m DataForwarderResourceModel) priorSegment(ctx context.Context) (*DataForwarderSegmentModel, diag.Diagnostics) {
+	if m.Segment.IsNull() || m.Segment.IsUnknown() {
+		return nil, nil
+	}
+	return m.Segment.Get(ctx)
+}
+
+func (m DataForwarderResourceModel) priorSplunk(ctx context.Context) (*DataForwarderSplunkModel, diag.Diagnostics) {
+	if m.Splunk.IsNull() || m.Splunk.IsUnknown() {
+		return nil, nil
+	}
+	return m.Splunk.Get(ctx)
+}
+
+func (m *DataForwarderResourceModel) Fill(ctx context.Context, forwarder apiclient.DataForwarder) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(forwarder.Id)
+	m.Enabled = types.BoolValue(forwarder.IsEnabled)
+	m.EnrollNewProjects = types.BoolValue(forwarder.EnrollNewProjects)
+	m.Projects = supertypes.NewSetValueOfSlice(ctx, lo.Map(forwarder.EnrolledProjects, func(p struct {
+		Id   string  `json:"id"`
+		Slug *string `json:"slug,omitempty"`
+	}, _ int) string {
+		return p.Id
+	}))
+	diags.Append(m.FillConfig(ctx, forwarder.Provider, forwarder.Config)...)
+	return
+}
+
+func (m DataForwarderResourceModel) ToRequestBody(ctx context.Context, config DataForwarderResourceModel, sendWriteOnly bool) (body apiclient.DataForwarderRequest, diags diag.Diagnostics) {
+	body = apiclient.DataForwarderRequest{
+		Provider:          m.Provider().Name,
+		IsEnabled:         m.Enabled.ValueBool(),
+		EnrollNewProjects: m.EnrollNewProjects.ValueBool(),
+		ProjectIds:        []int64{},
+	}
+
+	projectIds, d := m.ProjectIds(ctx)
+	diags.Append(d...)
+	body.ProjectIds = append(body.ProjectIds, projectIds...)
+
+	body.Config, d = m.ToConfig(ctx, config, sendWriteOnly)
+	diags.Append(d...)
+
+	return
+}
+
+func (m DataForwarderResourceModel) ProjectIds(ctx context.Context) ([]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projects, d := m.Projects.Get(ctx)
+	diags.Append(d...)
+
+	ids := make([]int64, 0, len(projects))
+	for _, project := range projects {
+		id, err := strconv.ParseInt(project, 10, 64)
+		if err != nil {
+			diags.AddAttributeError(path.Root("projects"), "Invalid project ID", err.Error())
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, diags
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+	"github.com/samber/lo"
+)
+
+// The version of the Discover query format. Version 2 queries list aggregates
+// as fields, e.g. `count()`, instead of separate aggregations.
+const discoverSavedQueryVersion = 2
+
+type DiscoverSavedQueryModel struct {
+	Id           types.String                   `tfsdk:"id"`
+	Organization types.String                   `tfsdk:"organization"`
+	Name         types.String                   `tfsdk:"name"`
+	Dataset      types.String                   `tfsdk:"dataset"`
+	Fields       supertypes.ListValueOf[string] `tfsdk:"fields"`
+	YAxis        supertypes.ListValueOf[string] `tfsdk:"y_axis"`
+	Query        types.String                   `tfsdk:"query"`
+	OrderBy      types.String                   `tfsdk:"order_by"`
+	Projects     supertypes.SetValueOf[string]  `tfsdk:"projects"`
+	Environments supertypes.SetValueOf[string]  `tfsdk:"environments"`
+	Range        types.String                   `tfsdk:"range"`
+	Start        types.String                   `tfsdk:"start"`
+	End          types.String                   `tfsdk:"end"`
+	Interval     types.String                   `tfsdk:"interval"`
+	Display      types.String                   `tfsdk:"display"`
+	TopEvents    types.Int64                    `tfsdk:"top_events"`
+}
+
+func (m *DiscoverSavedQueryModel) Fill(ctx context.Context, query apiclient.DiscoverSavedQuery) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(query.Id)
+	m.Name = types.StringValue(query.Name)
+	m.Dataset = types.StringPointerValue(query.QueryDataset)
+	m.Query = types.StringPointerValue(query.Query)
+	m.OrderBy = types.StringPointerValue(query.Orderby)
+	m.Range = types.StringPointerValue(query.Range)
+	// Keep the configured spelling of the timestamps if Sentry reformats them.
+	priorStart := m.Start
+	m.Start = types.StringNull()
+	if query.Start != nil {
+		m.Start = sameInstantStringValue(priorStart, *query.Start)
+	}
+	priorEnd := m.End
+	m.End = types.StringNull()
+	if query.End != nil {
+		m.End = sameInstantStringValue(priorEnd, *query.End)
+	}
+	m.Interval = types.StringPointerValue(query.Interval)
+	m.Display = types.StringPointerValue(query.Display)
+	m.TopEvents = types.Int64PointerValue(query.TopEvents)
+
+	if fields := lo.FromPtr(query.Fields); len(fields) > 0 {
+		m.Fields = supertypes.NewListValueOfSlice(ctx, fields)
+	} else {
+		m.Fields = supertypes.NewListValueOfNull[string](ctx)
+	}
+
+	if yAxis := lo.FromPtr(query.YAxis); len(yAxis) > 0 {
+		m.YAxis = supertypes.NewListValueOfSlice(ctx, yAxis)
+	} else {
+		m.YAxis = supertypes.NewListValueOfNull[string](ctx)
+	}
+
+	if len(query.Projects) > 0 {
+		m.Projects = supertypes.NewSetValueOfSlice(ctx, lo.Map(query.Projects, func(id int64, _ int) string {
+			return strconv.FormatInt(id, 10)
+		}))
+	} else {
+		m.Projects = supertypes.NewSetValueOfNull[string](ctx)
+	}
+
+	if environments := lo.FromPtr(query.Environment); len(environments) > 0 {
+		m.Environments = supertypes.NewSetValueOfSlice(ctx, environments)
+	} else {
+		m.Environments = supertypes.NewSetValueOfNull[string](ctx)
+	}
+
+	return
+}
+
+func (m DiscoverSavedQueryModel) ToRequestBody(ctx context.Context) (body apiclient.DiscoverSavedQueryRequest, diags diag.Diagnostics) {
+	body = apiclient.DiscoverSavedQueryRequest{
+		Name:         m.Name.ValueString(),
+		Projects:     []int64{},
+		QueryDataset: m.Dataset.ValueStringPointer(),
+		Query:        m.Query.ValueStringPointer(),
+		Orderby:      m.OrderBy.ValueStringPointer(),
+		Range:        m.Range.ValueStringPointer(),
+		Start:        m.Start.ValueStringPointer(),
+		End:          m.End.ValueStringPointer(),
+		Interval:     m.Interval.ValueStringPointer(),
+		Display:      m.Display.ValueStringPointer(),
+		TopEvents:    m.TopEvents.ValueInt64Pointer(),
+		Version:      discoverSavedQueryVersion,
+	}
+
+	fields, d := m.Fields.Get(ctx)
+	diags.Append(d...)
+	body.Fields = fields
+
+	if !m.YAxis.IsNull() && !m.YAxis.IsUnknown() {
+		yAxis, d := m.YAxis.Get(ctx)
+		diags.Append(d...)
+		body.YAxis = &yAxis
+	}
+
+	if !m.Projects.IsNull() && !m.Projects.IsUnknown() {
+		projects, d := m.Projects.Get(ctx)
+		diags.Append(d...)
+		for _, project := range projects {
+			id, err := strconv.ParseInt(project, 10, 64)
+			if err != nil {
+				diags.AddAttributeError(path.Root("projects"), "Invalid project ID", err.Error())
+				continue
+			}
+			body.Projects = append(body.Projects, id)
+		}
+	}
+
+	if !m.Environments.IsNull() && !m.Environments.IsUnknown() {
+		environments, d := m.Environments.Get(ctx)
+		diags.Append(d...)
+		body.Environment = &environments
+	}
+
+	return
+}
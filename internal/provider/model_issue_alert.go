@@ -549,7 +549,8 @@ func (m *IssueAlertFilterEventAttributeModel) Fill(ctx context.Context, filter a
 			m.Value = types.StringValue(v.String())
 		}
 	} else {
-		diags.AddError("Invalid event attribute value", fmt.Sprintf("Invalid event attribute value %q. Please report this to the provider developers.", filter.Value))
+		raw, _ := filter.Value.MarshalJSON()
+		diags.AddError("Invalid event attribute value", fmt.Sprintf("Invalid event attribute value %q. Please report this to the provider developers.", string(raw)))
 	}
 
 	return
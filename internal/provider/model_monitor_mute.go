@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+)
+
+type MonitorMuteResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Organization types.String `tfsdk:"organization"`
+	MonitorId    types.String `tfsdk:"monitor_id"`
+	Environment  types.String `tfsdk:"environment"`
+	Until        types.String `tfsdk:"until"`
+	Active       types.Bool   `tfsdk:"active"`
+}
+
+// isExpired reports whether the mute has passed its `until` date.
+func (m *MonitorMuteResourceModel) isExpired(now time.Time) bool {
+	return isUntilExpired(m.Until, now)
+}
+
+// isMuted reports whether the monitor, or its environment, is muted. Cron
+// monitors are muted in place while other monitors are muted by disabling
+// them.
+func (m *MonitorMuteResourceModel) isMuted(monitor apiclient.ProjectMonitor) (bool, error) {
+	cron, err := cronMonitorDataSource(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	if cron == nil {
+		if !m.Environment.IsNull() {
+			return false, errors.New("only cron monitors can be muted per environment")
+		}
+		return !monitor.Enabled, nil
+	}
+
+	if m.Environment.IsNull() {
+		return cron.IsMuted != nil && *cron.IsMuted, nil
+	}
+	if cron.Environments != nil {
+		for _, environment := range *cron.Environments {
+			if environment.Name == m.Environment.ValueString() {
+				return environment.IsMuted, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// cronMonitorDataSource returns the cron monitor backing a monitor, or nil if
+// the monitor is not a cron monitor.
+func cronMonitorDataSource(monitor apiclient.ProjectMonitor) (*apiclient.ProjectMonitorDataSourceCron, error) {
+	for _, dataSource := range monitor.DataSources {
+		discriminator, err := dataSource.Discriminator()
+		if err != nil {
+			return nil, err
+		}
+		if discriminator != "cron_monitor" {
+			continue
+		}
+
+		cron, err := dataSource.AsProjectMonitorDataSourceWrapperCronMonitor()
+		if err != nil {
+			return nil, err
+		}
+		return &cron.QueryObj, nil
+	}
+	return nil, nil
+}
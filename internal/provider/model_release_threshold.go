@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+)
+
+type ReleaseThresholdResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Organization    types.String `tfsdk:"organization"`
+	Project         types.String `tfsdk:"project"`
+	Environment     types.String `tfsdk:"environment"`
+	ThresholdType   types.String `tfsdk:"threshold_type"`
+	TriggerType     types.String `tfsdk:"trigger_type"`
+	Value           types.Int64  `tfsdk:"value"`
+	WindowInSeconds types.Int64  `tfsdk:"window_in_seconds"`
+}
+
+func (m *ReleaseThresholdResourceModel) Fill(threshold apiclient.ReleaseThreshold) error {
+	m.Id = types.StringValue(threshold.Id)
+	m.Environment = types.StringNull()
+	if environment, err := threshold.Environment.Get(); err == nil {
+		m.Environment = types.StringValue(environment.Name)
+	}
+	m.ThresholdType = types.StringValue(threshold.ThresholdType)
+	m.TriggerType = types.StringValue(threshold.TriggerType)
+	m.Value = types.Int64Value(threshold.Value)
+	m.WindowInSeconds = types.Int64Value(threshold.WindowInSeconds)
+
+	return nil
+}
+
+func (m ReleaseThresholdResourceModel) ToRequestBody() apiclient.ReleaseThresholdRequest {
+	return apiclient.ReleaseThresholdRequest{
+		Environment:     m.Environment.ValueStringPointer(),
+		ThresholdType:   m.ThresholdType.ValueString(),
+		TriggerType:     m.TriggerType.ValueString(),
+		Value:           m.Value.ValueInt64(),
+		WindowInSeconds: m.WindowInSeconds.ValueInt64(),
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,7 +21,9 @@ import (
 )
 
 var _ provider.Provider = &SentryProvider{}
+var _ provider.ProviderWithEphemeralResources = &SentryProvider{}
 var _ provider.ProviderWithFunctions = &SentryProvider{}
+var _ provider.ProviderWithActions = &SentryProvider{}
 
 // SentryProvider defines the provider implementation.
 type SentryProvider struct {
@@ -132,7 +136,9 @@ func (p *SentryProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		ApiClient: apiClient,
 	}
 
+	resp.ActionData = providerData
 	resp.DataSourceData = providerData
+	resp.EphemeralResourceData = providerData
 	resp.ResourceData = providerData
 }
 
@@ -140,19 +146,40 @@ func (p *SentryProvider) Resources(ctx context.Context) []func() resource.Resour
 	// Please keep the resources sorted by name.
 	return append(
 		AutoGeneratedResources,
+		NewAlertMonitorLinkResource,
+		NewAlertSnoozeResource,
 		NewAllProjectsSpikeProtectionResource,
 		NewClientKeyResource,
+		NewCustomDynamicSamplingRuleResource,
+		NewDashboardResource,
+		NewDataForwarderResource,
+		NewDiscoverSavedQueryResource,
+		NewExternalTeamResource,
+		NewExternalUserResource,
 		NewIntegrationOpsgenie,
 		NewIntegrationPagerDuty,
+		NewInternalIntegrationResource,
 		NewIssueAlertResource,
+		NewIssueViewResource,
+		NewMonitorMuteResource,
 		NewNotificationActionResource,
+		NewOrganizationAuthTokenResource,
+		NewOrganizationMemberResource,
 		NewOrganizationRepositoryResource,
+		NewOrganizationSamplingResource,
+		NewProjectCodeOwnersResource,
+		NewProjectEnvironmentResource,
 		NewProjectInboundDataFilterResource,
+		NewProjectPerformanceIssueSettingsResource,
 		NewProjectResource,
+		NewProjectSamplingResource,
 		NewProjectSpikeProtectionResource,
 		NewProjectSymbolSourcesResource,
 		NewProjectOwnershipResource,
+		NewReleaseThresholdResource,
+		NewSavedSearchResource,
 		NewTeamMemberResource,
+		NewTeamMembersResource,
 	)
 }
 
@@ -161,18 +188,39 @@ func (p *SentryProvider) DataSources(ctx context.Context) []func() datasource.Da
 	return append(
 		AutoGeneratedDataSources,
 		NewAllClientKeysDataSource,
+		NewAllOrganizationAuthTokensDataSource,
 		NewAllOrganizationMembersDataSource,
 		NewClientKeyDataSource,
+		NewDiscoverSavedQueryDataSource,
 		NewIssueAlertDataSource,
 		NewOrganizationIntegrationDataSource,
 		NewOrganizationMemberDataSource,
+		NewProjectEnvironmentsDataSource,
+		NewReleaseThresholdsDataSource,
 		NewSentryAppInstallationDataSource,
 	)
 }
 
+func (p *SentryProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	// Please keep the ephemeral resources sorted by name.
+	return []func() ephemeral.EphemeralResource{
+		NewInternalIntegrationTokenEphemeralResource,
+		NewOrganizationAuthTokenEphemeralResource,
+	}
+}
+
+func (p *SentryProvider) Actions(ctx context.Context) []func() action.Action {
+	// Please keep the actions sorted by name.
+	return []func() action.Action{
+		NewCronMonitorCheckInAction,
+	}
+}
+
 func (p *SentryProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewAssertionFunction,
+		NewAssertionEvaluateFunction,
+		NewCronNextRunsFunction,
 		NewOpAndFunction,
 		NewOpHeaderCheckFunction,
 		NewOpHeaderOperandGlobFunction,
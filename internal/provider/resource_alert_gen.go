@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
@@ -79,8 +80,9 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				CustomType:          supertypes.StringType{},
 			},
 			"monitor_ids": schema.SetAttribute{
-				MarkdownDescription: "The IDs of the monitors to create alerts for.",
-				Required:            true,
+				MarkdownDescription: "The IDs of the monitors to create alerts for. Leave unset to connect monitors with the [`sentry_alert_monitor_link`](alert_monitor_link.md) resource instead; the connected monitors are then left untouched and only read back.",
+				Optional:            true,
+				Computed:            true,
 				CustomType:          supertypes.NewSetTypeOf[string](ctx),
 			},
 			"frequency_minutes": schema.Int64Attribute{
@@ -100,7 +102,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							Optional:            true,
 							CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelTriggerConditionsItemFirstSeenEvent](ctx),
 							Validators: []validator.Object{
-								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("issue_resolved_trigger"), path.MatchRelative().AtParent().AtName("reappeared_event"), path.MatchRelative().AtParent().AtName("regression_event")),
+								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("issue_resolved_trigger"), path.MatchRelative().AtParent().AtName("reappeared_event"), path.MatchRelative().AtParent().AtName("regression_event"), path.MatchRelative().AtParent().AtName("raw")),
 							},
 							Attributes: map[string]schema.Attribute{},
 						},
@@ -109,7 +111,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							Optional:            true,
 							CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelTriggerConditionsItemIssueResolvedTrigger](ctx),
 							Validators: []validator.Object{
-								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("first_seen_event"), path.MatchRelative().AtParent().AtName("reappeared_event"), path.MatchRelative().AtParent().AtName("regression_event")),
+								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("first_seen_event"), path.MatchRelative().AtParent().AtName("reappeared_event"), path.MatchRelative().AtParent().AtName("regression_event"), path.MatchRelative().AtParent().AtName("raw")),
 							},
 							Attributes: map[string]schema.Attribute{},
 						},
@@ -118,7 +120,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							Optional:            true,
 							CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelTriggerConditionsItemReappearedEvent](ctx),
 							Validators: []validator.Object{
-								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("first_seen_event"), path.MatchRelative().AtParent().AtName("issue_resolved_trigger"), path.MatchRelative().AtParent().AtName("regression_event")),
+								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("first_seen_event"), path.MatchRelative().AtParent().AtName("issue_resolved_trigger"), path.MatchRelative().AtParent().AtName("regression_event"), path.MatchRelative().AtParent().AtName("raw")),
 							},
 							Attributes: map[string]schema.Attribute{},
 						},
@@ -127,10 +129,37 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							Optional:            true,
 							CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelTriggerConditionsItemRegressionEvent](ctx),
 							Validators: []validator.Object{
-								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("first_seen_event"), path.MatchRelative().AtParent().AtName("issue_resolved_trigger"), path.MatchRelative().AtParent().AtName("reappeared_event")),
+								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("first_seen_event"), path.MatchRelative().AtParent().AtName("issue_resolved_trigger"), path.MatchRelative().AtParent().AtName("reappeared_event"), path.MatchRelative().AtParent().AtName("raw")),
 							},
 							Attributes: map[string]schema.Attribute{},
 						},
+						"raw": schema.SingleNestedAttribute{
+							MarkdownDescription: "A trigger condition of a `type` not natively supported by this provider. Alerts with such trigger conditions are imported using this attribute.",
+							Optional:            true,
+							CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelTriggerConditionsItemRaw](ctx),
+							Validators: []validator.Object{
+								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("first_seen_event"), path.MatchRelative().AtParent().AtName("issue_resolved_trigger"), path.MatchRelative().AtParent().AtName("reappeared_event"), path.MatchRelative().AtParent().AtName("regression_event")),
+							},
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The trigger condition type.",
+									Required:            true,
+									CustomType:          supertypes.StringType{},
+								},
+								"comparison_json": schema.StringAttribute{
+									MarkdownDescription: "The comparison of the trigger condition as a JSON string.",
+									Required:            true,
+									CustomType:          jsontypes.NormalizedType{},
+								},
+								"condition_result": schema.BoolAttribute{
+									MarkdownDescription: "The result the trigger condition must evaluate to, e.g. `false` to negate it. Defaults to `true`.",
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(true),
+									CustomType:          supertypes.BoolType{},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -163,7 +192,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemAgeComparison](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"time": tfutils.WithEnumStringAttribute(
@@ -197,7 +226,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemAssignedTo](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"target_type": tfutils.WithEnumStringAttribute(
@@ -224,7 +253,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemIssueCategory](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"value": schema.Int64Attribute{
@@ -245,7 +274,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemIssueOccurrences](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"value": schema.Int64Attribute{
@@ -263,7 +292,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityDeescalating](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"comparison": schema.Int64Attribute{
@@ -278,7 +307,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityGreaterOrEqual](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"comparison": schema.Int64Attribute{
@@ -293,7 +322,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyCount](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"value": schema.Int64Attribute{
@@ -355,7 +384,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyCount](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"value": schema.Int64Attribute{
@@ -420,7 +449,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyPercent](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"value": schema.Int64Attribute{
@@ -484,7 +513,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													Required:            true,
 													CustomType:          supertypes.StringType{},
 												},
-												sentrydata.EventFrequencyStandardIntervals,
+												sentrydata.EventFrequencyComparisonIntervals,
 											),
 										},
 									},
@@ -493,7 +522,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsCount](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"value": schema.Int64Attribute{
@@ -519,7 +548,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsPercent](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"value": schema.Int64Attribute{
@@ -583,7 +612,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													Required:            true,
 													CustomType:          supertypes.StringType{},
 												},
-												sentrydata.EventFrequencyStandardIntervals,
+												sentrydata.EventFrequencyComparisonIntervals,
 											),
 										},
 									},
@@ -592,7 +621,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemEventAttribute](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"attribute": schema.StringAttribute{
@@ -620,7 +649,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemTaggedEvent](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"key": schema.StringAttribute{
@@ -648,7 +677,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemLatestRelease](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{},
 									},
@@ -657,7 +686,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemLatestAdoptedRelease](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"environment": schema.StringAttribute{
@@ -688,7 +717,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemLevel](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("issue_type")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"match": schema.StringAttribute{
@@ -708,7 +737,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemIssueType](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"value": schema.StringAttribute{
@@ -723,6 +752,231 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											},
 										},
 									},
+									"issue_open_duration": schema.SingleNestedAttribute{
+										MarkdownDescription: "Issue has been open for longer than `value` `time`.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemIssueOpenDuration](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
+										},
+										Attributes: map[string]schema.Attribute{
+											"time": tfutils.WithEnumStringAttribute(
+												schema.StringAttribute{
+													MarkdownDescription: "The unit of time for the duration.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												[]string{"minute", "hour", "day", "week"},
+											),
+											"value": schema.Int64Attribute{
+												MarkdownDescription: "The duration the issue must have been open for.",
+												Required:            true,
+												CustomType:          supertypes.Int64Type{},
+												Validators: []validator.Int64{
+													int64validator.AtLeast(1),
+												},
+											},
+										},
+									},
+									"issue_priority_equals": schema.SingleNestedAttribute{
+										MarkdownDescription: "Issue priority is `comparison`.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityEquals](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
+										},
+										Attributes: map[string]schema.Attribute{
+											"comparison": schema.Int64Attribute{
+												MarkdownDescription: "The priority the issue must be for the alert to fire. `25` for low, `50` for medium, and `75` for high.",
+												Required:            true,
+												CustomType:          supertypes.Int64Type{},
+											},
+										},
+									},
+									"issue_resolution_change": schema.SingleNestedAttribute{
+										MarkdownDescription: "The issue's status changes to `comparison`.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemIssueResolutionChange](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
+										},
+										Attributes: map[string]schema.Attribute{
+											"comparison": schema.Int64Attribute{
+												MarkdownDescription: "The status the issue must change to for the alert to fire. `0` for unresolved, `1` for resolved, and `2` for ignored.",
+												Required:            true,
+												CustomType:          supertypes.Int64Type{},
+											},
+										},
+									},
+									"event_seen_count": schema.SingleNestedAttribute{
+										MarkdownDescription: "The issue has been seen `comparison` times.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemEventSeenCount](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
+										},
+										Attributes: map[string]schema.Attribute{
+											"comparison": schema.Int64Attribute{
+												MarkdownDescription: "A positive integer representing how many times the issue has to be seen before the alert will fire.",
+												Required:            true,
+												CustomType:          supertypes.Int64Type{},
+												Validators: []validator.Int64{
+													int64validator.AtLeast(1),
+												},
+											},
+										},
+									},
+									"existing_high_priority_issue": schema.SingleNestedAttribute{
+										MarkdownDescription: "An existing issue escalates to high priority.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemExistingHighPriorityIssue](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
+										},
+										Attributes: map[string]schema.Attribute{},
+									},
+									"new_high_priority_issue": schema.SingleNestedAttribute{
+										MarkdownDescription: "A new issue is created with high priority.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemNewHighPriorityIssue](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
+										},
+										Attributes: map[string]schema.Attribute{},
+									},
+									"every_event": schema.SingleNestedAttribute{
+										MarkdownDescription: "Every event matches.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemEveryEvent](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
+										},
+										Attributes: map[string]schema.Attribute{},
+									},
+									"event_unique_user_frequency_percent": schema.SingleNestedAttribute{
+										MarkdownDescription: "Percent of users affected.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercent](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
+										},
+										Attributes: map[string]schema.Attribute{
+											"value": schema.Int64Attribute{
+												MarkdownDescription: "A positive integer representing the percentage increase in users affected that must occur before the alert will fire.",
+												Required:            true,
+												CustomType:          supertypes.Int64Type{},
+												Validators: []validator.Int64{
+													int64validator.AtLeast(1),
+												},
+											},
+											"filters": schema.ListNestedAttribute{
+												MarkdownDescription: "A list of additional sub-filters to evaluate before the alert will fire.",
+												Optional:            true,
+												Computed:            true,
+												CustomType:          supertypes.NewListNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercentFiltersItem](ctx),
+												NestedObject: schema.NestedAttributeObject{
+													Attributes: map[string]schema.Attribute{
+														"key": schema.StringAttribute{
+															MarkdownDescription: "The key of the filter. Conflicts with `attribute`.",
+															Optional:            true,
+															CustomType:          supertypes.StringType{},
+															Validators: []validator.String{
+																stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("attribute")),
+															},
+														},
+														"attribute": schema.StringAttribute{
+															MarkdownDescription: "The attribute of the filter. Conflicts with `key`.",
+															Optional:            true,
+															CustomType:          supertypes.StringType{},
+															Validators: []validator.String{
+																stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("key")),
+															},
+														},
+														"match": tfutils.WithEnumStringAttribute(
+															schema.StringAttribute{
+																MarkdownDescription: "The match type of the filter.",
+																Optional:            true,
+																CustomType:          supertypes.StringType{},
+															},
+															sentrydata.MatchTypeIds,
+														),
+														"value": schema.StringAttribute{
+															MarkdownDescription: "The value of the filter.",
+															Optional:            true,
+															CustomType:          supertypes.StringType{},
+														},
+													},
+												},
+											},
+											"interval": tfutils.WithEnumStringAttribute(
+												schema.StringAttribute{
+													MarkdownDescription: "The time period in which to evaluate the value. e.g. Number of users affected by an issue is `comparisonInterval` percent higher `value` compared to `interval`.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												sentrydata.EventFrequencyStandardIntervals,
+											),
+											"comparison_interval": tfutils.WithEnumStringAttribute(
+												schema.StringAttribute{
+													MarkdownDescription: "The time period to compare against.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												sentrydata.EventFrequencyComparisonIntervals,
+											),
+										},
+									},
+									"event_created_by_detector": schema.SingleNestedAttribute{
+										MarkdownDescription: "The event was created by the monitor `monitor_id`.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemEventCreatedByDetector](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("seer_activity_trigger"), path.MatchRelative().AtParent().AtName("raw")),
+										},
+										Attributes: map[string]schema.Attribute{
+											"monitor_id": schema.StringAttribute{
+												MarkdownDescription: "The internal ID of the monitor.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+										},
+									},
+									"seer_activity_trigger": schema.SingleNestedAttribute{
+										MarkdownDescription: "Seer activity occurs on the issue.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemSeerActivityTrigger](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("raw")),
+										},
+										Attributes: map[string]schema.Attribute{},
+									},
+									"raw": schema.SingleNestedAttribute{
+										MarkdownDescription: "A condition of a `type` not natively supported by this provider. Alerts with such conditions are imported using this attribute.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemConditionsItemRaw](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("age_comparison"), path.MatchRelative().AtParent().AtName("assigned_to"), path.MatchRelative().AtParent().AtName("issue_category"), path.MatchRelative().AtParent().AtName("issue_occurrences"), path.MatchRelative().AtParent().AtName("issue_priority_deescalating"), path.MatchRelative().AtParent().AtName("issue_priority_greater_or_equal"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_count"), path.MatchRelative().AtParent().AtName("event_frequency_percent"), path.MatchRelative().AtParent().AtName("percent_sessions_count"), path.MatchRelative().AtParent().AtName("percent_sessions_percent"), path.MatchRelative().AtParent().AtName("event_attribute"), path.MatchRelative().AtParent().AtName("tagged_event"), path.MatchRelative().AtParent().AtName("latest_release"), path.MatchRelative().AtParent().AtName("latest_adopted_release"), path.MatchRelative().AtParent().AtName("level"), path.MatchRelative().AtParent().AtName("issue_type"), path.MatchRelative().AtParent().AtName("issue_open_duration"), path.MatchRelative().AtParent().AtName("issue_priority_equals"), path.MatchRelative().AtParent().AtName("issue_resolution_change"), path.MatchRelative().AtParent().AtName("event_seen_count"), path.MatchRelative().AtParent().AtName("existing_high_priority_issue"), path.MatchRelative().AtParent().AtName("new_high_priority_issue"), path.MatchRelative().AtParent().AtName("every_event"), path.MatchRelative().AtParent().AtName("event_unique_user_frequency_percent"), path.MatchRelative().AtParent().AtName("event_created_by_detector"), path.MatchRelative().AtParent().AtName("seer_activity_trigger")),
+										},
+										Attributes: map[string]schema.Attribute{
+											"type": schema.StringAttribute{
+												MarkdownDescription: "The condition type.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											"comparison_json": schema.StringAttribute{
+												MarkdownDescription: "The comparison of the condition as a JSON string.",
+												Required:            true,
+												CustomType:          jsontypes.NormalizedType{},
+											},
+											"condition_result": schema.BoolAttribute{
+												MarkdownDescription: "The result the condition must evaluate to, e.g. `false` to negate it. Defaults to `true`.",
+												Optional:            true,
+												Computed:            true,
+												Default:             booldefault.StaticBool(true),
+												CustomType:          supertypes.BoolType{},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -740,7 +994,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemEmail](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"target_type": tfutils.WithEnumStringAttribute(
@@ -780,7 +1034,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemPlugin](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{},
 									},
@@ -789,7 +1043,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemSlack](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"integration_id": schema.StringAttribute{
@@ -826,7 +1080,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemPagerduty](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"integration_id": schema.StringAttribute{
@@ -856,7 +1110,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemDiscord](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"integration_id": schema.StringAttribute{
@@ -881,7 +1135,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemMsteams](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"integration_id": schema.StringAttribute{
@@ -906,7 +1160,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemOpsgenie](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"integration_id": schema.StringAttribute{
@@ -936,7 +1190,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemVsts](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"integration_id": schema.StringAttribute{
@@ -961,7 +1215,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemJira](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"integration_id": schema.StringAttribute{
@@ -986,7 +1240,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemJiraServer](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"integration_id": schema.StringAttribute{
@@ -1011,7 +1265,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemGithub](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"integration_id": schema.StringAttribute{
@@ -1041,7 +1295,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemSentryApp](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("webhook")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("webhook"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"sentry_app_id": schema.StringAttribute{
@@ -1081,7 +1335,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										Optional:            true,
 										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemWebhook](ctx),
 										Validators: []validator.Object{
-											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app")),
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("raw")),
 										},
 										Attributes: map[string]schema.Attribute{
 											"service": schema.StringAttribute{
@@ -1091,6 +1345,36 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											},
 										},
 									},
+									"raw": schema.SingleNestedAttribute{
+										MarkdownDescription: "An action of a `type` not natively supported by this provider. Alerts with such actions are imported using this attribute.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[AlertResourceModelActionFiltersItemActionsItemRaw](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email"), path.MatchRelative().AtParent().AtName("plugin"), path.MatchRelative().AtParent().AtName("slack"), path.MatchRelative().AtParent().AtName("pagerduty"), path.MatchRelative().AtParent().AtName("discord"), path.MatchRelative().AtParent().AtName("msteams"), path.MatchRelative().AtParent().AtName("opsgenie"), path.MatchRelative().AtParent().AtName("vsts"), path.MatchRelative().AtParent().AtName("jira"), path.MatchRelative().AtParent().AtName("jira_server"), path.MatchRelative().AtParent().AtName("github"), path.MatchRelative().AtParent().AtName("sentry_app"), path.MatchRelative().AtParent().AtName("webhook")),
+										},
+										Attributes: map[string]schema.Attribute{
+											"type": schema.StringAttribute{
+												MarkdownDescription: "The action type.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											"integration_id": schema.StringAttribute{
+												MarkdownDescription: "The internal ID of the integration, if the action uses one.",
+												Optional:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											"config_json": schema.StringAttribute{
+												MarkdownDescription: "The config of the action as a JSON string.",
+												Required:            true,
+												CustomType:          jsontypes.NormalizedType{},
+											},
+											"data_json": schema.StringAttribute{
+												MarkdownDescription: "The data of the action as a JSON string.",
+												Optional:            true,
+												CustomType:          jsontypes.NormalizedType{},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -1098,7 +1382,7 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"legacy_trigger_conditions": schema.ListAttribute{
-				MarkdownDescription: "⚠️ The trigger condition types listed here are not natively supported by this provider and may be deprecated by Sentry in a future API version. Trigger condition types present on this alert that are not representable in `trigger_conditions` (e.g. `new_high_priority_issue`, `existing_high_priority_issue`, `issue_resolution_change`). When omitted from config these will be removed on the next apply. Set explicitly to preserve them.",
+				MarkdownDescription: "⚠️ The trigger condition types listed here are not natively supported by this provider and may be deprecated by Sentry in a future API version. Trigger condition types present on this alert that are not representable in `trigger_conditions` (e.g. `new_high_priority_issue`, `existing_high_priority_issue`, `issue_resolution_change`). When omitted from config these will be removed on the next apply. Set explicitly to preserve them, or use the `raw` trigger condition instead.",
 				Optional:            true,
 				CustomType:          supertypes.NewListTypeOf[string](ctx),
 			},
@@ -1258,6 +1542,7 @@ type AlertResourceModelTriggerConditionsItem struct {
 	IssueResolvedTrigger supertypes.SingleNestedObjectValueOf[AlertResourceModelTriggerConditionsItemIssueResolvedTrigger] `tfsdk:"issue_resolved_trigger"`
 	ReappearedEvent      supertypes.SingleNestedObjectValueOf[AlertResourceModelTriggerConditionsItemReappearedEvent]      `tfsdk:"reappeared_event"`
 	RegressionEvent      supertypes.SingleNestedObjectValueOf[AlertResourceModelTriggerConditionsItemRegressionEvent]      `tfsdk:"regression_event"`
+	Raw                  supertypes.SingleNestedObjectValueOf[AlertResourceModelTriggerConditionsItemRaw]                  `tfsdk:"raw"`
 }
 
 type AlertResourceModelTriggerConditionsItemFirstSeenEvent struct {
@@ -1272,6 +1557,12 @@ type AlertResourceModelTriggerConditionsItemReappearedEvent struct {
 type AlertResourceModelTriggerConditionsItemRegressionEvent struct {
 }
 
+type AlertResourceModelTriggerConditionsItemRaw struct {
+	Type            supertypes.StringValue `tfsdk:"type"`
+	ComparisonJson  jsontypes.Normalized   `tfsdk:"comparison_json"`
+	ConditionResult supertypes.BoolValue   `tfsdk:"condition_result"`
+}
+
 type AlertResourceModelActionFiltersItem struct {
 	LogicType  supertypes.StringValue                                                                `tfsdk:"logic_type"`
 	Conditions supertypes.ListNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItem] `tfsdk:"conditions"`
@@ -1279,23 +1570,34 @@ type AlertResourceModelActionFiltersItem struct {
 }
 
 type AlertResourceModelActionFiltersItemConditionsItem struct {
-	AgeComparison                 supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemAgeComparison]                 `tfsdk:"age_comparison"`
-	AssignedTo                    supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemAssignedTo]                    `tfsdk:"assigned_to"`
-	IssueCategory                 supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssueCategory]                 `tfsdk:"issue_category"`
-	IssueOccurrences              supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssueOccurrences]              `tfsdk:"issue_occurrences"`
-	IssuePriorityDeescalating     supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityDeescalating]     `tfsdk:"issue_priority_deescalating"`
-	IssuePriorityGreaterOrEqual   supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityGreaterOrEqual]   `tfsdk:"issue_priority_greater_or_equal"`
-	EventUniqueUserFrequencyCount supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyCount] `tfsdk:"event_unique_user_frequency_count"`
-	EventFrequencyCount           supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyCount]           `tfsdk:"event_frequency_count"`
-	EventFrequencyPercent         supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyPercent]         `tfsdk:"event_frequency_percent"`
-	PercentSessionsCount          supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsCount]          `tfsdk:"percent_sessions_count"`
-	PercentSessionsPercent        supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsPercent]        `tfsdk:"percent_sessions_percent"`
-	EventAttribute                supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventAttribute]                `tfsdk:"event_attribute"`
-	TaggedEvent                   supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemTaggedEvent]                   `tfsdk:"tagged_event"`
-	LatestRelease                 supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemLatestRelease]                 `tfsdk:"latest_release"`
-	LatestAdoptedRelease          supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemLatestAdoptedRelease]          `tfsdk:"latest_adopted_release"`
-	Level                         supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemLevel]                         `tfsdk:"level"`
-	IssueType                     supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssueType]                     `tfsdk:"issue_type"`
+	AgeComparison                   supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemAgeComparison]                   `tfsdk:"age_comparison"`
+	AssignedTo                      supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemAssignedTo]                      `tfsdk:"assigned_to"`
+	IssueCategory                   supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssueCategory]                   `tfsdk:"issue_category"`
+	IssueOccurrences                supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssueOccurrences]                `tfsdk:"issue_occurrences"`
+	IssuePriorityDeescalating       supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityDeescalating]       `tfsdk:"issue_priority_deescalating"`
+	IssuePriorityGreaterOrEqual     supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityGreaterOrEqual]     `tfsdk:"issue_priority_greater_or_equal"`
+	EventUniqueUserFrequencyCount   supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyCount]   `tfsdk:"event_unique_user_frequency_count"`
+	EventFrequencyCount             supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyCount]             `tfsdk:"event_frequency_count"`
+	EventFrequencyPercent           supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyPercent]           `tfsdk:"event_frequency_percent"`
+	PercentSessionsCount            supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsCount]            `tfsdk:"percent_sessions_count"`
+	PercentSessionsPercent          supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsPercent]          `tfsdk:"percent_sessions_percent"`
+	EventAttribute                  supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventAttribute]                  `tfsdk:"event_attribute"`
+	TaggedEvent                     supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemTaggedEvent]                     `tfsdk:"tagged_event"`
+	LatestRelease                   supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemLatestRelease]                   `tfsdk:"latest_release"`
+	LatestAdoptedRelease            supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemLatestAdoptedRelease]            `tfsdk:"latest_adopted_release"`
+	Level                           supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemLevel]                           `tfsdk:"level"`
+	IssueType                       supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssueType]                       `tfsdk:"issue_type"`
+	IssueOpenDuration               supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssueOpenDuration]               `tfsdk:"issue_open_duration"`
+	IssuePriorityEquals             supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityEquals]             `tfsdk:"issue_priority_equals"`
+	IssueResolutionChange           supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemIssueResolutionChange]           `tfsdk:"issue_resolution_change"`
+	EventSeenCount                  supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventSeenCount]                  `tfsdk:"event_seen_count"`
+	ExistingHighPriorityIssue       supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemExistingHighPriorityIssue]       `tfsdk:"existing_high_priority_issue"`
+	NewHighPriorityIssue            supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemNewHighPriorityIssue]            `tfsdk:"new_high_priority_issue"`
+	EveryEvent                      supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEveryEvent]                      `tfsdk:"every_event"`
+	EventUniqueUserFrequencyPercent supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercent] `tfsdk:"event_unique_user_frequency_percent"`
+	EventCreatedByDetector          supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventCreatedByDetector]          `tfsdk:"event_created_by_detector"`
+	SeerActivityTrigger             supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemSeerActivityTrigger]             `tfsdk:"seer_activity_trigger"`
+	Raw                             supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemRaw]                             `tfsdk:"raw"`
 }
 
 type AlertResourceModelActionFiltersItemConditionsItemAgeComparison struct {
@@ -1416,6 +1718,59 @@ type AlertResourceModelActionFiltersItemConditionsItemIssueType struct {
 	Include supertypes.BoolValue   `tfsdk:"include"`
 }
 
+type AlertResourceModelActionFiltersItemConditionsItemIssueOpenDuration struct {
+	Time  supertypes.StringValue `tfsdk:"time"`
+	Value supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemIssuePriorityEquals struct {
+	Comparison supertypes.Int64Value `tfsdk:"comparison"`
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemIssueResolutionChange struct {
+	Comparison supertypes.Int64Value `tfsdk:"comparison"`
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemEventSeenCount struct {
+	Comparison supertypes.Int64Value `tfsdk:"comparison"`
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemExistingHighPriorityIssue struct {
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemNewHighPriorityIssue struct {
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemEveryEvent struct {
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercent struct {
+	Value              supertypes.Int64Value                                                                                                           `tfsdk:"value"`
+	Filters            supertypes.ListNestedObjectValueOf[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercentFiltersItem] `tfsdk:"filters"`
+	Interval           supertypes.StringValue                                                                                                          `tfsdk:"interval"`
+	ComparisonInterval supertypes.StringValue                                                                                                          `tfsdk:"comparison_interval"`
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercentFiltersItem struct {
+	Key       supertypes.StringValue `tfsdk:"key"`
+	Attribute supertypes.StringValue `tfsdk:"attribute"`
+	Match     supertypes.StringValue `tfsdk:"match"`
+	Value     supertypes.StringValue `tfsdk:"value"`
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemEventCreatedByDetector struct {
+	MonitorId supertypes.StringValue `tfsdk:"monitor_id"`
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemSeerActivityTrigger struct {
+}
+
+type AlertResourceModelActionFiltersItemConditionsItemRaw struct {
+	Type            supertypes.StringValue `tfsdk:"type"`
+	ComparisonJson  jsontypes.Normalized   `tfsdk:"comparison_json"`
+	ConditionResult supertypes.BoolValue   `tfsdk:"condition_result"`
+}
+
 type AlertResourceModelActionFiltersItemActionsItem struct {
 	Email      supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemActionsItemEmail]      `tfsdk:"email"`
 	Plugin     supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemActionsItemPlugin]     `tfsdk:"plugin"`
@@ -1430,6 +1785,7 @@ type AlertResourceModelActionFiltersItemActionsItem struct {
 	Github     supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemActionsItemGithub]     `tfsdk:"github"`
 	SentryApp  supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemActionsItemSentryApp]  `tfsdk:"sentry_app"`
 	Webhook    supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemActionsItemWebhook]    `tfsdk:"webhook"`
+	Raw        supertypes.SingleNestedObjectValueOf[AlertResourceModelActionFiltersItemActionsItemRaw]        `tfsdk:"raw"`
 }
 
 type AlertResourceModelActionFiltersItemActionsItemEmail struct {
@@ -1514,3 +1870,10 @@ type AlertResourceModelActionFiltersItemActionsItemSentryAppSettingsItem struct
 type AlertResourceModelActionFiltersItemActionsItemWebhook struct {
 	Service supertypes.StringValue `tfsdk:"service"`
 }
+
+type AlertResourceModelActionFiltersItemActionsItemRaw struct {
+	Type          supertypes.StringValue `tfsdk:"type"`
+	IntegrationId supertypes.StringValue `tfsdk:"integration_id"`
+	ConfigJson    jsontypes.Normalized   `tfsdk:"config_json"`
+	DataJson      jsontypes.Normalized   `tfsdk:"data_json"`
+}
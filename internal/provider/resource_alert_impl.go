@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/must"
@@ -364,6 +365,179 @@ func (r *AlertResource) getActionFilters(ctx context.Context, data AlertResource
 					diags.AddError("Failed to create condition", err.Error())
 					return nil, diags
 				}
+
+			case inCondition.IssueOpenDuration.IsKnown():
+				inIssueOpenDuration := inCondition.IssueOpenDuration.DiagsGet(ctx, diags)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				var outIssueOpenDuration apiclient.OrganizationWorkflowActionFilterConditionIssueOpenDuration
+				outIssueOpenDuration.Comparison.Time = apiclient.OrganizationWorkflowActionFilterConditionIssueOpenDurationComparisonTime(inIssueOpenDuration.Time.Get())
+				outIssueOpenDuration.Comparison.Value = inIssueOpenDuration.Value.Get()
+				outIssueOpenDuration.ConditionResult = true
+
+				if err := outCondition.FromOrganizationWorkflowActionFilterConditionIssueOpenDuration(outIssueOpenDuration); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+			case inCondition.IssuePriorityEquals.IsKnown():
+				inIssuePriorityEquals := inCondition.IssuePriorityEquals.DiagsGet(ctx, diags)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				var outIssuePriorityEquals apiclient.OrganizationWorkflowActionFilterConditionIssuePriorityEquals
+				outIssuePriorityEquals.Comparison = inIssuePriorityEquals.Comparison.Get()
+				outIssuePriorityEquals.ConditionResult = true
+
+				if err := outCondition.FromOrganizationWorkflowActionFilterConditionIssuePriorityEquals(outIssuePriorityEquals); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+			case inCondition.IssueResolutionChange.IsKnown():
+				inIssueResolutionChange := inCondition.IssueResolutionChange.DiagsGet(ctx, diags)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				var outIssueResolutionChange apiclient.OrganizationWorkflowActionFilterConditionIssueResolutionChange
+				outIssueResolutionChange.Comparison = inIssueResolutionChange.Comparison.Get()
+				outIssueResolutionChange.ConditionResult = true
+
+				if err := outCondition.FromOrganizationWorkflowActionFilterConditionIssueResolutionChange(outIssueResolutionChange); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+			case inCondition.EventSeenCount.IsKnown():
+				inEventSeenCount := inCondition.EventSeenCount.DiagsGet(ctx, diags)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				var outEventSeenCount apiclient.OrganizationWorkflowActionFilterConditionEventSeenCount
+				outEventSeenCount.Comparison = inEventSeenCount.Comparison.Get()
+				outEventSeenCount.ConditionResult = true
+
+				if err := outCondition.FromOrganizationWorkflowActionFilterConditionEventSeenCount(outEventSeenCount); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+			case inCondition.ExistingHighPriorityIssue.IsKnown():
+				var outExistingHighPriorityIssue apiclient.OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue
+				outExistingHighPriorityIssue.Comparison = true
+				outExistingHighPriorityIssue.ConditionResult = true
+
+				if err := outCondition.FromOrganizationWorkflowActionFilterConditionExistingHighPriorityIssue(outExistingHighPriorityIssue); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+			case inCondition.NewHighPriorityIssue.IsKnown():
+				var outNewHighPriorityIssue apiclient.OrganizationWorkflowActionFilterConditionNewHighPriorityIssue
+				outNewHighPriorityIssue.Comparison = true
+				outNewHighPriorityIssue.ConditionResult = true
+
+				if err := outCondition.FromOrganizationWorkflowActionFilterConditionNewHighPriorityIssue(outNewHighPriorityIssue); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+			case inCondition.EveryEvent.IsKnown():
+				var outEveryEvent apiclient.OrganizationWorkflowActionFilterConditionEveryEvent
+				outEveryEvent.Comparison = true
+				outEveryEvent.ConditionResult = true
+
+				if err := outCondition.FromOrganizationWorkflowActionFilterConditionEveryEvent(outEveryEvent); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+			case inCondition.EventUniqueUserFrequencyPercent.IsKnown():
+				inEventUniqueUserFrequencyPercent := inCondition.EventUniqueUserFrequencyPercent.DiagsGet(ctx, diags)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				inUniqueUserPercentFilters := inEventUniqueUserFrequencyPercent.Filters.DiagsGet(ctx, diags)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				var outEventUniqueUserFrequencyPercent apiclient.OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent
+				outEventUniqueUserFrequencyPercent.Comparison.Value = inEventUniqueUserFrequencyPercent.Value.Get()
+				outEventUniqueUserFrequencyPercent.Comparison.Filters = lo.ToPtr(lo.Map(inUniqueUserPercentFilters, func(inFilter *AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercentFiltersItem, _ int) apiclient.OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountFilter {
+					return apiclient.OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyCountFilter{
+						Attribute: inFilter.Attribute.GetPtr(),
+						Key:       inFilter.Key.GetPtr(),
+						Match:     inFilter.Match.GetPtr(),
+						Value:     inFilter.Value.GetPtr(),
+					}
+				}))
+				outEventUniqueUserFrequencyPercent.Comparison.Interval = inEventUniqueUserFrequencyPercent.Interval.Get()
+				outEventUniqueUserFrequencyPercent.Comparison.ComparisonInterval = inEventUniqueUserFrequencyPercent.ComparisonInterval.Get()
+				outEventUniqueUserFrequencyPercent.ConditionResult = true
+
+				if err := outCondition.FromOrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent(outEventUniqueUserFrequencyPercent); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+			case inCondition.EventCreatedByDetector.IsKnown():
+				inEventCreatedByDetector := inCondition.EventCreatedByDetector.DiagsGet(ctx, diags)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				monitorId, err := strconv.ParseInt(inEventCreatedByDetector.MonitorId.Get(), 10, 64)
+				if err != nil {
+					diags.AddError("Invalid monitor ID", err.Error())
+					return nil, diags
+				}
+
+				var outEventCreatedByDetector apiclient.OrganizationWorkflowActionFilterConditionEventCreatedByDetector
+				outEventCreatedByDetector.Comparison = monitorId
+				outEventCreatedByDetector.ConditionResult = true
+
+				if err := outCondition.FromOrganizationWorkflowActionFilterConditionEventCreatedByDetector(outEventCreatedByDetector); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+			case inCondition.SeerActivityTrigger.IsKnown():
+				var outSeerActivityTrigger apiclient.OrganizationWorkflowActionFilterConditionSeerActivityTrigger
+				outSeerActivityTrigger.Comparison = true
+				outSeerActivityTrigger.ConditionResult = true
+
+				if err := outCondition.FromOrganizationWorkflowActionFilterConditionSeerActivityTrigger(outSeerActivityTrigger); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+			case inCondition.Raw.IsKnown():
+				inRaw := inCondition.Raw.DiagsGet(ctx, diags)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				outRaw, err := json.Marshal(map[string]any{
+					"type":            inRaw.Type.Get(),
+					"comparison":      json.RawMessage(inRaw.ComparisonJson.ValueString()),
+					"conditionResult": inRaw.ConditionResult.ValueBool(),
+				})
+				if err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
+
+				if err := outCondition.UnmarshalJSON(outRaw); err != nil {
+					diags.AddError("Failed to create condition", err.Error())
+					return nil, diags
+				}
 			}
 			outConditions = append(outConditions, outCondition)
 		}
@@ -637,6 +811,34 @@ func (r *AlertResource) getActionFilters(ctx context.Context, data AlertResource
 					return nil, diags
 				}
 
+			case inAction.Raw.IsKnown():
+				inRaw := inAction.Raw.DiagsGet(ctx, diags)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				outRaw := map[string]any{
+					"type":   inRaw.Type.Get(),
+					"config": json.RawMessage(inRaw.ConfigJson.ValueString()),
+					"data":   map[string]any{},
+				}
+				if inRaw.IntegrationId.IsKnown() {
+					outRaw["integrationId"] = inRaw.IntegrationId.Get()
+				}
+				if !inRaw.DataJson.IsNull() && !inRaw.DataJson.IsUnknown() {
+					outRaw["data"] = json.RawMessage(inRaw.DataJson.ValueString())
+				}
+
+				outRawJson, err := json.Marshal(outRaw)
+				if err != nil {
+					diags.AddError("Failed to create action", err.Error())
+					return nil, diags
+				}
+
+				if err := outAction.UnmarshalJSON(outRawJson); err != nil {
+					diags.AddError("Failed to create action", err.Error())
+					return nil, diags
+				}
 			}
 
 			outActions = append(outActions, outAction)
@@ -680,6 +882,18 @@ func (r *AlertResource) getTriggerConditions(ctx context.Context, data AlertReso
 			outTriggerCondition.Type = "reappeared_event"
 		case triggerCondition.RegressionEvent.IsKnown():
 			outTriggerCondition.Type = "regression_event"
+		case triggerCondition.Raw.IsKnown():
+			inRaw := triggerCondition.Raw.DiagsGet(ctx, diags)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			outTriggerCondition.Type = inRaw.Type.Get()
+			outTriggerCondition.ConditionResult = inRaw.ConditionResult.ValueBool()
+			if err := outTriggerCondition.Comparison.UnmarshalJSON([]byte(inRaw.ComparisonJson.ValueString())); err != nil {
+				diags.AddError("Failed to create trigger condition", err.Error())
+				return nil, diags
+			}
 		}
 
 		outTriggerConditions = append(outTriggerConditions, outTriggerCondition)
@@ -711,9 +925,15 @@ func (r *AlertResource) getTriggerConditions(ctx context.Context, data AlertReso
 func (r *AlertResource) getCreateJSONRequestBody(ctx context.Context, data AlertResourceModel) (*apiclient.CreateOrganizationWorkflowJSONRequestBody, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	monitorIds := data.MonitorIds.DiagsGet(ctx, diags)
-	if diags.HasError() {
-		return nil, diags
+	// Leave the connected monitors untouched when `monitor_ids` is unset, so
+	// they can be managed with `sentry_alert_monitor_link` instead. An unset
+	// `monitor_ids` is planned as unknown, since it is computed.
+	var monitorIds *[]string
+	if !data.MonitorIds.IsNull() && !data.MonitorIds.IsUnknown() {
+		monitorIds = new(data.MonitorIds.DiagsGet(ctx, diags))
+		if diags.HasError() {
+			return nil, diags
+		}
 	}
 
 	triggerConditions := append(
@@ -742,9 +962,15 @@ func (r *AlertResource) getCreateJSONRequestBody(ctx context.Context, data Alert
 func (r *AlertResource) getUpdateJSONRequestBody(ctx context.Context, data AlertResourceModel) (*apiclient.UpdateOrganizationWorkflowJSONRequestBody, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	monitorIds := data.MonitorIds.DiagsGet(ctx, diags)
-	if diags.HasError() {
-		return nil, diags
+	// Leave the connected monitors untouched when `monitor_ids` is unset, so
+	// they can be managed with `sentry_alert_monitor_link` instead. An unset
+	// `monitor_ids` is planned as unknown, since it is computed.
+	var monitorIds *[]string
+	if !data.MonitorIds.IsNull() && !data.MonitorIds.IsUnknown() {
+		monitorIds = new(data.MonitorIds.DiagsGet(ctx, diags))
+		if diags.HasError() {
+			return nil, diags
+		}
 	}
 
 	triggerConditions := append(
@@ -797,6 +1023,16 @@ func (m *AlertResourceModel) Fill(ctx context.Context, data apiclient.Organizati
 		return aId - bId
 	})
 
+	// Trigger condition types the user has explicitly listed in `legacy_trigger_conditions` are kept there,
+	// and any other unsupported types fall back to the raw trigger condition.
+	var priorLegacyTriggerConditions []string
+	if m.LegacyTriggerConditions.IsKnown() {
+		priorLegacyTriggerConditions = m.LegacyTriggerConditions.DiagsGet(ctx, diags)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	triggerConditions := []AlertResourceModelTriggerConditionsItem{}
 	var legacyTriggerConditions []string
 	for _, triggerCondition := range triggers.Conditions {
@@ -805,6 +1041,7 @@ func (m *AlertResourceModel) Fill(ctx context.Context, data apiclient.Organizati
 			IssueResolvedTrigger: supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelTriggerConditionsItemIssueResolvedTrigger](ctx),
 			ReappearedEvent:      supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelTriggerConditionsItemReappearedEvent](ctx),
 			RegressionEvent:      supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelTriggerConditionsItemRegressionEvent](ctx),
+			Raw:                  supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelTriggerConditionsItemRaw](ctx),
 		}
 		switch triggerCondition.Type {
 		case "first_seen_event":
@@ -820,7 +1057,23 @@ func (m *AlertResourceModel) Fill(ctx context.Context, data apiclient.Organizati
 			outTriggerCondition.RegressionEvent = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelTriggerConditionsItemRegressionEvent{})
 			triggerConditions = append(triggerConditions, outTriggerCondition)
 		default:
-			legacyTriggerConditions = append(legacyTriggerConditions, triggerCondition.Type)
+			if slices.Contains(priorLegacyTriggerConditions, triggerCondition.Type) {
+				legacyTriggerConditions = append(legacyTriggerConditions, triggerCondition.Type)
+				continue
+			}
+
+			comparisonJson, err := triggerCondition.Comparison.MarshalJSON()
+			if err != nil {
+				diags.AddError("Failed to marshal trigger condition comparison", err.Error())
+				return diags
+			}
+
+			outTriggerCondition.Raw = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelTriggerConditionsItemRaw{
+				Type:            supertypes.NewStringValue(triggerCondition.Type),
+				ComparisonJson:  jsontypes.NewNormalizedValue(string(comparisonJson)),
+				ConditionResult: supertypes.NewBoolValue(triggerCondition.ConditionResult),
+			})
+			triggerConditions = append(triggerConditions, outTriggerCondition)
 		}
 	}
 	m.TriggerConditions = supertypes.NewListNestedObjectValueOfValueSlice(ctx, triggerConditions)
@@ -859,29 +1112,56 @@ func (m *AlertResourceModel) Fill(ctx context.Context, data apiclient.Organizati
 		outConditions := []AlertResourceModelActionFiltersItemConditionsItem{}
 		for _, condition := range actionFilter.Conditions {
 			outCondition := AlertResourceModelActionFiltersItemConditionsItem{
-				AgeComparison:                 supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemAgeComparison](ctx),
-				AssignedTo:                    supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemAssignedTo](ctx),
-				IssueCategory:                 supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueCategory](ctx),
-				IssueOccurrences:              supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueOccurrences](ctx),
-				IssuePriorityDeescalating:     supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityDeescalating](ctx),
-				IssuePriorityGreaterOrEqual:   supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityGreaterOrEqual](ctx),
-				EventUniqueUserFrequencyCount: supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyCount](ctx),
-				EventFrequencyCount:           supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyCount](ctx),
-				EventFrequencyPercent:         supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyPercent](ctx),
-				PercentSessionsCount:          supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsCount](ctx),
-				PercentSessionsPercent:        supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsPercent](ctx),
-				EventAttribute:                supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventAttribute](ctx),
-				TaggedEvent:                   supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemTaggedEvent](ctx),
-				LatestRelease:                 supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemLatestRelease](ctx),
-				LatestAdoptedRelease:          supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemLatestAdoptedRelease](ctx),
-				Level:                         supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemLevel](ctx),
-				IssueType:                     supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueType](ctx),
+				AgeComparison:                   supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemAgeComparison](ctx),
+				AssignedTo:                      supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemAssignedTo](ctx),
+				IssueCategory:                   supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueCategory](ctx),
+				IssueOccurrences:                supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueOccurrences](ctx),
+				IssuePriorityDeescalating:       supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityDeescalating](ctx),
+				IssuePriorityGreaterOrEqual:     supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityGreaterOrEqual](ctx),
+				EventUniqueUserFrequencyCount:   supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyCount](ctx),
+				EventFrequencyCount:             supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyCount](ctx),
+				EventFrequencyPercent:           supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventFrequencyPercent](ctx),
+				PercentSessionsCount:            supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsCount](ctx),
+				PercentSessionsPercent:          supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemPercentSessionsPercent](ctx),
+				EventAttribute:                  supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventAttribute](ctx),
+				TaggedEvent:                     supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemTaggedEvent](ctx),
+				LatestRelease:                   supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemLatestRelease](ctx),
+				LatestAdoptedRelease:            supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemLatestAdoptedRelease](ctx),
+				Level:                           supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemLevel](ctx),
+				IssueType:                       supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueType](ctx),
+				IssueOpenDuration:               supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueOpenDuration](ctx),
+				IssuePriorityEquals:             supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssuePriorityEquals](ctx),
+				IssueResolutionChange:           supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemIssueResolutionChange](ctx),
+				EventSeenCount:                  supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventSeenCount](ctx),
+				ExistingHighPriorityIssue:       supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemExistingHighPriorityIssue](ctx),
+				NewHighPriorityIssue:            supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemNewHighPriorityIssue](ctx),
+				EveryEvent:                      supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEveryEvent](ctx),
+				EventUniqueUserFrequencyPercent: supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercent](ctx),
+				EventCreatedByDetector:          supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemEventCreatedByDetector](ctx),
+				SeerActivityTrigger:             supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemSeerActivityTrigger](ctx),
+				Raw:                             supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemConditionsItemRaw](ctx),
 			}
 
 			conditionValue, err := condition.ValueByDiscriminator()
 			if err != nil {
-				diags.AddError("Failed to get condition value", err.Error())
-				return
+				// Fall back to the raw condition for condition types that are not natively supported by this provider
+				var rawCondition struct {
+					Type            string          `json:"type"`
+					Comparison      json.RawMessage `json:"comparison"`
+					ConditionResult bool            `json:"conditionResult"`
+				}
+				if err := json.Unmarshal(must.Get(condition.MarshalJSON()), &rawCondition); err != nil {
+					diags.AddError("Failed to parse condition", err.Error())
+					return
+				}
+
+				outCondition.Raw = supertypes.NewSingleNestedObjectValueOf(ctx, &AlertResourceModelActionFiltersItemConditionsItemRaw{
+					Type:            supertypes.NewStringValue(rawCondition.Type),
+					ComparisonJson:  jsontypes.NewNormalizedValue(string(rawCondition.Comparison)),
+					ConditionResult: supertypes.NewBoolValue(rawCondition.ConditionResult),
+				})
+				outConditions = append(outConditions, outCondition)
+				continue
 			}
 
 			switch conditionValue := conditionValue.(type) {
@@ -1066,6 +1346,78 @@ func (m *AlertResourceModel) Fill(ctx context.Context, data apiclient.Organizati
 				issueType.Include = supertypes.NewBoolValue(conditionValue.Comparison.Include)
 
 				outCondition.IssueType = supertypes.NewSingleNestedObjectValueOf(ctx, &issueType)
+
+			case apiclient.OrganizationWorkflowActionFilterConditionIssueOpenDuration:
+				var issueOpenDuration AlertResourceModelActionFiltersItemConditionsItemIssueOpenDuration
+				issueOpenDuration.Time = supertypes.NewStringValue(string(conditionValue.Comparison.Time))
+				issueOpenDuration.Value = supertypes.NewInt64Value(conditionValue.Comparison.Value)
+
+				outCondition.IssueOpenDuration = supertypes.NewSingleNestedObjectValueOf(ctx, &issueOpenDuration)
+
+			case apiclient.OrganizationWorkflowActionFilterConditionIssuePriorityEquals:
+				var issuePriorityEquals AlertResourceModelActionFiltersItemConditionsItemIssuePriorityEquals
+				issuePriorityEquals.Comparison = supertypes.NewInt64Value(conditionValue.Comparison)
+
+				outCondition.IssuePriorityEquals = supertypes.NewSingleNestedObjectValueOf(ctx, &issuePriorityEquals)
+
+			case apiclient.OrganizationWorkflowActionFilterConditionIssueResolutionChange:
+				var issueResolutionChange AlertResourceModelActionFiltersItemConditionsItemIssueResolutionChange
+				issueResolutionChange.Comparison = supertypes.NewInt64Value(conditionValue.Comparison)
+
+				outCondition.IssueResolutionChange = supertypes.NewSingleNestedObjectValueOf(ctx, &issueResolutionChange)
+
+			case apiclient.OrganizationWorkflowActionFilterConditionEventSeenCount:
+				var eventSeenCount AlertResourceModelActionFiltersItemConditionsItemEventSeenCount
+				eventSeenCount.Comparison = supertypes.NewInt64Value(conditionValue.Comparison)
+
+				outCondition.EventSeenCount = supertypes.NewSingleNestedObjectValueOf(ctx, &eventSeenCount)
+
+			case apiclient.OrganizationWorkflowActionFilterConditionExistingHighPriorityIssue:
+				var existingHighPriorityIssue AlertResourceModelActionFiltersItemConditionsItemExistingHighPriorityIssue
+
+				outCondition.ExistingHighPriorityIssue = supertypes.NewSingleNestedObjectValueOf(ctx, &existingHighPriorityIssue)
+
+			case apiclient.OrganizationWorkflowActionFilterConditionNewHighPriorityIssue:
+				var newHighPriorityIssue AlertResourceModelActionFiltersItemConditionsItemNewHighPriorityIssue
+
+				outCondition.NewHighPriorityIssue = supertypes.NewSingleNestedObjectValueOf(ctx, &newHighPriorityIssue)
+
+			case apiclient.OrganizationWorkflowActionFilterConditionEveryEvent:
+				var everyEvent AlertResourceModelActionFiltersItemConditionsItemEveryEvent
+
+				outCondition.EveryEvent = supertypes.NewSingleNestedObjectValueOf(ctx, &everyEvent)
+
+			case apiclient.OrganizationWorkflowActionFilterConditionEventUniqueUserFrequencyPercent:
+				var eventUniqueUserFrequencyPercent AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercent
+				eventUniqueUserFrequencyPercent.Value = supertypes.NewInt64Value(conditionValue.Comparison.Value)
+				eventUniqueUserFrequencyPercent.Interval = supertypes.NewStringValue(conditionValue.Comparison.Interval)
+				eventUniqueUserFrequencyPercent.ComparisonInterval = supertypes.NewStringValue(conditionValue.Comparison.ComparisonInterval)
+
+				outUniqueUserPercentFilters := []AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercentFiltersItem{}
+				if conditionValue.Comparison.Filters != nil {
+					for _, filter := range *conditionValue.Comparison.Filters {
+						outUniqueUserPercentFilters = append(outUniqueUserPercentFilters, AlertResourceModelActionFiltersItemConditionsItemEventUniqueUserFrequencyPercentFiltersItem{
+							Attribute: supertypes.NewStringPointerValueOrNull(filter.Attribute),
+							Key:       supertypes.NewStringPointerValueOrNull(filter.Key),
+							Match:     supertypes.NewStringPointerValueOrNull(filter.Match),
+							Value:     supertypes.NewStringPointerValueOrNull(filter.Value),
+						})
+					}
+				}
+				eventUniqueUserFrequencyPercent.Filters = supertypes.NewListNestedObjectValueOfValueSlice(ctx, outUniqueUserPercentFilters)
+
+				outCondition.EventUniqueUserFrequencyPercent = supertypes.NewSingleNestedObjectValueOf(ctx, &eventUniqueUserFrequencyPercent)
+
+			case apiclient.OrganizationWorkflowActionFilterConditionEventCreatedByDetector:
+				var eventCreatedByDetector AlertResourceModelActionFiltersItemConditionsItemEventCreatedByDetector
+				eventCreatedByDetector.MonitorId = supertypes.NewStringValue(strconv.FormatInt(conditionValue.Comparison, 10))
+
+				outCondition.EventCreatedByDetector = supertypes.NewSingleNestedObjectValueOf(ctx, &eventCreatedByDetector)
+
+			case apiclient.OrganizationWorkflowActionFilterConditionSeerActivityTrigger:
+				var seerActivityTrigger AlertResourceModelActionFiltersItemConditionsItemSeerActivityTrigger
+
+				outCondition.SeerActivityTrigger = supertypes.NewSingleNestedObjectValueOf(ctx, &seerActivityTrigger)
 			}
 
 			outConditions = append(outConditions, outCondition)
@@ -1088,12 +1440,36 @@ func (m *AlertResourceModel) Fill(ctx context.Context, data apiclient.Organizati
 				Github:     supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemGithub](ctx),
 				SentryApp:  supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemSentryApp](ctx),
 				Webhook:    supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemWebhook](ctx),
+				Raw:        supertypes.NewSingleNestedObjectValueOfNull[AlertResourceModelActionFiltersItemActionsItemRaw](ctx),
 			}
 
 			actionValue, err := action.ValueByDiscriminator()
 			if err != nil {
-				diags.AddError("Failed to get action value", err.Error())
-				return
+				// Fall back to the raw action for action types that are not natively supported by this provider
+				var rawAction struct {
+					Type          string          `json:"type"`
+					IntegrationId *string         `json:"integrationId"`
+					Config        json.RawMessage `json:"config"`
+					Data          json.RawMessage `json:"data"`
+				}
+				if err := json.Unmarshal(must.Get(action.MarshalJSON()), &rawAction); err != nil {
+					diags.AddError("Failed to parse action", err.Error())
+					return
+				}
+
+				outRaw := AlertResourceModelActionFiltersItemActionsItemRaw{
+					Type:          supertypes.NewStringValue(rawAction.Type),
+					IntegrationId: supertypes.NewStringPointerValueOrNull(rawAction.IntegrationId),
+					ConfigJson:    jsontypes.NewNormalizedValue(string(rawAction.Config)),
+					DataJson:      jsontypes.NewNormalizedNull(),
+				}
+				if len(rawAction.Data) > 0 && string(rawAction.Data) != "null" && string(rawAction.Data) != "{}" {
+					outRaw.DataJson = jsontypes.NewNormalizedValue(string(rawAction.Data))
+				}
+
+				outAction.Raw = supertypes.NewSingleNestedObjectValueOf(ctx, &outRaw)
+				outActions = append(outActions, outAction)
+				continue
 			}
 
 			switch actionValue := actionValue.(type) {
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+)
+
+// alertMonitorLinkMu serializes changes to the monitors connected to an alert,
+// keyed by the alert ID.
+var alertMonitorLinkMu tfutils.KeyedMutex
+
+var _ resource.Resource = &AlertMonitorLinkResource{}
+var _ resource.ResourceWithConfigure = &AlertMonitorLinkResource{}
+var _ resource.ResourceWithImportState = &AlertMonitorLinkResource{}
+
+func NewAlertMonitorLinkResource() resource.Resource {
+	return &AlertMonitorLinkResource{}
+}
+
+type AlertMonitorLinkResource struct {
+	baseResource
+}
+
+func (r *AlertMonitorLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_monitor_link"
+}
+
+func (r *AlertMonitorLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connects a monitor to a [`sentry_alert`](alert.md), so that monitors and alerts can be managed separately. Leave `monitor_ids` unset on the alert when using this resource, otherwise the two will overwrite each other.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alert_id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the alert.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the monitor.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AlertMonitorLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AlertMonitorLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := alertMonitorLinkMu.Lock(data.AlertId.ValueString())
+	defer unlock()
+
+	httpResp, err := r.apiClient.CreateOrganizationDetectorWorkflowWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.ToRequestBody(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON201); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertMonitorLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AlertMonitorLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look the connection up by its alert and monitor, as imported resources
+	// do not know the ID of the connection.
+	httpResp, err := r.apiClient.ListOrganizationDetectorWorkflowsWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		&apiclient.ListOrganizationDetectorWorkflowsParams{
+			DetectorId: data.MonitorId.ValueStringPointer(),
+			WorkflowId: data.AlertId.ValueStringPointer(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("alert monitor link"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	var found *apiclient.OrganizationDetectorWorkflow
+	for _, link := range *httpResp.JSON200 {
+		if link.WorkflowId == data.AlertId.ValueString() && link.DetectorId == data.MonitorId.ValueString() {
+			found = &link
+			break
+		}
+	}
+	if found == nil {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("alert monitor link"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := data.Fill(*found); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertMonitorLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(diagutils.NewNotSupportedError("update"))
+}
+
+func (r *AlertMonitorLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AlertMonitorLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := alertMonitorLinkMu.Lock(data.AlertId.ValueString())
+	defer unlock()
+
+	httpResp, err := r.apiClient.DeleteOrganizationDetectorWorkflowWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Id.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *AlertMonitorLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState3PartPath("organization", "alert_id", "monitor_id")(ctx, req, resp)
+}
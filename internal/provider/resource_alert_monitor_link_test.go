@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+func TestAlertMonitorLinkResourceModel_Fill(t *testing.T) {
+	var link apiclient.OrganizationDetectorWorkflow
+	if err := json.Unmarshal([]byte(`{"id": "7", "detectorId": "12", "workflowId": "34"}`), &link); err != nil {
+		t.Fatal(err)
+	}
+
+	var data AlertMonitorLinkResourceModel
+	if err := data.Fill(link); err != nil {
+		t.Fatalf("Fill() returned error: %v", err)
+	}
+
+	if got, want := data.Id.ValueString(), "7"; got != want {
+		t.Errorf("id = %q, want %q", got, want)
+	}
+
+	body := data.ToRequestBody()
+	if got, want := body.DetectorId, "12"; got != want {
+		t.Errorf("detectorId = %q, want %q", got, want)
+	}
+	if got, want := body.WorkflowId, "34"; got != want {
+		t.Errorf("workflowId = %q, want %q", got, want)
+	}
+}
+
+func TestAlertResourceModel_UnsetMonitorIds(t *testing.T) {
+	ctx := context.Background()
+
+	var workflow apiclient.OrganizationWorkflow
+	if err := json.Unmarshal([]byte(`{
+		"id": "34",
+		"name": "alert",
+		"enabled": true,
+		"environment": null,
+		"config": {"frequency": 60},
+		"detectorIds": ["12", "56"],
+		"triggers": {"logicType": "any-short", "conditions": []},
+		"actionFilters": []
+	}`), &workflow); err != nil {
+		t.Fatal(err)
+	}
+
+	data := AlertResourceModel{MonitorIds: supertypes.NewSetValueOfNull[string](ctx)}
+	if diags := data.Fill(ctx, workflow); diags.HasError() {
+		t.Fatalf("Fill() returned error: %v", diags)
+	}
+	monitorIds, diags := data.MonitorIds.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("monitor_ids: %v", diags)
+	}
+	slices.Sort(monitorIds)
+	if diff := cmp.Diff([]string{"12", "56"}, monitorIds); diff != "" {
+		t.Errorf("monitor_ids mismatch (-want +got):\n%s", diff)
+	}
+
+	data.MonitorIds = supertypes.NewSetValueOfUnknown[string](ctx)
+	body, diags := (&AlertResource{}).getUpdateJSONRequestBody(ctx, data)
+	if diags.HasError() {
+		t.Fatalf("getUpdateJSONRequestBody() returned error: %v", diags)
+	}
+	if body.DetectorIds != nil {
+		t.Errorf("detectorIds = %v, want omitted", *body.DetectorIds)
+	}
+}
+
+func TestAccAlertMonitorLinkResource(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-project")
+	monitorName := acctest.RandomWithPrefix("tf-monitor")
+	alertName := acctest.RandomWithPrefix("tf-alert")
+	rn := "sentry_alert_monitor_link.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertMonitorLinkResourceConfig(projectName, monitorName, alertName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.CompareValuePairs(rn, tfjsonpath.New("alert_id"), "sentry_alert.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs(rn, tfjsonpath.New("monitor_id"), "sentry_metric_monitor.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("sentry_alert.test", tfjsonpath.New("monitor_ids"), knownvalue.SetSizeExact(1)),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState3PartIDFunc(rn, "organization", "alert_id", "monitor_id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAlertMonitorLinkResourceConfig(projectName, monitorName, alertName string) string {
+	return testAccMetricMonitorResourceConfig(projectName, monitorName, `
+		aggregate = "count()"
+		dataset = "events"
+		event_types = ["default", "error"]
+
+		condition_group = {
+			conditions = [
+				{
+					type = "gt"
+					comparison = 100
+					condition_result = 75
+				},
+			]
+		}
+
+		issue_detection = {
+			type = "static"
+		}
+	`) + fmt.Sprintf(`
+		resource "sentry_alert" "test" {
+			organization = %[1]q
+			name         = %[2]q
+
+			frequency_minutes = 1440
+
+			trigger_conditions = [
+				{ first_seen_event = {} },
+			]
+
+			action_filters = [
+				{
+					logic_type = "all"
+					actions = [
+						{
+							email = {
+								target_type      = "issue_owners"
+								fallthrough_type = "AllMembers"
+							}
+						},
+					]
+				}
+			]
+		}
+
+		resource "sentry_alert_monitor_link" "test" {
+			organization = %[1]q
+			alert_id     = sentry_alert.test.id
+			monitor_id   = sentry_metric_monitor.test.id
+		}
+	`, acctest.TestOrganization, alertName)
+}
@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+)
+
+var _ resource.Resource = &AlertSnoozeResource{}
+var _ resource.ResourceWithConfigure = &AlertSnoozeResource{}
+var _ resource.ResourceWithModifyPlan = &AlertSnoozeResource{}
+var _ resource.ResourceWithValidateConfig = &AlertSnoozeResource{}
+
+func NewAlertSnoozeResource() resource.Resource {
+	return &AlertSnoozeResource{}
+}
+
+type AlertSnoozeResource struct {
+	baseResource
+}
+
+func (r *AlertSnoozeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_snooze"
+}
+
+func (r *AlertSnoozeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Snoozes an alert, e.g. during planned maintenance. The snooze is lifted when this resource is destroyed.\n\n" +
+			"Once `until` has passed, Terraform plans to lift the snooze and warns that the resource can be removed from the configuration.\n\n" +
+			"An issue alert ([`sentry_issue_alert`](issue_alert.md)) can be snoozed for yourself or for everyone. An alert ([`sentry_alert`](alert.md)) can only be snoozed for everyone and is snoozed by disabling it, so add `enabled` to its `lifecycle.ignore_changes` while it is snoozed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project of the issue alert. Required with `issue_alert_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_alert_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue alert to snooze. Conflicts with `alert_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("alert_id")),
+					stringvalidator.AlsoRequires(path.MatchRoot("project")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alert_id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the alert to snooze. Conflicts with `issue_alert_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Who the alert is snoozed for: `me` for the user the provider authenticates as, or `everyone`. Only `everyone` is supported with `alert_id`. Defaults to `everyone`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("everyone"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("me", "everyone"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "The date the snooze ends, in RFC 3339 format, e.g. `2025-01-01T06:00:00Z`. The alert is snoozed indefinitely if not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					tfutils.RFC3339(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the snooze is in effect. This is `false` once `until` has passed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AlertSnoozeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AlertSnoozeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AlertId.IsNull() && data.Target.ValueString() == "me" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target"),
+			"Invalid Attribute Combination",
+			"Alerts can only be snoozed for everyone. Set target to `everyone` or snooze an issue alert instead.",
+		)
+	}
+}
+
+func (r *AlertSnoozeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyUntilPlan(ctx, req, resp, "Alert snooze expired", "snooze")
+}
+
+// modifyUntilPlan plans `active` for a resource that is lifted once its
+// `until` date has passed, and warns that the expired resource can be removed.
+func modifyUntilPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, summary string, noun string) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var until types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("until"), &until)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	active := types.BoolValue(true)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("active"), &active)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if isUntilExpired(until, time.Now()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("until"),
+			summary,
+			fmt.Sprintf("The %s expired at %s and is lifted. This resource can be removed from the configuration.", noun, until.ValueString()),
+		)
+		active = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("active"), active)...)
+}
+
+func (r *AlertSnoozeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AlertSnoozeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IssueAlertId.IsNull() {
+		data.Id = data.AlertId
+	} else {
+		data.Id = data.IssueAlertId
+	}
+
+	// An expired snooze is planned as inactive and has nothing to apply.
+	if data.Active.ValueBool() {
+		resp.Diagnostics.Append(r.snooze(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertSnoozeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AlertSnoozeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is nothing to compare with once the snooze has been lifted.
+	if !data.Active.ValueBool() {
+		return
+	}
+
+	var snoozed bool
+	if !data.IssueAlertId.IsNull() {
+		httpResp, err := r.apiClient.GetProjectRuleWithResponse(
+			ctx,
+			data.Organization.ValueString(),
+			data.Project.ValueString(),
+			data.IssueAlertId.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+			return
+		} else if httpResp.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.Append(diagutils.NewNotFoundError("issue alert"))
+			resp.State.RemoveResource(ctx)
+			return
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+
+		snoozed = data.isSnoozed(*httpResp.JSON200)
+	} else {
+		httpResp, err := r.apiClient.GetOrganizationWorkflowWithResponse(
+			ctx,
+			data.Organization.ValueString(),
+			data.AlertId.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+			return
+		} else if httpResp.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.Append(diagutils.NewNotFoundError("alert"))
+			resp.State.RemoveResource(ctx)
+			return
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+
+		snoozed = !httpResp.JSON200.Enabled
+	}
+
+	if !snoozed {
+		if !data.isExpired(time.Now()) {
+			// The snooze was lifted outside of Terraform.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		// Sentry lifts issue alert snoozes once they end.
+		data.Active = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertSnoozeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so the only change is
+	// lifting an expired snooze.
+	var data, state AlertSnoozeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Active.ValueBool() && !data.Active.ValueBool() {
+		resp.Diagnostics.Append(r.unsnooze(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlertSnoozeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AlertSnoozeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Active.ValueBool() {
+		resp.Diagnostics.Append(r.unsnooze(ctx, data)...)
+	}
+}
+
+func (r *AlertSnoozeResource) snooze(ctx context.Context, data AlertSnoozeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.IssueAlertId.IsNull() {
+		diags.Append(r.setAlertEnabled(ctx, data, false)...)
+		return diags
+	}
+
+	body, err := data.ToRequestBody()
+	if err != nil {
+		diags.AddAttributeError(path.Root("until"), "Invalid Attribute Value", err.Error())
+		return diags
+	}
+
+	httpResp, err := r.apiClient.CreateProjectRuleSnoozeWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		data.IssueAlertId.ValueString(),
+		body,
+	)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("create", err))
+	} else if httpResp.StatusCode() == http.StatusGone {
+		diags.AddError("Client Error", fmt.Sprintf("Issue alert %s is already snoozed for %s.", data.IssueAlertId.ValueString(), data.Target.ValueString()))
+	} else if httpResp.StatusCode() != http.StatusCreated {
+		diags.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+	}
+	return diags
+}
+
+func (r *AlertSnoozeResource) unsnooze(ctx context.Context, data AlertSnoozeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.IssueAlertId.IsNull() {
+		diags.Append(r.setAlertEnabled(ctx, data, true)...)
+		return diags
+	}
+
+	httpResp, err := r.apiClient.DeleteProjectRuleSnoozeWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		data.IssueAlertId.ValueString(),
+		apiclient.RuleSnoozeDeleteRequest{
+			Target: apiclient.RuleSnoozeDeleteRequestTarget(data.Target.ValueString()),
+		},
+	)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("delete", err))
+	} else if httpResp.StatusCode() != http.StatusNoContent && httpResp.StatusCode() != http.StatusNotFound {
+		diags.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+	}
+	return diags
+}
+
+func (r *AlertSnoozeResource) setAlertEnabled(ctx context.Context, data AlertSnoozeResourceModel, enabled bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.apiClient.BulkUpdateOrganizationWorkflowsWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		&apiclient.BulkUpdateOrganizationWorkflowsParams{
+			Id: []string{data.AlertId.ValueString()},
+		},
+		apiclient.BulkEnabledRequest{
+			Enabled: enabled,
+		},
+	)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("update", err))
+	} else if httpResp.StatusCode() == http.StatusNotFound && enabled {
+		// The alert was deleted, so there is no snooze to lift.
+		return diags
+	} else if httpResp.StatusCode() != http.StatusOK {
+		diags.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+	}
+	return diags
+}
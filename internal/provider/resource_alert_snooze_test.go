@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+)
+
+func TestAlertSnoozeResourceModel_isExpired(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name  string
+		until types.String
+		want  bool
+	}{
+		{"indefinite", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+		{"future", types.StringValue("2025-01-01T13:00:00Z"), false},
+		{"now", types.StringValue("2025-01-01T12:00:00Z"), true},
+		{"past", types.StringValue("2025-01-01T11:00:00Z"), true},
+		{"past in another zone", types.StringValue("2025-01-01T12:30:00+01:00"), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := AlertSnoozeResourceModel{Until: tc.until}
+			if got := m.isExpired(now); got != tc.want {
+				t.Errorf("isExpired() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAlertSnoozeResourceModel_isSnoozed(t *testing.T) {
+	testCases := []struct {
+		name   string
+		body   string
+		target string
+		want   bool
+	}{
+		{"not snoozed", `{"snooze": false}`, "everyone", false},
+		{"snoozed for everyone", `{"snooze": true, "snoozeForEveryone": true}`, "everyone", true},
+		{"snoozed for me", `{"snooze": true, "snoozeForEveryone": false}`, "me", true},
+		{"snoozed for me, want everyone", `{"snooze": true, "snoozeForEveryone": false}`, "everyone", false},
+		{"snoozed for everyone, want me", `{"snooze": true, "snoozeForEveryone": true}`, "me", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rule apiclient.ProjectRule
+			if err := json.Unmarshal([]byte(tc.body), &rule); err != nil {
+				t.Fatal(err)
+			}
+
+			m := AlertSnoozeResourceModel{Target: types.StringValue(tc.target)}
+			if got := m.isSnoozed(rule); got != tc.want {
+				t.Errorf("isSnoozed() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAlertSnoozeResourceModel_ToRequestBody(t *testing.T) {
+	testCases := []struct {
+		name  string
+		until types.String
+		want  string
+	}{
+		{"indefinite", types.StringNull(), `{"target":"me"}`},
+		{"until", types.StringValue("2025-01-01T06:00:00Z"), `{"target":"me","until":"2025-01-01T06:00:00Z"}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := AlertSnoozeResourceModel{Target: types.StringValue("me"), Until: tc.until}
+			body, err := m.ToRequestBody()
+			if err != nil {
+				t.Fatalf("ToRequestBody() returned error: %v", err)
+			}
+
+			got, err := json.Marshal(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("ToRequestBody() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAccAlertSnoozeResource(t *testing.T) {
+	rn := "sentry_alert_snooze.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	project := acctest.RandomWithPrefix("tf-project")
+	alert := acctest.RandomWithPrefix("tf-issue-alert")
+	until := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAlertSnoozeResourceConfig(team, project, alert, `until = "tomorrow"`),
+				ExpectError: acctest.ExpectLiteralError(`Value must be a timestamp in RFC 3339 format`),
+			},
+			{
+				Config: testAccAlertSnoozeResourceConfig(team, project, alert, fmt.Sprintf(`until = %q`, until)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(rn, tfjsonpath.New("id"), "sentry_issue_alert.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("target"), knownvalue.StringExact("everyone")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("until"), knownvalue.StringExact(until)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("active"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testAccAlertSnoozeResourceConfig(team, project, alert, `until = "2020-01-01T00:00:00Z"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("active"), knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func testAccAlertSnoozeResourceConfig(team, project, alert, extras string) string {
+	return testAccIssueAlertConfig(team, project, alert, `
+		conditions_v2 = [
+			{ first_seen_event = {} },
+		]
+		actions_v2 = [
+			{ notify_event = {} },
+		]
+	`) + fmt.Sprintf(`
+resource "sentry_alert_snooze" "test" {
+	organization   = sentry_issue_alert.test.organization
+	project        = sentry_project.test.slug
+	issue_alert_id = sentry_issue_alert.test.id
+
+	%[1]s
+}
+`, extras)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -17,6 +19,7 @@ import (
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
 
 func init() {
@@ -72,6 +75,259 @@ func init() {
 	})
 }
 
+func TestAlertResourceModel_ActionFilterConditionsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	// Conditions as returned by the workflows API, one per data condition type.
+	testCases := []struct {
+		name      string
+		condition string
+		check     func(t *testing.T, condition AlertResourceModelActionFiltersItemConditionsItem)
+	}{
+		{
+			name:      "issue_open_duration",
+			condition: `{"id": "1", "type": "issue_open_duration", "comparison": {"time": "day", "value": 3}, "conditionResult": true}`,
+		},
+		{
+			name:      "issue_priority_equals",
+			condition: `{"id": "2", "type": "issue_priority_equals", "comparison": 75, "conditionResult": true}`,
+		},
+		{
+			name:      "issue_resolution_change",
+			condition: `{"id": "3", "type": "issue_resolution_change", "comparison": 1, "conditionResult": true}`,
+		},
+		{
+			name:      "event_seen_count",
+			condition: `{"id": "4", "type": "event_seen_count", "comparison": 10, "conditionResult": true}`,
+		},
+		{
+			name:      "existing_high_priority_issue",
+			condition: `{"id": "5", "type": "existing_high_priority_issue", "comparison": true, "conditionResult": true}`,
+		},
+		{
+			name:      "new_high_priority_issue",
+			condition: `{"id": "6", "type": "new_high_priority_issue", "comparison": true, "conditionResult": true}`,
+		},
+		{
+			name:      "every_event",
+			condition: `{"id": "7", "type": "every_event", "comparison": true, "conditionResult": true}`,
+		},
+		{
+			name: "event_unique_user_frequency_percent",
+			condition: `{
+				"id": "8",
+				"type": "event_unique_user_frequency_percent",
+				"comparison": {
+					"value": 50,
+					"interval": "1h",
+					"comparisonInterval": "1w",
+					"filters": [{"key": "environment", "match": "eq", "value": "production"}]
+				},
+				"conditionResult": true
+			}`,
+		},
+		{
+			name:      "event_created_by_detector",
+			condition: `{"id": "9", "type": "event_created_by_detector", "comparison": 123456, "conditionResult": true}`,
+			check: func(t *testing.T, condition AlertResourceModelActionFiltersItemConditionsItem) {
+				eventCreatedByDetector, diags := condition.EventCreatedByDetector.Get(ctx)
+				if diags.HasError() {
+					t.Fatalf("event_created_by_detector: %v", diags)
+				}
+				if got, want := eventCreatedByDetector.MonitorId.ValueString(), "123456"; got != want {
+					t.Errorf("event_created_by_detector.monitor_id = %q, want %q", got, want)
+				}
+			},
+		},
+		{
+			name:      "seer_activity_trigger",
+			condition: `{"id": "10", "type": "seer_activity_trigger", "comparison": true, "conditionResult": true}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var workflow apiclient.OrganizationWorkflow
+			if err := json.Unmarshal([]byte(`{
+				"id": "1",
+				"name": "tf-alert",
+				"enabled": true,
+				"config": {"frequency": 30},
+				"detectorIds": ["2"],
+				"triggers": {"logicType": "any-short", "conditions": []},
+				"actionFilters": [
+					{
+						"logicType": "all",
+						"conditions": [`+tc.condition+`],
+						"actions": [
+							{"type": "email", "config": {"targetType": "issue_owners"}, "data": {"fallthroughType": "ActiveMembers"}}
+						]
+					}
+				]
+			}`), &workflow); err != nil {
+				t.Fatal(err)
+			}
+
+			var data AlertResourceModel
+			if diags := data.Fill(ctx, workflow); diags.HasError() {
+				t.Fatalf("Fill() returned errors: %v", diags)
+			}
+
+			actionFilters, diags := data.ActionFilters.Get(ctx)
+			if diags.HasError() {
+				t.Fatalf("action_filters: %v", diags)
+			}
+			filledConditions, diags := actionFilters[0].Conditions.Get(ctx)
+			if diags.HasError() {
+				t.Fatalf("conditions: %v", diags)
+			}
+			if got, want := len(filledConditions), 1; got != want {
+				t.Fatalf("len(conditions) = %d, want %d", got, want)
+			}
+			if tc.check != nil {
+				tc.check(t, *filledConditions[0])
+			}
+
+			outActionFilters, diags := (&AlertResource{}).getActionFilters(ctx, data)
+			if diags.HasError() {
+				t.Fatalf("getActionFilters() returned errors: %v", diags)
+			}
+
+			outConditionsJson, err := json.Marshal(outActionFilters[0].Conditions)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []map[string]any
+			if err := json.Unmarshal(outConditionsJson, &got); err != nil {
+				t.Fatal(err)
+			}
+
+			// The condition ID is assigned by Sentry and not sent back.
+			var want map[string]any
+			if err := json.Unmarshal([]byte(tc.condition), &want); err != nil {
+				t.Fatal(err)
+			}
+			delete(want, "id")
+
+			if diff := cmp.Diff([]map[string]any{want}, got); diff != "" {
+				t.Errorf("conditions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAlertResourceModel_RawRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	var workflow apiclient.OrganizationWorkflow
+	if err := json.Unmarshal([]byte(`{
+		"id": "1",
+		"name": "tf-alert",
+		"enabled": true,
+		"config": {"frequency": 30},
+		"detectorIds": ["2"],
+		"triggers": {
+			"logicType": "any-short",
+			"conditions": [
+				{"id": "1", "type": "first_seen_event", "comparison": true, "conditionResult": true},
+				{"id": "2", "type": "issue_escalating_trigger", "comparison": {"window": "1h"}, "conditionResult": false},
+				{"id": "3", "type": "new_high_priority_issue", "comparison": true, "conditionResult": true}
+			]
+		},
+		"actionFilters": [
+			{
+				"logicType": "all",
+				"conditions": [
+					{"id": "4", "type": "issue_custom_field", "comparison": {"field": "team", "value": "backend"}, "conditionResult": false}
+				],
+				"actions": [
+					{"type": "linear", "integrationId": "123", "config": {"targetType": "specific", "targetIdentifier": "team-1"}, "data": {"labels": ["bug"]}},
+					{"type": "incident_io", "config": {"targetType": "specific"}, "data": {}}
+				]
+			}
+		]
+	}`), &workflow); err != nil {
+		t.Fatal(err)
+	}
+
+	data := AlertResourceModel{
+		LegacyTriggerConditions: supertypes.NewListValueOfSlice(ctx, []string{"new_high_priority_issue"}),
+	}
+	if diags := data.Fill(ctx, workflow); diags.HasError() {
+		t.Fatalf("Fill() returned errors: %v", diags)
+	}
+
+	legacyTriggerConditions, diags := data.LegacyTriggerConditions.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("legacy_trigger_conditions: %v", diags)
+	}
+	if diff := cmp.Diff([]string{"new_high_priority_issue"}, legacyTriggerConditions); diff != "" {
+		t.Errorf("legacy_trigger_conditions mismatch (-want +got):\n%s", diff)
+	}
+
+	actionFilters, diags := data.ActionFilters.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("action_filters: %v", diags)
+	}
+	actions, diags := actionFilters[0].Actions.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("actions: %v", diags)
+	}
+	incidentIo, diags := actions[1].Raw.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("actions.1.raw: %v", diags)
+	}
+	if !incidentIo.IntegrationId.IsNull() || !incidentIo.DataJson.IsNull() {
+		t.Errorf("actions.1.raw = %+v, want null integration_id and data_json", incidentIo)
+	}
+
+	r := &AlertResource{}
+	toJson := func(v any) (out any) {
+		t.Helper()
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(b, &out); err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	outTriggerConditions, diags := r.getTriggerConditions(ctx, data)
+	if diags.HasError() {
+		t.Fatalf("getTriggerConditions() returned errors: %v", diags)
+	}
+	wantTriggerConditions := toJson([]map[string]any{
+		{"type": "first_seen_event", "comparison": true, "conditionResult": true},
+		{"type": "issue_escalating_trigger", "comparison": map[string]any{"window": "1h"}, "conditionResult": false},
+		{"type": "new_high_priority_issue", "comparison": true, "conditionResult": true},
+	})
+	if diff := cmp.Diff(wantTriggerConditions, toJson(outTriggerConditions)); diff != "" {
+		t.Errorf("trigger conditions mismatch (-want +got):\n%s", diff)
+	}
+
+	outActionFilters, diags := r.getActionFilters(ctx, data)
+	if diags.HasError() {
+		t.Fatalf("getActionFilters() returned errors: %v", diags)
+	}
+	wantActionFilters := toJson([]map[string]any{
+		{
+			"logicType": "all",
+			"conditions": []map[string]any{
+				{"type": "issue_custom_field", "comparison": map[string]any{"field": "team", "value": "backend"}, "conditionResult": false},
+			},
+			"actions": []map[string]any{
+				{"type": "linear", "integrationId": "123", "config": map[string]any{"targetType": "specific", "targetIdentifier": "team-1"}, "data": map[string]any{"labels": []string{"bug"}}},
+				{"type": "incident_io", "config": map[string]any{"targetType": "specific"}, "data": map[string]any{}},
+			},
+		},
+	})
+	if diff := cmp.Diff(wantActionFilters, toJson(outActionFilters)); diff != "" {
+		t.Errorf("action filters mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestAccAlertResource_validation(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -181,6 +437,45 @@ func TestAccAlertResource_validation(t *testing.T) {
 				`,
 				ExpectError: acctest.ExpectLiteralError(`Attribute "action_filters[0].conditions[0].assigned_to" cannot be specified when "action_filters[0].conditions[0].age_comparison" is specified`),
 			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_alert" "test" {
+						organization = "1"
+						name         = "alert name"
+
+						frequency_minutes = 1440
+						environment       = "production"
+						monitor_ids       = ["1"]
+
+						trigger_conditions = []
+
+						action_filters = [
+							{
+								logic_type = "all"
+								conditions = [
+									{
+										percent_sessions_percent = {
+											value               = 10
+											interval            = "1m"
+											comparison_interval = "1m"
+										}
+									}
+								]
+								actions = [
+									{
+										email = {
+											target_type = "issue_owners"
+											fallthrough_type = "AllMembers"
+										}
+									},
+								]
+							}
+						]
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Attribute action_filters[0].conditions[0].percent_sessions_percent.comparison_interval value must be one of`),
+			},
 		},
 	})
 }
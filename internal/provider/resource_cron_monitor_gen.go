@@ -132,13 +132,14 @@ func (r *CronMonitorResource) Schema(ctx context.Context, req resource.SchemaReq
 				CustomType:          supertypes.NewSingleNestedObjectTypeOf[CronMonitorResourceModelSchedule](ctx),
 				Attributes: map[string]schema.Attribute{
 					"crontab": schema.StringAttribute{
-						MarkdownDescription: "Use the crontab syntax (e.g. `0 0 * * *`). Conflicts with `interval_value` and `interval_unit`.",
+						MarkdownDescription: "Use the crontab syntax (e.g. `0 0 * * *` or `@daily`). Must not run more often than `max_runtime_minutes` or `checkin_margin_minutes`. Conflicts with `interval_value` and `interval_unit`.",
 						Optional:            true,
 						CustomType:          supertypes.StringType{},
 						Validators: []validator.String{
 							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("interval_value"), path.MatchRelative().AtParent().AtName("interval_unit")),
 							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("interval_value")),
 							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("interval_unit")),
+							tfutils.Crontab(),
 						},
 					},
 					"interval_value": schema.Int64Attribute{
@@ -174,6 +175,40 @@ func (r *CronMonitorResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 				sentrydata.Timezones,
 			),
+			"environments": schema.ListNestedAttribute{
+				MarkdownDescription: "The environments this monitor has received check-ins from. Use `sentry_monitor_mute` with `environment` to mute a single environment.",
+				Computed:            true,
+				CustomType:          supertypes.NewListNestedObjectTypeOf[CronMonitorResourceModelEnvironmentsItem](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the environment.",
+							Computed:            true,
+							CustomType:          supertypes.StringType{},
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the environment, e.g. `active`, `ok`, `error`, `missed_checkin` or `timeout`.",
+							Computed:            true,
+							CustomType:          supertypes.StringType{},
+						},
+						"is_muted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the environment is muted.",
+							Computed:            true,
+							CustomType:          supertypes.BoolType{},
+						},
+						"last_check_in": schema.StringAttribute{
+							MarkdownDescription: "The RFC 3339 date of the last check-in, or `null` if there has been none.",
+							Computed:            true,
+							CustomType:          supertypes.StringType{},
+						},
+						"next_check_in": schema.StringAttribute{
+							MarkdownDescription: "The RFC 3339 date the next check-in is expected, or `null` if unknown.",
+							Computed:            true,
+							CustomType:          supertypes.StringType{},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -313,19 +348,20 @@ func (r *CronMonitorResource) ImportState(ctx context.Context, req resource.Impo
 }
 
 type CronMonitorResourceModel struct {
-	Id                    supertypes.StringValue                                                 `tfsdk:"id"`
-	Organization          supertypes.StringValue                                                 `tfsdk:"organization"`
-	Project               supertypes.StringValue                                                 `tfsdk:"project"`
-	Enabled               supertypes.BoolValue                                                   `tfsdk:"enabled"`
-	Name                  supertypes.StringValue                                                 `tfsdk:"name"`
-	Description           supertypes.StringValue                                                 `tfsdk:"description"`
-	Owner                 supertypes.SingleNestedObjectValueOf[CronMonitorResourceModelOwner]    `tfsdk:"owner"`
-	CheckinMarginMinutes  supertypes.Int64Value                                                  `tfsdk:"checkin_margin_minutes"`
-	FailureIssueThreshold supertypes.Int64Value                                                  `tfsdk:"failure_issue_threshold"`
-	MaxRuntimeMinutes     supertypes.Int64Value                                                  `tfsdk:"max_runtime_minutes"`
-	RecoveryThreshold     supertypes.Int64Value                                                  `tfsdk:"recovery_threshold"`
-	Schedule              supertypes.SingleNestedObjectValueOf[CronMonitorResourceModelSchedule] `tfsdk:"schedule"`
-	Timezone              supertypes.StringValue                                                 `tfsdk:"timezone"`
+	Id                    supertypes.StringValue                                                       `tfsdk:"id"`
+	Organization          supertypes.StringValue                                                       `tfsdk:"organization"`
+	Project               supertypes.StringValue                                                       `tfsdk:"project"`
+	Enabled               supertypes.BoolValue                                                         `tfsdk:"enabled"`
+	Name                  supertypes.StringValue                                                       `tfsdk:"name"`
+	Description           supertypes.StringValue                                                       `tfsdk:"description"`
+	Owner                 supertypes.SingleNestedObjectValueOf[CronMonitorResourceModelOwner]          `tfsdk:"owner"`
+	CheckinMarginMinutes  supertypes.Int64Value                                                        `tfsdk:"checkin_margin_minutes"`
+	FailureIssueThreshold supertypes.Int64Value                                                        `tfsdk:"failure_issue_threshold"`
+	MaxRuntimeMinutes     supertypes.Int64Value                                                        `tfsdk:"max_runtime_minutes"`
+	RecoveryThreshold     supertypes.Int64Value                                                        `tfsdk:"recovery_threshold"`
+	Schedule              supertypes.SingleNestedObjectValueOf[CronMonitorResourceModelSchedule]       `tfsdk:"schedule"`
+	Timezone              supertypes.StringValue                                                       `tfsdk:"timezone"`
+	Environments          supertypes.ListNestedObjectValueOf[CronMonitorResourceModelEnvironmentsItem] `tfsdk:"environments"`
 }
 
 type CronMonitorResourceModelOwner struct {
@@ -338,3 +374,11 @@ type CronMonitorResourceModelSchedule struct {
 	IntervalValue supertypes.Int64Value  `tfsdk:"interval_value"`
 	IntervalUnit  supertypes.StringValue `tfsdk:"interval_unit"`
 }
+
+type CronMonitorResourceModelEnvironmentsItem struct {
+	Name        supertypes.StringValue `tfsdk:"name"`
+	Status      supertypes.StringValue `tfsdk:"status"`
+	IsMuted     supertypes.BoolValue   `tfsdk:"is_muted"`
+	LastCheckIn supertypes.StringValue `tfsdk:"last_check_in"`
+	NextCheckIn supertypes.StringValue `tfsdk:"next_check_in"`
+}
@@ -3,12 +3,104 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/crontab"
 	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
 
+var _ resource.ResourceWithValidateConfig = &CronMonitorResource{}
+
+// ValidateConfig rejects schedules that run more often than
+// `max_runtime_minutes` or `checkin_margin_minutes`, as the next check-in would
+// be due before the previous one has timed out or been marked as missed.
+func (r *CronMonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CronMonitorResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.Schedule.IsKnown() {
+		return
+	}
+
+	schedule := tfutils.MergeDiagnostics(data.Schedule.Get(ctx))(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	interval, ok := cronMonitorShortestInterval(schedule, data.Timezone)
+	if !ok {
+		return
+	}
+
+	for _, limit := range []struct {
+		name  string
+		value supertypes.Int64Value
+	}{
+		{"max_runtime_minutes", data.MaxRuntimeMinutes},
+		{"checkin_margin_minutes", data.CheckinMarginMinutes},
+	} {
+		if limit.value.IsKnown() && interval < time.Duration(limit.value.Get())*time.Minute {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schedule"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute schedule must not run more often than %s, got runs %d minutes apart while %s is %d", limit.name, int64(interval.Minutes()), limit.name, limit.value.Get()),
+			)
+		}
+	}
+}
+
+// cronMonitorShortestInterval returns the shortest time between two runs of a
+// schedule, or false if it cannot be determined from the configuration.
+func cronMonitorShortestInterval(schedule *CronMonitorResourceModelSchedule, timezone supertypes.StringValue) (time.Duration, bool) {
+	switch {
+	case schedule.Crontab.IsKnown():
+		if timezone.IsUnknown() {
+			return 0, false
+		}
+		loc := time.UTC
+		if timezone.IsKnown() {
+			var err error
+			if loc, err = time.LoadLocation(timezone.Get()); err != nil {
+				return 0, false
+			}
+		}
+
+		s, err := crontab.Parse(schedule.Crontab.Get())
+		if err != nil {
+			// Reported by the crontab validator.
+			return 0, false
+		}
+
+		// Start from a fixed leap year so that validation does not depend on
+		// when it runs.
+		interval := s.ShortestInterval(time.Date(2024, 1, 1, 0, 0, 0, 0, loc))
+		return interval, interval > 0
+
+	case schedule.IntervalValue.IsKnown() && schedule.IntervalUnit.IsKnown():
+		units := map[string]time.Duration{
+			"minute": time.Minute,
+			"hour":   time.Hour,
+			"day":    24 * time.Hour,
+			"week":   7 * 24 * time.Hour,
+			"month":  28 * 24 * time.Hour,
+			"year":   365 * 24 * time.Hour,
+		}
+		unit, ok := units[schedule.IntervalUnit.Get()]
+		if !ok {
+			return 0, false
+		}
+		return time.Duration(schedule.IntervalValue.Get()) * unit, true
+
+	default:
+		return 0, false
+	}
+}
+
 func (r *CronMonitorResource) getCreateJSONRequestBody(ctx context.Context, data CronMonitorResourceModel) (*apiclient.CreateProjectMonitorJSONRequestBody, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -213,5 +305,26 @@ func (m *CronMonitorResourceModel) Fill(ctx context.Context, data apiclient.Proj
 
 	diags.Append(m.Schedule.Set(ctx, schedule)...)
 
+	outEnvironments := make([]*CronMonitorResourceModelEnvironmentsItem, 0)
+	if dataSource.QueryObj.Environments != nil {
+		for _, inEnvironment := range *dataSource.QueryObj.Environments {
+			outEnvironment := &CronMonitorResourceModelEnvironmentsItem{
+				Name:        supertypes.NewStringValue(inEnvironment.Name),
+				Status:      supertypes.NewStringPointerValue(inEnvironment.Status),
+				IsMuted:     supertypes.NewBoolValue(inEnvironment.IsMuted),
+				LastCheckIn: supertypes.NewStringNull(),
+				NextCheckIn: supertypes.NewStringNull(),
+			}
+			if v, err := inEnvironment.LastCheckIn.Get(); err == nil {
+				outEnvironment.LastCheckIn = supertypes.NewStringValue(v.Format(time.RFC3339))
+			}
+			if v, err := inEnvironment.NextCheckIn.Get(); err == nil {
+				outEnvironment.NextCheckIn = supertypes.NewStringValue(v.Format(time.RFC3339))
+			}
+			outEnvironments = append(outEnvironments, outEnvironment)
+		}
+	}
+	diags.Append(m.Environments.Set(ctx, outEnvironments)...)
+
 	return
 }
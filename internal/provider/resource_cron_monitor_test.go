@@ -2,11 +2,13 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -16,6 +18,7 @@ import (
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
 
 func init() {
@@ -62,6 +65,126 @@ func init() {
 	})
 }
 
+func TestCronMonitorResourceModel_FillEnvironments(t *testing.T) {
+	var monitor apiclient.ProjectMonitor
+	if err := json.Unmarshal([]byte(`{
+		"id": "1",
+		"name": "nightly",
+		"type": "monitor_check_in_failure",
+		"enabled": true,
+		"dataSources": [
+			{
+				"type": "cron_monitor",
+				"queryObj": {
+					"name": "nightly",
+					"config": {"schedule_type": "crontab", "schedule": "0 0 * * *", "checkin_margin": 1, "failure_issue_threshold": 1, "max_runtime": 1, "recovery_threshold": 1, "timezone": "UTC"},
+					"environments": [
+						{"name": "production", "status": "ok", "isMuted": false, "lastCheckIn": "2025-01-01T00:00:05Z", "nextCheckIn": "2025-01-02T00:00:00Z"},
+						{"name": "staging", "status": "active", "isMuted": true, "lastCheckIn": null, "nextCheckIn": null}
+					]
+				}
+			}
+		]
+	}`), &monitor); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	var m CronMonitorResourceModel
+	if diags := m.Fill(ctx, monitor); diags.HasError() {
+		t.Fatalf("Fill() returned errors: %v", diags)
+	}
+
+	environments, diags := m.Environments.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("Environments.Get() returned errors: %v", diags)
+	}
+	if len(environments) != 2 {
+		t.Fatalf("got %d environments, want 2", len(environments))
+	}
+
+	production := environments[0]
+	if got := production.Name.ValueString(); got != "production" {
+		t.Errorf("name = %q, want %q", got, "production")
+	}
+	if got := production.Status.ValueString(); got != "ok" {
+		t.Errorf("status = %q, want %q", got, "ok")
+	}
+	if production.IsMuted.ValueBool() {
+		t.Error("is_muted = true, want false")
+	}
+	if got := production.LastCheckIn.ValueString(); got != "2025-01-01T00:00:05Z" {
+		t.Errorf("last_check_in = %q, want %q", got, "2025-01-01T00:00:05Z")
+	}
+	if got := production.NextCheckIn.ValueString(); got != "2025-01-02T00:00:00Z" {
+		t.Errorf("next_check_in = %q, want %q", got, "2025-01-02T00:00:00Z")
+	}
+
+	staging := environments[1]
+	if !staging.IsMuted.ValueBool() {
+		t.Error("is_muted = false, want true")
+	}
+	if !staging.LastCheckIn.IsNull() || !staging.NextCheckIn.IsNull() {
+		t.Errorf("last_check_in = %s, next_check_in = %s, want null", staging.LastCheckIn, staging.NextCheckIn)
+	}
+}
+
+func TestCronMonitorShortestInterval(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule CronMonitorResourceModelSchedule
+		timezone supertypes.StringValue
+		want     time.Duration
+		wantOk   bool
+	}{
+		{
+			name:     "crontab",
+			schedule: CronMonitorResourceModelSchedule{Crontab: supertypes.NewStringValue("0 9,17 * * *")},
+			timezone: supertypes.NewStringValue("Europe/London"),
+			want:     8 * time.Hour,
+			wantOk:   true,
+		},
+		{
+			name:     "crontab macro",
+			schedule: CronMonitorResourceModelSchedule{Crontab: supertypes.NewStringValue("@hourly")},
+			timezone: supertypes.NewStringNull(),
+			want:     time.Hour,
+			wantOk:   true,
+		},
+		{
+			name:     "crontab with unknown timezone",
+			schedule: CronMonitorResourceModelSchedule{Crontab: supertypes.NewStringValue("@hourly")},
+			timezone: supertypes.NewStringUnknown(),
+		},
+		{
+			name:     "invalid crontab",
+			schedule: CronMonitorResourceModelSchedule{Crontab: supertypes.NewStringValue("@often")},
+			timezone: supertypes.NewStringValue("UTC"),
+		},
+		{
+			name:     "interval",
+			schedule: CronMonitorResourceModelSchedule{Crontab: supertypes.NewStringNull(), IntervalValue: supertypes.NewInt64Value(2), IntervalUnit: supertypes.NewStringValue("week")},
+			timezone: supertypes.NewStringValue("UTC"),
+			want:     14 * 24 * time.Hour,
+			wantOk:   true,
+		},
+		{
+			name:     "unknown interval",
+			schedule: CronMonitorResourceModelSchedule{Crontab: supertypes.NewStringNull(), IntervalValue: supertypes.NewInt64Unknown(), IntervalUnit: supertypes.NewStringValue("week")},
+			timezone: supertypes.NewStringValue("UTC"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := cronMonitorShortestInterval(&tc.schedule, tc.timezone)
+			if ok != tc.wantOk || got != tc.want {
+				t.Errorf("cronMonitorShortestInterval() = %s, %t, want %s, %t", got, ok, tc.want, tc.wantOk)
+			}
+		})
+	}
+}
+
 func TestAccCronMonitorResource_validation(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -271,6 +394,73 @@ func TestAccCronMonitorResource_validation(t *testing.T) {
 					"No attribute specified when one (and only one) of [owner.user_id.<.team_id] is required",
 				),
 			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_cron_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "cron monitor name"
+
+						checkin_margin_minutes = 1
+						failure_issue_threshold = 2
+						max_runtime_minutes = 3
+						recovery_threshold = 4
+
+						schedule = {
+							crontab = "0 25 * * *"
+						}
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(
+					`Attribute schedule.crontab must be a valid crontab schedule, got: 0 25 * * *: invalid hour "25": must be between 0 and 23`,
+				),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_cron_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "cron monitor name"
+
+						checkin_margin_minutes = 1
+						failure_issue_threshold = 2
+						max_runtime_minutes = 30
+						recovery_threshold = 4
+
+						schedule = {
+							crontab = "*/15 * * * *"
+						}
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(
+					`Attribute schedule must not run more often than max_runtime_minutes, got runs 15 minutes apart while max_runtime_minutes is 30`,
+				),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_cron_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "cron monitor name"
+
+						checkin_margin_minutes = 90
+						failure_issue_threshold = 2
+						max_runtime_minutes = 3
+						recovery_threshold = 4
+
+						schedule = {
+							interval_value = 1
+							interval_unit = "hour"
+						}
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(
+					`Attribute schedule must not run more often than checkin_margin_minutes, got runs 60 minutes apart while checkin_margin_minutes is 90`,
+				),
+			},
 		},
 	})
 }
@@ -288,6 +478,7 @@ func TestAccCronMonitorResource_basic(t *testing.T) {
 			"user_id": knownvalue.Null(),
 			"team_id": knownvalue.NotNull(),
 		})),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("environments"), knownvalue.ListExact([]knownvalue.Check{})),
 	}
 
 	resource.Test(t, resource.TestCase{
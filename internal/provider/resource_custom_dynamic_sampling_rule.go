@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+type CustomDynamicSamplingRuleResourceModel struct {
+	Id           types.String                  `tfsdk:"id"`
+	Organization types.String                  `tfsdk:"organization"`
+	Query        types.String                  `tfsdk:"query"`
+	Projects     supertypes.SetValueOf[string] `tfsdk:"projects"`
+	Period       types.String                  `tfsdk:"period"`
+	StartDate    types.String                  `tfsdk:"start_date"`
+	EndDate      types.String                  `tfsdk:"end_date"`
+	NumSamples   types.Int64                   `tfsdk:"num_samples"`
+	SampleRate   types.Float64                 `tfsdk:"sample_rate"`
+}
+
+func (m *CustomDynamicSamplingRuleResourceModel) Fill(rule apiclient.CustomDynamicSamplingRule) error {
+	m.Id = types.StringValue(strconv.FormatInt(rule.RuleId, 10))
+	m.StartDate = types.StringValue(rule.StartDate.Format(time.RFC3339))
+	m.EndDate = types.StringValue(rule.EndDate.Format(time.RFC3339))
+	m.NumSamples = types.Int64Value(rule.NumSamples)
+	m.SampleRate = types.Float64Value(rule.SampleRate)
+
+	return nil
+}
+
+// isExpired reports whether the rule in the state is no longer active.
+func (m *CustomDynamicSamplingRuleResourceModel) isExpired(now time.Time) bool {
+	if m.EndDate.IsNull() || m.EndDate.IsUnknown() {
+		return false
+	}
+
+	endDate, err := time.Parse(time.RFC3339, m.EndDate.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return !endDate.After(now)
+}
+
+var _ resource.Resource = &CustomDynamicSamplingRuleResource{}
+var _ resource.ResourceWithConfigure = &CustomDynamicSamplingRuleResource{}
+var _ resource.ResourceWithModifyPlan = &CustomDynamicSamplingRuleResource{}
+
+func NewCustomDynamicSamplingRuleResource() resource.Resource {
+	return &CustomDynamicSamplingRuleResource{}
+}
+
+type CustomDynamicSamplingRuleResource struct {
+	baseResource
+}
+
+func (r *CustomDynamicSamplingRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_dynamic_sampling_rule"
+}
+
+func (r *CustomDynamicSamplingRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom dynamic sampling rule, which temporarily boosts the sample rate of transactions matching a query, e.g. while investigating an issue.\n\n" +
+			"Rules are active from `start_date` to `end_date`. Once a rule expires, Terraform plans to create a new one. Sentry does not support deleting rules, so destroying this resource only removes it from the Terraform state and the rule stays active until it expires.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the rule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The search query matching the transactions to boost, e.g. `event.type:transaction transaction:/checkout`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"projects": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects the rule applies to. The rule applies to all projects when this is not set.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "How long the rule is active for, e.g. `1h`, `2d` or `1w`. Defaults to `1h`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("1h"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[1-9][0-9]*[smhdw]$`), "must be a number followed by one of `s`, `m`, `h`, `d` or `w`"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "The date the rule became active, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "The date the rule expires, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"num_samples": schema.Int64Attribute{
+				MarkdownDescription: "The number of samples the rule collects before it stops boosting.",
+				Computed:            true,
+			},
+			"sample_rate": schema.Float64Attribute{
+				MarkdownDescription: "The sample rate applied to matching transactions.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CustomDynamicSamplingRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state CustomDynamicSamplingRuleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The rule may have expired since it was last refreshed, e.g. when planning
+	// with -refresh=false, in which case a new rule has to be created.
+	if state.isExpired(time.Now()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("end_date"),
+			"Custom dynamic sampling rule expired",
+			fmt.Sprintf("The rule expired at %s and will be replaced by a new rule.", state.EndDate.ValueString()),
+		)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("start_date"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end_date"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("num_samples"), types.Int64Unknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sample_rate"), types.Float64Unknown())...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("end_date"))
+	}
+}
+
+func (r *CustomDynamicSamplingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomDynamicSamplingRuleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects := []string{}
+	if !data.Projects.IsNull() {
+		resp.Diagnostics.Append(data.Projects.ElementsAs(ctx, &projects, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	httpResp, err := r.apiClient.CreateOrganizationCustomDynamicSamplingRuleWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		apiclient.CreateOrganizationCustomDynamicSamplingRuleJSONRequestBody{
+			Query:    data.Query.ValueString(),
+			Projects: projects,
+			Period:   data.Period.ValueString(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomDynamicSamplingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomDynamicSamplingRuleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &apiclient.GetOrganizationCustomDynamicSamplingRuleParams{
+		Query: data.Query.ValueString(),
+	}
+	if !data.Projects.IsNull() {
+		var projects []string
+		resp.Diagnostics.Append(data.Projects.ElementsAs(ctx, &projects, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		params.Project = &projects
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationCustomDynamicSamplingRuleWithResponse(ctx, data.Organization.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNoContent || httpResp.StatusCode() == http.StatusNotFound {
+		// Sentry only returns active rules.
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	if data.isExpired(time.Now()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomDynamicSamplingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so there is nothing to
+	// update in Sentry.
+	var data CustomDynamicSamplingRuleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomDynamicSamplingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomDynamicSamplingRuleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.isExpired(time.Now()) {
+		resp.Diagnostics.AddWarning(
+			"Custom dynamic sampling rule not deleted",
+			fmt.Sprintf("Sentry does not support deleting custom dynamic sampling rules. Rule %s stays active until %s.", data.Id.ValueString(), data.EndDate.ValueString()),
+		)
+	}
+}
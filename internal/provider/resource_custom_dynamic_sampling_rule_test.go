@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestCustomDynamicSamplingRuleResourceModel_isExpired(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		endDate types.String
+		want    bool
+	}{
+		{"null", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+		{"future", types.StringValue("2025-01-01T13:00:00Z"), false},
+		{"now", types.StringValue("2025-01-01T12:00:00Z"), true},
+		{"past", types.StringValue("2025-01-01T11:00:00Z"), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := CustomDynamicSamplingRuleResourceModel{EndDate: tc.endDate}
+			if got := m.isExpired(now); got != tc.want {
+				t.Errorf("isExpired() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAccCustomDynamicSamplingRuleResource(t *testing.T) {
+	rn := "sentry_custom_dynamic_sampling_rule.test"
+	projectName := acctest.RandomWithPrefix("tf-project")
+	transaction := acctest.RandomWithPrefix("/tf-transaction")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCustomDynamicSamplingRuleResourceConfig(projectName, transaction, "1x"),
+				ExpectError: regexp.MustCompile(`must be a number followed by one of`),
+			},
+			{
+				Config: testAccCustomDynamicSamplingRuleResourceConfig(projectName, transaction, "2h"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^\d+$`))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("query"), knownvalue.StringExact("event.type:transaction transaction:"+transaction)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("projects"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("period"), knownvalue.StringExact("2h")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("start_date"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("end_date"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("num_samples"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sample_rate"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccCustomDynamicSamplingRuleResourceConfig(projectName string, transaction string, period string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_project" "test" {
+	organization = data.sentry_organization.test.slug
+	teams        = [%[1]q]
+	name         = %[2]q
+	platform     = "go"
+}
+
+resource "sentry_custom_dynamic_sampling_rule" "test" {
+	organization = data.sentry_organization.test.slug
+	query        = "event.type:transaction transaction:%[3]s"
+	projects     = [sentry_project.test.internal_id]
+	period       = %[4]q
+}
+`, acctest.TestTeam.Slug, projectName, transaction, period)
+}
@@ -0,0 +1,856 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+	"github.com/samber/lo"
+)
+
+var _ resource.Resource = &DashboardResource{}
+var _ resource.ResourceWithConfigure = &DashboardResource{}
+var _ resource.ResourceWithImportState = &DashboardResource{}
+var _ resource.ResourceWithUpgradeState = &DashboardResource{}
+var _ resource.ResourceWithValidateConfig = &DashboardResource{}
+
+func NewDashboardResource() resource.Resource {
+	return &DashboardResource{}
+}
+
+type DashboardResource struct {
+	baseResource
+}
+
+func (r *DashboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (r *DashboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	relativePeriodRegexp := regexp.MustCompile(`^[1-9][0-9]*[smhdw]$`)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom dashboard. Widgets are matched by `title` and `display_type`, so reordering them in the configuration does not recreate them.",
+
+		Version: 1,
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the dashboard.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"projects": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects the dashboard is filtered to. Use `-1` for all projects. When not set, the projects of the viewing user are shown.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^-?[0-9]+$`), "must be a numeric project ID"),
+					),
+				},
+			},
+			"environments": schema.SetAttribute{
+				MarkdownDescription: "The environments the dashboard is filtered to. All environments are shown when this is not set.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "A relative time range, e.g. `24h` or `14d`. Conflicts with `start` and `end`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(relativePeriodRegexp, "must be a relative time range such as 24h or 14d"),
+					stringvalidator.ConflictsWith(path.MatchRoot("start")),
+				},
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "The start of an absolute time range, in RFC 3339 format.",
+				Optional:            true,
+				Validators: []validator.String{
+					tfutils.RFC3339(),
+					stringvalidator.AlsoRequires(path.MatchRoot("end")),
+				},
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "The end of an absolute time range, in RFC 3339 format.",
+				Optional:            true,
+				Validators: []validator.String{
+					tfutils.RFC3339(),
+					stringvalidator.AlsoRequires(path.MatchRoot("start")),
+				},
+			},
+			"utc": schema.BoolAttribute{
+				MarkdownDescription: "Whether to display times in UTC.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filters": schema.SingleNestedAttribute{
+				MarkdownDescription: "Global filters applied to every widget of the dashboard.",
+				Optional:            true,
+				CustomType:          supertypes.NewSingleNestedObjectTypeOf[DashboardFiltersModel](ctx),
+				Validators: []validator.Object{
+					objectvalidator.AtLeastOneOf(
+						path.MatchRelative().AtName("releases"),
+						path.MatchRelative().AtName("global_filters"),
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"releases": schema.SetAttribute{
+						MarkdownDescription: "The releases to filter by.",
+						Optional:            true,
+						CustomType:          supertypes.NewSetTypeOf[string](ctx),
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+					"global_filters": schema.ListNestedAttribute{
+						MarkdownDescription: "Tag filters applied to the widgets of a dataset.",
+						Optional:            true,
+						CustomType:          supertypes.NewListNestedObjectTypeOf[DashboardGlobalFilterModel](ctx),
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"dataset": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+									MarkdownDescription: "The dataset of the widgets to filter.",
+									Required:            true,
+								}, sentrydata.DashboardWidgetTypes),
+								"key": schema.StringAttribute{
+									MarkdownDescription: "The tag key to filter by.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"value": schema.StringAttribute{
+									MarkdownDescription: "The filter condition, e.g. `browser.name:[Chrome,Firefox]`.",
+									Required:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"permissions": schema.SingleNestedAttribute{
+				MarkdownDescription: "Who can edit the dashboard. Defaults to editable by everyone.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          supertypes.NewSingleNestedObjectTypeOf[DashboardPermissionsModel](ctx),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"is_editable_by_everyone": schema.BoolAttribute{
+						MarkdownDescription: "Whether every member of the organization can edit the dashboard.",
+						Required:            true,
+					},
+					"teams_with_edit_access": schema.SetAttribute{
+						MarkdownDescription: "The IDs of the teams that can edit the dashboard when `is_editable_by_everyone` is `false`. The creator of the dashboard can always edit it.",
+						Optional:            true,
+						CustomType:          supertypes.NewSetTypeOf[string](ctx),
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(
+								stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a numeric team ID"),
+							),
+						},
+					},
+				},
+			},
+			"widgets": schema.ListNestedAttribute{
+				MarkdownDescription: "The widgets of the dashboard.",
+				Optional:            true,
+				CustomType:          supertypes.NewListNestedObjectTypeOf[DashboardWidgetModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the widget.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the widget.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the widget.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"display_type": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+							MarkdownDescription: "How the widget is displayed.",
+							Required:            true,
+						}, sentrydata.DashboardWidgetDisplayTypes),
+						"widget_type": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+							MarkdownDescription: "The dataset the widget queries.",
+							Optional:            true,
+							Computed:            true,
+						}, sentrydata.DashboardWidgetTypes),
+						"interval": schema.StringAttribute{
+							MarkdownDescription: "The interval of the widget's chart, e.g. `5m`.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(relativePeriodRegexp, "must be an interval such as 5m or 1h"),
+							},
+						},
+						"limit": schema.Int64Attribute{
+							MarkdownDescription: "The number of series to display.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 10),
+							},
+						},
+						"dataset_source": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+							MarkdownDescription: "How the dataset of the widget was determined.",
+							Optional:            true,
+							Computed:            true,
+						}, sentrydata.DashboardDatasetSources),
+						"thresholds": schema.SingleNestedAttribute{
+							MarkdownDescription: "Thresholds for coloring a `big_number` widget.",
+							Optional:            true,
+							CustomType:          supertypes.NewSingleNestedObjectTypeOf[DashboardWidgetThresholdsModel](ctx),
+							Attributes: map[string]schema.Attribute{
+								"max1": schema.Float64Attribute{
+									MarkdownDescription: "The upper bound of the good range.",
+									Optional:            true,
+								},
+								"max2": schema.Float64Attribute{
+									MarkdownDescription: "The upper bound of the meh range.",
+									Optional:            true,
+									Validators: []validator.Float64{
+										float64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("max1")),
+									},
+								},
+								"unit": schema.StringAttribute{
+									MarkdownDescription: "The unit of the thresholds, e.g. `millisecond`.",
+									Optional:            true,
+								},
+							},
+						},
+						"layout": schema.SingleNestedAttribute{
+							MarkdownDescription: "The position and size of the widget on the grid. Sentry places the widget automatically when this is not set.",
+							Optional:            true,
+							CustomType:          supertypes.NewSingleNestedObjectTypeOf[DashboardWidgetLayoutModel](ctx),
+							Attributes: map[string]schema.Attribute{
+								"x": schema.Int64Attribute{
+									MarkdownDescription: "The column of the widget.",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.Between(0, 5),
+									},
+								},
+								"y": schema.Int64Attribute{
+									MarkdownDescription: "The row of the widget.",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+									},
+								},
+								"w": schema.Int64Attribute{
+									MarkdownDescription: "The width of the widget, in columns.",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.Between(1, 6),
+									},
+								},
+								"h": schema.Int64Attribute{
+									MarkdownDescription: "The height of the widget, in rows.",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"min_h": schema.Int64Attribute{
+									MarkdownDescription: "The minimum height of the widget. Defaults to `1`.",
+									Optional:            true,
+									Computed:            true,
+									Default:             int64default.StaticInt64(1),
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+						},
+						"queries": schema.ListNestedAttribute{
+							MarkdownDescription: "The queries of the widget.",
+							Required:            true,
+							CustomType:          supertypes.NewListNestedObjectTypeOf[DashboardWidgetQueryModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "The ID of the query.",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The legend alias of the query.",
+										Optional:            true,
+										Computed:            true,
+										Default:             stringdefault.StaticString(""),
+									},
+									"fields": schema.ListAttribute{
+										MarkdownDescription: "The fields of the query, in order.",
+										Optional:            true,
+										Computed:            true,
+										CustomType:          supertypes.NewListTypeOf[string](ctx),
+									},
+									"aggregates": schema.ListAttribute{
+										MarkdownDescription: "The aggregates of the query, e.g. `count()`.",
+										Optional:            true,
+										Computed:            true,
+										CustomType:          supertypes.NewListTypeOf[string](ctx),
+									},
+									"columns": schema.ListAttribute{
+										MarkdownDescription: "The columns of the query to group by.",
+										Optional:            true,
+										Computed:            true,
+										CustomType:          supertypes.NewListTypeOf[string](ctx),
+									},
+									"field_aliases": schema.ListAttribute{
+										MarkdownDescription: "The display aliases of the fields, in order.",
+										Optional:            true,
+										Computed:            true,
+										CustomType:          supertypes.NewListTypeOf[string](ctx),
+									},
+									"conditions": schema.StringAttribute{
+										MarkdownDescription: "The search conditions of the query.",
+										Optional:            true,
+										Computed:            true,
+										Default:             stringdefault.StaticString(""),
+									},
+									"order_by": schema.StringAttribute{
+										MarkdownDescription: "The field to sort the results by. Prefix with `-` to sort in descending order.",
+										Optional:            true,
+										Computed:            true,
+										Default:             stringdefault.StaticString(""),
+									},
+									"is_hidden": schema.BoolAttribute{
+										MarkdownDescription: "Whether the query is hidden from the chart.",
+										Optional:            true,
+										Computed:            true,
+										Default:             booldefault.StaticBool(false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"definition_json": schema.StringAttribute{
+				MarkdownDescription: "The dashboard as exported from Sentry, in JSON format. Use this instead of `projects`, `environments`, `period`, `start`, `end`, `filters` and `widgets` to manage a dashboard designed in the Sentry UI. Keys set by Sentry, such as IDs and dates, are ignored, as are values Sentry fills in for keys left `null`. The `title` in the definition must match `title`, and `utc` and `permissions` take precedence over the definition.",
+				Optional:            true,
+				CustomType: sentrytypes.LossyJsonType{
+					IgnoreKeys: dashboardDefinitionIgnoreKeys,
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("projects"),
+						path.MatchRoot("environments"),
+						path.MatchRoot("period"),
+						path.MatchRoot("start"),
+						path.MatchRoot("end"),
+						path.MatchRoot("filters"),
+						path.MatchRoot("widgets"),
+					),
+				},
+			},
+			"internal_id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the dashboard.",
+				DeprecationMessage:  "Use `id` instead.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DashboardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DefinitionJson.IsNull() || data.DefinitionJson.IsUnknown() {
+		return
+	}
+
+	definition, err := decodeDashboardDefinition(data.DefinitionJson.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition_json"), "Invalid dashboard definition", err.Error())
+		return
+	}
+
+	if title, ok := definition["title"]; ok && !data.Title.IsUnknown() && title != data.Title.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("definition_json"),
+			"Invalid dashboard definition",
+			fmt.Sprintf("The title in the definition, %q, must match the title of the dashboard, %q.", title, data.Title.ValueString()),
+		)
+	}
+}
+
+func (r *DashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DashboardResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpResp *apiclient.CreateOrganizationDashboardResponse
+	var err error
+
+	if data.DefinitionJson.IsNull() {
+		body, diags := data.ToRequestBody(ctx, nil)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		httpResp, err = r.apiClient.CreateOrganizationDashboardWithResponse(ctx, data.Organization.ValueString(), body)
+	} else {
+		body, diags := data.DefinitionRequestBody(ctx, nil)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		httpResp, err = r.apiClient.CreateOrganizationDashboardWithBodyWithResponse(ctx, data.Organization.ValueString(), "application/json", bytes.NewReader(body))
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON201)...)
+	if !data.DefinitionJson.IsNull() {
+		resp.Diagnostics.Append(data.FillDefinitionJson(httpResp.Body)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DashboardResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationDashboardWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("dashboard"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON200)...)
+	if !data.DefinitionJson.IsNull() {
+		resp.Diagnostics.Append(data.FillDefinitionJson(httpResp.Body)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DashboardResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpResp *apiclient.UpdateOrganizationDashboardResponse
+	var err error
+
+	if data.DefinitionJson.IsNull() {
+		var priorWidgets []DashboardWidgetModel
+		if !state.Widgets.IsNull() {
+			resp.Diagnostics.Append(state.Widgets.ElementsAs(ctx, &priorWidgets, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		body, diags := data.ToRequestBody(ctx, priorWidgets)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		httpResp, err = r.apiClient.UpdateOrganizationDashboardWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString(), body)
+	} else {
+		// The state holds the definition as configured, without widget IDs, so
+		// look up the current widgets to update them in place.
+		currentResp, getErr := r.apiClient.GetOrganizationDashboardWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+		if getErr != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("read", getErr))
+			return
+		} else if currentResp.StatusCode() != http.StatusOK || currentResp.JSON200 == nil {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("read", currentResp.StatusCode(), currentResp.Body))
+			return
+		}
+
+		body, diags := data.DefinitionRequestBody(ctx, currentResp.JSON200.Widgets)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		httpResp, err = r.apiClient.UpdateOrganizationDashboardWithBodyWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString(), "application/json", bytes.NewReader(body))
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON200)...)
+	if !data.DefinitionJson.IsNull() {
+		resp.Diagnostics.Append(data.FillDefinitionJson(httpResp.Body)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DashboardResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteOrganizationDashboardWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *DashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "id")(ctx, req, resp)
+}
+
+func (r *DashboardResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	type layoutModelV0 struct {
+		X    types.Int64 `tfsdk:"x"`
+		Y    types.Int64 `tfsdk:"y"`
+		W    types.Int64 `tfsdk:"w"`
+		H    types.Int64 `tfsdk:"h"`
+		MinH types.Int64 `tfsdk:"min_h"`
+	}
+
+	type queryModelV0 struct {
+		Id           types.String `tfsdk:"id"`
+		Fields       []string     `tfsdk:"fields"`
+		Aggregates   []string     `tfsdk:"aggregates"`
+		Columns      []string     `tfsdk:"columns"`
+		FieldAliases []string     `tfsdk:"field_aliases"`
+		Name         types.String `tfsdk:"name"`
+		Conditions   types.String `tfsdk:"conditions"`
+		OrderBy      types.String `tfsdk:"order_by"`
+	}
+
+	type widgetModelV0 struct {
+		Id          types.String    `tfsdk:"id"`
+		Title       types.String    `tfsdk:"title"`
+		DisplayType types.String    `tfsdk:"display_type"`
+		Interval    types.String    `tfsdk:"interval"`
+		Query       []queryModelV0  `tfsdk:"query"`
+		WidgetType  types.String    `tfsdk:"widget_type"`
+		Limit       types.Int64     `tfsdk:"limit"`
+		Layout      []layoutModelV0 `tfsdk:"layout"`
+	}
+
+	type modelV0 struct {
+		Id           types.String    `tfsdk:"id"`
+		Organization types.String    `tfsdk:"organization"`
+		Title        types.String    `tfsdk:"title"`
+		Widget       []widgetModelV0 `tfsdk:"widget"`
+		InternalId   types.String    `tfsdk:"internal_id"`
+	}
+
+	stringOrNull := func(v types.String) types.String {
+		if v.ValueString() == "" {
+			return types.StringNull()
+		}
+		return v
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"organization": schema.StringAttribute{
+						Required: true,
+					},
+					"title": schema.StringAttribute{
+						Required: true,
+					},
+					"internal_id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"widget": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed: true,
+								},
+								"title": schema.StringAttribute{
+									Required: true,
+								},
+								"display_type": schema.StringAttribute{
+									Required: true,
+								},
+								"interval": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+								},
+								"widget_type": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+								},
+								"limit": schema.Int64Attribute{
+									Optional: true,
+									Computed: true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"query": schema.ListNestedBlock{
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"id": schema.StringAttribute{
+												Computed: true,
+											},
+											"fields": schema.ListAttribute{
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+											},
+											"aggregates": schema.SetAttribute{
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+											},
+											"columns": schema.SetAttribute{
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+											},
+											"field_aliases": schema.ListAttribute{
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+											},
+											"name": schema.StringAttribute{
+												Optional: true,
+											},
+											"conditions": schema.StringAttribute{
+												Optional: true,
+												Computed: true,
+											},
+											"order_by": schema.StringAttribute{
+												Optional: true,
+												Computed: true,
+											},
+										},
+									},
+								},
+								"layout": schema.ListNestedBlock{
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"x": schema.Int64Attribute{
+												Required: true,
+											},
+											"y": schema.Int64Attribute{
+												Required: true,
+											},
+											"w": schema.Int64Attribute{
+												Required: true,
+											},
+											"h": schema.Int64Attribute{
+												Required: true,
+											},
+											"min_h": schema.Int64Attribute{
+												Required: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorStateData modelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				organization, dashboardId, err := resourceid.Split2Path(priorStateData.Id.ValueString(), "organization", "dashboard-id")
+				if err != nil {
+					resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+					return
+				}
+
+				widgets := lo.Map(priorStateData.Widget, func(w widgetModelV0, _ int) DashboardWidgetModel {
+					widget := DashboardWidgetModel{
+						Id:            w.Id,
+						Title:         w.Title,
+						Description:   types.StringNull(),
+						DisplayType:   w.DisplayType,
+						WidgetType:    stringOrNull(w.WidgetType),
+						Interval:      stringOrNull(w.Interval),
+						Limit:         types.Int64Null(),
+						DatasetSource: types.StringNull(),
+						Thresholds:    supertypes.NewSingleNestedObjectValueOfNull[DashboardWidgetThresholdsModel](ctx),
+						Layout:        supertypes.NewSingleNestedObjectValueOfNull[DashboardWidgetLayoutModel](ctx),
+						Queries: supertypes.NewListNestedObjectValueOfValueSlice(ctx, lo.Map(w.Query, func(q queryModelV0, _ int) DashboardWidgetQueryModel {
+							return DashboardWidgetQueryModel{
+								Id:           q.Id,
+								Name:         types.StringValue(q.Name.ValueString()),
+								Fields:       supertypes.NewListValueOfSlice(ctx, append([]string{}, q.Fields...)),
+								Aggregates:   supertypes.NewListValueOfSlice(ctx, append([]string{}, q.Aggregates...)),
+								Columns:      supertypes.NewListValueOfSlice(ctx, append([]string{}, q.Columns...)),
+								FieldAliases: supertypes.NewListValueOfSlice(ctx, append([]string{}, q.FieldAliases...)),
+								Conditions:   types.StringValue(q.Conditions.ValueString()),
+								OrderBy:      types.StringValue(q.OrderBy.ValueString()),
+								IsHidden:     types.BoolValue(false),
+							}
+						})),
+					}
+					if w.Limit.ValueInt64() > 0 {
+						widget.Limit = w.Limit
+					}
+					if len(w.Layout) > 0 {
+						widget.Layout = supertypes.NewSingleNestedObjectValueOf(ctx, &DashboardWidgetLayoutModel{
+							X:    w.Layout[0].X,
+							Y:    w.Layout[0].Y,
+							W:    w.Layout[0].W,
+							H:    w.Layout[0].H,
+							MinH: w.Layout[0].MinH,
+						})
+					}
+					return widget
+				})
+
+				upgradedStateData := DashboardResourceModel{
+					Id:             types.StringValue(dashboardId),
+					Organization:   types.StringValue(organization),
+					Title:          priorStateData.Title,
+					Projects:       supertypes.NewSetValueOfNull[string](ctx),
+					Environments:   supertypes.NewSetValueOfNull[string](ctx),
+					Period:         types.StringNull(),
+					Start:          types.StringNull(),
+					End:            types.StringNull(),
+					Utc:            types.BoolValue(false),
+					Filters:        supertypes.NewSingleNestedObjectValueOfNull[DashboardFiltersModel](ctx),
+					Permissions:    supertypes.NewSingleNestedObjectValueOfNull[DashboardPermissionsModel](ctx),
+					Widgets:        supertypes.NewListNestedObjectValueOfNull[DashboardWidgetModel](ctx),
+					DefinitionJson: sentrytypes.NewLossyJsonNull(),
+					InternalId:     types.StringValue(dashboardId),
+				}
+				if len(widgets) > 0 {
+					upgradedStateData.Widgets = supertypes.NewListNestedObjectValueOfValueSlice(ctx, widgets)
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgradedStateData)...)
+			},
+		},
+	}
+}
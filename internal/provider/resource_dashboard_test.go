@@ -2,12 +2,26 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"regexp"
 	"strings"
+	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/jianyuan/go-sentry/v2/sentry"
 	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
+	"github.com/oapi-codegen/nullable"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
 
 func init() {
@@ -50,3 +64,713 @@ func init() {
 		},
 	})
 }
+
+func TestDashboardResourceModel_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	dashboard := apiclient.Dashboard{
+		Id:          "1",
+		Title:       "Errors",
+		Projects:    []int64{2},
+		Environment: &[]string{"production"},
+		Period:      nullable.NewNullableWithValue("14d"),
+		Utc:         nullable.NewNullableWithValue(true),
+		Filters: apiclient.DashboardFilters{
+			Release: &[]string{"1.0.0"},
+		},
+		Permissions: nullable.NewNullableWithValue(apiclient.DashboardPermissions{
+			IsEditableByEveryone: false,
+			TeamsWithEditAccess:  &[]int64{3},
+		}),
+		Widgets: []apiclient.DashboardWidget{
+			{
+				Id:            new("10"),
+				Title:         "Number of Errors",
+				Description:   nullable.NewNullableWithValue("All errors."),
+				DisplayType:   "big_number",
+				WidgetType:    new("error-events"),
+				Interval:      new("5m"),
+				Limit:         nullable.NewNullNullable[int64](),
+				DatasetSource: new("user"),
+				Thresholds: nullable.NewNullableWithValue(apiclient.DashboardWidgetThresholds{
+					MaxValues: struct {
+						Max1 *float64 `json:"max1,omitempty"`
+						Max2 *float64 `json:"max2,omitempty"`
+					}{Max1: new(100.0), Max2: new(500.0)},
+					Unit: nullable.NewNullNullable[string](),
+				}),
+				Layout: nullable.NewNullableWithValue(apiclient.DashboardWidgetLayout{X: 0, Y: 0, W: 1, H: 1, MinH: 1}),
+				Queries: []apiclient.DashboardWidgetQuery{
+					{
+						Id:           new("20"),
+						Name:         new(""),
+						Fields:       &[]string{"count()"},
+						Aggregates:   &[]string{"count()"},
+						Columns:      &[]string{},
+						FieldAliases: &[]string{},
+						Conditions:   new("!event.type:transaction"),
+						Orderby:      new(""),
+						IsHidden:     new(false),
+					},
+				},
+			},
+		},
+	}
+
+	var data DashboardResourceModel
+	if diags := data.Fill(ctx, dashboard); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !data.Start.IsNull() || !data.End.IsNull() {
+		t.Errorf("expected start and end to be null")
+	}
+
+	got, diags := data.ToRequestBody(ctx, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := apiclient.DashboardRequest{
+		Title:       "Errors",
+		Projects:    []int64{2},
+		Environment: &[]string{"production"},
+		Period:      new("14d"),
+		Utc:         new(true),
+		Filters: &apiclient.DashboardFilters{
+			Release:      &[]string{"1.0.0"},
+			GlobalFilter: &[]apiclient.DashboardGlobalFilter{},
+		},
+		Permissions: &apiclient.DashboardPermissions{
+			IsEditableByEveryone: false,
+			TeamsWithEditAccess:  &[]int64{3},
+		},
+		Widgets: dashboard.Widgets,
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToRequestBody() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDashboardResourceModel_KeepsPriorTimestampSpelling(t *testing.T) {
+	ctx := context.Background()
+
+	dashboard := apiclient.Dashboard{
+		Id:       "1",
+		Title:    "Errors",
+		Projects: []int64{},
+		Start:    nullable.NewNullableWithValue("2024-01-01T00:00:00Z"),
+		End:      nullable.NewNullableWithValue("2024-01-02T00:00:00Z"),
+		Widgets:  []apiclient.DashboardWidget{},
+	}
+
+	data := DashboardResourceModel{
+		Start: types.StringValue("2024-01-01T00:00:00+00:00"),
+		End:   types.StringValue("2024-01-03T00:00:00+00:00"),
+	}
+	if diags := data.Fill(ctx, dashboard); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got, want := data.Start.ValueString(), "2024-01-01T00:00:00+00:00"; got != want {
+		t.Errorf("start = %q, want %q", got, want)
+	}
+	if got, want := data.End.ValueString(), "2024-01-02T00:00:00Z"; got != want {
+		t.Errorf("end = %q, want %q", got, want)
+	}
+}
+
+func TestDashboardResourceModel_WidgetOrdering(t *testing.T) {
+	ctx := context.Background()
+
+	widget := func(id, title string) apiclient.DashboardWidget {
+		return apiclient.DashboardWidget{
+			Id:          new(id),
+			Title:       title,
+			DisplayType: "table",
+			Queries: []apiclient.DashboardWidgetQuery{
+				{Id: new(id + "-query")},
+			},
+		}
+	}
+
+	planned := func(title string) DashboardWidgetModel {
+		return DashboardWidgetModel{
+			Id:            types.StringUnknown(),
+			Title:         types.StringValue(title),
+			DisplayType:   types.StringValue("table"),
+			Description:   types.StringNull(),
+			WidgetType:    types.StringUnknown(),
+			Interval:      types.StringUnknown(),
+			Limit:         types.Int64Null(),
+			DatasetSource: types.StringUnknown(),
+			Thresholds:    supertypes.NewSingleNestedObjectValueOfNull[DashboardWidgetThresholdsModel](ctx),
+			Layout:        supertypes.NewSingleNestedObjectValueOfNull[DashboardWidgetLayoutModel](ctx),
+			Queries: supertypes.NewListNestedObjectValueOfValueSlice(ctx, []DashboardWidgetQueryModel{
+				{
+					Id:           types.StringUnknown(),
+					Name:         types.StringValue(""),
+					Fields:       supertypes.NewListValueOfUnknown[string](ctx),
+					Aggregates:   supertypes.NewListValueOfUnknown[string](ctx),
+					Columns:      supertypes.NewListValueOfUnknown[string](ctx),
+					FieldAliases: supertypes.NewListValueOfUnknown[string](ctx),
+					Conditions:   types.StringValue(""),
+					OrderBy:      types.StringValue(""),
+					IsHidden:     types.BoolValue(false),
+				},
+			}),
+		}
+	}
+
+	var data DashboardResourceModel
+	if diags := data.Fill(ctx, apiclient.Dashboard{
+		Id:      "1",
+		Widgets: []apiclient.DashboardWidget{widget("10", "A"), widget("11", "B")},
+	}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var prior []DashboardWidgetModel
+	if diags := data.Widgets.ElementsAs(ctx, &prior, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Swapping the widgets in the configuration keeps their IDs.
+	data.Widgets = supertypes.NewListNestedObjectValueOfValueSlice(ctx, []DashboardWidgetModel{planned("B"), planned("A"), planned("C")})
+	body, diags := data.ToRequestBody(ctx, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var gotIds [][2]*string
+	for _, w := range body.Widgets {
+		gotIds = append(gotIds, [2]*string{w.Id, w.Queries[0].Id})
+	}
+	wantIds := [][2]*string{
+		{new("11"), new("11-query")},
+		{new("10"), new("10-query")},
+		{nil, nil},
+	}
+	if diff := cmp.Diff(wantIds, gotIds); diff != "" {
+		t.Errorf("widget IDs mismatch (-want +got):\n%s", diff)
+	}
+
+	// The API returns the widgets in the order they were sent, but a read of a
+	// dashboard reordered in Sentry keeps the order of the configuration.
+	if diags := data.Fill(ctx, apiclient.Dashboard{
+		Id:      "1",
+		Widgets: []apiclient.DashboardWidget{widget("12", "C"), widget("10", "A"), widget("11", "B")},
+	}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var gotTitles []string
+	for _, w := range data.Widgets.MustGet(ctx) {
+		gotTitles = append(gotTitles, w.Title.ValueString())
+	}
+	if diff := cmp.Diff([]string{"B", "A", "C"}, gotTitles); diff != "" {
+		t.Errorf("widget order mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDashboardResourceModel_DefinitionJson(t *testing.T) {
+	ctx := context.Background()
+
+	// As exported from Sentry.
+	definition := `{
+		"title": "Errors",
+		"projects": [],
+		"environment": [],
+		"period": null,
+		"start": "2025-01-01T00:00:00Z",
+		"end": "2025-01-31T00:00:00Z",
+		"filters": {},
+		"widgets": [
+			{
+				"title": "Number of Errors",
+				"displayType": "big_number",
+				"widgetType": "error-events",
+				"layout": null,
+				"queries": [
+					{"fields": ["count()"], "aggregates": ["count()"], "conditions": ""}
+				]
+			},
+			{
+				"title": "Errors by Browser",
+				"displayType": "table",
+				"widgetType": "error-events",
+				"layout": {"x": 1, "y": 0, "w": 2, "h": 2, "minH": 2},
+				"queries": [
+					{"fields": ["browser.name", "count()"], "aggregates": ["count()"], "columns": ["browser.name"], "conditions": ""}
+				]
+			}
+		]
+	}`
+
+	data := DashboardResourceModel{
+		Title:          types.StringValue("Errors"),
+		Utc:            types.BoolUnknown(),
+		Permissions:    supertypes.NewSingleNestedObjectValueOfUnknown[DashboardPermissionsModel](ctx),
+		DefinitionJson: sentrytypes.NewLossyJsonValue(definition),
+	}
+
+	body, diags := data.DefinitionRequestBody(ctx, []apiclient.DashboardWidget{
+		{Id: new("11"), Title: "Errors by Browser", DisplayType: "table", Queries: []apiclient.DashboardWidgetQuery{{Id: new("21")}}},
+		{Id: new("10"), Title: "Removed", DisplayType: "big_number", Queries: []apiclient.DashboardWidgetQuery{{Id: new("20")}}},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var got struct {
+		Title   string `json:"title"`
+		Utc     *bool  `json:"utc"`
+		Widgets []struct {
+			Id      *string `json:"id"`
+			Queries []struct {
+				Id *string `json:"id"`
+			} `json:"queries"`
+		} `json:"widgets"`
+	}
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Title != "Errors" || got.Utc != nil {
+		t.Errorf("unexpected title or utc: %s", body)
+	}
+	wantIds := [][2]*string{{nil, nil}, {new("11"), new("21")}}
+	var gotIds [][2]*string
+	for _, w := range got.Widgets {
+		gotIds = append(gotIds, [2]*string{w.Id, w.Queries[0].Id})
+	}
+	if diff := cmp.Diff(wantIds, gotIds); diff != "" {
+		t.Errorf("widget IDs mismatch (-want +got):\n%s", diff)
+	}
+
+	// Sentry fills in IDs, dates and the layout of the first widget.
+	response := `{
+		"id": "1",
+		"title": "Errors",
+		"dateCreated": "2025-02-01T00:00:00.000000Z",
+		"createdBy": {"id": "1"},
+		"projects": [],
+		"environment": [],
+		"period": null,
+		"start": "2025-01-01T00:00:00+00:00",
+		"end": "2025-01-31T00:00:00+00:00",
+		"utc": false,
+		"filters": {},
+		"permissions": null,
+		"widgets": [
+			{
+				"id": "12",
+				"dashboardId": "1",
+				"title": "Number of Errors",
+				"description": null,
+				"displayType": "big_number",
+				"widgetType": "error-events",
+				"interval": "5m",
+				"layout": {"x": 0, "y": 0, "w": 1, "h": 1, "minH": 1},
+				"queries": [
+					{"id": "22", "widgetId": "12", "name": "", "fields": ["count()"], "aggregates": ["count()"], "columns": [], "fieldAliases": [], "conditions": "", "orderby": "", "isHidden": false}
+				]
+			},
+			{
+				"id": "11",
+				"dashboardId": "1",
+				"title": "Errors by Browser",
+				"description": null,
+				"displayType": "table",
+				"widgetType": "error-events",
+				"interval": "5m",
+				"layout": {"x": 1, "y": 0, "w": 2, "h": 2, "minH": 2},
+				"queries": [
+					{"id": "21", "widgetId": "11", "name": "", "fields": ["browser.name", "count()"], "aggregates": ["count()"], "columns": ["browser.name"], "fieldAliases": [], "conditions": "", "orderby": "", "isHidden": false}
+				]
+			}
+		]
+	}`
+
+	if diags := data.FillDefinitionJson([]byte(response)); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	newValue := sentrytypes.LossyJson{
+		StringValue: data.DefinitionJson.StringValue,
+		IgnoreKeys:  dashboardDefinitionIgnoreKeys,
+	}
+	equal, diags := newValue.StringSemanticEquals(ctx, sentrytypes.NewLossyJsonValue(definition))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !equal {
+		t.Errorf("expected the definition to be semantically equal, got %s", data.DefinitionJson.ValueString())
+	}
+
+	// A change made in Sentry is not hidden.
+	if diags := data.FillDefinitionJson([]byte(strings.Replace(response, `"minH": 2`, `"minH": 3`, 1))); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	newValue.StringValue = data.DefinitionJson.StringValue
+	if equal, _ := newValue.StringSemanticEquals(ctx, sentrytypes.NewLossyJsonValue(definition)); equal {
+		t.Errorf("expected the definition to differ, got %s", data.DefinitionJson.ValueString())
+	}
+}
+
+func TestAccDashboardResource(t *testing.T) {
+	rn := "sentry_dashboard.test"
+	title := acctest.RandomWithPrefix("tf-dashboard")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardResourceConfig(title, `
+	period = "14d"
+
+	widgets = [
+		{
+			title        = "Number of Errors"
+			description  = "All errors."
+			display_type = "big_number"
+			widget_type  = "error-events"
+
+			thresholds = {
+				max1 = 100
+				max2 = 500
+			}
+
+			queries = [
+				{
+					fields     = ["count()"]
+					aggregates = ["count()"]
+				},
+			]
+
+			layout = {
+				x = 0
+				y = 0
+				w = 1
+				h = 1
+			}
+		},
+		{
+			title        = "Errors by Country"
+			display_type = "table"
+			widget_type  = "error-events"
+
+			queries = [
+				{
+					name       = "Metric"
+					fields     = ["geo.country_code", "geo.region", "count()"]
+					aggregates = ["count()"]
+					columns    = ["geo.country_code", "geo.region"]
+					conditions = "!event.type:transaction has:geo.country_code"
+				},
+			]
+		},
+	]
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^\d+$`))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("title"), knownvalue.StringExact(title)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("projects"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environments"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("period"), knownvalue.StringExact("14d")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("utc"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("filters"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("permissions"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"is_editable_by_everyone": knownvalue.Bool(true),
+						"teams_with_edit_access":  knownvalue.Null(),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(0).AtMapKey("title"), knownvalue.StringExact("Number of Errors")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(0).AtMapKey("description"), knownvalue.StringExact("All errors.")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(0).AtMapKey("thresholds"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"max1": knownvalue.Float64Exact(100),
+						"max2": knownvalue.Float64Exact(500),
+						"unit": knownvalue.Null(),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(0).AtMapKey("layout").AtMapKey("min_h"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(1).AtMapKey("title"), knownvalue.StringExact("Errors by Country")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(1).AtMapKey("layout"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(1).AtMapKey("queries").AtSliceIndex(0).AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(1).AtMapKey("queries").AtSliceIndex(0).AtMapKey("fields"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("geo.country_code"),
+						knownvalue.StringExact("geo.region"),
+						knownvalue.StringExact("count()"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(1).AtMapKey("queries").AtSliceIndex(0).AtMapKey("field_aliases"), knownvalue.ListSizeExact(0)),
+				},
+			},
+			{
+				Config: testAccDashboardResourceConfig(title+"-updated", `
+	environments = ["production"]
+	start        = "2025-01-01T00:00:00Z"
+	end          = "2025-01-31T00:00:00Z"
+	utc          = true
+
+	filters = {
+		releases = ["1.0.0"]
+		global_filters = [
+			{
+				dataset = "error-events"
+				key     = "browser.name"
+				value   = "browser.name:[Chrome,Firefox]"
+			},
+		]
+	}
+
+	permissions = {
+		is_editable_by_everyone = false
+		teams_with_edit_access  = [sentry_team.test.internal_id]
+	}
+
+	widgets = [
+		{
+			title        = "Errors by Country"
+			display_type = "table"
+			widget_type  = "error-events"
+
+			queries = [
+				{
+					name       = "Metric"
+					fields     = ["geo.country_code", "count()"]
+					aggregates = ["count()"]
+					columns    = ["geo.country_code"]
+				},
+			]
+		},
+		{
+			title        = "Number of Errors"
+			display_type = "big_number"
+			widget_type  = "error-events"
+
+			queries = [
+				{
+					fields     = ["count()"]
+					aggregates = ["count()"]
+				},
+			]
+		},
+	]
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("title"), knownvalue.StringExact(title+"-updated")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environments"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("production"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("period"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("start"), knownvalue.StringExact("2025-01-01T00:00:00Z")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("end"), knownvalue.StringExact("2025-01-31T00:00:00Z")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("utc"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("filters").AtMapKey("releases"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("1.0.0"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("filters").AtMapKey("global_filters"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"dataset": knownvalue.StringExact("error-events"),
+							"key":     knownvalue.StringExact("browser.name"),
+							"value":   knownvalue.StringExact("browser.name:[Chrome,Firefox]"),
+						}),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("permissions").AtMapKey("is_editable_by_everyone"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("permissions").AtMapKey("teams_with_edit_access"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(0).AtMapKey("title"), knownvalue.StringExact("Errors by Country")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(1).AtMapKey("title"), knownvalue.StringExact("Number of Errors")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(1).AtMapKey("description"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(1).AtMapKey("thresholds"), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDashboardResource_definitionJson(t *testing.T) {
+	rn := "sentry_dashboard.test"
+	title := acctest.RandomWithPrefix("tf-dashboard")
+
+	widget := func(title, displayType string) string {
+		return fmt.Sprintf(`
+			{
+				title       = %[1]q
+				displayType = %[2]q
+				widgetType  = "error-events"
+				interval    = "5m"
+				layout      = null
+				queries = [
+					{
+						name       = ""
+						fields     = ["count()"]
+						aggregates = ["count()"]
+						columns    = []
+						conditions = ""
+						orderby    = ""
+					},
+				]
+			},`, title, displayType)
+	}
+
+	config := func(definitionTitle string, widgets ...string) string {
+		return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_dashboard" "test" {
+	organization = data.sentry_organization.test.slug
+	title        = %[1]q
+
+	definition_json = jsonencode({
+		title       = %[2]q
+		projects    = []
+		environment = ["production"]
+		period      = "7d"
+		filters     = {}
+		widgets     = [%[3]s
+		]
+	})
+}
+`, title, definitionTitle, strings.Join(widgets, ""))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("Other title", widget("Errors", "big_number")),
+				ExpectError: regexp.MustCompile(`must match the title of the dashboard`),
+			},
+			{
+				Config: config(title, widget("Errors", "big_number")),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("title"), knownvalue.StringExact(title)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environments"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("definition_json"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: config(title, widget("Errors", "big_number"), widget("Errors over time", "line")),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("definition_json"), knownvalue.StringRegexp(regexp.MustCompile(`Errors over time`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDashboardResource_upgradeFromVersion(t *testing.T) {
+	rn := "sentry_dashboard.test"
+	title := acctest.RandomWithPrefix("tf-dashboard")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					acctest.ProviderName: {
+						Source:            "jianyuan/sentry",
+						VersionConstraint: "0.14.1",
+					},
+				},
+				Config: testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_dashboard" "test" {
+	organization = data.sentry_organization.test.slug
+	title        = %[1]q
+
+	widget {
+		title        = "Custom Widget"
+		display_type = "table"
+
+		query {
+			name       = "Metric"
+			fields     = ["geo.country_code", "count()"]
+			aggregates = ["count()"]
+			columns    = ["geo.country_code"]
+		}
+
+		layout {
+			x     = 0
+			y     = 0
+			w     = 2
+			h     = 1
+			min_h = 1
+		}
+	}
+}
+`, title),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^.+/\d+$`))),
+				},
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config: testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_dashboard" "test" {
+	organization = data.sentry_organization.test.slug
+	title        = %[1]q
+
+	widgets = [
+		{
+			title        = "Custom Widget"
+			display_type = "table"
+
+			queries = [
+				{
+					name       = "Metric"
+					fields     = ["geo.country_code", "count()"]
+					aggregates = ["count()"]
+					columns    = ["geo.country_code"]
+				},
+			]
+
+			layout = {
+				x     = 0
+				y     = 0
+				w     = 2
+				h     = 1
+				min_h = 1
+			}
+		},
+	]
+}
+`, title),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^\d+$`))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(0).AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("widgets").AtSliceIndex(0).AtMapKey("queries").AtSliceIndex(0).AtMapKey("name"), knownvalue.StringExact("Metric")),
+				},
+			},
+		},
+	})
+}
+
+func testAccDashboardResourceConfig(title, extras string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+data "sentry_project" "test" {
+	organization = data.sentry_organization.test.slug
+	slug         = %[1]q
+}
+
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = %[2]q
+}
+
+resource "sentry_dashboard" "test" {
+	organization = data.sentry_organization.test.slug
+	title        = %[3]q
+	projects     = [data.sentry_project.test.internal_id]
+%[4]s
+}
+`, acctest.TestProject.Slug, acctest.RandomWithPrefix("tf-team"), title, extras)
+}
@@ -0,0 +1,615 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+	"github.com/samber/lo"
+)
+
+var _ resource.Resource = &DataForwarderResource{}
+var _ resource.ResourceWithConfigure = &DataForwarderResource{}
+var _ resource.ResourceWithConfigValidators = &DataForwarderResource{}
+var _ resource.ResourceWithImportState = &DataForwarderResource{}
+
+func NewDataForwarderResource() resource.Resource {
+	return &DataForwarderResource{}
+}
+
+type DataForwarderResource struct {
+	baseResource
+}
+
+func (r *DataForwarderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_forwarder"
+}
+
+func (r *DataForwarderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	secretAttributes := func(name string, description string) (schema.StringAttribute, schema.StringAttribute) {
+		return schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("%s Exactly one of `%s` or `%s_wo` must be set.", description, name, name),
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName(name + "_wo")),
+			},
+		}, schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("%s This value is write-only and is never stored in the state. It is only sent when the forwarder is created, when `secrets_wo_version` changes or when switching to a different provider, so change `secrets_wo_version` to send a new value.", description),
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+		}
+	}
+
+	sqsSecretKey, sqsSecretKeyWo := secretAttributes("secret_key", "The AWS secret access key.")
+	segmentWriteKey, segmentWriteKeyWo := secretAttributes("write_key", "The Segment write key.")
+	splunkToken, splunkTokenWo := secretAttributes("token", "The Splunk HTTP Event Collector token.")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forwards the events of one or more projects to Amazon SQS, Segment or Splunk. Exactly one of `sqs`, `segment` or `splunk` must be set.\n\n" +
+			"On organizations without the data forwarding API, the forwarder is configured through the legacy plugin of each project instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data forwarder, or the ID of the plugin when configured through the legacy plugins.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"projects": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects whose events are forwarded, e.g. `sentry_project.default.internal_id`.",
+				Required:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a numeric ID"),
+					),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether events are forwarded. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"enroll_new_projects": schema.BoolAttribute{
+				MarkdownDescription: "Whether projects created later are enrolled automatically. Defaults to `false`. Not supported by the legacy plugins.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"secrets_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to send the write-only secrets of the provider again. Otherwise they are omitted on update, and Sentry keeps their current values.",
+				Optional:            true,
+			},
+			"sqs": schema.SingleNestedAttribute{
+				MarkdownDescription: "Forward events to an Amazon SQS queue.",
+				Optional:            true,
+				CustomType:          supertypes.NewSingleNestedObjectTypeOf[DataForwarderSqsModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"queue_url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the SQS queue.",
+						Required:            true,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "The AWS region of the queue, e.g. `us-east-1`.",
+						Required:            true,
+					},
+					"access_key": schema.StringAttribute{
+						MarkdownDescription: "The AWS access key ID.",
+						Required:            true,
+					},
+					"secret_key":    sqsSecretKey,
+					"secret_key_wo": sqsSecretKeyWo,
+					"message_group_id": schema.StringAttribute{
+						MarkdownDescription: "The message group ID. Required for FIFO queues.",
+						Optional:            true,
+					},
+					"s3_bucket": schema.StringAttribute{
+						MarkdownDescription: "The S3 bucket used to store events that are too large for SQS.",
+						Optional:            true,
+					},
+				},
+			},
+			"segment": schema.SingleNestedAttribute{
+				MarkdownDescription: "Forward events to Segment.",
+				Optional:            true,
+				CustomType:          supertypes.NewSingleNestedObjectTypeOf[DataForwarderSegmentModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"write_key":    segmentWriteKey,
+					"write_key_wo": segmentWriteKeyWo,
+				},
+			},
+			"splunk": schema.SingleNestedAttribute{
+				MarkdownDescription: "Forward events to a Splunk HTTP Event Collector.",
+				Optional:            true,
+				CustomType:          supertypes.NewSingleNestedObjectTypeOf[DataForwarderSplunkModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"instance_url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the Splunk instance, e.g. `https://splunk.example.com:8088`.",
+						Required:            true,
+					},
+					"index": schema.StringAttribute{
+						MarkdownDescription: "The Splunk index.",
+						Required:            true,
+					},
+					"source": schema.StringAttribute{
+						MarkdownDescription: "The Splunk source. Sentry picks a default when not set.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"token":    splunkToken,
+					"token_wo": splunkTokenWo,
+				},
+			},
+		},
+	}
+}
+
+func (r *DataForwarderResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("sqs"),
+			path.MatchRoot("segment"),
+			path.MatchRoot("splunk"),
+		),
+	}
+}
+
+func dataForwarderProviderByPluginId(pluginId string) (dataForwarderProvider, bool) {
+	return lo.Find([]dataForwarderProvider{
+		dataForwarderProviderSqs,
+		dataForwarderProviderSegment,
+		dataForwarderProviderSplunk,
+	}, func(p dataForwarderProvider) bool {
+		return p.PluginId == pluginId
+	})
+}
+
+func (r *DataForwarderResource) readDataForwarder(ctx context.Context, organization string, id string) (*apiclient.DataForwarder, error) {
+	httpResp, err := r.apiClient.ListOrganizationDataForwardersWithResponse(ctx, organization)
+	if err != nil {
+		return nil, err
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return nil, errNotFound
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to list data forwarders, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	for _, forwarder := range *httpResp.JSON200 {
+		if forwarder.Id == id {
+			return &forwarder, nil
+		}
+	}
+
+	return nil, errNotFound
+}
+
+// checkOrganization distinguishes a missing data forwarding API from a missing
+// organization, as both are reported as 404 Not Found.
+func (r *DataForwarderResource) checkOrganization(ctx context.Context, organization string) (diags diag.Diagnostics) {
+	httpResp, err := r.apiClient.GetOrganizationWithResponse(ctx, organization)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("read organization", err))
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		diags.Append(diagutils.NewNotFoundError("organization"))
+	} else if httpResp.StatusCode() != http.StatusOK {
+		diags.Append(diagutils.NewClientStatusError("read organization", httpResp.StatusCode(), httpResp.Body))
+	}
+	return
+}
+
+// configurePlugins enables and configures the plugin on each project, for
+// organizations without the data forwarding API.
+func (r *DataForwarderResource) configurePlugins(ctx context.Context, organization string, projects []string, provider dataForwarderProvider, enabled bool, config map[string]string) (diags diag.Diagnostics) {
+	pluginConfig := provider.PluginConfig(config)
+
+	for _, project := range projects {
+		httpResp, err := r.apiClient.UpdateProjectPluginWithResponse(ctx, organization, project, provider.PluginId, pluginConfig)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("configure plugin", err))
+			return
+		} else if httpResp.StatusCode() != http.StatusOK {
+			diags.Append(diagutils.NewClientStatusError("configure plugin", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+
+		if enabled {
+			diags.Append(r.enablePlugin(ctx, organization, project, provider.PluginId)...)
+		} else {
+			diags.Append(r.disablePlugin(ctx, organization, project, provider.PluginId)...)
+		}
+		if diags.HasError() {
+			return
+		}
+	}
+
+	return
+}
+
+func (r *DataForwarderResource) enablePlugin(ctx context.Context, organization string, project string, pluginId string) (diags diag.Diagnostics) {
+	httpResp, err := r.apiClient.EnableProjectPluginWithResponse(ctx, organization, project, pluginId, apiclient.EnableProjectPluginJSONRequestBody{})
+	if err != nil {
+		diags.Append(diagutils.NewClientError("enable plugin", err))
+	} else if httpResp.StatusCode() != http.StatusCreated && httpResp.StatusCode() != http.StatusOK {
+		diags.Append(diagutils.NewClientStatusError("enable plugin", httpResp.StatusCode(), httpResp.Body))
+	}
+	return
+}
+
+func (r *DataForwarderResource) disablePlugin(ctx context.Context, organization string, project string, pluginId string) (diags diag.Diagnostics) {
+	httpResp, err := r.apiClient.DisableProjectPluginWithResponse(ctx, organization, project, pluginId)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("disable plugin", err))
+	} else if httpResp.StatusCode() != http.StatusNoContent && httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusNotFound {
+		diags.Append(diagutils.NewClientStatusError("disable plugin", httpResp.StatusCode(), httpResp.Body))
+	}
+	return
+}
+
+// removePlugin resets the configuration of the plugin, so that its credentials
+// are not left behind, and disables it.
+func (r *DataForwarderResource) removePlugin(ctx context.Context, organization string, project string, pluginId string) (diags diag.Diagnostics) {
+	httpResp, err := r.apiClient.EnableProjectPluginWithResponse(ctx, organization, project, pluginId, apiclient.EnableProjectPluginJSONRequestBody{
+		Reset: new(true),
+	})
+	if err != nil {
+		diags.Append(diagutils.NewClientError("reset plugin", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusCreated && httpResp.StatusCode() != http.StatusNotFound {
+		diags.Append(diagutils.NewClientStatusError("reset plugin", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	diags.Append(r.disablePlugin(ctx, organization, project, pluginId)...)
+	return
+}
+
+// readPlugins refreshes a forwarder configured through the legacy plugins.
+// Projects where the plugin no longer exists are dropped, and the settings are
+// taken from the first remaining project.
+func (r *DataForwarderResource) readPlugins(ctx context.Context, data *DataForwarderResourceModel) (found bool, diags diag.Diagnostics) {
+	provider, ok := dataForwarderProviderByPluginId(data.Id.ValueString())
+	if !ok {
+		diags.AddError("Unsupported plugin", fmt.Sprintf("The plugin %q is not a supported data forwarding provider.", data.Id.ValueString()))
+		return
+	}
+
+	projects, d := data.Projects.Get(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	var foundProjects []string
+	var config map[string]string
+	enabled := false
+
+	for _, project := range projects {
+		httpResp, err := r.apiClient.GetProjectPluginWithResponse(ctx, data.Organization.ValueString(), project, provider.PluginId)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("read plugin", err))
+			return
+		} else if httpResp.StatusCode() == http.StatusNotFound {
+			continue
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			diags.Append(diagutils.NewClientStatusError("read plugin", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+
+		foundProjects = append(foundProjects, project)
+		enabled = enabled || httpResp.JSON200.Enabled
+		if config == nil {
+			config = provider.ConfigFromPlugin(*httpResp.JSON200)
+		}
+	}
+
+	if len(foundProjects) == 0 {
+		return
+	}
+
+	data.Projects = supertypes.NewSetValueOfSlice(ctx, foundProjects)
+	data.Enabled = types.BoolValue(enabled)
+	if data.EnrollNewProjects.IsNull() {
+		data.EnrollNewProjects = types.BoolValue(false)
+	}
+	diags.Append(data.FillConfig(ctx, provider.Name, config)...)
+
+	return true, diags
+}
+
+func (r *DataForwarderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config DataForwarderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := data.ToRequestBody(ctx, config, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.CreateOrganizationDataForwarderWithResponse(ctx, data.Organization.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		// The data forwarding API is not available, fall back to the legacy
+		// plugins unless the organization itself does not exist.
+		resp.Diagnostics.Append(r.checkOrganization(ctx, data.Organization.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		provider := data.Provider()
+		projects, diags := data.Projects.Get(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(r.configurePlugins(ctx, data.Organization.ValueString(), projects, provider, data.Enabled.ValueBool(), body.Config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Read the plugin back, so that computed settings left out of the
+		// configuration are known.
+		data.Id = types.StringValue(provider.PluginId)
+		found, diags := r.readPlugins(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		} else if !found {
+			resp.Diagnostics.Append(diagutils.NewNotFoundError("data forwarder"))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON201)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataForwarderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DataForwarderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UsesPlugin() {
+		found, diags := r.readPlugins(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		} else if !found {
+			resp.Diagnostics.Append(diagutils.NewNotFoundError("data forwarder"))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	forwarder, err := r.readDataForwarder(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if errors.Is(err, errNotFound) {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("data forwarder"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *forwarder)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataForwarderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, config, state DataForwarderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only secrets are only sent when requested, or when they belong to a
+	// different provider than the one currently configured.
+	sendWriteOnly := !data.SecretsWoVersion.Equal(state.SecretsWoVersion) || data.Provider().Name != state.Provider().Name
+
+	body, diags := data.ToRequestBody(ctx, config, sendWriteOnly)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.UsesPlugin() {
+		statePlugin, _ := dataForwarderProviderByPluginId(state.Id.ValueString())
+		provider := data.Provider()
+
+		stateProjects, diags := state.Projects.Get(ctx)
+		resp.Diagnostics.Append(diags...)
+		projects, diags := data.Projects.Get(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Remove the plugin from the projects that are no longer forwarded, or
+		// from all projects when switching to a different provider.
+		for _, project := range stateProjects {
+			if statePlugin.PluginId != provider.PluginId || !lo.Contains(projects, project) {
+				resp.Diagnostics.Append(r.removePlugin(ctx, data.Organization.ValueString(), project, statePlugin.PluginId)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
+		}
+
+		// Projects that are newly forwarded have no secrets yet.
+		newProjects, existingProjects := lo.FilterReject(projects, func(project string, _ int) bool {
+			return !lo.Contains(stateProjects, project)
+		})
+		if len(newProjects) > 0 && !sendWriteOnly {
+			newConfig, diags := data.ToConfig(ctx, config, true)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(r.configurePlugins(ctx, data.Organization.ValueString(), newProjects, provider, data.Enabled.ValueBool(), newConfig)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			projects = existingProjects
+		}
+
+		resp.Diagnostics.Append(r.configurePlugins(ctx, data.Organization.ValueString(), projects, provider, data.Enabled.ValueBool(), body.Config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Read the plugin back, so that computed settings left out of the
+		// configuration are known.
+		data.Id = types.StringValue(provider.PluginId)
+		found, diags := r.readPlugins(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		} else if !found {
+			resp.Diagnostics.Append(diagutils.NewNotFoundError("data forwarder"))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	httpResp, err := r.apiClient.UpdateOrganizationDataForwarderWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataForwarderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DataForwarderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UsesPlugin() {
+		projects, diags := data.Projects.Get(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, project := range projects {
+			resp.Diagnostics.Append(r.removePlugin(ctx, data.Organization.ValueString(), project, data.Id.ValueString())...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteOrganizationDataForwarderWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+// ImportState accepts either `organization/data-forwarder-id`, or
+// `organization/project-id/plugin-id` for forwarders configured through the
+// legacy plugins.
+func (r *DataForwarderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(strings.TrimSpace(req.ID), "/")
+	if lo.Contains(parts, "") || (len(parts) != 2 && len(parts) != 3) {
+		resp.Diagnostics.AddError(
+			"Invalid Resource Import ID",
+			fmt.Sprintf("Unexpected import ID format %q, expected organization/id or organization/project-id/plugin-id", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), parts[0])...)
+	if len(parts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("projects"), supertypes.NewSetValueOfSlice(ctx, []string{parts[1]}))...)
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+func TestDataForwarderResourceModel_Fill(t *testing.T) {
+	ctx := context.Background()
+
+	var forwarder apiclient.DataForwarder
+	if err := json.Unmarshal([]byte(`{
+		"id": "42",
+		"provider": "sqs",
+		"isEnabled": true,
+		"enrollNewProjects": false,
+		"enrolledProjects": [{"id": "1", "slug": "web"}, {"id": "2", "slug": "api"}],
+		"config": {
+			"queue_url": "https://sqs.us-east-1.amazonaws.com/123456789012/events",
+			"region": "us-east-1",
+			"access_key": "AKIAEXAMPLE",
+			"secret_key": "********"
+		}
+	}`), &forwarder); err != nil {
+		t.Fatal(err)
+	}
+
+	data := DataForwarderResourceModel{
+		Sqs: supertypes.NewSingleNestedObjectValueOf(ctx, &DataForwarderSqsModel{
+			QueueUrl:       types.StringValue("https://sqs.us-east-1.amazonaws.com/123456789012/events"),
+			Region:         types.StringValue("us-east-1"),
+			AccessKey:      types.StringValue("AKIAEXAMPLE"),
+			SecretKey:      types.StringValue("secret"),
+			SecretKeyWo:    types.StringNull(),
+			MessageGroupId: types.StringNull(),
+			S3Bucket:       types.StringNull(),
+		}),
+		Segment: supertypes.NewSingleNestedObjectValueOfNull[DataForwarderSegmentModel](ctx),
+		Splunk:  supertypes.NewSingleNestedObjectValueOfNull[DataForwarderSplunkModel](ctx),
+	}
+	if diags := data.Fill(ctx, forwarder); diags.HasError() {
+		t.Fatalf("Fill() returned errors: %v", diags)
+	}
+
+	if data.UsesPlugin() {
+		t.Error("UsesPlugin() = true, want false")
+	}
+
+	projects, diags := data.Projects.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("projects: %v", diags)
+	}
+	if diff := cmp.Diff([]string{"1", "2"}, projects); diff != "" {
+		t.Errorf("projects mismatch (-want +got):\n%s", diff)
+	}
+
+	sqs, diags := data.Sqs.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("sqs: %v", diags)
+	}
+	if got, want := sqs.SecretKey.ValueString(), "secret"; got != want {
+		t.Errorf("sqs.secret_key = %q, want the prior value %q", got, want)
+	}
+	if !sqs.MessageGroupId.IsNull() {
+		t.Errorf("sqs.message_group_id = %v, want null", sqs.MessageGroupId)
+	}
+	if !data.Segment.IsNull() || !data.Splunk.IsNull() {
+		t.Error("segment and splunk should be null")
+	}
+}
+
+func TestDataForwarderResourceModel_ToRequestBody(t *testing.T) {
+	ctx := context.Background()
+
+	plan := DataForwarderResourceModel{
+		Projects:          supertypes.NewSetValueOfSlice(ctx, []string{"1"}),
+		Enabled:           types.BoolValue(true),
+		EnrollNewProjects: types.BoolValue(true),
+		Sqs:               supertypes.NewSingleNestedObjectValueOfNull[DataForwarderSqsModel](ctx),
+		Segment:           supertypes.NewSingleNestedObjectValueOfNull[DataForwarderSegmentModel](ctx),
+		Splunk: supertypes.NewSingleNestedObjectValueOf(ctx, &DataForwarderSplunkModel{
+			InstanceUrl: types.StringValue("https://splunk.example.com:8088"),
+			Index:       types.StringValue("main"),
+			Source:      types.StringUnknown(),
+			Token:       types.StringNull(),
+			TokenWo:     types.StringNull(),
+		}),
+	}
+
+	// Write-only values are only present in the configuration.
+	config := plan
+	config.Splunk = supertypes.NewSingleNestedObjectValueOf(ctx, &DataForwarderSplunkModel{
+		InstanceUrl: types.StringValue("https://splunk.example.com:8088"),
+		Index:       types.StringValue("main"),
+		Source:      types.StringNull(),
+		Token:       types.StringNull(),
+		TokenWo:     types.StringValue("hec-token"),
+	})
+
+	body, diags := plan.ToRequestBody(ctx, config, true)
+	if diags.HasError() {
+		t.Fatalf("ToRequestBody() returned errors: %v", diags)
+	}
+
+	want := apiclient.DataForwarderRequest{
+		Provider:          "splunk",
+		IsEnabled:         true,
+		EnrollNewProjects: true,
+		ProjectIds:        []int64{1},
+		Config: map[string]string{
+			"instance_url": "https://splunk.example.com:8088",
+			"index":        "main",
+			"token":        "hec-token",
+		},
+	}
+	if diff := cmp.Diff(want, body); diff != "" {
+		t.Errorf("ToRequestBody() mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(map[string]string{
+		"instance": "https://splunk.example.com:8088",
+		"index":    "main",
+		"token":    "hec-token",
+	}, dataForwarderProviderSplunk.PluginConfig(body.Config)); diff != "" {
+		t.Errorf("PluginConfig() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Write-only secrets are omitted unless they are sent again.
+	body, diags = plan.ToRequestBody(ctx, config, false)
+	if diags.HasError() {
+		t.Fatalf("ToRequestBody() returned errors: %v", diags)
+	}
+	if _, ok := body.Config["token"]; ok {
+		t.Errorf("ToRequestBody() config = %v, want no token", body.Config)
+	}
+}
+
+func TestDataForwarderProvider_ConfigFromPlugin(t *testing.T) {
+	var plugin apiclient.ProjectPlugin
+	if err := json.Unmarshal([]byte(`{
+		"id": "splunk",
+		"enabled": true,
+		"config": [
+			{"name": "instance", "value": "https://splunk.example.com:8088"},
+			{"name": "index", "value": "main"},
+			{"name": "source", "value": null},
+			{"name": "token", "value": "********"}
+		]
+	}`), &plugin); err != nil {
+		t.Fatal(err)
+	}
+
+	got := dataForwarderProviderSplunk.ConfigFromPlugin(plugin)
+	want := map[string]string{
+		"instance_url": "https://splunk.example.com:8088",
+		"index":        "main",
+		"token":        "********",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ConfigFromPlugin() mismatch (-want +got):\n%s", diff)
+	}
+
+	if p, ok := dataForwarderProviderByPluginId("amazon-sqs"); !ok || p.Name != "sqs" {
+		t.Errorf("dataForwarderProviderByPluginId(amazon-sqs) = %v, %v", p, ok)
+	}
+}
+
+func TestAccDataForwarderResource(t *testing.T) {
+	rn := "sentry_data_forwarder.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataForwarderResourceConfig(`
+	enabled = true
+
+	segment = {
+		write_key = "tf-write-key"
+	}
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("projects"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("segment").AtMapKey("write_key"), knownvalue.StringExact("tf-write-key")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("splunk"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccDataForwarderResourceConfig(`
+	enabled            = false
+	secrets_wo_version = 1
+
+	splunk = {
+		instance_url = "https://splunk.example.com:8088"
+		index        = "main"
+		token_wo     = "tf-token"
+	}
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("segment"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("splunk").AtMapKey("instance_url"), knownvalue.StringExact("https://splunk.example.com:8088")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("splunk").AtMapKey("token"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("splunk").AtMapKey("token_wo"), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:            rn,
+				ImportState:             true,
+				ImportStateIdFunc:       resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secrets_wo_version"},
+			},
+		},
+	})
+}
+
+func testAccDataForwarderResourceConfig(body string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+data "sentry_project" "test" {
+	organization = data.sentry_organization.test.slug
+	slug         = %[1]q
+}
+
+resource "sentry_data_forwarder" "test" {
+	organization = data.sentry_organization.test.slug
+	projects     = [data.sentry_project.test.internal_id]
+%[2]s
+}
+`, acctest.TestProject.Slug, body)
+}
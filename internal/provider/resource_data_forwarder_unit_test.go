@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+func TestDataForwarderResource_plugins(t *testing.T) {
+	var calls []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/0/organizations/missing-org/forwarding/", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("GET /api/0/organizations/missing-org/", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/api/0/projects/my-org/1/plugins/segment/", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, strings.TrimSpace(r.Method+" "+string(body)))
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("POST /api/0/organizations/my-org/forwarding/", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("GET /api/0/organizations/my-org/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "1", "slug": "my-org", "name": "My Org", "orgRoleList": []}`))
+	})
+	mux.HandleFunc("/api/0/projects/my-org/1/plugins/splunk/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet {
+			w.Write([]byte(`{}`))
+			return
+		}
+		w.Write([]byte(`{
+			"id": "splunk",
+			"enabled": true,
+			"config": [
+				{"name": "instance", "value": "https://splunk.example.com:8088"},
+				{"name": "index", "value": "main"},
+				{"name": "source", "value": null},
+				{"name": "token", "value": "********"}
+			]
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	apiClient, err := apiclient.NewClientWithResponses(server.URL+"/api/", apiclient.WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &DataForwarderResource{baseResource: baseResource{apiClient: apiClient}}
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	newState := func(data DataForwarderResourceModel) tfsdk.State {
+		state := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		if diags := state.Set(ctx, &data); diags.HasError() {
+			t.Fatalf("State.Set() returned errors: %v", diags)
+		}
+		return state
+	}
+
+	data := DataForwarderResourceModel{
+		Id:                types.StringValue("segment"),
+		Organization:      types.StringValue("my-org"),
+		Projects:          supertypes.NewSetValueOfSlice(ctx, []string{"1"}),
+		Enabled:           types.BoolValue(true),
+		EnrollNewProjects: types.BoolValue(false),
+		SecretsWoVersion:  types.Int64Null(),
+		Sqs:               supertypes.NewSingleNestedObjectValueOfNull[DataForwarderSqsModel](ctx),
+		Segment: supertypes.NewSingleNestedObjectValueOf(ctx, &DataForwarderSegmentModel{
+			WriteKey:   types.StringValue("write-key"),
+			WriteKeyWo: types.StringNull(),
+		}),
+		Splunk: supertypes.NewSingleNestedObjectValueOfNull[DataForwarderSplunkModel](ctx),
+	}
+
+	t.Run("missing organization", func(t *testing.T) {
+		plan := data
+		plan.Id = types.StringUnknown()
+		plan.Organization = types.StringValue("missing-org")
+		state := newState(plan)
+
+		resp := resource.CreateResponse{State: newState(plan)}
+		r.Create(ctx, resource.CreateRequest{
+			Plan:   tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+			Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+		}, &resp)
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("Create() returned no error")
+		}
+		if got, want := resp.Diagnostics.Errors()[0].Summary(), diagutils.NewNotFoundError("organization").Summary(); got != want {
+			t.Errorf("error = %q, want %q", got, want)
+		}
+		if len(calls) != 0 {
+			t.Errorf("plugin calls = %q, want none", calls)
+		}
+	})
+
+	t.Run("create splunk without source", func(t *testing.T) {
+		plan := data
+		plan.Id = types.StringUnknown()
+		plan.Segment = supertypes.NewSingleNestedObjectValueOfNull[DataForwarderSegmentModel](ctx)
+		plan.Splunk = supertypes.NewSingleNestedObjectValueOf(ctx, &DataForwarderSplunkModel{
+			InstanceUrl: types.StringValue("https://splunk.example.com:8088"),
+			Index:       types.StringValue("main"),
+			Source:      types.StringUnknown(),
+			Token:       types.StringValue("token"),
+			TokenWo:     types.StringNull(),
+		})
+		config := plan
+		config.Splunk = supertypes.NewSingleNestedObjectValueOf(ctx, &DataForwarderSplunkModel{
+			InstanceUrl: types.StringValue("https://splunk.example.com:8088"),
+			Index:       types.StringValue("main"),
+			Source:      types.StringNull(),
+			Token:       types.StringValue("token"),
+			TokenWo:     types.StringNull(),
+		})
+		planState, configState := newState(plan), newState(config)
+
+		resp := resource.CreateResponse{State: newState(plan)}
+		r.Create(ctx, resource.CreateRequest{
+			Plan:   tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw},
+			Config: tfsdk.Config{Schema: configState.Schema, Raw: configState.Raw},
+		}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Create() returned errors: %v", resp.Diagnostics)
+		}
+		if !resp.State.Raw.IsFullyKnown() {
+			t.Errorf("state is not fully known: %v", resp.State.Raw)
+		}
+
+		var got DataForwarderResourceModel
+		if diags := resp.State.Get(ctx, &got); diags.HasError() {
+			t.Fatalf("State.Get() returned errors: %v", diags)
+		}
+		splunk, diags := got.Splunk.Get(ctx)
+		if diags.HasError() {
+			t.Fatalf("Splunk.Get() returned errors: %v", diags)
+		}
+		if !splunk.Source.IsNull() {
+			t.Errorf("splunk.source = %v, want null", splunk.Source)
+		}
+		if got, want := splunk.Token.ValueString(), "token"; got != want {
+			t.Errorf("splunk.token = %q, want %q", got, want)
+		}
+		if got, want := got.Id.ValueString(), "splunk"; got != want {
+			t.Errorf("id = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("delete resets the plugin", func(t *testing.T) {
+		calls = nil
+
+		var resp resource.DeleteResponse
+		r.Delete(ctx, resource.DeleteRequest{State: newState(data)}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Delete() returned errors: %v", resp.Diagnostics)
+		}
+		if diff := cmp.Diff([]string{`POST {"reset":true}`, "DELETE"}, calls); diff != "" {
+			t.Errorf("plugin calls mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/searchsyntax"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+var _ resource.Resource = &DiscoverSavedQueryResource{}
+var _ resource.ResourceWithConfigure = &DiscoverSavedQueryResource{}
+var _ resource.ResourceWithImportState = &DiscoverSavedQueryResource{}
+
+func NewDiscoverSavedQueryResource() resource.Resource {
+	return &DiscoverSavedQueryResource{}
+}
+
+type DiscoverSavedQueryResource struct {
+	baseResource
+}
+
+func (r *DiscoverSavedQueryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discover_saved_query"
+}
+
+func (r *DiscoverSavedQueryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	relativePeriodRegexp := regexp.MustCompile(`^[1-9][0-9]*[smhdw]$`)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Discover saved query, shared with all members of the organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the saved query.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"dataset": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+				MarkdownDescription: "The dataset to query.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("error-events"),
+			}, sentrydata.DiscoverSavedQueryTypes),
+			"fields": schema.ListAttribute{
+				MarkdownDescription: "The columns of the query, in order. Aggregates are listed as functions, e.g. `count()` or `p95(transaction.duration)`.",
+				Required:            true,
+				CustomType:          supertypes.NewListTypeOf[string](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"y_axis": schema.ListAttribute{
+				MarkdownDescription: "The aggregates to plot on the chart, e.g. `count()`. Each aggregate should also be one of the `fields`.",
+				Optional:            true,
+				CustomType:          supertypes.NewListTypeOf[string](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The search conditions of the query, e.g. `event.type:error (level:error OR level:fatal)`.",
+				Optional:            true,
+				Validators: []validator.String{
+					tfutils.SearchQuery(searchsyntax.DiscoverSearch),
+				},
+			},
+			"order_by": schema.StringAttribute{
+				MarkdownDescription: "The field to sort the results by. Prefix with `-` to sort in descending order, e.g. `-count()`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"projects": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects to query. When not set, the projects of the viewing user are queried.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^-?[0-9]+$`), "must be a numeric project ID"),
+					),
+				},
+			},
+			"environments": schema.SetAttribute{
+				MarkdownDescription: "The environments to query. All environments are queried when this is not set.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"range": schema.StringAttribute{
+				MarkdownDescription: "A relative time range, e.g. `24h` or `14d`. Conflicts with `start` and `end`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(relativePeriodRegexp, "must be a relative time range such as 24h or 14d"),
+					stringvalidator.ConflictsWith(path.MatchRoot("start")),
+				},
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "The start of an absolute time range, in RFC 3339 format.",
+				Optional:            true,
+				Validators: []validator.String{
+					tfutils.RFC3339(),
+					stringvalidator.AlsoRequires(path.MatchRoot("end")),
+				},
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "The end of an absolute time range, in RFC 3339 format.",
+				Optional:            true,
+				Validators: []validator.String{
+					tfutils.RFC3339(),
+					stringvalidator.AlsoRequires(path.MatchRoot("start")),
+				},
+			},
+			"interval": schema.StringAttribute{
+				MarkdownDescription: "The interval of the chart, e.g. `1h`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(relativePeriodRegexp, "must be an interval such as 5m or 1h"),
+				},
+			},
+			"display": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+				MarkdownDescription: "The display mode of the chart.",
+				Optional:            true,
+			}, sentrydata.DiscoverDisplayModes),
+			"top_events": schema.Int64Attribute{
+				MarkdownDescription: "The number of top events to plot when `display` is `top5` or `dailytop5`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscoverSavedQueryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscoverSavedQueryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := data.ToRequestBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.CreateOrganizationDiscoverSavedQueryWithResponse(ctx, data.Organization.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON201)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscoverSavedQueryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscoverSavedQueryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationDiscoverSavedQueryWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("discover saved query"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscoverSavedQueryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DiscoverSavedQueryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := data.ToRequestBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.UpdateOrganizationDiscoverSavedQueryWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscoverSavedQueryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscoverSavedQueryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteOrganizationDiscoverSavedQueryWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *DiscoverSavedQueryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "id")(ctx, req, resp)
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+func TestDiscoverSavedQueryModel_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	query := apiclient.DiscoverSavedQuery{
+		Id:           "1",
+		Name:         "Slow endpoints",
+		Projects:     []int64{2},
+		QueryDataset: new("transaction-like"),
+		Fields:       &[]string{"transaction", "p95(transaction.duration)", "count()"},
+		YAxis:        &[]string{"p95(transaction.duration)"},
+		Query:        new("event.type:transaction"),
+		Orderby:      new("-p95_transaction_duration"),
+		Environment:  &[]string{"production"},
+		Range:        new("7d"),
+		Interval:     new("1h"),
+		Display:      new("top5"),
+		TopEvents:    new(int64(5)),
+	}
+
+	var data DiscoverSavedQueryModel
+	if diags := data.Fill(ctx, query); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !data.Start.IsNull() || !data.End.IsNull() {
+		t.Errorf("expected start and end to be null")
+	}
+
+	got, diags := data.ToRequestBody(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := apiclient.DiscoverSavedQueryRequest{
+		Name:         "Slow endpoints",
+		Projects:     []int64{2},
+		QueryDataset: new("transaction-like"),
+		Fields:       []string{"transaction", "p95(transaction.duration)", "count()"},
+		YAxis:        &[]string{"p95(transaction.duration)"},
+		Query:        new("event.type:transaction"),
+		Orderby:      new("-p95_transaction_duration"),
+		Environment:  &[]string{"production"},
+		Range:        new("7d"),
+		Interval:     new("1h"),
+		Display:      new("top5"),
+		TopEvents:    new(int64(5)),
+		Version:      discoverSavedQueryVersion,
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToRequestBody() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiscoverSavedQueryModel_KeepsPriorTimestampSpelling(t *testing.T) {
+	ctx := context.Background()
+
+	query := apiclient.DiscoverSavedQuery{
+		Id:       "1",
+		Name:     "Slow endpoints",
+		Projects: []int64{},
+		Start:    new("2025-01-01T00:00:00+00:00"),
+		End:      new("2025-01-31T00:00:00.000000Z"),
+	}
+
+	data := DiscoverSavedQueryModel{
+		Start: types.StringValue("2025-01-01T00:00:00Z"),
+		End:   types.StringValue("2025-01-30T00:00:00Z"),
+	}
+	if diags := data.Fill(ctx, query); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got, want := data.Start.ValueString(), "2025-01-01T00:00:00Z"; got != want {
+		t.Errorf("start = %q, want %q", got, want)
+	}
+	if got, want := data.End.ValueString(), "2025-01-31T00:00:00.000000Z"; got != want {
+		t.Errorf("end = %q, want %q", got, want)
+	}
+}
+
+func TestAccDiscoverSavedQueryResource(t *testing.T) {
+	rn := "sentry_discover_saved_query.test"
+	name := acctest.RandomWithPrefix("tf-discover-query")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiscoverSavedQueryResourceConfig(name, `
+	query = "level:error OR"
+`),
+				ExpectError: regexp.MustCompile(`Invalid search query`),
+			},
+			{
+				Config: testAccDiscoverSavedQueryResourceConfig(name, `
+	query    = "level:error OR level:fatal"
+	order_by = "-count()"
+	range    = "7d"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("dataset"), knownvalue.StringExact("error-events")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("fields"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("title"),
+						knownvalue.StringExact("count()"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("y_axis"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("query"), knownvalue.StringExact("level:error OR level:fatal")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("order_by"), knownvalue.StringExact("-count()")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("projects"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environments"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("range"), knownvalue.StringExact("7d")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("display"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccDiscoverSavedQueryResourceConfig(name+"-updated", `
+	y_axis       = ["count()"]
+	environments = ["production"]
+	start        = "2025-01-01T00:00:00Z"
+	end          = "2025-01-31T00:00:00Z"
+	interval     = "1d"
+	display      = "top5"
+	top_events   = 5
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(name+"-updated")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("y_axis"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("count()"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("query"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environments"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("production"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("range"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("start"), knownvalue.StringExact("2025-01-01T00:00:00Z")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("interval"), knownvalue.StringExact("1d")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("display"), knownvalue.StringExact("top5")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("top_events"), knownvalue.Int64Exact(5)),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDiscoverSavedQueryResourceConfig(name, extras string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+data "sentry_project" "test" {
+	organization = data.sentry_organization.test.slug
+	slug         = %[1]q
+}
+
+resource "sentry_discover_saved_query" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = %[2]q
+	fields       = ["title", "count()"]
+	projects     = [data.sentry_project.test.internal_id]
+%[3]s
+}
+`, acctest.TestProject.Slug, name, extras)
+}
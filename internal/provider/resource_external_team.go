@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+)
+
+type ExternalTeamResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Organization  types.String `tfsdk:"organization"`
+	Team          types.String `tfsdk:"team"`
+	IntegrationId types.String `tfsdk:"integration_id"`
+	ProviderKey   types.String `tfsdk:"provider_key"`
+	ExternalName  types.String `tfsdk:"external_name"`
+	ExternalId    types.String `tfsdk:"external_id"`
+}
+
+func (m *ExternalTeamResourceModel) Fill(externalTeam apiclient.ExternalActor) error {
+	m.Id = types.StringValue(externalTeam.Id)
+	m.IntegrationId = types.StringValue(externalTeam.IntegrationId)
+	m.ProviderKey = types.StringValue(externalTeam.Provider)
+	m.ExternalName = types.StringValue(externalTeam.ExternalName)
+	m.ExternalId = types.StringNull()
+	if v, err := externalTeam.ExternalId.Get(); err == nil && v != "" {
+		m.ExternalId = types.StringValue(v)
+	}
+
+	return nil
+}
+
+func (m ExternalTeamResourceModel) ToRequestBody() (apiclient.ExternalTeamRequest, error) {
+	integrationId, err := strconv.ParseInt(m.IntegrationId.ValueString(), 10, 64)
+	if err != nil {
+		return apiclient.ExternalTeamRequest{}, err
+	}
+
+	return apiclient.ExternalTeamRequest{
+		IntegrationId: integrationId,
+		Provider:      m.ProviderKey.ValueString(),
+		ExternalName:  m.ExternalName.ValueString(),
+		ExternalId:    m.ExternalId.ValueStringPointer(),
+	}, nil
+}
+
+var _ resource.Resource = &ExternalTeamResource{}
+var _ resource.ResourceWithConfigure = &ExternalTeamResource{}
+var _ resource.ResourceWithImportState = &ExternalTeamResource{}
+var _ resource.ResourceWithModifyPlan = &ExternalTeamResource{}
+
+func NewExternalTeamResource() resource.Resource {
+	return &ExternalTeamResource{}
+}
+
+type ExternalTeamResource struct {
+	baseResource
+}
+
+func (r *ExternalTeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_team"
+}
+
+func (r *ExternalTeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Links a Sentry team to a team in an external integration, e.g. a GitHub team, so that code owners and notifications can refer to it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "The slug of the Sentry team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization integration. The integration must belong to `provider_key`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a numeric ID"),
+				},
+			},
+			"provider_key": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+				MarkdownDescription: "The provider of the integration.",
+				Required:            true,
+			}, externalActorProviders),
+			"external_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the team in the integration, e.g. `@my-org/my-team` for GitHub.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team in the integration. Required by some providers, e.g. the channel ID for Slack.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ExternalTeamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ExternalTeamResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateExternalActorIntegration(ctx, r.apiClient, plan.Organization, plan.IntegrationId, plan.ProviderKey)...)
+}
+
+func (r *ExternalTeamResource) readExternalTeam(ctx context.Context, organization string, team string, id string) (*apiclient.ExternalActor, error) {
+	httpResp, err := r.apiClient.ListOrganizationTeamsWithResponse(ctx, organization, &apiclient.ListOrganizationTeamsParams{
+		Expand: &apiclient.Expand{"externalTeams"},
+		Query:  new("slug:" + team),
+	})
+	if err != nil {
+		return nil, err
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return nil, errNotFound
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to list teams, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	for _, t := range *httpResp.JSON200 {
+		if t.Slug != team || t.ExternalTeams == nil {
+			continue
+		}
+		for _, externalTeam := range *t.ExternalTeams {
+			if externalTeam.Id == id {
+				return &externalTeam, nil
+			}
+		}
+	}
+
+	return nil, errNotFound
+}
+
+func (r *ExternalTeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExternalTeamResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := data.ToRequestBody()
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	httpResp, err := r.apiClient.CreateExternalTeamWithResponse(ctx, data.Organization.ValueString(), data.Team.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	}
+
+	var externalTeam *apiclient.ExternalActor
+	switch {
+	case httpResp.StatusCode() == http.StatusCreated && httpResp.JSON201 != nil:
+		externalTeam = httpResp.JSON201
+	case httpResp.StatusCode() == http.StatusOK && httpResp.JSON200 != nil:
+		externalTeam = httpResp.JSON200
+	default:
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*externalTeam); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalTeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExternalTeamResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	externalTeam, err := r.readExternalTeam(ctx, data.Organization.ValueString(), data.Team.ValueString(), data.Id.ValueString())
+	if errors.Is(err, errNotFound) {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("external team"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	}
+
+	if err := data.Fill(*externalTeam); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalTeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExternalTeamResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := data.ToRequestBody()
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	httpResp, err := r.apiClient.UpdateExternalTeamWithResponse(ctx, data.Organization.ValueString(), data.Team.ValueString(), data.Id.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalTeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExternalTeamResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteExternalTeamWithResponse(ctx, data.Organization.ValueString(), data.Team.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *ExternalTeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState3PartPath("organization", "team", "id")(ctx, req, resp)
+}
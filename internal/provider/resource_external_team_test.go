@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+func TestAccExternalTeamResource_invalidIntegration(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("tf-team")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExternalTeamResourceConfig(teamName, "1", "github", "@my-org/my-team"),
+				ExpectError: regexp.MustCompile(`Integration not found`),
+			},
+		},
+	})
+}
+
+func TestAccExternalTeamResource_GitHub(t *testing.T) {
+	rn := "sentry_external_team.test"
+	teamName := acctest.RandomWithPrefix("tf-team")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+
+			if acctest.TestGitHubInstallationId == "" {
+				t.Skip("Skipping test due to missing SENTRY_TEST_GITHUB_INSTALLATION_ID environment variable")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExternalTeamResourceConfig(teamName, acctest.TestGitHubInstallationId, "gitlab", "@my-org/"+teamName),
+				ExpectError: regexp.MustCompile(`Invalid provider`),
+			},
+			{
+				Config: testAccExternalTeamResourceConfig(teamName, acctest.TestGitHubInstallationId, "github", "@my-org/"+teamName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("team"), knownvalue.StringExact(teamName)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("integration_id"), knownvalue.StringExact(acctest.TestGitHubInstallationId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("provider_key"), knownvalue.StringExact("github")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("external_name"), knownvalue.StringExact("@my-org/"+teamName)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("external_id"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccExternalTeamResourceConfig(teamName, acctest.TestGitHubInstallationId, "github", "@my-org/"+teamName+"-renamed"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("external_name"), knownvalue.StringExact("@my-org/"+teamName+"-renamed")),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState3PartIDFunc(rn, "organization", "team", "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccExternalTeamResourceConfig(teamName string, integrationId string, providerKey string, externalName string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = %[1]q
+	slug         = %[1]q
+}
+
+resource "sentry_external_team" "test" {
+	organization   = data.sentry_organization.test.slug
+	team           = sentry_team.test.slug
+	integration_id = %[2]q
+	provider_key   = %[3]q
+	external_name  = %[4]q
+}
+`, teamName, integrationId, providerKey, externalName)
+}
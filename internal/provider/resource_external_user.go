@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+)
+
+type ExternalUserResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Organization  types.String `tfsdk:"organization"`
+	MemberId      types.String `tfsdk:"member_id"`
+	IntegrationId types.String `tfsdk:"integration_id"`
+	ProviderKey   types.String `tfsdk:"provider_key"`
+	ExternalName  types.String `tfsdk:"external_name"`
+	ExternalId    types.String `tfsdk:"external_id"`
+}
+
+func (m *ExternalUserResourceModel) Fill(externalUser apiclient.ExternalActor) error {
+	m.Id = types.StringValue(externalUser.Id)
+	m.IntegrationId = types.StringValue(externalUser.IntegrationId)
+	m.ProviderKey = types.StringValue(externalUser.Provider)
+	m.ExternalName = types.StringValue(externalUser.ExternalName)
+	m.ExternalId = types.StringNull()
+	if v, err := externalUser.ExternalId.Get(); err == nil && v != "" {
+		m.ExternalId = types.StringValue(v)
+	}
+
+	return nil
+}
+
+func (m ExternalUserResourceModel) ToRequestBody() (apiclient.ExternalUserRequest, error) {
+	memberId, err := strconv.ParseInt(m.MemberId.ValueString(), 10, 64)
+	if err != nil {
+		return apiclient.ExternalUserRequest{}, err
+	}
+
+	integrationId, err := strconv.ParseInt(m.IntegrationId.ValueString(), 10, 64)
+	if err != nil {
+		return apiclient.ExternalUserRequest{}, err
+	}
+
+	return apiclient.ExternalUserRequest{
+		MemberId:      memberId,
+		IntegrationId: integrationId,
+		Provider:      m.ProviderKey.ValueString(),
+		ExternalName:  m.ExternalName.ValueString(),
+		ExternalId:    m.ExternalId.ValueStringPointer(),
+	}, nil
+}
+
+var _ resource.Resource = &ExternalUserResource{}
+var _ resource.ResourceWithConfigure = &ExternalUserResource{}
+var _ resource.ResourceWithImportState = &ExternalUserResource{}
+var _ resource.ResourceWithModifyPlan = &ExternalUserResource{}
+
+func NewExternalUserResource() resource.Resource {
+	return &ExternalUserResource{}
+}
+
+type ExternalUserResource struct {
+	baseResource
+}
+
+func (r *ExternalUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_user"
+}
+
+func (r *ExternalUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Links a Sentry organization member to a user in an external integration, e.g. a GitHub user, so that code owners and notifications can refer to them.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Sentry organization member.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a numeric ID"),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization integration. The integration must belong to `provider_key`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a numeric ID"),
+				},
+			},
+			"provider_key": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+				MarkdownDescription: "The provider of the integration.",
+				Required:            true,
+			}, externalActorProviders),
+			"external_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user in the integration, e.g. `@octocat` for GitHub.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user in the integration. Required by some providers, e.g. the user ID for Slack.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ExternalUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ExternalUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateExternalActorIntegration(ctx, r.apiClient, plan.Organization, plan.IntegrationId, plan.ProviderKey)...)
+}
+
+func (r *ExternalUserResource) readExternalUser(ctx context.Context, organization string, memberId string, id string) (*apiclient.ExternalActor, error) {
+	httpResp, err := r.apiClient.ListOrganizationMembersWithResponse(ctx, organization, &apiclient.ListOrganizationMembersParams{
+		Expand: &apiclient.Expand{"externalUsers"},
+		Query:  new("id:" + memberId),
+	})
+	if err != nil {
+		return nil, err
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return nil, errNotFound
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to list organization members, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	for _, member := range *httpResp.JSON200 {
+		if member.Id != memberId || member.ExternalUsers == nil {
+			continue
+		}
+		for _, externalUser := range *member.ExternalUsers {
+			if externalUser.Id == id {
+				return &externalUser, nil
+			}
+		}
+	}
+
+	return nil, errNotFound
+}
+
+func (r *ExternalUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExternalUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := data.ToRequestBody()
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	httpResp, err := r.apiClient.CreateExternalUserWithResponse(ctx, data.Organization.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	}
+
+	var externalUser *apiclient.ExternalActor
+	switch {
+	case httpResp.StatusCode() == http.StatusCreated && httpResp.JSON201 != nil:
+		externalUser = httpResp.JSON201
+	case httpResp.StatusCode() == http.StatusOK && httpResp.JSON200 != nil:
+		externalUser = httpResp.JSON200
+	default:
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*externalUser); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExternalUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	externalUser, err := r.readExternalUser(ctx, data.Organization.ValueString(), data.MemberId.ValueString(), data.Id.ValueString())
+	if errors.Is(err, errNotFound) {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("external user"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	}
+
+	if err := data.Fill(*externalUser); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExternalUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := data.ToRequestBody()
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	httpResp, err := r.apiClient.UpdateExternalUserWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExternalUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteExternalUserWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *ExternalUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState3PartPath("organization", "member_id", "id")(ctx, req, resp)
+}
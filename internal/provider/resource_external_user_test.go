@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+func TestAccExternalUserResource_invalidIntegration(t *testing.T) {
+	email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExternalUserResourceConfig(email, "1", "github", "@octocat"),
+				ExpectError: regexp.MustCompile(`Integration not found`),
+			},
+		},
+	})
+}
+
+func TestAccExternalUserResource_GitHub(t *testing.T) {
+	rn := "sentry_external_user.test"
+	email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+	externalName := "@" + acctest.RandomWithPrefix("tf-user")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+
+			if acctest.TestGitHubInstallationId == "" {
+				t.Skip("Skipping test due to missing SENTRY_TEST_GITHUB_INSTALLATION_ID environment variable")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExternalUserResourceConfig(email, acctest.TestGitHubInstallationId, "github", externalName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("member_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("integration_id"), knownvalue.StringExact(acctest.TestGitHubInstallationId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("provider_key"), knownvalue.StringExact("github")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("external_name"), knownvalue.StringExact(externalName)),
+				},
+			},
+			{
+				Config: testAccExternalUserResourceConfig(email, acctest.TestGitHubInstallationId, "github", externalName+"-renamed"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("external_name"), knownvalue.StringExact(externalName+"-renamed")),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState3PartIDFunc(rn, "organization", "member_id", "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccExternalUserResourceConfig(email string, integrationId string, providerKey string, externalName string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_organization_member" "test" {
+	organization = data.sentry_organization.test.slug
+	email        = %[1]q
+	role         = "member"
+}
+
+resource "sentry_external_user" "test" {
+	organization   = data.sentry_organization.test.slug
+	member_id      = sentry_organization_member.test.id
+	integration_id = %[2]q
+	provider_key   = %[3]q
+	external_name  = %[4]q
+}
+`, email, integrationId, providerKey, externalName)
+}
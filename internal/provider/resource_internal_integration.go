@@ -0,0 +1,438 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+var internalIntegrationEvents = []string{"issue", "error", "comment"}
+
+var internalIntegrationScopes = []string{
+	"project:read",
+	"project:write",
+	"project:admin",
+	"project:releases",
+	"team:read",
+	"team:write",
+	"team:admin",
+	"event:read",
+	"event:write",
+	"event:admin",
+	"org:read",
+	"org:write",
+	"org:admin",
+	"member:read",
+	"member:write",
+	"member:admin",
+	"alerts:read",
+	"alerts:write",
+}
+
+type InternalIntegrationResourceModel struct {
+	Id               types.String                  `tfsdk:"id"`
+	Organization     types.String                  `tfsdk:"organization"`
+	Name             types.String                  `tfsdk:"name"`
+	Overview         types.String                  `tfsdk:"overview"`
+	WebhookUrl       types.String                  `tfsdk:"webhook_url"`
+	RedirectUrl      types.String                  `tfsdk:"redirect_url"`
+	Events           supertypes.SetValueOf[string] `tfsdk:"events"`
+	Alertable        types.Bool                    `tfsdk:"alertable"`
+	Scopes           supertypes.SetValueOf[string] `tfsdk:"scopes"`
+	Schema           sentrytypes.LossyJson         `tfsdk:"schema"`
+	AllowedOrigins   supertypes.SetValueOf[string] `tfsdk:"allowed_origins"`
+	Slug             types.String                  `tfsdk:"slug"`
+	Uuid             types.String                  `tfsdk:"uuid"`
+	Status           types.String                  `tfsdk:"status"`
+	InstallationUuid types.String                  `tfsdk:"installation_uuid"`
+	SentryAppId      types.Int64                   `tfsdk:"sentry_app_id"`
+}
+
+// Fill populates the model from the API response. Optional collections that
+// are unset stay unset when Sentry returns them empty.
+func (m *InternalIntegrationResourceModel) Fill(ctx context.Context, app apiclient.SentryApp, installation apiclient.SentryAppInstallation) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(app.Slug)
+	m.Name = types.StringValue(app.Name)
+	m.Overview = types.StringNull()
+	if v, err := app.Overview.Get(); err == nil && v != "" {
+		m.Overview = types.StringValue(v)
+	}
+	m.WebhookUrl = types.StringNull()
+	if v, err := app.WebhookUrl.Get(); err == nil && v != "" {
+		m.WebhookUrl = types.StringValue(v)
+	}
+	m.RedirectUrl = types.StringNull()
+	if v, err := app.RedirectUrl.Get(); err == nil && v != "" {
+		m.RedirectUrl = types.StringValue(v)
+	}
+	if !m.Events.IsNull() || len(app.Events) > 0 {
+		m.Events = supertypes.NewSetValueOfSlice(ctx, app.Events)
+	}
+	m.Alertable = types.BoolValue(app.IsAlertable)
+	m.Scopes = supertypes.NewSetValueOfSlice(ctx, app.Scopes)
+	if !m.Schema.IsNull() || len(app.Schema) > 0 {
+		b, err := json.Marshal(app.Schema)
+		if err != nil {
+			diags.Append(diagutils.NewFillError(err))
+			return
+		}
+		m.Schema = sentrytypes.NewLossyJsonValue(string(b))
+	}
+	if !m.AllowedOrigins.IsNull() || len(app.AllowedOrigins) > 0 {
+		m.AllowedOrigins = supertypes.NewSetValueOfSlice(ctx, app.AllowedOrigins)
+	}
+	m.Slug = types.StringValue(app.Slug)
+	m.Uuid = types.StringValue(app.Uuid)
+	m.Status = types.StringValue(app.Status)
+	m.InstallationUuid = types.StringValue(installation.Uuid)
+	m.SentryAppId = types.Int64Value(int64(installation.App.SentryAppId))
+
+	return
+}
+
+func (m InternalIntegrationResourceModel) ToRequest(ctx context.Context) (apiclient.SentryAppRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	req := apiclient.SentryAppRequest{
+		Name:         m.Name.ValueString(),
+		Organization: m.Organization.ValueStringPointer(),
+		IsInternal:   new(true),
+		IsAlertable:  m.Alertable.ValueBoolPointer(),
+		Overview:     nullableFromPtr(m.Overview.ValueStringPointer()),
+		WebhookUrl:   nullableFromPtr(m.WebhookUrl.ValueStringPointer()),
+		RedirectUrl:  nullableFromPtr(m.RedirectUrl.ValueStringPointer()),
+		Events:       new([]string{}),
+		Schema:       new(map[string]any{}),
+	}
+
+	scopes, d := m.Scopes.Get(ctx)
+	diags.Append(d...)
+	req.Scopes = scopes
+
+	if m.Events.IsKnown() {
+		events, d := m.Events.Get(ctx)
+		diags.Append(d...)
+		req.Events = &events
+	}
+
+	if m.AllowedOrigins.IsKnown() {
+		allowedOrigins, d := m.AllowedOrigins.Get(ctx)
+		diags.Append(d...)
+		req.AllowedOrigins = &allowedOrigins
+	} else {
+		req.AllowedOrigins = new([]string{})
+	}
+
+	if !m.Schema.IsNull() && !m.Schema.IsUnknown() {
+		if err := json.Unmarshal([]byte(m.Schema.ValueString()), req.Schema); err != nil {
+			diags.AddAttributeError(path.Root("schema"), "Invalid schema", fmt.Sprintf("Unable to parse schema: %s", err))
+		}
+	}
+
+	return req, diags
+}
+
+var _ resource.Resource = &InternalIntegrationResource{}
+var _ resource.ResourceWithConfigure = &InternalIntegrationResource{}
+var _ resource.ResourceWithValidateConfig = &InternalIntegrationResource{}
+var _ resource.ResourceWithImportState = &InternalIntegrationResource{}
+
+func NewInternalIntegrationResource() resource.Resource {
+	return &InternalIntegrationResource{}
+}
+
+type InternalIntegrationResource struct {
+	baseResource
+}
+
+func (r *InternalIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_integration"
+}
+
+func (r *InternalIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an internal integration, a Sentry App that is only installed in the organization that created it. Use `sentry_app_id` in `sentry_app` alert actions, and the `sentry_internal_integration_token` ephemeral resource to obtain API tokens.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization the integration belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the integration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 57),
+				},
+			},
+			"overview": schema.StringAttribute{
+				MarkdownDescription: "A description of the integration.",
+				Optional:            true,
+			},
+			"webhook_url": schema.StringAttribute{
+				MarkdownDescription: "The URL Sentry sends webhook requests to. Required when `events` is set or `alertable` is enabled.",
+				Optional:            true,
+			},
+			"redirect_url": schema.StringAttribute{
+				MarkdownDescription: "The URL Sentry redirects users to after installation.",
+				Optional:            true,
+			},
+			"events": tfutils.WithEnumSetAttributeStringElements(schema.SetAttribute{
+				MarkdownDescription: "The resources the integration subscribes to webhooks for.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				Validators: []validator.Set{
+					setvalidator.AlsoRequires(path.MatchRoot("webhook_url")),
+				},
+			}, internalIntegrationEvents),
+			"alertable": schema.BoolAttribute{
+				MarkdownDescription: "Whether the integration can be used as an action in alerts. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"scopes": tfutils.WithEnumSetAttributeStringElements(schema.SetAttribute{
+				MarkdownDescription: "The permissions granted to the integration's tokens.",
+				Required:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+			}, internalIntegrationScopes),
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "The UI components of the integration, as a JSON-encoded [schema](https://docs.sentry.io/organization/integrations/integration-platform/ui-components/).",
+				Optional:            true,
+				CustomType:          sentrytypes.LossyJsonType{},
+			},
+			"allowed_origins": schema.SetAttribute{
+				MarkdownDescription: "The origins allowed to make requests with the integration's tokens, e.g. from the browser.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the integration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the integration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the integration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"installation_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the integration's installation in the organization. Use as `sentry_app_installation_uuid` in `notify_event_sentry_app` issue alert actions.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sentry_app_id": schema.Int64Attribute{
+				MarkdownDescription: "The numerical Sentry App ID. Use as `sentry_app_id` in `sentry_app` alert actions.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InternalIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data InternalIntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Alertable.ValueBool() && data.WebhookUrl.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("webhook_url"),
+			"Missing webhook URL",
+			"A webhook URL is required for the integration to be alertable.",
+		)
+	}
+}
+
+func (r *InternalIntegrationResource) readInstallation(ctx context.Context, organization string, slug string) (*apiclient.SentryAppInstallation, error) {
+	params := &apiclient.ListSentryAppInstallationsParams{}
+
+	for {
+		httpResp, err := r.apiClient.ListSentryAppInstallationsWithResponse(ctx, organization, params)
+		if err != nil {
+			return nil, err
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			return nil, fmt.Errorf("unable to list sentry app installations, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+		}
+
+		for _, installation := range *httpResp.JSON200 {
+			if installation.App.Slug == slug {
+				return &installation, nil
+			}
+		}
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			return nil, fmt.Errorf("unable to find the installation of %q: %w", slug, errNotFound)
+		}
+	}
+}
+
+func (r *InternalIntegrationResource) fill(ctx context.Context, data *InternalIntegrationResourceModel, app apiclient.SentryApp) (diags diag.Diagnostics) {
+	installation, err := r.readInstallation(ctx, data.Organization.ValueString(), app.Slug)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("read", err))
+		return
+	}
+
+	diags.Append(data.Fill(ctx, app, *installation)...)
+	return
+}
+
+func (r *InternalIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InternalIntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := data.ToRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.CreateSentryAppWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(r.fill(ctx, &data, *httpResp.JSON201)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InternalIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InternalIntegrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetSentryAppWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(r.fill(ctx, &data, *httpResp.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InternalIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state InternalIntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := plan.ToRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.UpdateSentryAppWithResponse(ctx, state.Id.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(r.fill(ctx, &plan, *httpResp.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *InternalIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InternalIntegrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteSentryAppWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *InternalIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "id")(ctx, req, resp)
+}
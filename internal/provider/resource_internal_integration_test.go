@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+func TestAccInternalIntegrationResource(t *testing.T) {
+	rn := "sentry_internal_integration.test"
+	name := acctest.RandomWithPrefix("tf-integration")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckInternalIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInternalIntegrationResourceConfig(name, `
+	scopes = ["project:read", "event:read"]
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("webhook_url"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("events"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("alertable"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("scopes"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("project:read"),
+						knownvalue.StringExact("event:read"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("schema"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("allowed_origins"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("slug"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("uuid"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("status"), knownvalue.StringExact("internal")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("installation_uuid"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sentry_app_id"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccInternalIntegrationResourceConfig(name+"-updated", `
+	overview        = "Managed by Terraform"
+	webhook_url     = "https://example.com/webhook"
+	events          = ["issue", "error"]
+	alertable       = true
+	scopes          = ["project:read", "event:read", "event:write"]
+	allowed_origins = ["https://example.com"]
+
+	schema = jsonencode({
+		elements = [
+			{
+				type = "alert-rule-action"
+				title = "Create a ticket"
+				settings = {
+					type = "alert-rule-settings"
+					uri  = "/alert-rule-action/"
+					required_fields = [
+						{
+							type  = "text"
+							label = "Title"
+							name  = "title"
+						},
+					]
+				}
+			},
+		]
+	})
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(name+"-updated")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("overview"), knownvalue.StringExact("Managed by Terraform")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("webhook_url"), knownvalue.StringExact("https://example.com/webhook")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("events"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("issue"),
+						knownvalue.StringExact("error"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("alertable"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("scopes"), knownvalue.SetSizeExact(3)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("allowed_origins"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("https://example.com"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("schema"), knownvalue.NotNull()),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"schema",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckInternalIntegrationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sentry_internal_integration" {
+			continue
+		}
+
+		ctx := context.Background()
+		httpResp, err := acctest.SharedApiClient.GetSentryAppWithResponse(ctx, rs.Primary.Attributes["id"])
+		if err != nil {
+			return err
+		} else if httpResp.StatusCode() != http.StatusNotFound {
+			return errors.New("internal integration still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccInternalIntegrationResourceConfig(name, extras string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_internal_integration" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+%[2]s
+}
+`, name, extras)
+}
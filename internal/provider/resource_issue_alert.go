@@ -69,7 +69,7 @@ func (r *IssueAlertResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"- Issue-state `conditions` (`first_seen_event`, `regression_event`, `reappeared_event`) become `trigger_conditions`. Frequency conditions (e.g. `event_frequency`) move to `action_filters[].conditions` (e.g. `event_frequency_count`).\n" +
 			"- `filters` become `action_filters[].conditions` (e.g. `tagged_event`, `age_comparison`, `level`), and `filter_match` becomes `action_filters[].logic_type`.\n" +
 			"- `actions` become `action_filters[].actions` (e.g. `email`, `slack`), and `frequency` becomes `frequency_minutes`.\n" +
-			"- `sentry_alert` requires `monitor_ids`. For a classic alert that is not tied to a monitor, reference a project default monitor with the [`sentry_project_error_monitor`](../data-sources/project_error_monitor.md) or [`sentry_project_issue_stream_monitor`](../data-sources/project_issue_stream_monitor.md) data source — no monitor resource needs to be created.\n\n" +
+			"- `sentry_alert` is connected to monitors through `monitor_ids` or the [`sentry_alert_monitor_link`](alert_monitor_link.md) resource. For a classic alert that is not tied to a monitor, reference a project default monitor with the [`sentry_project_error_monitor`](../data-sources/project_error_monitor.md) or [`sentry_project_issue_stream_monitor`](../data-sources/project_issue_stream_monitor.md) data source — no monitor resource needs to be created.\n\n" +
 			"A few legacy trigger types (e.g. `new_high_priority_issue`, `existing_high_priority_issue`) are currently only available through `sentry_alert`'s `legacy_trigger_conditions` passthrough.\n\n" +
 			"**NOTE:** The `conditions`, `filters`, and `actions` attributes, which are JSON strings, have been deprecated in favor of `conditions_v2`, `filters_v2`, and `actions_v2`, which are lists of objects.\n\n" +
 			"The `*_v2` attributes are available starting from v0.14.2.",
@@ -0,0 +1,456 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/searchsyntax"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	"github.com/oapi-codegen/nullable"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+	"github.com/samber/lo"
+)
+
+type IssueViewTimeFiltersModel struct {
+	Period types.String `tfsdk:"period"`
+	Start  types.String `tfsdk:"start"`
+	End    types.String `tfsdk:"end"`
+	Utc    types.Bool   `tfsdk:"utc"`
+}
+
+func (m *IssueViewTimeFiltersModel) Fill(timeFilters apiclient.GroupSearchViewTimeFilters) {
+	m.Period = types.StringNull()
+	if v, err := timeFilters.Period.Get(); err == nil {
+		m.Period = types.StringValue(v)
+	}
+	// Keep the configured spelling of the timestamps if Sentry reformats them.
+	priorStart := m.Start
+	m.Start = types.StringNull()
+	if v, err := timeFilters.Start.Get(); err == nil {
+		m.Start = sameInstantStringValue(priorStart, v)
+	}
+	priorEnd := m.End
+	m.End = types.StringNull()
+	if v, err := timeFilters.End.Get(); err == nil {
+		m.End = sameInstantStringValue(priorEnd, v)
+	}
+	m.Utc = types.BoolNull()
+	if v, err := timeFilters.Utc.Get(); err == nil {
+		m.Utc = types.BoolValue(v)
+	}
+}
+
+func (m IssueViewTimeFiltersModel) ToRequestBody() apiclient.GroupSearchViewTimeFilters {
+	var body apiclient.GroupSearchViewTimeFilters
+	if !m.Period.IsNull() {
+		body.Period = nullable.NewNullableWithValue(m.Period.ValueString())
+	}
+	if !m.Start.IsNull() {
+		body.Start = nullable.NewNullableWithValue(m.Start.ValueString())
+	}
+	if !m.End.IsNull() {
+		body.End = nullable.NewNullableWithValue(m.End.ValueString())
+	}
+	if !m.Utc.IsNull() {
+		body.Utc = nullable.NewNullableWithValue(m.Utc.ValueBool())
+	}
+	return body
+}
+
+type IssueViewResourceModel struct {
+	Id           types.String                                                    `tfsdk:"id"`
+	Organization types.String                                                    `tfsdk:"organization"`
+	Name         types.String                                                    `tfsdk:"name"`
+	Query        types.String                                                    `tfsdk:"query"`
+	QuerySort    types.String                                                    `tfsdk:"query_sort"`
+	Projects     supertypes.SetValueOf[string]                                   `tfsdk:"projects"`
+	AllProjects  types.Bool                                                      `tfsdk:"all_projects"`
+	Environments supertypes.SetValueOf[string]                                   `tfsdk:"environments"`
+	TimeFilters  supertypes.SingleNestedObjectValueOf[IssueViewTimeFiltersModel] `tfsdk:"time_filters"`
+	Starred      types.Bool                                                      `tfsdk:"starred"`
+}
+
+func (m *IssueViewResourceModel) Fill(ctx context.Context, view apiclient.GroupSearchView) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(view.Id)
+	m.Name = types.StringValue(view.Name)
+	m.Query = types.StringValue(view.Query)
+	m.QuerySort = types.StringValue(view.QuerySort)
+	m.AllProjects = types.BoolValue(view.IsAllProjects)
+	m.Starred = types.BoolValue(lo.FromPtr(view.Starred))
+
+	if len(view.Projects) > 0 {
+		m.Projects = supertypes.NewSetValueOfSlice(ctx, lo.Map(view.Projects, func(id int64, _ int) string {
+			return strconv.FormatInt(id, 10)
+		}))
+	} else {
+		m.Projects = supertypes.NewSetValueOfNull[string](ctx)
+	}
+
+	if len(view.Environments) > 0 {
+		m.Environments = supertypes.NewSetValueOfSlice(ctx, view.Environments)
+	} else {
+		m.Environments = supertypes.NewSetValueOfNull[string](ctx)
+	}
+
+	var timeFilters IssueViewTimeFiltersModel
+	if !m.TimeFilters.IsNull() && !m.TimeFilters.IsUnknown() {
+		prior, d := m.TimeFilters.Get(ctx)
+		diags.Append(d...)
+		if prior != nil {
+			timeFilters = *prior
+		}
+	}
+	timeFilters.Fill(view.TimeFilters)
+
+	m.TimeFilters = supertypes.NewSingleNestedObjectValueOfNull[IssueViewTimeFiltersModel](ctx)
+	diags.Append(m.TimeFilters.Set(ctx, &timeFilters)...)
+
+	return
+}
+
+func (m IssueViewResourceModel) ToRequestBody(ctx context.Context) (body apiclient.GroupSearchViewRequest, diags diag.Diagnostics) {
+	body = apiclient.GroupSearchViewRequest{
+		Name:          m.Name.ValueString(),
+		Query:         m.Query.ValueString(),
+		QuerySort:     m.QuerySort.ValueString(),
+		Projects:      []int64{},
+		IsAllProjects: m.AllProjects.ValueBool(),
+		Environments:  []string{},
+		TimeFilters:   apiclient.GroupSearchViewTimeFilters{Period: nullable.NewNullableWithValue("14d")},
+		Starred:       m.Starred.ValueBoolPointer(),
+	}
+
+	if !m.Projects.IsNull() && !m.Projects.IsUnknown() {
+		projects, d := m.Projects.Get(ctx)
+		diags.Append(d...)
+		for _, project := range projects {
+			id, err := strconv.ParseInt(project, 10, 64)
+			if err != nil {
+				diags.AddAttributeError(path.Root("projects"), "Invalid project ID", err.Error())
+				continue
+			}
+			body.Projects = append(body.Projects, id)
+		}
+	}
+
+	if !m.Environments.IsNull() && !m.Environments.IsUnknown() {
+		environments, d := m.Environments.Get(ctx)
+		diags.Append(d...)
+		body.Environments = environments
+	}
+
+	if !m.TimeFilters.IsNull() && !m.TimeFilters.IsUnknown() {
+		timeFilters, d := m.TimeFilters.Get(ctx)
+		diags.Append(d...)
+		if timeFilters != nil {
+			body.TimeFilters = timeFilters.ToRequestBody()
+		}
+	}
+
+	return
+}
+
+var _ resource.Resource = &IssueViewResource{}
+var _ resource.ResourceWithConfigure = &IssueViewResource{}
+var _ resource.ResourceWithImportState = &IssueViewResource{}
+var _ resource.ResourceWithValidateConfig = &IssueViewResource{}
+
+func NewIssueViewResource() resource.Resource {
+	return &IssueViewResource{}
+}
+
+type IssueViewResource struct {
+	baseResource
+}
+
+func (r *IssueViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_view"
+}
+
+func (r *IssueViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an issue view, a saved tab of the issue stream with its own query, projects, environments and time range. Issue views belong to the user of the auth token.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the issue view.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The issue search query, e.g. `is:unresolved issue.priority:[high, medium]`.",
+				Required:            true,
+				Validators: []validator.String{
+					tfutils.SearchQuery(searchsyntax.IssueSearch),
+				},
+			},
+			"query_sort": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+				MarkdownDescription: "The sort order of the issues.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("date"),
+			}, sentrydata.SavedSearchSortOptions),
+			"projects": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects to show issues from. When neither this nor `all_projects` is set, the view shows the projects of the user.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a numeric project ID"),
+					),
+				},
+			},
+			"all_projects": schema.BoolAttribute{
+				MarkdownDescription: "Whether to show issues from all projects. Conflicts with `projects`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"environments": schema.SetAttribute{
+				MarkdownDescription: "The environments to show issues from. All environments are shown when this is not set.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"time_filters": schema.SingleNestedAttribute{
+				MarkdownDescription: "The time range of the issue view. Defaults to the last 14 days.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          supertypes.NewSingleNestedObjectTypeOf[IssueViewTimeFiltersModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"period": schema.StringAttribute{
+						MarkdownDescription: "A relative time range, e.g. `24h` or `14d`. Exactly one of `period` or `start` must be set.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^[1-9][0-9]*[smhdw]$`), "must be a relative time range such as 24h or 14d"),
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("start")),
+						},
+					},
+					"start": schema.StringAttribute{
+						MarkdownDescription: "The start of an absolute time range, in RFC 3339 format.",
+						Optional:            true,
+						Validators: []validator.String{
+							tfutils.RFC3339(),
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("end")),
+						},
+					},
+					"end": schema.StringAttribute{
+						MarkdownDescription: "The end of an absolute time range, in RFC 3339 format.",
+						Optional:            true,
+						Validators: []validator.String{
+							tfutils.RFC3339(),
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("start")),
+						},
+					},
+					"utc": schema.BoolAttribute{
+						MarkdownDescription: "Whether to show the time range in UTC.",
+						Optional:            true,
+						Computed:            true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"starred": schema.BoolAttribute{
+				MarkdownDescription: "Whether the issue view is starred, which shows it in the sidebar.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *IssueViewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IssueViewResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AllProjects.ValueBool() && !data.Projects.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("projects"),
+			"Invalid attribute combination",
+			"Attribute must be null when all_projects is true.",
+		)
+	}
+}
+
+func (r *IssueViewResource) star(ctx context.Context, organization string, id string, starred bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.apiClient.StarOrganizationGroupSearchViewWithResponse(ctx, organization, id, apiclient.StarOrganizationGroupSearchViewJSONRequestBody{
+		Starred: starred,
+	})
+	if err != nil {
+		diags.Append(diagutils.NewClientError("star", err))
+	} else if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusNoContent {
+		diags.Append(diagutils.NewClientStatusError("star", httpResp.StatusCode(), httpResp.Body))
+	}
+
+	return diags
+}
+
+func (r *IssueViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IssueViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := data.ToRequestBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.CreateOrganizationGroupSearchViewWithResponse(ctx, data.Organization.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON201)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IssueViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationGroupSearchViewWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("issue view"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IssueViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := data.ToRequestBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Starring is managed through its own endpoint.
+	body.Starred = nil
+
+	httpResp, err := r.apiClient.UpdateOrganizationGroupSearchViewWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	view := *httpResp.JSON200
+
+	if !data.Starred.Equal(state.Starred) {
+		resp.Diagnostics.Append(r.star(ctx, data.Organization.ValueString(), data.Id.ValueString(), data.Starred.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		view.Starred = data.Starred.ValueBoolPointer()
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, view)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IssueViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteOrganizationGroupSearchViewWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *IssueViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "id")(ctx, req, resp)
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/oapi-codegen/nullable"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+func TestIssueViewResourceModel_ToRequestBody(t *testing.T) {
+	ctx := context.Background()
+
+	var data IssueViewResourceModel
+	diags := data.Fill(ctx, apiclient.GroupSearchView{
+		Id:            "1",
+		Name:          "My view",
+		Query:         "is:unresolved",
+		QuerySort:     "freq",
+		Projects:      []int64{2, 3},
+		IsAllProjects: false,
+		Environments:  []string{"production"},
+		TimeFilters: apiclient.GroupSearchViewTimeFilters{
+			Period: nullable.NewNullableWithValue("24h"),
+			Start:  nullable.NewNullNullable[string](),
+			End:    nullable.NewNullNullable[string](),
+			Utc:    nullable.NewNullableWithValue(true),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if data.Starred.ValueBool() {
+		t.Errorf("expected starred to be false")
+	}
+
+	got, diags := data.ToRequestBody(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := apiclient.GroupSearchViewRequest{
+		Name:          "My view",
+		Query:         "is:unresolved",
+		QuerySort:     "freq",
+		Projects:      []int64{2, 3},
+		IsAllProjects: false,
+		Environments:  []string{"production"},
+		TimeFilters: apiclient.GroupSearchViewTimeFilters{
+			Period: nullable.NewNullableWithValue("24h"),
+			Utc:    nullable.NewNullableWithValue(true),
+		},
+		Starred: new(false),
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToRequestBody() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIssueViewResourceModel_KeepsPriorTimestampSpelling(t *testing.T) {
+	ctx := context.Background()
+
+	view := apiclient.GroupSearchView{
+		Id:           "1",
+		Name:         "My view",
+		Query:        "is:unresolved",
+		QuerySort:    "date",
+		Projects:     []int64{},
+		Environments: []string{},
+		TimeFilters: apiclient.GroupSearchViewTimeFilters{
+			Period: nullable.NewNullNullable[string](),
+			Start:  nullable.NewNullableWithValue("2024-01-01T00:00:00.000000Z"),
+			End:    nullable.NewNullableWithValue("2024-01-02T00:00:00+00:00"),
+			Utc:    nullable.NewNullableWithValue(true),
+		},
+	}
+
+	data := IssueViewResourceModel{
+		TimeFilters: supertypes.NewSingleNestedObjectValueOf(ctx, &IssueViewTimeFiltersModel{
+			Period: types.StringNull(),
+			Start:  types.StringValue("2024-01-01T00:00:00Z"),
+			End:    types.StringValue("2024-01-03T00:00:00Z"),
+			Utc:    types.BoolValue(true),
+		}),
+	}
+	if diags := data.Fill(ctx, view); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	timeFilters, diags := data.TimeFilters.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got, want := timeFilters.Start.ValueString(), "2024-01-01T00:00:00Z"; got != want {
+		t.Errorf("start = %q, want %q", got, want)
+	}
+	if got, want := timeFilters.End.ValueString(), "2024-01-02T00:00:00+00:00"; got != want {
+		t.Errorf("end = %q, want %q", got, want)
+	}
+}
+
+func TestAccIssueViewResource(t *testing.T) {
+	rn := "sentry_issue_view.test"
+	name := acctest.RandomWithPrefix("tf-issue-view")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIssueViewResourceConfig(name, "is:unresolved times_seen:>lots", `period = "24h"`, false),
+				ExpectError: regexp.MustCompile(`Invalid search query`),
+			},
+			{
+				Config: testAccIssueViewResourceConfig(name, "is:unresolved", `period = "24h"`, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("query"), knownvalue.StringExact("is:unresolved")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("query_sort"), knownvalue.StringExact("date")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("projects"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("all_projects"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environments"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("production"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("time_filters").AtMapKey("period"), knownvalue.StringExact("24h")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("starred"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: testAccIssueViewResourceConfig(name+"-updated", "is:unresolved issue.priority:high", `start = "2025-01-01T00:00:00Z"
+		end   = "2025-01-31T00:00:00Z"`, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(name+"-updated")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("query"), knownvalue.StringExact("is:unresolved issue.priority:high")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("time_filters").AtMapKey("period"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("time_filters").AtMapKey("start"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("starred"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIssueViewResourceConfig(name, query, timeFilters string, starred bool) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+data "sentry_project" "test" {
+	organization = data.sentry_organization.test.slug
+	slug         = %[1]q
+}
+
+resource "sentry_issue_view" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = %[2]q
+	query        = %[3]q
+	projects     = [data.sentry_project.test.internal_id]
+	environments = ["production"]
+
+	time_filters = {
+		%[4]s
+	}
+
+	starred = %[5]t
+}
+`, acctest.TestProject.Slug, name, query, timeFilters, starred)
+}
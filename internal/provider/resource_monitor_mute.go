@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+)
+
+var _ resource.Resource = &MonitorMuteResource{}
+var _ resource.ResourceWithConfigure = &MonitorMuteResource{}
+var _ resource.ResourceWithModifyPlan = &MonitorMuteResource{}
+
+func NewMonitorMuteResource() resource.Resource {
+	return &MonitorMuteResource{}
+}
+
+type MonitorMuteResource struct {
+	baseResource
+}
+
+func (r *MonitorMuteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_mute"
+}
+
+func (r *MonitorMuteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mutes a monitor for everyone, e.g. during planned maintenance. The monitor is unmuted when this resource is destroyed.\n\n" +
+			"Once `until` has passed, Terraform plans to unmute the monitor and warns that the resource can be removed from the configuration.\n\n" +
+			"A cron monitor ([`sentry_cron_monitor`](cron_monitor.md)) is muted in place, either entirely or for a single environment. Other monitors ([`sentry_metric_monitor`](metric_monitor.md), [`sentry_uptime_monitor`](uptime_monitor.md)) are muted by disabling them, so add `enabled` to their `lifecycle.ignore_changes` while they are muted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the monitor to mute.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "The environment to mute the cron monitor in. All environments are muted if not set. Only supported for cron monitors.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "The date the mute ends, in RFC 3339 format, e.g. `2025-01-01T06:00:00Z`. The monitor is muted indefinitely if not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					tfutils.RFC3339(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the mute is in effect. This is `false` once `until` has passed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *MonitorMuteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyUntilPlan(ctx, req, resp, "Monitor mute expired", "mute")
+}
+
+func (r *MonitorMuteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MonitorMuteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.MonitorId
+
+	// An expired mute is planned as inactive and has nothing to apply.
+	if data.Active.ValueBool() {
+		resp.Diagnostics.Append(r.setMuted(ctx, data, true)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitorMuteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MonitorMuteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is nothing to compare with once the mute has been lifted.
+	if !data.Active.ValueBool() {
+		return
+	}
+
+	monitor, diags := r.getMonitor(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if monitor == nil {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("monitor"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	muted, err := data.isMuted(*monitor)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	if !muted {
+		if !data.isExpired(time.Now()) {
+			// The monitor was unmuted outside of Terraform.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.Active = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitorMuteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so the only change is
+	// lifting an expired mute.
+	var data, state MonitorMuteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Active.ValueBool() && !data.Active.ValueBool() {
+		resp.Diagnostics.Append(r.setMuted(ctx, state, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitorMuteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MonitorMuteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Active.ValueBool() {
+		resp.Diagnostics.Append(r.setMuted(ctx, data, false)...)
+	}
+}
+
+// getMonitor returns the monitor, or nil if it no longer exists.
+func (r *MonitorMuteResource) getMonitor(ctx context.Context, data MonitorMuteResourceModel) (*apiclient.ProjectMonitor, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.apiClient.GetProjectMonitorWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.MonitorId.ValueString(),
+	)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("read", err))
+		return nil, diags
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return nil, diags
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return nil, diags
+	}
+
+	return httpResp.JSON200, diags
+}
+
+func (r *MonitorMuteResource) setMuted(ctx context.Context, data MonitorMuteResourceModel, muted bool) diag.Diagnostics {
+	monitor, diags := r.getMonitor(ctx, data)
+	if diags.HasError() {
+		return diags
+	} else if monitor == nil {
+		if muted {
+			diags.Append(diagutils.NewNotFoundError("monitor"))
+		}
+		// Otherwise the monitor was deleted, so there is no mute to lift.
+		return diags
+	}
+
+	cron, err := cronMonitorDataSource(*monitor)
+	if err != nil {
+		diags.Append(diagutils.NewFillError(err))
+		return diags
+	}
+
+	if cron == nil {
+		if !data.Environment.IsNull() {
+			diags.AddAttributeError(
+				path.Root("environment"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Monitor %s is a %s monitor. Only cron monitors can be muted per environment.", data.MonitorId.ValueString(), monitor.Type),
+			)
+			return diags
+		}
+
+		httpResp, err := r.apiClient.BulkUpdateOrganizationMonitorsWithResponse(
+			ctx,
+			data.Organization.ValueString(),
+			&apiclient.BulkUpdateOrganizationMonitorsParams{
+				Id: []string{data.MonitorId.ValueString()},
+			},
+			apiclient.BulkEnabledRequest{
+				Enabled: !muted,
+			},
+		)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("update", err))
+		} else if httpResp.StatusCode() != http.StatusOK {
+			diags.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		}
+		return diags
+	}
+
+	if cron.Slug == nil {
+		diags.Append(diagutils.NewFillError(fmt.Errorf("cron monitor %s has no slug", data.MonitorId.ValueString())))
+		return diags
+	}
+
+	body := apiclient.CronMonitorMuteRequest{IsMuted: muted}
+	var statusCode int
+	var respBody []byte
+	if data.Environment.IsNull() {
+		httpResp, err := r.apiClient.UpdateProjectCronMonitorMuteWithResponse(
+			ctx,
+			data.Organization.ValueString(),
+			monitor.ProjectId,
+			*cron.Slug,
+			body,
+		)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("update", err))
+			return diags
+		}
+		statusCode, respBody = httpResp.StatusCode(), httpResp.Body
+	} else {
+		httpResp, err := r.apiClient.UpdateProjectCronMonitorEnvironmentMuteWithResponse(
+			ctx,
+			data.Organization.ValueString(),
+			monitor.ProjectId,
+			*cron.Slug,
+			data.Environment.ValueString(),
+			body,
+		)
+		if err != nil {
+			diags.Append(diagutils.NewClientError("update", err))
+			return diags
+		}
+		statusCode, respBody = httpResp.StatusCode(), httpResp.Body
+	}
+
+	if statusCode == http.StatusNotFound && !muted {
+		// The environment was deleted, so there is no mute to lift.
+		return diags
+	} else if statusCode != http.StatusOK {
+		diags.Append(diagutils.NewClientStatusError("update", statusCode, respBody))
+	}
+	return diags
+}
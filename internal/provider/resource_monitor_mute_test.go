@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+)
+
+func TestMonitorMuteResourceModel_isMuted(t *testing.T) {
+	cronMonitor := `{
+		"id": "1",
+		"type": "monitor_check_in_failure",
+		"enabled": true,
+		"dataSources": [
+			{
+				"type": "cron_monitor",
+				"queryObj": {
+					"name": "nightly",
+					"slug": "nightly",
+					"isMuted": %t,
+					"config": {"schedule_type": "crontab", "schedule": "0 0 * * *", "checkin_margin": 1, "failure_issue_threshold": 1, "max_runtime": 1, "recovery_threshold": 1, "timezone": "UTC"},
+					"environments": [
+						{"name": "production", "isMuted": false},
+						{"name": "staging", "isMuted": true}
+					]
+				}
+			}
+		]
+	}`
+
+	testCases := []struct {
+		name        string
+		body        string
+		environment types.String
+		want        bool
+		wantErr     bool
+	}{
+		{"cron muted", fmt.Sprintf(cronMonitor, true), types.StringNull(), true, false},
+		{"cron not muted", fmt.Sprintf(cronMonitor, false), types.StringNull(), false, false},
+		{"cron environment muted", fmt.Sprintf(cronMonitor, false), types.StringValue("staging"), true, false},
+		{"cron environment not muted", fmt.Sprintf(cronMonitor, false), types.StringValue("production"), false, false},
+		{"cron environment unknown", fmt.Sprintf(cronMonitor, false), types.StringValue("development"), false, false},
+		{"disabled", `{"id": "2", "type": "uptime_domain_failure", "enabled": false, "dataSources": []}`, types.StringNull(), true, false},
+		{"enabled", `{"id": "2", "type": "uptime_domain_failure", "enabled": true, "dataSources": []}`, types.StringNull(), false, false},
+		{"environment of non-cron monitor", `{"id": "2", "type": "uptime_domain_failure", "enabled": true, "dataSources": []}`, types.StringValue("production"), false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var monitor apiclient.ProjectMonitor
+			if err := json.Unmarshal([]byte(tc.body), &monitor); err != nil {
+				t.Fatal(err)
+			}
+
+			m := MonitorMuteResourceModel{Environment: tc.environment}
+			got, err := m.isMuted(monitor)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("isMuted() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("isMuted() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAccMonitorMuteResource(t *testing.T) {
+	rn := "sentry_monitor_mute.test"
+	projectName := acctest.RandomWithPrefix("tf-project")
+	monitorName := acctest.RandomWithPrefix("tf-monitor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorMuteResourceConfig(projectName, monitorName, ``),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environment"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("until"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("active"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testAccMonitorMuteResourceConfig(projectName, monitorName, `until = "2020-01-01T00:00:00Z"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("active"), knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorMuteResourceConfig(projectName, monitorName, extras string) string {
+	return testAccCronMonitorResourceConfig(projectName, monitorName, `
+		schedule = {
+			crontab = "0 0 * * *"
+		}
+	`) + fmt.Sprintf(`
+		resource "sentry_monitor_mute" "test" {
+			organization = sentry_cron_monitor.test.organization
+			monitor_id   = sentry_cron_monitor.test.id
+
+			%[1]s
+		}
+	`, extras)
+}
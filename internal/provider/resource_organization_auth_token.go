@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+type OrganizationAuthTokenResourceModel struct {
+	Id                  types.String                  `tfsdk:"id"`
+	Organization        types.String                  `tfsdk:"organization"`
+	Name                types.String                  `tfsdk:"name"`
+	Scopes              supertypes.SetValueOf[string] `tfsdk:"scopes"`
+	TokenLastCharacters types.String                  `tfsdk:"token_last_characters"`
+	DateCreated         types.String                  `tfsdk:"date_created"`
+	DateLastUsed        types.String                  `tfsdk:"date_last_used"`
+}
+
+func (m *OrganizationAuthTokenResourceModel) Fill(ctx context.Context, token apiclient.OrganizationAuthToken) error {
+	m.Id = types.StringValue(token.Id)
+	m.Name = types.StringValue(token.Name)
+	m.Scopes = supertypes.NewSetValueOfSlice(ctx, token.Scopes)
+	m.TokenLastCharacters = types.StringNull()
+	if v, err := token.TokenLastCharacters.Get(); err == nil {
+		m.TokenLastCharacters = types.StringValue(v)
+	}
+	m.DateCreated = types.StringValue(token.DateCreated.Format(time.RFC3339))
+	m.DateLastUsed = types.StringNull()
+	if v, err := token.DateLastUsed.Get(); err == nil {
+		m.DateLastUsed = types.StringValue(v.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+var _ resource.Resource = &OrganizationAuthTokenResource{}
+var _ resource.ResourceWithConfigure = &OrganizationAuthTokenResource{}
+var _ resource.ResourceWithImportState = &OrganizationAuthTokenResource{}
+
+func NewOrganizationAuthTokenResource() resource.Resource {
+	return &OrganizationAuthTokenResource{}
+}
+
+type OrganizationAuthTokenResource struct {
+	baseResource
+}
+
+func (r *OrganizationAuthTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_auth_token"
+}
+
+func (r *OrganizationAuthTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an organization auth token, e.g. for uploading source maps and debug files from CI. Destroying this resource revokes the token.\n\n" +
+			"**Note:** The token value is never stored in the Terraform state, so it cannot be read from this resource. Use the [`sentry_organization_auth_token`](../ephemeral-resources/organization_auth_token.md) ephemeral resource to create a token that is only needed during a Terraform run.",
+
+		Attributes: map[string]schema.Attribute{
+			"id":           ResourceIdAttribute(),
+			"organization": ResourceOrganizationAttribute(),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the token.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "The scopes granted to the token. Sentry issues organization auth tokens with the `org:ci` scope.",
+				Computed:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_last_characters": schema.StringAttribute{
+				MarkdownDescription: "The last characters of the token, as displayed in Sentry.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_created": schema.StringAttribute{
+				MarkdownDescription: "The date the token was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_last_used": schema.StringAttribute{
+				MarkdownDescription: "The date the token was last used, in RFC 3339 format.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationAuthTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationAuthTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.CreateOrganizationAuthTokenWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		apiclient.CreateOrganizationAuthTokenJSONRequestBody{
+			Name: data.Name.ValueString(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	token := httpResp.JSON201
+	if err := data.Fill(ctx, apiclient.OrganizationAuthToken{
+		Id:                  token.Id,
+		Name:                token.Name,
+		Scopes:              token.Scopes,
+		TokenLastCharacters: token.TokenLastCharacters,
+		DateCreated:         token.DateCreated,
+		DateLastUsed:        token.DateLastUsed,
+		ProjectLastUsedId:   token.ProjectLastUsedId,
+	}); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationAuthTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationAuthTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationAuthTokenWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Id.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(ctx, *httpResp.JSON200); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationAuthTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationAuthTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateHttpResp, err := r.apiClient.UpdateOrganizationAuthTokenWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Id.ValueString(),
+		apiclient.UpdateOrganizationAuthTokenJSONRequestBody{
+			Name: data.Name.ValueString(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if updateHttpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", updateHttpResp.StatusCode(), updateHttpResp.Body))
+		return
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationAuthTokenWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Id.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(ctx, *httpResp.JSON200); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationAuthTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationAuthTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteOrganizationAuthTokenWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Id.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *OrganizationAuthTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "id")(ctx, req, resp)
+}
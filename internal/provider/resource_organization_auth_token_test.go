@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+func TestAccOrganizationAuthTokenResource(t *testing.T) {
+	rn := "sentry_organization_auth_token.test"
+	name := acctest.RandomWithPrefix("tf-token")
+
+	checks := func(name string) []statecheck.StateCheck {
+		return []statecheck.StateCheck{
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("scopes"), knownvalue.SetExact([]knownvalue.Check{
+				knownvalue.StringExact("org:ci"),
+			})),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("token_last_characters"), knownvalue.NotNull()),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("date_created"), knownvalue.NotNull()),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationAuthTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:            testAccOrganizationAuthTokenResourceConfig(name),
+				ConfigStateChecks: checks(name),
+			},
+			{
+				Config: testAccOrganizationAuthTokenResourceConfig(name + "-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(rn, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: checks(name + "-renamed"),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"date_last_used",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationAuthTokenDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sentry_organization_auth_token" {
+			continue
+		}
+
+		ctx := context.Background()
+		httpResp, err := acctest.SharedApiClient.GetOrganizationAuthTokenWithResponse(
+			ctx,
+			rs.Primary.Attributes["organization"],
+			rs.Primary.Attributes["id"],
+		)
+		if err != nil {
+			return err
+		} else if httpResp.StatusCode() != http.StatusNotFound {
+			return errors.New("organization auth token still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccOrganizationAuthTokenResourceConfig(name string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_organization_auth_token" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+}
+`, name)
+}
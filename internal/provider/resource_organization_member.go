@@ -0,0 +1,631 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	"github.com/oapi-codegen/nullable"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+	"github.com/samber/lo"
+)
+
+var organizationMemberRoles = []string{"billing", "member", "admin", "manager", "owner"}
+
+var organizationMemberTeamRoles = []string{"contributor", "admin"}
+
+const (
+	organizationMemberSsoPollInterval = 10 * time.Second
+	organizationMemberSsoPollTimeout  = 10 * time.Minute
+)
+
+type OrganizationMemberTeamRoleModel struct {
+	Team types.String `tfsdk:"team"`
+	Role types.String `tfsdk:"role"`
+}
+
+type OrganizationMemberResourceModel struct {
+	Id           types.String                                                       `tfsdk:"id"`
+	Organization types.String                                                       `tfsdk:"organization"`
+	Email        types.String                                                       `tfsdk:"email"`
+	Role         types.String                                                       `tfsdk:"role"`
+	Teams        supertypes.SetValueOf[string]                                      `tfsdk:"teams"`
+	TeamRoles    supertypes.SetNestedObjectValueOf[OrganizationMemberTeamRoleModel] `tfsdk:"team_roles"`
+	Reinvite     types.Bool                                                         `tfsdk:"reinvite"`
+	WaitForSso   types.Bool                                                         `tfsdk:"wait_for_sso"`
+	InternalId   types.String                                                       `tfsdk:"internal_id"`
+	Pending      types.Bool                                                         `tfsdk:"pending"`
+	Expired      types.Bool                                                         `tfsdk:"expired"`
+}
+
+// Fill populates the model from the API response. Team assignments are only
+// tracked when the configuration manages them, i.e. when `teams` or `team_roles`
+// is already non-null.
+func (m *OrganizationMemberResourceModel) Fill(ctx context.Context, organization string, member apiclient.OrganizationMemberWithRoles) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(member.Id)
+	m.Organization = types.StringValue(organization)
+	m.Email = types.StringValue(member.Email)
+	m.Role = types.StringValue(member.OrgRole)
+	m.InternalId = types.StringValue(member.Id)
+	m.Pending = types.BoolValue(member.Pending)
+	m.Expired = types.BoolValue(member.Expired)
+
+	if m.Teams.IsNull() && m.TeamRoles.IsNull() {
+		return
+	}
+
+	var teams []string
+	var teamRoles []OrganizationMemberTeamRoleModel
+	for _, teamRole := range member.TeamRoles {
+		if role, err := teamRole.Role.Get(); err == nil && role != "" {
+			teamRoles = append(teamRoles, OrganizationMemberTeamRoleModel{
+				Team: types.StringValue(teamRole.TeamSlug),
+				Role: types.StringValue(role),
+			})
+		} else {
+			teams = append(teams, teamRole.TeamSlug)
+		}
+	}
+
+	// Keep an unset attribute unset when the API has nothing to report for it,
+	// otherwise configuring only one of the two attributes would produce a diff.
+	if !m.Teams.IsNull() || len(teams) > 0 {
+		m.Teams = supertypes.NewSetValueOfSlice(ctx, teams)
+	}
+	if !m.TeamRoles.IsNull() || len(teamRoles) > 0 {
+		m.TeamRoles = supertypes.NewSetNestedObjectValueOfValueSlice(ctx, teamRoles)
+	}
+
+	return
+}
+
+// managesTeams reports whether the configuration manages team assignments.
+func (m OrganizationMemberResourceModel) managesTeams() bool {
+	return !m.Teams.IsNull() || !m.TeamRoles.IsNull()
+}
+
+// teamRolesToApi merges `teams` and `team_roles` into the list of team
+// assignments expected by the API. Teams without an explicit role are sent with
+// a null role, which resolves to the minimum team role of the member's
+// organization role.
+func (m OrganizationMemberResourceModel) teamRolesToApi(ctx context.Context) ([]apiclient.TeamRole, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	teams, d := m.Teams.Get(ctx)
+	diags.Append(d...)
+	teamRoles, d := m.TeamRoles.Get(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make([]apiclient.TeamRole, 0, len(teams)+len(teamRoles))
+	for _, team := range teams {
+		result = append(result, apiclient.TeamRole{
+			TeamSlug: team,
+			Role:     nullable.NewNullNullable[string](),
+		})
+	}
+	for _, teamRole := range teamRoles {
+		result = append(result, apiclient.TeamRole{
+			TeamSlug: teamRole.Team.ValueString(),
+			Role:     nullable.NewNullableWithValue(teamRole.Role.ValueString()),
+		})
+	}
+
+	slices.SortFunc(result, func(a, b apiclient.TeamRole) int {
+		return strings.Compare(a.TeamSlug, b.TeamSlug)
+	})
+
+	return result, diags
+}
+
+var _ resource.Resource = &OrganizationMemberResource{}
+var _ resource.ResourceWithConfigure = &OrganizationMemberResource{}
+var _ resource.ResourceWithValidateConfig = &OrganizationMemberResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationMemberResource{}
+var _ resource.ResourceWithImportState = &OrganizationMemberResource{}
+var _ resource.ResourceWithUpgradeState = &OrganizationMemberResource{}
+
+func NewOrganizationMemberResource() resource.Resource {
+	return &OrganizationMemberResource{}
+}
+
+type OrganizationMemberResource struct {
+	baseResource
+}
+
+func (r *OrganizationMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+func (r *OrganizationMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource for managing Sentry organization members and their team assignments.\n\n" +
+			"Team memberships can be managed here through `teams` and `team_roles`, or individually through the `sentry_team_member` resource. Do not use both for the same member, as they will overwrite each other.",
+
+		Version: 1,
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization the user should be invited to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email of the organization member.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+				MarkdownDescription: "This is the role of the organization member.",
+				Required:            true,
+			}, organizationMemberRoles),
+			"teams": schema.SetAttribute{
+				MarkdownDescription: "The slugs of the teams the member belongs to, using the minimum team role granted by the member's organization role. When `teams` or `team_roles` is set, the member's team memberships are managed authoritatively and any other team membership is removed.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+			},
+			"team_roles": schema.SetNestedAttribute{
+				MarkdownDescription: "The teams the member belongs to with an explicit team role. When `teams` or `team_roles` is set, the member's team memberships are managed authoritatively and any other team membership is removed.",
+				Optional:            true,
+				CustomType:          supertypes.NewSetNestedObjectTypeOf[OrganizationMemberTeamRoleModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"team": schema.StringAttribute{
+							MarkdownDescription: "The slug of the team.",
+							Required:            true,
+						},
+						"role": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+							MarkdownDescription: "The role of the member in the team.",
+							Required:            true,
+						}, organizationMemberTeamRoles),
+					},
+				},
+			},
+			"reinvite": schema.BoolAttribute{
+				MarkdownDescription: "Whether to regenerate and resend the invite when it has expired. When enabled, an expired invite shows up as a change in the plan and is resent on apply. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_sso": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the member to be provisioned through SSO or SCIM instead of sending an invite. On create, the provider polls the organization for a member with the given email for up to 10 minutes before applying the role and team assignments. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"internal_id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID for this organization membership.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pending": schema.BoolAttribute{
+				MarkdownDescription: "The invite is pending.",
+				Computed:            true,
+			},
+			"expired": schema.BoolAttribute{
+				MarkdownDescription: "The invite has expired.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OrganizationMemberResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Teams.IsKnown() || !data.TeamRoles.IsKnown() {
+		return
+	}
+
+	teams, diags := data.Teams.Get(ctx)
+	resp.Diagnostics.Append(diags...)
+	teamRoles, diags := data.TeamRoles.Get(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(teams)+len(teamRoles))
+	for _, team := range teams {
+		seen[team] = true
+	}
+	for _, teamRole := range teamRoles {
+		if teamRole.Team.IsUnknown() {
+			continue
+		}
+		team := teamRole.Team.ValueString()
+		if seen[team] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("team_roles"),
+				"Duplicate team",
+				fmt.Sprintf("Team %q is assigned more than once. Each team must appear only once across `teams` and `team_roles`.", team),
+			)
+		}
+		seen[team] = true
+	}
+}
+
+func (r *OrganizationMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state OrganizationMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Surface an expired invite as a change so that Update can resend it.
+	if plan.Reinvite.ValueBool() && state.Expired.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expired"), types.BoolUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending"), types.BoolUnknown())...)
+	}
+}
+
+func (r *OrganizationMemberResource) readMember(ctx context.Context, organization string, memberId string) (*apiclient.OrganizationMemberWithRoles, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.apiClient.GetOrganizationMemberWithResponse(ctx, organization, memberId)
+	if err != nil {
+		diags.Append(diagutils.NewClientError("read", err))
+		return nil, diags
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return nil, diags
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		diags.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return nil, diags
+	}
+
+	return httpResp.JSON200, diags
+}
+
+// findMemberByEmail returns the ID of the organization member with the given
+// email, or nil if there is none.
+func (r *OrganizationMemberResource) findMemberByEmail(ctx context.Context, organization string, email string) (*string, error) {
+	params := &apiclient.ListOrganizationMembersParams{
+		Query: new("email:" + email),
+	}
+
+	for {
+		httpResp, err := r.apiClient.ListOrganizationMembersWithResponse(ctx, organization, params)
+		if err != nil {
+			return nil, err
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			return nil, fmt.Errorf("unable to list organization members, got status %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+		}
+
+		if member, ok := lo.Find(*httpResp.JSON200, func(member apiclient.OrganizationMember) bool {
+			return strings.EqualFold(member.Email, email)
+		}); ok {
+			return &member.Id, nil
+		}
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			return nil, nil
+		}
+	}
+}
+
+// waitForSsoMember polls the organization until a member with the given email
+// has been provisioned through SSO or SCIM.
+func (r *OrganizationMemberResource) waitForSsoMember(ctx context.Context, organization string, email string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, organizationMemberSsoPollTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(organizationMemberSsoPollInterval)
+	defer ticker.Stop()
+
+	for {
+		memberId, err := r.findMemberByEmail(ctx, organization, email)
+		if err != nil {
+			return "", err
+		} else if memberId != nil {
+			return *memberId, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("timed out waiting for member %q to be provisioned through SSO", email)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *OrganizationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var teamRoles *[]apiclient.TeamRole
+	if data.managesTeams() {
+		v, diags := data.teamRolesToApi(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		teamRoles = &v
+	}
+
+	var memberId string
+	if data.WaitForSso.ValueBool() {
+		v, err := r.waitForSsoMember(ctx, data.Organization.ValueString(), data.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+			return
+		}
+		memberId = v
+
+		httpResp, err := r.apiClient.UpdateOrganizationMemberWithResponse(
+			ctx,
+			data.Organization.ValueString(),
+			memberId,
+			apiclient.UpdateOrganizationMemberJSONRequestBody{
+				OrgRole:   data.Role.ValueStringPointer(),
+				TeamRoles: teamRoles,
+			},
+		)
+		if err != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+			return
+		} else if httpResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+	} else {
+		httpResp, err := r.apiClient.CreateOrganizationMemberWithResponse(
+			ctx,
+			data.Organization.ValueString(),
+			apiclient.CreateOrganizationMemberJSONRequestBody{
+				Email:     data.Email.ValueString(),
+				OrgRole:   data.Role.ValueString(),
+				TeamRoles: teamRoles,
+			},
+		)
+		if err != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+			return
+		} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+		memberId = httpResp.JSON201.Id
+	}
+
+	member, diags := r.readMember(ctx, data.Organization.ValueString(), memberId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if member == nil {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("organization member"))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, data.Organization.ValueString(), *member)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, diags := r.readMember(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, data.Organization.ValueString(), *member)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OrganizationMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateBody := apiclient.UpdateOrganizationMemberJSONRequestBody{
+		OrgRole: plan.Role.ValueStringPointer(),
+	}
+
+	if plan.managesTeams() {
+		teamRoles, diags := plan.teamRolesToApi(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateBody.TeamRoles = &teamRoles
+	}
+
+	if plan.Reinvite.ValueBool() && state.Expired.ValueBool() {
+		updateBody.Reinvite = new(true)
+		updateBody.Regenerate = new(true)
+	}
+
+	httpResp, err := r.apiClient.UpdateOrganizationMemberWithResponse(
+		ctx,
+		plan.Organization.ValueString(),
+		plan.Id.ValueString(),
+		updateBody,
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.Fill(ctx, plan.Organization.ValueString(), *httpResp.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteOrganizationMemberWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Id.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *OrganizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "id")(ctx, req, resp)
+}
+
+func (r *OrganizationMemberResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	type modelV0 struct {
+		Id           types.String `tfsdk:"id"`
+		Organization types.String `tfsdk:"organization"`
+		Email        types.String `tfsdk:"email"`
+		Role         types.String `tfsdk:"role"`
+		InternalId   types.String `tfsdk:"internal_id"`
+		Pending      types.Bool   `tfsdk:"pending"`
+		Expired      types.Bool   `tfsdk:"expired"`
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"organization": schema.StringAttribute{
+						Required: true,
+					},
+					"email": schema.StringAttribute{
+						Required: true,
+					},
+					"role": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(organizationMemberRoles...),
+						},
+					},
+					"internal_id": schema.StringAttribute{
+						Computed: true,
+					},
+					"pending": schema.BoolAttribute{
+						Computed: true,
+					},
+					"expired": schema.BoolAttribute{
+						Computed: true,
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorStateData modelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				organization, memberId, err := resourceid.Split2Path(priorStateData.Id.ValueString(), "organization", "member-id")
+				if err != nil {
+					resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+					return
+				}
+
+				upgradedStateData := OrganizationMemberResourceModel{
+					Id:           types.StringValue(memberId),
+					Organization: types.StringValue(organization),
+					Email:        priorStateData.Email,
+					Role:         priorStateData.Role,
+					Teams:        supertypes.NewSetValueOfNull[string](ctx),
+					TeamRoles:    supertypes.NewSetNestedObjectValueOfNull[OrganizationMemberTeamRoleModel](ctx),
+					Reinvite:     types.BoolValue(false),
+					WaitForSso:   types.BoolValue(false),
+					InternalId:   types.StringValue(memberId),
+					Pending:      priorStateData.Pending,
+					Expired:      priorStateData.Expired,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgradedStateData)...)
+			},
+		},
+	}
+}
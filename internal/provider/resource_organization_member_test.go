@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+func TestAccOrganizationMemberResource(t *testing.T) {
+	rn := "sentry_organization_member.test"
+	memberEmail := acctest.RandomWithPrefix("tf-member") + "@example.com"
+
+	checks := func(role string) []statecheck.StateCheck {
+		return []statecheck.StateCheck{
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("email"), knownvalue.StringExact(memberEmail)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("role"), knownvalue.StringExact(role)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("teams"), knownvalue.Null()),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("team_roles"), knownvalue.Null()),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("internal_id"), knownvalue.NotNull()),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("pending"), knownvalue.Bool(true)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("expired"), knownvalue.Bool(false)),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:            testAccOrganizationMemberResourceConfig(memberEmail, "member"),
+				ConfigStateChecks: checks("member"),
+			},
+			{
+				Config:            testAccOrganizationMemberResourceConfig(memberEmail, "manager"),
+				ConfigStateChecks: checks("manager"),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"reinvite",
+					"wait_for_sso",
+				},
+			},
+		},
+	})
+}
+
+func TestAccOrganizationMemberResource_teams(t *testing.T) {
+	rn := "sentry_organization_member.test"
+	team1Name := acctest.RandomWithPrefix("tf-team")
+	team2Name := acctest.RandomWithPrefix("tf-team")
+	memberEmail := acctest.RandomWithPrefix("tf-member") + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationMemberResourceConfigTeams(team1Name, team2Name, memberEmail, `
+	teams = [sentry_team.test_1.slug]
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("teams"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact(team1Name),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("team_roles"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccOrganizationMemberResourceConfigTeams(team1Name, team2Name, memberEmail, `
+	teams = [sentry_team.test_1.slug]
+
+	team_roles = [
+		{
+			team = sentry_team.test_2.slug
+			role = "admin"
+		},
+	]
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("teams"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact(team1Name),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("team_roles"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"team": knownvalue.StringExact(team2Name),
+							"role": knownvalue.StringExact("admin"),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testAccOrganizationMemberResourceConfigTeams(team1Name, team2Name, memberEmail, `
+	teams = []
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("teams"), knownvalue.SetSizeExact(0)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("team_roles"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccOrganizationMemberResourceConfigTeams(team1Name, team2Name, memberEmail, `
+	teams = [sentry_team.test_1.slug]
+
+	team_roles = [
+		{
+			team = sentry_team.test_1.slug
+			role = "admin"
+		},
+	]
+`),
+				ExpectError: regexp.MustCompile(`Duplicate team`),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationMemberResource_upgradeFromVersion(t *testing.T) {
+	rn := "sentry_organization_member.test"
+	memberEmail := acctest.RandomWithPrefix("tf-member") + "@example.com"
+	config := testAccOrganizationMemberResourceConfig(memberEmail, "member")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: testAccCheckOrganizationMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					acctest.ProviderName: {
+						Source:            "jianyuan/sentry",
+						VersionConstraint: "0.14.1",
+					},
+				},
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^.+/\d+$`))),
+				},
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config:                   config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^\d+$`))),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("email"), knownvalue.StringExact(memberEmail)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role"), knownvalue.StringExact("member")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("teams"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("team_roles"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationMemberDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sentry_organization_member" {
+			continue
+		}
+
+		ctx := context.Background()
+		httpResp, err := acctest.SharedApiClient.GetOrganizationMemberWithResponse(
+			ctx,
+			rs.Primary.Attributes["organization"],
+			rs.Primary.Attributes["id"],
+		)
+		if err != nil {
+			return err
+		} else if httpResp.StatusCode() != http.StatusNotFound {
+			return errors.New("organization member still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccOrganizationMemberResourceConfig(email, role string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_organization_member" "test" {
+	organization = data.sentry_organization.test.slug
+	email        = "%[1]s"
+	role         = "%[2]s"
+}
+`, email, role)
+}
+
+func testAccOrganizationMemberResourceConfigTeams(team1Name, team2Name, email, extras string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "test_1" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_team" "test_2" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[2]s"
+	slug         = "%[2]s"
+}
+
+resource "sentry_organization_member" "test" {
+	organization = data.sentry_organization.test.slug
+	email        = "%[3]s"
+	role         = "member"
+%[4]s
+}
+`, team1Name, team2Name, email, extras)
+}
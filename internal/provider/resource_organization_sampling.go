@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+)
+
+const (
+	samplingModeOrganization = "organization"
+	samplingModeProject      = "project"
+)
+
+var samplingModes = []string{samplingModeOrganization, samplingModeProject}
+
+type OrganizationSamplingResourceModel struct {
+	Id               types.String  `tfsdk:"id"`
+	Organization     types.String  `tfsdk:"organization"`
+	SamplingMode     types.String  `tfsdk:"sampling_mode"`
+	TargetSampleRate types.Float64 `tfsdk:"target_sample_rate"`
+}
+
+func (m *OrganizationSamplingResourceModel) Fill(organization apiclient.Organization) error {
+	m.Id = types.StringValue(organization.Slug)
+	m.Organization = types.StringValue(organization.Slug)
+	m.SamplingMode = types.StringPointerValue(organization.SamplingMode)
+
+	// The organization-wide target sample rate is only used in organization mode.
+	m.TargetSampleRate = types.Float64Null()
+	if m.SamplingMode.ValueString() == samplingModeOrganization {
+		if v, err := organization.TargetSampleRate.Get(); err == nil {
+			m.TargetSampleRate = types.Float64Value(v)
+		}
+	}
+
+	return nil
+}
+
+var _ resource.Resource = &OrganizationSamplingResource{}
+var _ resource.ResourceWithConfigure = &OrganizationSamplingResource{}
+var _ resource.ResourceWithImportState = &OrganizationSamplingResource{}
+
+func NewOrganizationSamplingResource() resource.Resource {
+	return &OrganizationSamplingResource{}
+}
+
+type OrganizationSamplingResource struct {
+	baseResource
+}
+
+func (r *OrganizationSamplingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_sampling"
+}
+
+func (r *OrganizationSamplingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the dynamic sampling settings of an organization. Destroying this resource leaves the settings unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sampling_mode": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+				MarkdownDescription: "Whether sample rates are set for the whole organization or per project with `sentry_project_sampling`.",
+				Required:            true,
+			}, samplingModes),
+			"target_sample_rate": schema.Float64Attribute{
+				MarkdownDescription: "The target sample rate for the organization, between `0` and `1`. Required when `sampling_mode` is `organization`, and must not be set otherwise.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+					tfutils.RequireIfAttributeIsOneOfFloat64(path.MatchRoot("sampling_mode"), []attr.Value{
+						types.StringValue(samplingModeOrganization),
+					}),
+					tfutils.NullIfAttributeIsOneOfFloat64(path.MatchRoot("sampling_mode"), []attr.Value{
+						types.StringValue(samplingModeProject),
+					}),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationSamplingResource) update(ctx context.Context, data *OrganizationSamplingResourceModel) (*apiclient.Organization, error) {
+	body := apiclient.UpdateOrganizationJSONRequestBody{
+		SamplingMode:     data.SamplingMode.ValueStringPointer(),
+		TargetSampleRate: data.TargetSampleRate.ValueFloat64Pointer(),
+	}
+
+	httpResp, err := r.apiClient.UpdateOrganizationWithResponse(ctx, data.Organization.ValueString(), body)
+	if err != nil {
+		return nil, err
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to update organization, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	return httpResp.JSON200, nil
+}
+
+func (r *OrganizationSamplingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationSamplingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := r.update(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	}
+
+	if err := data.Fill(*organization); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationSamplingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationSamplingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationWithResponse(ctx, data.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationSamplingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationSamplingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := r.update(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	}
+
+	if err := data.Fill(*organization); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationSamplingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The sampling settings cannot be removed from an organization, so they are
+	// left as they are.
+}
+
+func (r *OrganizationSamplingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("organization"), req, resp)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccOrganizationSamplingResource(t *testing.T) {
+	rn := "sentry_organization_sampling.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationSamplingResourceConfig(`
+	sampling_mode = "project"
+	target_sample_rate = 0.5
+`),
+				ExpectError: regexp.MustCompile(`Attribute must be null when`),
+			},
+			{
+				Config: testAccOrganizationSamplingResourceConfig(`
+	sampling_mode = "organization"
+`),
+				ExpectError: regexp.MustCompile(`Attribute must be set when`),
+			},
+			{
+				Config: testAccOrganizationSamplingResourceConfig(`
+	sampling_mode      = "organization"
+	target_sample_rate = 0.5
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sampling_mode"), knownvalue.StringExact("organization")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("target_sample_rate"), knownvalue.Float64Exact(0.5)),
+				},
+			},
+			{
+				Config: testAccOrganizationSamplingResourceConfig(`
+	sampling_mode = "project"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sampling_mode"), knownvalue.StringExact("project")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("target_sample_rate"), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateId:     acctest.TestOrganization,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationSamplingResourceConfig(body string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_organization_sampling" "test" {
+	organization = data.sentry_organization.test.slug
+%[1]s
+}
+`, body)
+}
@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+	"github.com/samber/lo"
+)
+
+type ProjectCodeOwnersErrorsModel struct {
+	MissingExternalTeams supertypes.SetValueOf[string] `tfsdk:"missing_external_teams"`
+	MissingExternalUsers supertypes.SetValueOf[string] `tfsdk:"missing_external_users"`
+	MissingUserEmails    supertypes.SetValueOf[string] `tfsdk:"missing_user_emails"`
+	TeamsWithoutAccess   supertypes.SetValueOf[string] `tfsdk:"teams_without_access"`
+	UsersWithoutAccess   supertypes.SetValueOf[string] `tfsdk:"users_without_access"`
+}
+
+func (m *ProjectCodeOwnersErrorsModel) Fill(ctx context.Context, codeOwnersErrors *apiclient.ProjectCodeOwnersErrors) {
+	if codeOwnersErrors == nil {
+		codeOwnersErrors = &apiclient.ProjectCodeOwnersErrors{}
+	}
+
+	m.MissingExternalTeams = supertypes.NewSetValueOfSlice(ctx, lo.FromPtr(codeOwnersErrors.MissingExternalTeams))
+	m.MissingExternalUsers = supertypes.NewSetValueOfSlice(ctx, lo.FromPtr(codeOwnersErrors.MissingExternalUsers))
+	m.MissingUserEmails = supertypes.NewSetValueOfSlice(ctx, lo.FromPtr(codeOwnersErrors.MissingUserEmails))
+	m.TeamsWithoutAccess = supertypes.NewSetValueOfSlice(ctx, lo.FromPtr(codeOwnersErrors.TeamsWithoutAccess))
+	m.UsersWithoutAccess = supertypes.NewSetValueOfSlice(ctx, lo.FromPtr(codeOwnersErrors.UsersWithoutAccess))
+}
+
+type ProjectCodeOwnersResourceModel struct {
+	Id              types.String                                                       `tfsdk:"id"`
+	Organization    types.String                                                       `tfsdk:"organization"`
+	Project         types.String                                                       `tfsdk:"project"`
+	CodeMappingId   types.String                                                       `tfsdk:"code_mapping_id"`
+	Raw             sentrytypes.TrimmedString                                          `tfsdk:"raw"`
+	OwnershipSyntax types.String                                                       `tfsdk:"ownership_syntax"`
+	Errors          supertypes.SingleNestedObjectValueOf[ProjectCodeOwnersErrorsModel] `tfsdk:"errors"`
+}
+
+func (m *ProjectCodeOwnersResourceModel) Fill(ctx context.Context, codeOwners apiclient.ProjectCodeOwners) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(codeOwners.Id)
+	m.CodeMappingId = types.StringValue(codeOwners.CodeMappingId)
+	m.Raw = sentrytypes.TrimmedStringValue(codeOwners.Raw)
+	m.OwnershipSyntax = types.StringPointerValue(codeOwners.OwnershipSyntax)
+
+	var codeOwnersErrors ProjectCodeOwnersErrorsModel
+	codeOwnersErrors.Fill(ctx, codeOwners.Errors)
+
+	m.Errors = supertypes.NewSingleNestedObjectValueOfNull[ProjectCodeOwnersErrorsModel](ctx)
+	diags.Append(m.Errors.Set(ctx, &codeOwnersErrors)...)
+
+	return
+}
+
+// codeOwnersErrorsDiagnostics turns the problems Sentry found while
+// translating a CODEOWNERS file into warnings, so they surface during plan
+// and apply instead of only in the Sentry UI.
+func codeOwnersErrorsDiagnostics(codeOwnersErrors *apiclient.ProjectCodeOwnersErrors) (diags diag.Diagnostics) {
+	if codeOwnersErrors == nil {
+		return
+	}
+
+	for _, item := range []struct {
+		summary string
+		values  *[]string
+	}{
+		{"Missing external teams", codeOwnersErrors.MissingExternalTeams},
+		{"Missing external users", codeOwnersErrors.MissingExternalUsers},
+		{"Missing user emails", codeOwnersErrors.MissingUserEmails},
+		{"Teams without access", codeOwnersErrors.TeamsWithoutAccess},
+		{"Users without access", codeOwnersErrors.UsersWithoutAccess},
+	} {
+		if values := lo.FromPtr(item.values); len(values) > 0 {
+			diags.AddAttributeWarning(
+				path.Root("errors"),
+				item.summary,
+				fmt.Sprintf("The following CODEOWNERS entries could not be mapped to Sentry: %s.", strings.Join(values, ", ")),
+			)
+		}
+	}
+
+	return
+}
+
+var _ resource.Resource = &ProjectCodeOwnersResource{}
+var _ resource.ResourceWithConfigure = &ProjectCodeOwnersResource{}
+var _ resource.ResourceWithImportState = &ProjectCodeOwnersResource{}
+
+func NewProjectCodeOwnersResource() resource.Resource {
+	return &ProjectCodeOwnersResource{}
+}
+
+type ProjectCodeOwnersResource struct {
+	baseResource
+}
+
+func (r *ProjectCodeOwnersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_codeowners"
+}
+
+func (r *ProjectCodeOwnersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	errorsAttribute := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			CustomType:          supertypes.NewSetTypeOf[string](ctx),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Imports a repository's CODEOWNERS file into the ownership rules of a project, through a code mapping. Use together with `sentry_project_ownership` to control `codeowners_auto_sync`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"code_mapping_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the code mapping, e.g. `sentry_organization_code_mapping.default.id`. The code mapping must belong to the project.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a numeric ID"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"raw": schema.StringAttribute{
+				MarkdownDescription: "The contents of the CODEOWNERS file. If not set, the file is fetched from the repository of the code mapping when the resource is created, and is kept up to date by Sentry when `codeowners_auto_sync` is enabled.",
+				CustomType:          sentrytypes.TrimmedStringType{},
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ownership_syntax": schema.StringAttribute{
+				MarkdownDescription: "The CODEOWNERS file translated into Sentry's ownership rules syntax.",
+				Computed:            true,
+			},
+			"errors": schema.SingleNestedAttribute{
+				MarkdownDescription: "The entries of the CODEOWNERS file that could not be mapped to Sentry. These are also reported as warnings.",
+				Computed:            true,
+				CustomType:          supertypes.NewSingleNestedObjectTypeOf[ProjectCodeOwnersErrorsModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"missing_external_teams": errorsAttribute("External teams without a `sentry_external_team` mapping."),
+					"missing_external_users": errorsAttribute("External users without a `sentry_external_user` mapping."),
+					"missing_user_emails":    errorsAttribute("Emails that do not belong to a member of the organization."),
+					"teams_without_access":   errorsAttribute("Teams that do not have access to the project."),
+					"users_without_access":   errorsAttribute("Users that do not have access to the project."),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectCodeOwnersResource) getRaw(ctx context.Context, data ProjectCodeOwnersResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.Raw.IsNull() && !data.Raw.IsUnknown() {
+		return data.Raw.ValueString(), diags
+	}
+
+	httpResp, err := r.apiClient.GetOrganizationCodeMappingCodeOwnersFileWithResponse(ctx, data.Organization.ValueString(), data.CodeMappingId.ValueString())
+	if err != nil {
+		diags.Append(diagutils.NewClientError("read CODEOWNERS file", err))
+		return "", diags
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		diags.AddAttributeError(
+			path.Root("code_mapping_id"),
+			"CODEOWNERS file not found",
+			"Sentry could not find a CODEOWNERS file in the repository of the code mapping. Set `raw` to provide the contents explicitly.",
+		)
+		return "", diags
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		diags.Append(diagutils.NewClientStatusError("read CODEOWNERS file", httpResp.StatusCode(), httpResp.Body))
+		return "", diags
+	}
+
+	return httpResp.JSON200.Raw, diags
+}
+
+func (r *ProjectCodeOwnersResource) readCodeOwners(ctx context.Context, organization string, project string, id string) (*apiclient.ProjectCodeOwners, error) {
+	httpResp, err := r.apiClient.ListProjectCodeOwnersWithResponse(ctx, organization, project, &apiclient.ListProjectCodeOwnersParams{
+		Expand: &apiclient.Expand{"ownershipSyntax", "errors"},
+	})
+	if err != nil {
+		return nil, err
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return nil, errNotFound
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to list CODEOWNERS, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	for _, codeOwners := range *httpResp.JSON200 {
+		if codeOwners.Id == id {
+			return &codeOwners, nil
+		}
+	}
+
+	return nil, errNotFound
+}
+
+func (r *ProjectCodeOwnersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectCodeOwnersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	raw, diags := r.getRaw(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.CreateProjectCodeOwnersWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		apiclient.ProjectCodeOwnersRequest{
+			Raw:           raw,
+			CodeMappingId: data.CodeMappingId.ValueString(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusCreated || httpResp.JSON201 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("create", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON201)...)
+	resp.Diagnostics.Append(codeOwnersErrorsDiagnostics(httpResp.JSON201.Errors)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectCodeOwnersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectCodeOwnersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codeOwners, err := r.readCodeOwners(ctx, data.Organization.ValueString(), data.Project.ValueString(), data.Id.ValueString())
+	if errors.Is(err, errNotFound) {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("CODEOWNERS"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *codeOwners)...)
+	resp.Diagnostics.Append(codeOwnersErrorsDiagnostics(codeOwners.Errors)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectCodeOwnersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectCodeOwnersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	raw, diags := r.getRaw(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.UpdateProjectCodeOwnersWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		data.Id.ValueString(),
+		apiclient.ProjectCodeOwnersRequest{
+			Raw:           raw,
+			CodeMappingId: data.CodeMappingId.ValueString(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("update", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, *httpResp.JSON200)...)
+	resp.Diagnostics.Append(codeOwnersErrorsDiagnostics(httpResp.JSON200.Errors)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectCodeOwnersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectCodeOwnersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteProjectCodeOwnersWithResponse(ctx, data.Organization.ValueString(), data.Project.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *ProjectCodeOwnersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState3PartPath("organization", "project", "id")(ctx, req, resp)
+}
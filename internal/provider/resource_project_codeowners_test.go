@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/must"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+func TestProjectCodeOwnersResourceModel_Fill(t *testing.T) {
+	ctx := context.Background()
+
+	var codeOwners apiclient.ProjectCodeOwners
+	if err := json.Unmarshal([]byte(`{
+		"id": "123",
+		"raw": "* @my-org/backend\n*.py test@example.com\n",
+		"codeMappingId": "456",
+		"provider": "github",
+		"ownershipSyntax": "codeowners:* #backend\n",
+		"errors": {
+			"missing_external_teams": [],
+			"missing_external_users": [],
+			"missing_user_emails": ["test@example.com"],
+			"teams_without_access": [],
+			"users_without_access": []
+		}
+	}`), &codeOwners); err != nil {
+		t.Fatal(err)
+	}
+
+	var data ProjectCodeOwnersResourceModel
+	if diags := data.Fill(ctx, codeOwners); diags.HasError() {
+		t.Fatalf("Fill() returned errors: %v", diags)
+	}
+
+	if got, want := data.Id.ValueString(), "123"; got != want {
+		t.Errorf("id = %q, want %q", got, want)
+	}
+	if got, want := data.CodeMappingId.ValueString(), "456"; got != want {
+		t.Errorf("code_mapping_id = %q, want %q", got, want)
+	}
+	if got, want := data.Raw.ValueString(), "* @my-org/backend\n*.py test@example.com\n"; got != want {
+		t.Errorf("raw = %q, want %q", got, want)
+	}
+	if got, want := data.OwnershipSyntax.ValueString(), "codeowners:* #backend\n"; got != want {
+		t.Errorf("ownership_syntax = %q, want %q", got, want)
+	}
+
+	codeOwnersErrors, diags := data.Errors.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("errors: %v", diags)
+	}
+
+	missingUserEmails, diags := codeOwnersErrors.MissingUserEmails.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("errors.missing_user_emails: %v", diags)
+	}
+	if diff := cmp.Diff([]string{"test@example.com"}, missingUserEmails); diff != "" {
+		t.Errorf("errors.missing_user_emails mismatch (-want +got):\n%s", diff)
+	}
+
+	missingExternalTeams, diags := codeOwnersErrors.MissingExternalTeams.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("errors.missing_external_teams: %v", diags)
+	}
+	if len(missingExternalTeams) != 0 {
+		t.Errorf("errors.missing_external_teams = %v, want empty", missingExternalTeams)
+	}
+
+	warnings := codeOwnersErrorsDiagnostics(codeOwners.Errors)
+	if warnings.WarningsCount() != 1 || warnings[0].Summary() != "Missing user emails" {
+		t.Errorf("codeOwnersErrorsDiagnostics() = %v, want a single missing user emails warning", warnings)
+	}
+}
+
+func TestAccProjectCodeOwnersResource_GitHub(t *testing.T) {
+	rn := "sentry_project_codeowners.test"
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+
+			if acctest.TestGitHubInstallationId == "" {
+				t.Skip("Skipping test due to missing SENTRY_TEST_GITHUB_INSTALLATION_ID environment variable")
+			}
+			if acctest.TestGitHubRepositoryIdentifier == "" {
+				t.Skip("Skipping test due to missing SENTRY_TEST_GITHUB_REPOSITORY_IDENTIFIER environment variable")
+			}
+
+			must.Do(testAccOrganizationRepositoryResourcePreCheck())
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectCodeOwnersResourceConfig(project, "* @tf-missing-org/tf-missing-team"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("code_mapping_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("raw"), knownvalue.StringExact("* @tf-missing-org/tf-missing-team")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("ownership_syntax"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("errors").AtMapKey("missing_external_teams"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("@tf-missing-org/tf-missing-team"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("errors").AtMapKey("missing_user_emails"), knownvalue.SetSizeExact(0)),
+				},
+			},
+			{
+				Config: testAccProjectCodeOwnersResourceConfig(project, "*.py tf-missing-user@example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("raw"), knownvalue.StringExact("*.py tf-missing-user@example.com")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("errors").AtMapKey("missing_external_teams"), knownvalue.SetSizeExact(0)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("errors").AtMapKey("missing_user_emails"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("tf-missing-user@example.com"),
+					})),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState3PartIDFunc(rn, "organization", "project", "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectCodeOwnersResourceConfig(project string, raw string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_project" "test" {
+	organization = data.sentry_organization.test.slug
+	teams        = [%[1]q]
+	name         = %[2]q
+}
+
+resource "sentry_organization_repository" "test" {
+	organization     = data.sentry_organization.test.slug
+	integration_type = "github"
+	integration_id   = %[3]q
+	identifier       = %[4]q
+}
+
+resource "sentry_organization_code_mapping" "test" {
+	organization   = data.sentry_organization.test.slug
+	integration_id = %[3]q
+	repository_id  = sentry_organization_repository.test.id
+	project_id     = sentry_project.test.internal_id
+	default_branch = "main"
+}
+
+resource "sentry_project_codeowners" "test" {
+	organization    = data.sentry_organization.test.slug
+	project         = sentry_project.test.slug
+	code_mapping_id = sentry_organization_code_mapping.test.id
+	raw             = %[5]q
+}
+`, acctest.TestTeam.Slug, project, acctest.TestGitHubInstallationId, acctest.TestGitHubRepositoryIdentifier, raw)
+}
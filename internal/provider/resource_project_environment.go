@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+type ProjectEnvironmentResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Name         types.String `tfsdk:"name"`
+	IsHidden     types.Bool   `tfsdk:"is_hidden"`
+}
+
+func (m *ProjectEnvironmentResourceModel) Fill(environment apiclient.ProjectEnvironment) error {
+	if id, err := resourceid.BuildPath3(m.Organization.ValueString(), m.Project.ValueString(), environment.Name); err != nil {
+		return err
+	} else {
+		m.Id = types.StringValue(id)
+	}
+
+	m.Name = types.StringValue(environment.Name)
+	m.IsHidden = types.BoolValue(environment.IsHidden)
+
+	return nil
+}
+
+var _ resource.Resource = &ProjectEnvironmentResource{}
+var _ resource.ResourceWithConfigure = &ProjectEnvironmentResource{}
+var _ resource.ResourceWithImportState = &ProjectEnvironmentResource{}
+
+func NewProjectEnvironmentResource() resource.Resource {
+	return &ProjectEnvironmentResource{}
+}
+
+type ProjectEnvironmentResource struct {
+	baseResource
+}
+
+func (r *ProjectEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_environment"
+}
+
+func (r *ProjectEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the visibility of a project environment. Environments are created by Sentry when the first event is received from them, so the environment must already exist. Destroying this resource makes the environment visible again.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_hidden": schema.BoolAttribute{
+				MarkdownDescription: "Whether the environment is hidden from environment pickers, e.g. in alerts and dashboards.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectEnvironmentResource) update(ctx context.Context, data *ProjectEnvironmentResourceModel, isHidden bool) (*apiclient.ProjectEnvironment, error) {
+	httpResp, err := r.apiClient.UpdateProjectEnvironmentWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		data.Name.ValueString(),
+		apiclient.UpdateProjectEnvironmentJSONRequestBody{
+			IsHidden: isHidden,
+		},
+	)
+	if err != nil {
+		return nil, err
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return nil, errNotFound
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to update environment, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	return httpResp.JSON200, nil
+}
+
+func (r *ProjectEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, err := r.update(ctx, &data, data.IsHidden.ValueBool())
+	if errors.Is(err, errNotFound) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Environment not found",
+			fmt.Sprintf("Environment %q does not exist in project %q. Sentry creates environments when it receives the first event from them.", data.Name.ValueString(), data.Project.ValueString()),
+		)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	}
+
+	if err := data.Fill(*environment); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetProjectEnvironmentWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		data.Name.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("environment"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment, err := r.update(ctx, &data, data.IsHidden.ValueBool())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	}
+
+	if err := data.Fill(*environment); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Environments cannot be deleted, so make it visible again.
+	_, err := r.update(ctx, &data, false)
+	if errors.Is(err, errNotFound) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	}
+}
+
+func (r *ProjectEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState3PartPath("organization", "project", "name")(ctx, req, resp)
+}
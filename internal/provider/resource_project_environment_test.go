@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+)
+
+// testAccEnsureProjectEnvironment sends an event to the shared project so that
+// Sentry creates the environment, and waits until the environment is available.
+func testAccEnsureProjectEnvironment(t *testing.T, environment string) {
+	t.Helper()
+	ctx := context.Background()
+
+	keysHttpResp, err := acctest.SharedApiClient.ListProjectClientKeysWithResponse(ctx, acctest.TestOrganization, acctest.TestProject.Slug, &apiclient.ListProjectClientKeysParams{})
+	if err != nil {
+		t.Fatal(err)
+	} else if keysHttpResp.StatusCode() != http.StatusOK || keysHttpResp.JSON200 == nil || len(*keysHttpResp.JSON200) == 0 {
+		t.Fatalf("unable to list client keys, got status code %d: %s", keysHttpResp.StatusCode(), string(keysHttpResp.Body))
+	}
+
+	dsn, err := url.Parse((*keysHttpResp.JSON200)[0].Dsn["public"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeUrl := fmt.Sprintf("%s://%s/api%s/store/", dsn.Scheme, dsn.Host, dsn.Path)
+
+	body, err := json.Marshal(map[string]any{
+		"message":     "terraform-provider-sentry acceptance test",
+		"environment": environment,
+		"platform":    "go",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, storeUrl, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf("Sentry sentry_version=7, sentry_key=%s", dsn.User.Username()))
+
+	httpResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		t.Fatalf("unable to send event, got status code %d", httpResp.StatusCode)
+	}
+
+	// Events are processed asynchronously.
+	for range 30 {
+		envHttpResp, err := acctest.SharedApiClient.GetProjectEnvironmentWithResponse(ctx, acctest.TestOrganization, acctest.TestProject.Slug, environment)
+		if err != nil {
+			t.Fatal(err)
+		} else if envHttpResp.StatusCode() == http.StatusOK {
+			return
+		}
+		time.Sleep(2 * time.Second)
+	}
+
+	t.Fatalf("environment %q was not created", environment)
+}
+
+func TestAccProjectEnvironmentResource(t *testing.T) {
+	rn := "sentry_project_environment.test"
+	environment := strings.ReplaceAll(acctest.RandomWithPrefix("tf-env"), "_", "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { testAccEnsureProjectEnvironment(t, environment) },
+				Config:    testAccProjectEnvironmentResourceConfig(environment, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(acctest.TestOrganization+"/"+acctest.TestProject.Slug+"/"+environment)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(acctest.TestProject.Slug)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(environment)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("is_hidden"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testAccProjectEnvironmentResourceConfig(environment, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("is_hidden"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateId:     acctest.TestOrganization + "/" + acctest.TestProject.Slug + "/" + environment,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectEnvironmentResourceConfig(environment string, isHidden bool) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_project_environment" "test" {
+	organization = data.sentry_organization.test.slug
+	project      = %[1]q
+	name         = %[2]q
+	is_hidden    = %[3]t
+}
+`, acctest.TestProject.Slug, environment, isHidden)
+}
@@ -0,0 +1,427 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	fint64validator "github.com/orange-cloudavenue/terraform-plugin-framework-validators/int64validator"
+)
+
+const tenSecondsInMs = 10_000
+const tenMegabytes = 10_000_000
+
+type ProjectPerformanceIssueSettingsResourceModel struct {
+	Id                                            types.String  `tfsdk:"id"`
+	Organization                                  types.String  `tfsdk:"organization"`
+	Project                                       types.String  `tfsdk:"project"`
+	NPlusOneDbQueriesDetectionEnabled             types.Bool    `tfsdk:"n_plus_one_db_queries_detection_enabled"`
+	NPlusOneDbDurationThreshold                   types.Int64   `tfsdk:"n_plus_one_db_duration_threshold"`
+	NPlusOneApiCallsDetectionEnabled              types.Bool    `tfsdk:"n_plus_one_api_calls_detection_enabled"`
+	NPlusOneApiCallsTotalDurationThreshold        types.Int64   `tfsdk:"n_plus_one_api_calls_total_duration_threshold"`
+	SlowDbQueriesDetectionEnabled                 types.Bool    `tfsdk:"slow_db_queries_detection_enabled"`
+	SlowDbQueryDurationThreshold                  types.Int64   `tfsdk:"slow_db_query_duration_threshold"`
+	ConsecutiveDbQueriesDetectionEnabled          types.Bool    `tfsdk:"consecutive_db_queries_detection_enabled"`
+	ConsecutiveDbQueriesMinTimeSavedThreshold     types.Int64   `tfsdk:"consecutive_db_queries_min_time_saved_threshold"`
+	ConsecutiveHttpSpansDetectionEnabled          types.Bool    `tfsdk:"consecutive_http_spans_detection_enabled"`
+	ConsecutiveHttpSpansMinTimeSavedThreshold     types.Int64   `tfsdk:"consecutive_http_spans_min_time_saved_threshold"`
+	LargeHttpPayloadDetectionEnabled              types.Bool    `tfsdk:"large_http_payload_detection_enabled"`
+	LargeHttpPayloadSizeThreshold                 types.Int64   `tfsdk:"large_http_payload_size_threshold"`
+	LargeRenderBlockingAssetDetectionEnabled      types.Bool    `tfsdk:"large_render_blocking_asset_detection_enabled"`
+	LargeRenderBlockingAssetFcpRatio              types.Float64 `tfsdk:"large_render_blocking_asset_fcp_ratio"`
+	RenderBlockingFcpMinDurationThreshold         types.Int64   `tfsdk:"render_blocking_fcp_min_duration_threshold"`
+	RenderBlockingFcpMaxDurationThreshold         types.Int64   `tfsdk:"render_blocking_fcp_max_duration_threshold"`
+	UncompressedAssetsDetectionEnabled            types.Bool    `tfsdk:"uncompressed_assets_detection_enabled"`
+	UncompressedAssetDurationThreshold            types.Int64   `tfsdk:"uncompressed_asset_duration_threshold"`
+	UncompressedAssetSizeThreshold                types.Int64   `tfsdk:"uncompressed_asset_size_threshold"`
+	DbOnMainThreadDetectionEnabled                types.Bool    `tfsdk:"db_on_main_thread_detection_enabled"`
+	DbOnMainThreadDurationThreshold               types.Int64   `tfsdk:"db_on_main_thread_duration_threshold"`
+	FileIoOnMainThreadDetectionEnabled            types.Bool    `tfsdk:"file_io_on_main_thread_detection_enabled"`
+	FileIoOnMainThreadDurationThreshold           types.Int64   `tfsdk:"file_io_on_main_thread_duration_threshold"`
+	HttpOverheadDetectionEnabled                  types.Bool    `tfsdk:"http_overhead_detection_enabled"`
+	HttpRequestDelayThreshold                     types.Int64   `tfsdk:"http_request_delay_threshold"`
+	TransactionDurationRegressionDetectionEnabled types.Bool    `tfsdk:"transaction_duration_regression_detection_enabled"`
+	FunctionDurationRegressionDetectionEnabled    types.Bool    `tfsdk:"function_duration_regression_detection_enabled"`
+}
+
+func (m *ProjectPerformanceIssueSettingsResourceModel) Fill(settings apiclient.ProjectPerformanceIssueSettings) error {
+	if id, err := resourceid.BuildPath2(m.Organization.ValueString(), m.Project.ValueString()); err != nil {
+		return err
+	} else {
+		m.Id = types.StringValue(id)
+	}
+
+	m.NPlusOneDbQueriesDetectionEnabled = types.BoolPointerValue(settings.NPlusOneDbQueriesDetectionEnabled)
+	m.NPlusOneDbDurationThreshold = types.Int64PointerValue(settings.NPlusOneDbDurationThreshold)
+	m.NPlusOneApiCallsDetectionEnabled = types.BoolPointerValue(settings.NPlusOneApiCallsDetectionEnabled)
+	m.NPlusOneApiCallsTotalDurationThreshold = types.Int64PointerValue(settings.NPlusOneApiCallsTotalDurationThreshold)
+	m.SlowDbQueriesDetectionEnabled = types.BoolPointerValue(settings.SlowDbQueriesDetectionEnabled)
+	m.SlowDbQueryDurationThreshold = types.Int64PointerValue(settings.SlowDbQueryDurationThreshold)
+	m.ConsecutiveDbQueriesDetectionEnabled = types.BoolPointerValue(settings.ConsecutiveDbQueriesDetectionEnabled)
+	m.ConsecutiveDbQueriesMinTimeSavedThreshold = types.Int64PointerValue(settings.ConsecutiveDbQueriesMinTimeSavedThreshold)
+	m.ConsecutiveHttpSpansDetectionEnabled = types.BoolPointerValue(settings.ConsecutiveHttpSpansDetectionEnabled)
+	m.ConsecutiveHttpSpansMinTimeSavedThreshold = types.Int64PointerValue(settings.ConsecutiveHttpSpansMinTimeSavedThreshold)
+	m.LargeHttpPayloadDetectionEnabled = types.BoolPointerValue(settings.LargeHttpPayloadDetectionEnabled)
+	m.LargeHttpPayloadSizeThreshold = types.Int64PointerValue(settings.LargeHttpPayloadSizeThreshold)
+	m.LargeRenderBlockingAssetDetectionEnabled = types.BoolPointerValue(settings.LargeRenderBlockingAssetDetectionEnabled)
+	m.LargeRenderBlockingAssetFcpRatio = types.Float64PointerValue(settings.LargeRenderBlockingAssetFcpRatio)
+	m.RenderBlockingFcpMinDurationThreshold = types.Int64PointerValue(settings.RenderBlockingFcpMinDurationThreshold)
+	m.RenderBlockingFcpMaxDurationThreshold = types.Int64PointerValue(settings.RenderBlockingFcpMaxDurationThreshold)
+	m.UncompressedAssetsDetectionEnabled = types.BoolPointerValue(settings.UncompressedAssetsDetectionEnabled)
+	m.UncompressedAssetDurationThreshold = types.Int64PointerValue(settings.UncompressedAssetDurationThreshold)
+	m.UncompressedAssetSizeThreshold = types.Int64PointerValue(settings.UncompressedAssetSizeThreshold)
+	m.DbOnMainThreadDetectionEnabled = types.BoolPointerValue(settings.DbOnMainThreadDetectionEnabled)
+	m.DbOnMainThreadDurationThreshold = types.Int64PointerValue(settings.DbOnMainThreadDurationThreshold)
+	m.FileIoOnMainThreadDetectionEnabled = types.BoolPointerValue(settings.FileIoOnMainThreadDetectionEnabled)
+	m.FileIoOnMainThreadDurationThreshold = types.Int64PointerValue(settings.FileIoOnMainThreadDurationThreshold)
+	m.HttpOverheadDetectionEnabled = types.BoolPointerValue(settings.HttpOverheadDetectionEnabled)
+	m.HttpRequestDelayThreshold = types.Int64PointerValue(settings.HttpRequestDelayThreshold)
+	m.TransactionDurationRegressionDetectionEnabled = types.BoolPointerValue(settings.TransactionDurationRegressionDetectionEnabled)
+	m.FunctionDurationRegressionDetectionEnabled = types.BoolPointerValue(settings.FunctionDurationRegressionDetectionEnabled)
+
+	return nil
+}
+
+// ToRequestBody only includes the attributes that are set, so it must be
+// called on the configuration rather than the plan, where unset attributes
+// take their values from the state.
+func (m ProjectPerformanceIssueSettingsResourceModel) ToRequestBody() apiclient.UpdateProjectPerformanceIssueSettingsJSONRequestBody {
+	return apiclient.UpdateProjectPerformanceIssueSettingsJSONRequestBody{
+		NPlusOneDbQueriesDetectionEnabled:             m.NPlusOneDbQueriesDetectionEnabled.ValueBoolPointer(),
+		NPlusOneDbDurationThreshold:                   m.NPlusOneDbDurationThreshold.ValueInt64Pointer(),
+		NPlusOneApiCallsDetectionEnabled:              m.NPlusOneApiCallsDetectionEnabled.ValueBoolPointer(),
+		NPlusOneApiCallsTotalDurationThreshold:        m.NPlusOneApiCallsTotalDurationThreshold.ValueInt64Pointer(),
+		SlowDbQueriesDetectionEnabled:                 m.SlowDbQueriesDetectionEnabled.ValueBoolPointer(),
+		SlowDbQueryDurationThreshold:                  m.SlowDbQueryDurationThreshold.ValueInt64Pointer(),
+		ConsecutiveDbQueriesDetectionEnabled:          m.ConsecutiveDbQueriesDetectionEnabled.ValueBoolPointer(),
+		ConsecutiveDbQueriesMinTimeSavedThreshold:     m.ConsecutiveDbQueriesMinTimeSavedThreshold.ValueInt64Pointer(),
+		ConsecutiveHttpSpansDetectionEnabled:          m.ConsecutiveHttpSpansDetectionEnabled.ValueBoolPointer(),
+		ConsecutiveHttpSpansMinTimeSavedThreshold:     m.ConsecutiveHttpSpansMinTimeSavedThreshold.ValueInt64Pointer(),
+		LargeHttpPayloadDetectionEnabled:              m.LargeHttpPayloadDetectionEnabled.ValueBoolPointer(),
+		LargeHttpPayloadSizeThreshold:                 m.LargeHttpPayloadSizeThreshold.ValueInt64Pointer(),
+		LargeRenderBlockingAssetDetectionEnabled:      m.LargeRenderBlockingAssetDetectionEnabled.ValueBoolPointer(),
+		LargeRenderBlockingAssetFcpRatio:              m.LargeRenderBlockingAssetFcpRatio.ValueFloat64Pointer(),
+		RenderBlockingFcpMinDurationThreshold:         m.RenderBlockingFcpMinDurationThreshold.ValueInt64Pointer(),
+		RenderBlockingFcpMaxDurationThreshold:         m.RenderBlockingFcpMaxDurationThreshold.ValueInt64Pointer(),
+		UncompressedAssetsDetectionEnabled:            m.UncompressedAssetsDetectionEnabled.ValueBoolPointer(),
+		UncompressedAssetDurationThreshold:            m.UncompressedAssetDurationThreshold.ValueInt64Pointer(),
+		UncompressedAssetSizeThreshold:                m.UncompressedAssetSizeThreshold.ValueInt64Pointer(),
+		DbOnMainThreadDetectionEnabled:                m.DbOnMainThreadDetectionEnabled.ValueBoolPointer(),
+		DbOnMainThreadDurationThreshold:               m.DbOnMainThreadDurationThreshold.ValueInt64Pointer(),
+		FileIoOnMainThreadDetectionEnabled:            m.FileIoOnMainThreadDetectionEnabled.ValueBoolPointer(),
+		FileIoOnMainThreadDurationThreshold:           m.FileIoOnMainThreadDurationThreshold.ValueInt64Pointer(),
+		HttpOverheadDetectionEnabled:                  m.HttpOverheadDetectionEnabled.ValueBoolPointer(),
+		HttpRequestDelayThreshold:                     m.HttpRequestDelayThreshold.ValueInt64Pointer(),
+		TransactionDurationRegressionDetectionEnabled: m.TransactionDurationRegressionDetectionEnabled.ValueBoolPointer(),
+		FunctionDurationRegressionDetectionEnabled:    m.FunctionDurationRegressionDetectionEnabled.ValueBoolPointer(),
+	}
+}
+
+var _ resource.Resource = &ProjectPerformanceIssueSettingsResource{}
+var _ resource.ResourceWithConfigure = &ProjectPerformanceIssueSettingsResource{}
+var _ resource.ResourceWithImportState = &ProjectPerformanceIssueSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectPerformanceIssueSettingsResource{}
+
+func NewProjectPerformanceIssueSettingsResource() resource.Resource {
+	return &ProjectPerformanceIssueSettingsResource{}
+}
+
+type ProjectPerformanceIssueSettingsResource struct {
+	baseResource
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_performance_issue_settings"
+}
+
+func performanceIssueDetectorAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// performanceIssueThresholdAttribute returns a threshold attribute, which can
+// only be changed while its detector is enabled.
+func performanceIssueThresholdAttribute(description string, detector string, min int64, max int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: fmt.Sprintf("%s Must be between `%d` and `%d`, and can only be set when `%s` is not `false`.", description, min, max, detector),
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.Between(min, max),
+			fint64validator.NullIfAttributeIsOneOf(path.MatchRoot(detector), []attr.Value{types.BoolValue(false)}),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the performance issue detection settings of a project. Only the configured settings are managed, the others keep their current values. Destroying this resource resets the thresholds to Sentry's defaults and leaves the detectors enabled or disabled as they are.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"n_plus_one_db_queries_detection_enabled": performanceIssueDetectorAttribute("Whether N+1 DB query issues are detected."),
+			"n_plus_one_db_duration_threshold": performanceIssueThresholdAttribute(
+				"The minimum total duration of the repeated DB queries, in milliseconds.",
+				"n_plus_one_db_queries_detection_enabled", 50, tenSecondsInMs,
+			),
+			"n_plus_one_api_calls_detection_enabled": performanceIssueDetectorAttribute("Whether N+1 API call issues are detected."),
+			"n_plus_one_api_calls_total_duration_threshold": performanceIssueThresholdAttribute(
+				"The minimum total duration of the repeated API calls, in milliseconds.",
+				"n_plus_one_api_calls_detection_enabled", 100, tenSecondsInMs,
+			),
+			"slow_db_queries_detection_enabled": performanceIssueDetectorAttribute("Whether slow DB query issues are detected."),
+			"slow_db_query_duration_threshold": performanceIssueThresholdAttribute(
+				"The minimum duration of a slow DB query, in milliseconds.",
+				"slow_db_queries_detection_enabled", 100, tenSecondsInMs,
+			),
+			"consecutive_db_queries_detection_enabled": performanceIssueDetectorAttribute("Whether consecutive DB query issues are detected."),
+			"consecutive_db_queries_min_time_saved_threshold": performanceIssueThresholdAttribute(
+				"The minimum time that running the queries in parallel would save, in milliseconds.",
+				"consecutive_db_queries_detection_enabled", 50, 5_000,
+			),
+			"consecutive_http_spans_detection_enabled": performanceIssueDetectorAttribute("Whether consecutive HTTP issues are detected."),
+			"consecutive_http_spans_min_time_saved_threshold": performanceIssueThresholdAttribute(
+				"The minimum time that running the HTTP requests in parallel would save, in milliseconds.",
+				"consecutive_http_spans_detection_enabled", 1_000, tenSecondsInMs,
+			),
+			"large_http_payload_detection_enabled": performanceIssueDetectorAttribute("Whether large HTTP payload issues are detected."),
+			"large_http_payload_size_threshold": performanceIssueThresholdAttribute(
+				"The minimum size of a large HTTP payload, in bytes.",
+				"large_http_payload_detection_enabled", 100_000, tenMegabytes,
+			),
+			"large_render_blocking_asset_detection_enabled": performanceIssueDetectorAttribute("Whether large render-blocking asset issues are detected."),
+			"large_render_blocking_asset_fcp_ratio": schema.Float64Attribute{
+				MarkdownDescription: "The minimum ratio of the asset's load duration to the First Contentful Paint. Must be between `0.2` and `1`, and can only be set when `large_render_blocking_asset_detection_enabled` is not `false`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0.2, 1),
+					tfutils.NullIfAttributeIsOneOfFloat64(path.MatchRoot("large_render_blocking_asset_detection_enabled"), []attr.Value{types.BoolValue(false)}),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"render_blocking_fcp_min_duration_threshold": performanceIssueThresholdAttribute(
+				"The minimum First Contentful Paint for render-blocking asset issues, in milliseconds.",
+				"large_render_blocking_asset_detection_enabled", 500, tenSecondsInMs,
+			),
+			"render_blocking_fcp_max_duration_threshold": performanceIssueThresholdAttribute(
+				"The maximum First Contentful Paint for render-blocking asset issues, in milliseconds.",
+				"large_render_blocking_asset_detection_enabled", 500, tenSecondsInMs,
+			),
+			"uncompressed_assets_detection_enabled": performanceIssueDetectorAttribute("Whether uncompressed asset issues are detected."),
+			"uncompressed_asset_duration_threshold": performanceIssueThresholdAttribute(
+				"The minimum load duration of an uncompressed asset, in milliseconds.",
+				"uncompressed_assets_detection_enabled", 100, tenSecondsInMs,
+			),
+			"uncompressed_asset_size_threshold": performanceIssueThresholdAttribute(
+				"The minimum size of an uncompressed asset, in bytes.",
+				"uncompressed_assets_detection_enabled", 100_000, tenMegabytes,
+			),
+			"db_on_main_thread_detection_enabled": performanceIssueDetectorAttribute("Whether DB on main thread issues are detected."),
+			"db_on_main_thread_duration_threshold": performanceIssueThresholdAttribute(
+				"The minimum duration of a DB query on the main thread, in milliseconds.",
+				"db_on_main_thread_detection_enabled", 10, 50,
+			),
+			"file_io_on_main_thread_detection_enabled": performanceIssueDetectorAttribute("Whether file I/O on main thread issues are detected."),
+			"file_io_on_main_thread_duration_threshold": performanceIssueThresholdAttribute(
+				"The minimum duration of file I/O on the main thread, in milliseconds.",
+				"file_io_on_main_thread_detection_enabled", 10, 50,
+			),
+			"http_overhead_detection_enabled": performanceIssueDetectorAttribute("Whether HTTP/1.1 overhead issues are detected."),
+			"http_request_delay_threshold": performanceIssueThresholdAttribute(
+				"The minimum delay of queued HTTP requests, in milliseconds.",
+				"http_overhead_detection_enabled", 200, tenSecondsInMs,
+			),
+			"transaction_duration_regression_detection_enabled": performanceIssueDetectorAttribute("Whether transaction duration regression issues are detected."),
+			"function_duration_regression_detection_enabled":    performanceIssueDetectorAttribute("Whether function duration regression issues are detected."),
+		},
+	}
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProjectPerformanceIssueSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	minDuration, maxDuration := data.RenderBlockingFcpMinDurationThreshold, data.RenderBlockingFcpMaxDurationThreshold
+	if minDuration.IsNull() || minDuration.IsUnknown() || maxDuration.IsNull() || maxDuration.IsUnknown() {
+		return
+	}
+
+	if minDuration.ValueInt64() > maxDuration.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("render_blocking_fcp_min_duration_threshold"),
+			"Invalid attribute value",
+			"Must not be greater than render_blocking_fcp_max_duration_threshold.",
+		)
+	}
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) update(ctx context.Context, data *ProjectPerformanceIssueSettingsResourceModel, config *ProjectPerformanceIssueSettingsResourceModel) (*apiclient.ProjectPerformanceIssueSettings, error) {
+	httpResp, err := r.apiClient.UpdateProjectPerformanceIssueSettingsWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+		config.ToRequestBody(),
+	)
+	if err != nil {
+		return nil, err
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to update performance issue settings, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	return httpResp.JSON200, nil
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config ProjectPerformanceIssueSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.update(ctx, &data, &config)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	}
+
+	if err := data.Fill(*settings); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectPerformanceIssueSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.GetProjectPerformanceIssueSettingsWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("project"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("read", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, config ProjectPerformanceIssueSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.update(ctx, &data, &config)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	}
+
+	if err := data.Fill(*settings); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectPerformanceIssueSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.apiClient.ResetProjectPerformanceIssueSettingsWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		data.Project.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *ProjectPerformanceIssueSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "project")(ctx, req, resp)
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestProjectPerformanceIssueSettingsResourceModel_ToRequestBody(t *testing.T) {
+	body := ProjectPerformanceIssueSettingsResourceModel{
+		NPlusOneDbQueriesDetectionEnabled: types.BoolValue(true),
+		NPlusOneDbDurationThreshold:       types.Int64Value(100),
+		SlowDbQueriesDetectionEnabled:     types.BoolNull(),
+		SlowDbQueryDurationThreshold:      types.Int64Null(),
+		LargeRenderBlockingAssetFcpRatio:  types.Float64Value(0.5),
+	}.ToRequestBody()
+
+	if body.NPlusOneDbQueriesDetectionEnabled == nil || !*body.NPlusOneDbQueriesDetectionEnabled {
+		t.Errorf("expected n_plus_one_db_queries_detection_enabled to be written")
+	}
+	if body.NPlusOneDbDurationThreshold == nil || *body.NPlusOneDbDurationThreshold != 100 {
+		t.Errorf("expected n_plus_one_db_duration_threshold to be written")
+	}
+	if body.LargeRenderBlockingAssetFcpRatio == nil || *body.LargeRenderBlockingAssetFcpRatio != 0.5 {
+		t.Errorf("expected large_render_blocking_asset_fcp_ratio to be written")
+	}
+	if body.SlowDbQueriesDetectionEnabled != nil || body.SlowDbQueryDurationThreshold != nil || body.HttpRequestDelayThreshold != nil {
+		t.Errorf("expected unset attributes not to be written")
+	}
+}
+
+func TestAccProjectPerformanceIssueSettingsResource(t *testing.T) {
+	rn := "sentry_project_performance_issue_settings.test"
+	projectName := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPerformanceIssueSettingsResourceConfig(projectName, `
+	slow_db_queries_detection_enabled = false
+	slow_db_query_duration_threshold  = 2000
+`),
+				ExpectError: regexp.MustCompile(`Attribute must be null when`),
+			},
+			{
+				Config: testAccProjectPerformanceIssueSettingsResourceConfig(projectName, `
+	n_plus_one_db_duration_threshold = 10
+`),
+				ExpectError: regexp.MustCompile(`Attribute n_plus_one_db_duration_threshold value must be between 50 and 10000`),
+			},
+			{
+				Config: testAccProjectPerformanceIssueSettingsResourceConfig(projectName, `
+	render_blocking_fcp_min_duration_threshold = 3000
+	render_blocking_fcp_max_duration_threshold = 2000
+`),
+				ExpectError: regexp.MustCompile(`Must not be greater than`),
+			},
+			{
+				Config: testAccProjectPerformanceIssueSettingsResourceConfig(projectName, `
+	n_plus_one_db_queries_detection_enabled = true
+	n_plus_one_db_duration_threshold        = 200
+	large_render_blocking_asset_fcp_ratio   = 0.5
+	file_io_on_main_thread_detection_enabled = false
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(acctest.TestOrganization+"/"+projectName)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("n_plus_one_db_queries_detection_enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("n_plus_one_db_duration_threshold"), knownvalue.Int64Exact(200)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("large_render_blocking_asset_fcp_ratio"), knownvalue.Float64Exact(0.5)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("file_io_on_main_thread_detection_enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("slow_db_query_duration_threshold"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccProjectPerformanceIssueSettingsResourceConfig(projectName, `
+	n_plus_one_db_queries_detection_enabled = true
+	n_plus_one_db_duration_threshold        = 500
+	slow_db_query_duration_threshold        = 2000
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("n_plus_one_db_duration_threshold"), knownvalue.Int64Exact(500)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("slow_db_query_duration_threshold"), knownvalue.Int64Exact(2000)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("large_render_blocking_asset_fcp_ratio"), knownvalue.Float64Exact(0.5)),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateId:     acctest.TestOrganization + "/" + projectName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectPerformanceIssueSettingsResourceConfig(projectName string, body string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_project" "test" {
+	organization = data.sentry_organization.test.slug
+	teams        = [%[1]q]
+	name         = %[2]q
+	platform     = "python"
+}
+
+resource "sentry_project_performance_issue_settings" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.id
+%[3]s
+}
+`, acctest.TestTeam.Slug, projectName, body)
+}
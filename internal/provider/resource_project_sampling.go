@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+)
+
+type ProjectSamplingResourceModel struct {
+	Id           types.String  `tfsdk:"id"`
+	Organization types.String  `tfsdk:"organization"`
+	Project      types.String  `tfsdk:"project"`
+	SampleRate   types.Float64 `tfsdk:"sample_rate"`
+}
+
+func (m *ProjectSamplingResourceModel) Fill(sampleRate apiclient.ProjectSampleRate) error {
+	if id, err := resourceid.BuildPath2(m.Organization.ValueString(), m.Project.ValueString()); err != nil {
+		return err
+	} else {
+		m.Id = types.StringValue(id)
+	}
+
+	m.SampleRate = types.Float64Value(sampleRate.SampleRate)
+
+	return nil
+}
+
+var _ resource.Resource = &ProjectSamplingResource{}
+var _ resource.ResourceWithConfigure = &ProjectSamplingResource{}
+var _ resource.ResourceWithImportState = &ProjectSamplingResource{}
+
+func NewProjectSamplingResource() resource.Resource {
+	return &ProjectSamplingResource{}
+}
+
+type ProjectSamplingResource struct {
+	baseResource
+}
+
+func (r *ProjectSamplingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_sampling"
+}
+
+func (r *ProjectSamplingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the dynamic sampling rate of a project. The organization must use the `project` sampling mode, see `sentry_organization_sampling`. Destroying this resource leaves the sample rate unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sample_rate": schema.Float64Attribute{
+				MarkdownDescription: "The sample rate of the project, between `0` and `1`.",
+				Required:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+		},
+	}
+}
+
+// getProjectId resolves the numeric ID of a project, which the sample rates
+// endpoints use instead of the slug.
+func (r *ProjectSamplingResource) getProjectId(ctx context.Context, organization string, project string) (int64, bool, error) {
+	httpResp, err := r.apiClient.GetOrganizationProjectWithResponse(ctx, organization, project)
+	if err != nil {
+		return 0, false, err
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return 0, false, nil
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return 0, false, fmt.Errorf("unable to read project (organization=%s, project=%s), got status code %d: %s", organization, project, httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	id, err := strconv.ParseInt(httpResp.JSON200.Id, 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return id, true, nil
+}
+
+func (r *ProjectSamplingResource) getSampleRate(ctx context.Context, organization string, projectId int64) (*apiclient.ProjectSampleRate, error) {
+	params := &apiclient.ListOrganizationProjectSampleRatesParams{}
+
+	for {
+		httpResp, err := r.apiClient.ListOrganizationProjectSampleRatesWithResponse(ctx, organization, params)
+		if err != nil {
+			return nil, err
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			return nil, fmt.Errorf("unable to list project sample rates (organization=%s), got status code %d: %s", organization, httpResp.StatusCode(), string(httpResp.Body))
+		}
+
+		for _, sampleRate := range *httpResp.JSON200 {
+			if sampleRate.Id == projectId {
+				return &sampleRate, nil
+			}
+		}
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *ProjectSamplingResource) update(ctx context.Context, data *ProjectSamplingResourceModel) (*apiclient.ProjectSampleRate, error) {
+	projectId, found, err := r.getProjectId(ctx, data.Organization.ValueString(), data.Project.ValueString())
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, fmt.Errorf("project %q not found", data.Project.ValueString())
+	}
+
+	httpResp, err := r.apiClient.UpdateOrganizationProjectSampleRatesWithResponse(
+		ctx,
+		data.Organization.ValueString(),
+		apiclient.UpdateOrganizationProjectSampleRatesJSONRequestBody{
+			{
+				Id:         projectId,
+				SampleRate: data.SampleRate.ValueFloat64(),
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to update project sample rate, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	for _, sampleRate := range *httpResp.JSON200 {
+		if sampleRate.Id == projectId {
+			return &sampleRate, nil
+		}
+	}
+
+	return nil, fmt.Errorf("project %q missing from the updated sample rates", data.Project.ValueString())
+}
+
+func (r *ProjectSamplingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectSamplingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sampleRate, err := r.update(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	}
+
+	if err := data.Fill(*sampleRate); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSamplingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectSamplingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId, found, err := r.getProjectId(ctx, data.Organization.ValueString(), data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if !found {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("project"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	sampleRate, err := r.getSampleRate(ctx, data.Organization.ValueString(), projectId)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if sampleRate == nil {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("project sample rate"))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := data.Fill(*sampleRate); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSamplingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectSamplingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sampleRate, err := r.update(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	}
+
+	if err := data.Fill(*sampleRate); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSamplingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Projects always have a sample rate in the project sampling mode, so it is
+	// left as it is.
+}
+
+func (r *ProjectSamplingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "project")(ctx, req, resp)
+}
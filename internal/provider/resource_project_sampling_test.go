@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccProjectSamplingResource(t *testing.T) {
+	rn := "sentry_project_sampling.test"
+	projectName := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectSamplingResourceConfig(projectName, 0.25),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(acctest.TestOrganization+"/"+projectName)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(projectName)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sample_rate"), knownvalue.Float64Exact(0.25)),
+				},
+			},
+			{
+				Config: testAccProjectSamplingResourceConfig(projectName, 0.75),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sample_rate"), knownvalue.Float64Exact(0.75)),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateId:     acctest.TestOrganization + "/" + projectName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectSamplingResourceConfig(projectName string, sampleRate float64) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_organization_sampling" "test" {
+	organization  = data.sentry_organization.test.slug
+	sampling_mode = "project"
+}
+
+resource "sentry_project" "test" {
+	organization = data.sentry_organization.test.slug
+	teams        = [%[1]q]
+	name         = %[2]q
+	platform     = "go"
+}
+
+resource "sentry_project_sampling" "test" {
+	organization = sentry_organization_sampling.test.organization
+	project      = sentry_project.test.id
+	sample_rate  = %[3]v
+}
+`, acctest.TestTeam.Slug, projectName, sampleRate)
+}
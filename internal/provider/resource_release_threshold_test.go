@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+func TestReleaseThresholdResourceModel_Fill(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		body            string
+		wantEnvironment *string
+	}{
+		{
+			name: "environment",
+			body: `{
+				"id": "12",
+				"threshold_type": "crash_free_session_rate",
+				"trigger_type": "under",
+				"value": 99,
+				"window_in_seconds": 3600,
+				"project": {"id": "1", "slug": "web"},
+				"environment": {"id": "3", "name": "production"},
+				"date_added": "2025-01-01T00:00:00Z"
+			}`,
+			wantEnvironment: new("production"),
+		},
+		{
+			name: "all environments",
+			body: `{
+				"id": "12",
+				"threshold_type": "crash_free_session_rate",
+				"trigger_type": "under",
+				"value": 99,
+				"window_in_seconds": 3600,
+				"project": {"id": "1", "slug": "web"},
+				"environment": null
+			}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var threshold apiclient.ReleaseThreshold
+			if err := json.Unmarshal([]byte(tc.body), &threshold); err != nil {
+				t.Fatal(err)
+			}
+
+			var data ReleaseThresholdResourceModel
+			if err := data.Fill(threshold); err != nil {
+				t.Fatalf("Fill() returned error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.wantEnvironment, data.Environment.ValueStringPointer()); diff != "" {
+				t.Errorf("environment mismatch (-want +got):\n%s", diff)
+			}
+			if got, want := data.ThresholdType.ValueString(), "crash_free_session_rate"; got != want {
+				t.Errorf("threshold_type = %q, want %q", got, want)
+			}
+			if got, want := data.Value.ValueInt64(), int64(99); got != want {
+				t.Errorf("value = %d, want %d", got, want)
+			}
+
+			body := data.ToRequestBody()
+			if got, want := body.WindowInSeconds, int64(3600); got != want {
+				t.Errorf("window_in_seconds = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestAccReleaseThresholdResource(t *testing.T) {
+	rn := "sentry_release_threshold.test"
+	project := acctest.RandomWithPrefix("tf-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReleaseThresholdResourceConfig(project, `
+	threshold_type    = "new_issue_count"
+	trigger_type      = "over"
+	value             = 10
+	window_in_seconds = 3600
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project"), knownvalue.StringExact(project)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environment"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("threshold_type"), knownvalue.StringExact("new_issue_count")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("trigger_type"), knownvalue.StringExact("over")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("value"), knownvalue.Int64Exact(10)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("window_in_seconds"), knownvalue.Int64Exact(3600)),
+				},
+			},
+			{
+				Config: testAccReleaseThresholdResourceConfig(project, `
+	environment       = "production"
+	threshold_type    = "crash_free_session_rate"
+	trigger_type      = "under"
+	value             = 99
+	window_in_seconds = 86400
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("environment"), knownvalue.StringExact("production")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("threshold_type"), knownvalue.StringExact("crash_free_session_rate")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("trigger_type"), knownvalue.StringExact("under")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("value"), knownvalue.Int64Exact(99)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("window_in_seconds"), knownvalue.Int64Exact(86400)),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState3PartIDFunc(rn, "organization", "project", "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccReleaseThresholdResourceConfig(project string, body string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_project" "test" {
+	organization = data.sentry_organization.test.slug
+	teams        = [%[1]q]
+	name         = %[2]q
+}
+
+resource "sentry_project_environment" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project.test.slug
+	name         = "production"
+}
+
+resource "sentry_release_threshold" "test" {
+	organization = sentry_project.test.organization
+	project      = sentry_project_environment.test.project
+%[3]s
+}
+`, acctest.TestTeam.Slug, project, body)
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/searchsyntax"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+)
+
+const (
+	// savedSearchTypeIssue is the search type of the issue stream.
+	savedSearchTypeIssue int64 = 0
+
+	savedSearchVisibilityOrganization = "organization"
+	savedSearchVisibilityOwnerPinned  = "owner_pinned"
+)
+
+type SavedSearchResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Organization types.String `tfsdk:"organization"`
+	Name         types.String `tfsdk:"name"`
+	Query        types.String `tfsdk:"query"`
+	Sort         types.String `tfsdk:"sort"`
+	Visibility   types.String `tfsdk:"visibility"`
+}
+
+func (m *SavedSearchResourceModel) Fill(search apiclient.SavedSearch) error {
+	m.Id = types.StringValue(search.Id)
+	m.Name = types.StringValue(search.Name)
+	m.Query = types.StringValue(search.Query)
+	m.Sort = types.StringValue("date")
+	if v, err := search.Sort.Get(); err == nil && v != "" {
+		m.Sort = types.StringValue(v)
+	}
+	m.Visibility = types.StringValue(search.Visibility)
+
+	return nil
+}
+
+func (m SavedSearchResourceModel) isPinned() bool {
+	return m.Visibility.ValueString() == savedSearchVisibilityOwnerPinned
+}
+
+var _ resource.Resource = &SavedSearchResource{}
+var _ resource.ResourceWithConfigure = &SavedSearchResource{}
+var _ resource.ResourceWithImportState = &SavedSearchResource{}
+var _ resource.ResourceWithValidateConfig = &SavedSearchResource{}
+
+func NewSavedSearchResource() resource.Resource {
+	return &SavedSearchResource{}
+}
+
+type SavedSearchResource struct {
+	baseResource
+}
+
+func (r *SavedSearchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saved_search"
+}
+
+func (r *SavedSearchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a saved issue search. Searches with the `owner` or `owner_pinned` visibility belong to the user of the auth token.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": ResourceIdAttribute(),
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization of this resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the saved search. Required unless `visibility` is `owner_pinned`, in which case Sentry names the search.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The issue search query, e.g. `is:unresolved assigned:me`.",
+				Required:            true,
+				Validators: []validator.String{
+					tfutils.SearchQuery(searchsyntax.IssueSearch),
+				},
+			},
+			"sort": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+				MarkdownDescription: "The sort order of the results.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("date"),
+			}, sentrydata.SavedSearchSortOptions),
+			"visibility": tfutils.WithEnumStringAttribute(schema.StringAttribute{
+				MarkdownDescription: "Who can see the saved search. `organization` searches are shared with the whole organization and require the `org:write` scope, `owner` searches are private, and an `owner_pinned` search is the default search of the user. Changing from or to `owner_pinned` recreates the search.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(savedSearchVisibilityOrganization),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.StateValue.ValueString() == savedSearchVisibilityOwnerPinned ||
+								req.PlanValue.ValueString() == savedSearchVisibilityOwnerPinned
+						},
+						"Changing from or to the `owner_pinned` visibility recreates the search.",
+						"Changing from or to the `owner_pinned` visibility recreates the search.",
+					),
+				},
+			}, sentrydata.SavedSearchVisibilities),
+		},
+	}
+}
+
+func (r *SavedSearchResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SavedSearchResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Visibility.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.isPinned() && !data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid attribute combination",
+			"Attribute must be null when visibility is \"owner_pinned\".",
+		)
+	} else if !data.isPinned() && data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Missing required argument",
+			"Attribute must be set unless visibility is \"owner_pinned\".",
+		)
+	}
+}
+
+func (r *SavedSearchResource) readSavedSearch(ctx context.Context, organization string, id string) (*apiclient.SavedSearch, error) {
+	httpResp, err := r.apiClient.ListOrganizationSavedSearchesWithResponse(ctx, organization, &apiclient.ListOrganizationSavedSearchesParams{
+		Type: new(savedSearchTypeIssue),
+	})
+	if err != nil {
+		return nil, err
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return nil, errNotFound
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to list saved searches, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	for _, search := range *httpResp.JSON200 {
+		if search.Id == id {
+			return &search, nil
+		}
+	}
+
+	return nil, errNotFound
+}
+
+// save creates or updates the saved search. Pinned searches are upserted
+// through their own endpoint, as there is at most one per user.
+func (r *SavedSearchResource) save(ctx context.Context, data SavedSearchResourceModel) (*apiclient.SavedSearch, error) {
+	if data.isPinned() {
+		httpResp, err := r.apiClient.PinOrganizationSearchWithResponse(ctx, data.Organization.ValueString(), apiclient.PinOrganizationSearchJSONRequestBody{
+			Type:  savedSearchTypeIssue,
+			Query: data.Query.ValueString(),
+			Sort:  data.Sort.ValueStringPointer(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case httpResp.StatusCode() == http.StatusCreated && httpResp.JSON201 != nil:
+			return httpResp.JSON201, nil
+		case httpResp.StatusCode() == http.StatusOK && httpResp.JSON200 != nil:
+			return httpResp.JSON200, nil
+		default:
+			return nil, fmt.Errorf("unable to pin search, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+		}
+	}
+
+	body := apiclient.SavedSearchRequest{
+		Type:       savedSearchTypeIssue,
+		Name:       data.Name.ValueString(),
+		Query:      data.Query.ValueString(),
+		Sort:       data.Sort.ValueStringPointer(),
+		Visibility: data.Visibility.ValueString(),
+	}
+
+	if data.Id.IsNull() || data.Id.IsUnknown() {
+		httpResp, err := r.apiClient.CreateOrganizationSavedSearchWithResponse(ctx, data.Organization.ValueString(), body)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case httpResp.StatusCode() == http.StatusCreated && httpResp.JSON201 != nil:
+			return httpResp.JSON201, nil
+		case httpResp.StatusCode() == http.StatusOK && httpResp.JSON200 != nil:
+			return httpResp.JSON200, nil
+		default:
+			return nil, fmt.Errorf("unable to create saved search, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+		}
+	}
+
+	httpResp, err := r.apiClient.UpdateOrganizationSavedSearchWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString(), body)
+	if err != nil {
+		return nil, err
+	} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+		return nil, fmt.Errorf("unable to update saved search, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+	}
+
+	return httpResp.JSON200, nil
+}
+
+func (r *SavedSearchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SavedSearchResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	search, err := r.save(ctx, data)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	}
+
+	if err := data.Fill(*search); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SavedSearchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SavedSearchResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	search, err := r.readSavedSearch(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if errors.Is(err, errNotFound) {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("saved search"))
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	}
+
+	if err := data.Fill(*search); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SavedSearchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SavedSearchResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	search, err := r.save(ctx, data)
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	}
+
+	if err := data.Fill(*search); err != nil {
+		resp.Diagnostics.Append(diagutils.NewFillError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SavedSearchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SavedSearchResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.isPinned() {
+		httpResp, err := r.apiClient.UnpinOrganizationSearchWithResponse(ctx, data.Organization.ValueString(), apiclient.UnpinOrganizationSearchJSONRequestBody{
+			Type: savedSearchTypeIssue,
+		})
+		if err != nil {
+			resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+			return
+		} else if httpResp.StatusCode() != http.StatusNoContent {
+			resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+			return
+		}
+		return
+	}
+
+	httpResp, err := r.apiClient.DeleteOrganizationSavedSearchWithResponse(ctx, data.Organization.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	} else if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	} else if httpResp.StatusCode() != http.StatusNoContent {
+		resp.Diagnostics.Append(diagutils.NewClientStatusError("delete", httpResp.StatusCode(), httpResp.Body))
+		return
+	}
+}
+
+func (r *SavedSearchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "id")(ctx, req, resp)
+}
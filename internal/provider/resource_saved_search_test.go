@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+)
+
+func TestAccSavedSearchResource(t *testing.T) {
+	rn := "sentry_saved_search.test"
+	name := acctest.RandomWithPrefix("tf-saved-search")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSavedSearchResourceConfig(name, "is:unresolved OR is:ignored", "date", "organization"),
+				ExpectError: regexp.MustCompile(`Invalid search query`),
+			},
+			{
+				Config: testAccSavedSearchResourceConfig(name, "is:unresolved issue.priority:[high, medium]", "date", "organization"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("query"), knownvalue.StringExact("is:unresolved issue.priority:[high, medium]")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sort"), knownvalue.StringExact("date")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("visibility"), knownvalue.StringExact("organization")),
+				},
+			},
+			{
+				Config: testAccSavedSearchResourceConfig(name+"-updated", "is:unresolved times_seen:>10", "freq", "owner"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(name+"-updated")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("query"), knownvalue.StringExact("is:unresolved times_seen:>10")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("sort"), knownvalue.StringExact("freq")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("visibility"), knownvalue.StringExact("owner")),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateIdFunc: resourceid.ImportState2PartIDFunc(rn, "organization", "id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSavedSearchResourceConfig(name, query, sort, visibility string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_saved_search" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = %[1]q
+	query        = %[2]q
+	sort         = %[3]q
+	visibility   = %[4]q
+}
+`, name, query, sort, visibility)
+}
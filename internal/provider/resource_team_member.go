@@ -25,6 +25,10 @@ import (
 
 var errNotFound = errors.New("not found")
 
+// teamMemberRoleMu serializes team role changes and reads across the team
+// member resources, as the effective role depends on the member's other roles.
+var teamMemberRoleMu sync.Mutex
+
 type TeamMemberResourceModel struct {
 	Id            types.String `tfsdk:"id"`
 	Organization  types.String `tfsdk:"organization"`
@@ -59,8 +63,6 @@ func NewTeamMemberResource() resource.Resource {
 
 type TeamMemberResource struct {
 	baseResource
-
-	roleMu sync.Mutex
 }
 
 func (r *TeamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -117,8 +119,8 @@ func hasOrgRoleOverwrite(orgRole *apiclient.OrganizationRoleListItem, orgRoleLis
 
 // Adapted from https://github.com/getsentry/sentry/blob/23.12.1/static/app/components/teamRoleSelect.tsx#L30-L69
 func (r *TeamMemberResource) getEffectiveTeamRole(ctx context.Context, organization string, memberId string, teamSlug string) (*string, error) {
-	r.roleMu.Lock()
-	defer r.roleMu.Unlock()
+	teamMemberRoleMu.Lock()
+	defer teamMemberRoleMu.Unlock()
 
 	orgHttpResp, err := r.apiClient.GetOrganizationWithResponse(ctx, organization)
 	if err != nil {
@@ -163,11 +165,20 @@ func (r *TeamMemberResource) getEffectiveTeamRole(ctx context.Context, organizat
 	return &member.TeamRoleList[0].Id, nil
 }
 
-func (r *TeamMemberResource) updateRole(ctx context.Context, organization string, memberId string, team string, role string) (*string, error) {
-	r.roleMu.Lock()
-	defer r.roleMu.Unlock()
+func addTeamMember(ctx context.Context, client *sentry.Client, organization string, memberId string, team string) error {
+	_, _, err := client.TeamMembers.Create(ctx, organization, memberId, team)
+	if err != nil {
+		return fmt.Errorf("unable to add team member (organization=%s, team=%s, member_id=%s), got error: %s", organization, team, memberId, err)
+	}
+
+	return nil
+}
+
+func updateTeamMemberRole(ctx context.Context, client *sentry.Client, organization string, memberId string, team string, role string) (*string, error) {
+	teamMemberRoleMu.Lock()
+	defer teamMemberRoleMu.Unlock()
 
-	member, _, err := r.client.TeamMembers.Update(ctx, organization, memberId, team, &sentry.UpdateTeamMemberParams{
+	member, _, err := client.TeamMembers.Update(ctx, organization, memberId, team, &sentry.UpdateTeamMemberParams{
 		TeamRole: sentry.String(role),
 	})
 	if err != nil {
@@ -181,6 +192,15 @@ func (r *TeamMemberResource) updateRole(ctx context.Context, organization string
 	return member.TeamRole, nil
 }
 
+func removeTeamMember(ctx context.Context, client *sentry.Client, organization string, memberId string, team string) error {
+	_, _, err := client.TeamMembers.Delete(ctx, organization, memberId, team)
+	if err != nil {
+		return fmt.Errorf("unable to remove team member (organization=%s, team=%s, member_id=%s), got error: %s", organization, team, memberId, err)
+	}
+
+	return nil
+}
+
 func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data TeamMemberResourceModel
 
@@ -191,8 +211,9 @@ func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	_, _, err := r.client.TeamMembers.Create(
+	err := addTeamMember(
 		ctx,
+		r.client,
 		data.Organization.ValueString(),
 		data.MemberId.ValueString(),
 		data.Team.ValueString(),
@@ -203,7 +224,7 @@ func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	if !data.Role.IsNull() {
-		_, err = r.updateRole(ctx, data.Organization.ValueString(), data.MemberId.ValueString(), data.Team.ValueString(), data.Role.ValueString())
+		_, err = updateTeamMemberRole(ctx, r.client, data.Organization.ValueString(), data.MemberId.ValueString(), data.Team.ValueString(), data.Role.ValueString())
 		if err != nil {
 			resp.Diagnostics.Append(diagutils.NewClientError("create", err))
 			return
@@ -279,7 +300,7 @@ func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Update the role if it has changed
 	if !plan.Role.Equal(state.Role) {
-		_, err := r.updateRole(ctx, plan.Organization.ValueString(), plan.MemberId.ValueString(), plan.Team.ValueString(), plan.Role.ValueString())
+		_, err := updateTeamMemberRole(ctx, r.client, plan.Organization.ValueString(), plan.MemberId.ValueString(), plan.Team.ValueString(), plan.Role.ValueString())
 		if err != nil {
 			resp.Diagnostics.Append(diagutils.NewClientError("update", err))
 			return
@@ -317,8 +338,9 @@ func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	_, _, err := r.client.TeamMembers.Delete(
+	err := removeTeamMember(
 		ctx,
+		r.client,
 		data.Organization.ValueString(),
 		data.MemberId.ValueString(),
 		data.Team.ValueString(),
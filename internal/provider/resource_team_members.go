@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-sentry/internal/diagutils"
+	intresource "github.com/jianyuan/terraform-provider-sentry/internal/resource"
+	"github.com/jianyuan/terraform-provider-sentry/internal/resourceid"
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentryclient"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+// teamMembersDefaultRole is sent when a member's explicit team role is
+// removed. The API resolves it to the minimum team role granted by the
+// member's organization role, which is the same as having no team role.
+const teamMembersDefaultRole = "contributor"
+
+type TeamMembersMemberModel struct {
+	MemberId types.String `tfsdk:"member_id"`
+	Role     types.String `tfsdk:"role"`
+}
+
+type TeamMembersResourceModel struct {
+	Id           types.String                                              `tfsdk:"id"`
+	Organization types.String                                              `tfsdk:"organization"`
+	Team         types.String                                              `tfsdk:"team"`
+	Members      supertypes.SetNestedObjectValueOf[TeamMembersMemberModel] `tfsdk:"members"`
+}
+
+// Fill populates the model from the team's members. A member whose role was
+// unset keeps it unset as long as the team has no explicit role for them, or
+// only the default role that is sent when a role is removed. Any other role is
+// reported so that changes made in Sentry show up as a diff.
+func (m *TeamMembersResourceModel) Fill(ctx context.Context, organization string, team string, members []apiclient.TeamMember) (diags diag.Diagnostics) {
+	if id, err := resourceid.BuildPath2(organization, team); err != nil {
+		diags.Append(diagutils.NewFillError(err))
+		return
+	} else {
+		m.Id = types.StringValue(id)
+	}
+	m.Organization = types.StringValue(organization)
+	m.Team = types.StringValue(team)
+
+	priorRoles := make(map[string]types.String)
+	if m.Members.IsKnown() {
+		priorMembers, d := m.Members.Get(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+		for _, member := range priorMembers {
+			priorRoles[member.MemberId.ValueString()] = member.Role
+		}
+	}
+
+	memberModels := make([]TeamMembersMemberModel, 0, len(members))
+	for _, member := range members {
+		role := types.StringNull()
+		if v, err := member.TeamRole.Get(); err == nil {
+			if priorRole, ok := priorRoles[member.Id]; ok && priorRole.IsNull() && v == teamMembersDefaultRole {
+				// Keep the role unset
+			} else {
+				role = types.StringValue(v)
+			}
+		}
+
+		memberModels = append(memberModels, TeamMembersMemberModel{
+			MemberId: types.StringValue(member.Id),
+			Role:     role,
+		})
+	}
+	m.Members = supertypes.NewSetNestedObjectValueOfValueSlice(ctx, memberModels)
+
+	return
+}
+
+var _ resource.Resource = &TeamMembersResource{}
+var _ resource.ResourceWithConfigure = &TeamMembersResource{}
+var _ resource.ResourceWithValidateConfig = &TeamMembersResource{}
+var _ resource.ResourceWithImportState = &TeamMembersResource{}
+
+func NewTeamMembersResource() resource.Resource {
+	return &TeamMembersResource{}
+}
+
+type TeamMembersResource struct {
+	baseResource
+}
+
+func (r *TeamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *TeamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the members of a Sentry team. Members that are not listed, including those added outside of Terraform, are removed from the team.\n\nDo not use this resource together with `sentry_team_member` or the `teams` and `team_roles` attributes of `sentry_organization_member` for the same team, as they will overwrite each other.",
+
+		Attributes: map[string]schema.Attribute{
+			"id":           ResourceIdAttribute(),
+			"organization": ResourceOrganizationAttribute(),
+			"team": schema.StringAttribute{
+				Description: "The slug of the team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: "The complete set of team members. Each member may only be listed once. On creation, any existing member of the team that is not listed here is removed.",
+				Required:            true,
+				CustomType:          supertypes.NewSetNestedObjectTypeOf[TeamMembersMemberModel](ctx),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"member_id": schema.StringAttribute{
+							Description: "The ID of the organization member.",
+							Required:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the member in the team. When not set, resolve to the minimum team role given by this member's organization role.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("contributor", "admin"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Members.IsKnown() {
+		return
+	}
+
+	members, diags := data.Members.Get(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(members))
+	for _, member := range members {
+		if member.MemberId.IsUnknown() {
+			continue
+		}
+		memberId := member.MemberId.ValueString()
+		if seen[memberId] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("members"),
+				"Duplicate member",
+				fmt.Sprintf("Member %q is listed more than once. Each member must appear only once in `members`.", memberId),
+			)
+		}
+		seen[memberId] = true
+	}
+}
+
+func (r *TeamMembersResource) listMembers(ctx context.Context, organization string, team string) ([]apiclient.TeamMember, bool, error) {
+	var members []apiclient.TeamMember
+	params := &apiclient.ListTeamMembersParams{}
+
+	for {
+		httpResp, err := r.apiClient.ListTeamMembersWithResponse(ctx, organization, team, params)
+		if err != nil {
+			return nil, false, err
+		} else if httpResp.StatusCode() == http.StatusNotFound {
+			return nil, false, nil
+		} else if httpResp.StatusCode() != http.StatusOK || httpResp.JSON200 == nil {
+			return nil, false, fmt.Errorf("unable to list team members (organization=%s, team=%s), got status code %d: %s", organization, team, httpResp.StatusCode(), string(httpResp.Body))
+		}
+
+		members = append(members, *httpResp.JSON200...)
+
+		params.Cursor = sentryclient.ParseNextPaginationCursor(httpResp.HTTPResponse)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	return members, true, nil
+}
+
+// reconcile adds, removes and updates the roles of team members so that the
+// team matches the desired members.
+func (r *TeamMembersResource) reconcile(ctx context.Context, organization string, team string, current []*TeamMembersMemberModel, desired []*TeamMembersMemberModel) error {
+	currentRoles := make(map[string]types.String, len(current))
+	for _, member := range current {
+		currentRoles[member.MemberId.ValueString()] = member.Role
+	}
+
+	desiredIds := make(map[string]bool, len(desired))
+	for _, member := range desired {
+		desiredIds[member.MemberId.ValueString()] = true
+	}
+
+	for _, member := range current {
+		memberId := member.MemberId.ValueString()
+		if desiredIds[memberId] {
+			continue
+		}
+
+		if err := removeTeamMember(ctx, r.client, organization, memberId, team); err != nil {
+			return err
+		}
+	}
+
+	for _, member := range desired {
+		memberId := member.MemberId.ValueString()
+
+		currentRole, exists := currentRoles[memberId]
+		if !exists {
+			if err := addTeamMember(ctx, r.client, organization, memberId, team); err != nil {
+				return err
+			}
+			currentRole = types.StringNull()
+		}
+
+		if member.Role.Equal(currentRole) {
+			continue
+		}
+
+		role := member.Role.ValueString()
+		if member.Role.IsNull() {
+			role = teamMembersDefaultRole
+		}
+
+		if _, err := updateTeamMemberRole(ctx, r.client, organization, memberId, team, role); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TeamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := data.Members.Get(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, found, err := r.listMembers(ctx, data.Organization.ValueString(), data.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	} else if !found {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("team"))
+		return
+	}
+
+	current := make([]*TeamMembersMemberModel, 0, len(members))
+	for _, member := range members {
+		role := types.StringNull()
+		if v, err := member.TeamRole.Get(); err == nil {
+			role = types.StringValue(v)
+		}
+
+		current = append(current, &TeamMembersMemberModel{
+			MemberId: types.StringValue(member.Id),
+			Role:     role,
+		})
+	}
+
+	if err := r.reconcile(ctx, data.Organization.ValueString(), data.Team.ValueString(), current, desired); err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	}
+
+	members, _, err = r.listMembers(ctx, data.Organization.ValueString(), data.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("create", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, data.Organization.ValueString(), data.Team.ValueString(), members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, found, err := r.listMembers(ctx, data.Organization.ValueString(), data.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("read", err))
+		return
+	} else if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fill(ctx, data.Organization.ValueString(), data.Team.ValueString(), members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := state.Members.Get(ctx)
+	resp.Diagnostics.Append(diags...)
+	desired, diags := plan.Members.Get(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, plan.Organization.ValueString(), plan.Team.ValueString(), current, desired); err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	}
+
+	members, found, err := r.listMembers(ctx, plan.Organization.ValueString(), plan.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("update", err))
+		return
+	} else if !found {
+		resp.Diagnostics.Append(diagutils.NewNotFoundError("team"))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.Fill(ctx, plan.Organization.ValueString(), plan.Team.ValueString(), members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TeamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := data.Members.Get(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, data.Organization.ValueString(), data.Team.ValueString(), current, nil); err != nil {
+		resp.Diagnostics.Append(diagutils.NewClientError("delete", err))
+		return
+	}
+}
+
+func (r *TeamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	intresource.ImportState2PartPath("organization", "team")(ctx, req, resp)
+}
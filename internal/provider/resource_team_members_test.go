@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-sentry/internal/acctest"
+)
+
+func TestAccTeamMembersResource(t *testing.T) {
+	rn := "sentry_team_members.test"
+	team := acctest.RandomWithPrefix("tf-team")
+	member1Email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+	member2Email := acctest.RandomWithPrefix("tf-member") + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembersResourceConfig(team, member1Email, member2Email, `
+		{
+			member_id = "1"
+		},
+		{
+			member_id = "1"
+			role      = "admin"
+		},
+`),
+				ExpectError: regexp.MustCompile(`Duplicate member`),
+			},
+			{
+				Config: testAccTeamMembersResourceConfig(team, member1Email, member2Email, `
+		{
+			member_id = sentry_organization_member.test_1.internal_id
+		},
+		{
+			member_id = sentry_organization_member.test_2.internal_id
+			role      = "admin"
+		},
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("organization"), knownvalue.StringExact(acctest.TestOrganization)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("team"), knownvalue.StringExact(team)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("members"), knownvalue.SetSizeExact(2)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("members"), knownvalue.SetPartial([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"member_id": knownvalue.NotNull(),
+							"role":      knownvalue.Null(),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"member_id": knownvalue.NotNull(),
+							"role":      knownvalue.StringExact("admin"),
+						}),
+					})),
+				},
+			},
+			{
+				Config: testAccTeamMembersResourceConfig(team, member1Email, member2Email, `
+		{
+			member_id = sentry_organization_member.test_2.internal_id
+			role      = "contributor"
+		},
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("members"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"member_id": knownvalue.NotNull(),
+							"role":      knownvalue.StringExact("contributor"),
+						}),
+					})),
+					statecheck.CompareValuePairs(
+						rn, tfjsonpath.New("members").AtSliceIndex(0).AtMapKey("member_id"),
+						"sentry_organization_member.test_2", tfjsonpath.New("internal_id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTeamMembersResourceConfig(teamName, member1Email, member2Email, members string) string {
+	return testAccOrganizationDataSourceConfig + fmt.Sprintf(`
+resource "sentry_team" "test" {
+	organization = data.sentry_organization.test.slug
+	name         = "%[1]s"
+	slug         = "%[1]s"
+}
+
+resource "sentry_organization_member" "test_1" {
+	organization = data.sentry_organization.test.slug
+	email        = "%[2]s"
+	role         = "member"
+}
+
+resource "sentry_organization_member" "test_2" {
+	organization = data.sentry_organization.test.slug
+	email        = "%[3]s"
+	role         = "member"
+}
+
+resource "sentry_team_members" "test" {
+	organization = data.sentry_organization.test.slug
+	team         = sentry_team.test.slug
+
+	members = [
+%[4]s
+	]
+}
+`, teamName, member1Email, member2Email, members)
+}
@@ -7,6 +7,8 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -42,7 +44,7 @@ func (r *UptimeMonitorResource) Metadata(ctx context.Context, req resource.Metad
 
 func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Create an Uptime Monitor for a Project.\n\nThe `assertion_json` argument is a JSON string that represents the assertion to use for the monitor. It is a JSON object with a single key `root` whose value is the root operation of the assertion. The assertion is a tree of operations that are evaluated in order. Operations may be constructed using the `op_` functions.",
+		MarkdownDescription: "Create an Uptime Monitor for a Project.\n\nThe `assertion_json` argument is a JSON string that represents the assertion to use for the monitor. It is a JSON object with a single key `root` whose value is the root operation of the assertion. The assertion is a tree of operations that are evaluated in order. Operations may be constructed using the `op_` functions.\n\nAlternatively, the `assertion` argument describes the same tree with `and`, `or`, `not`, `status_code`, `json_path` and `header` attributes. Each operation must set exactly one of them. `and`, `or` and `not` can be nested up to 2 levels deep; use `assertion_json` for deeper trees.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The internal ID of this monitor.",
@@ -169,9 +171,1487 @@ func (r *UptimeMonitorResource) Schema(ctx context.Context, req resource.SchemaR
 				CustomType:          supertypes.Int64Type{},
 			},
 			"assertion_json": schema.StringAttribute{
-				MarkdownDescription: "Define conditions that must be met for the check to be considered successful.",
+				MarkdownDescription: "Define conditions that must be met for the check to be considered successful. Conflicts with `assertion`.",
 				Optional:            true,
 				CustomType:          jsontypes.NormalizedType{},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("assertion")),
+				},
+			},
+			"assertion": schema.SingleNestedAttribute{
+				MarkdownDescription: "Define conditions that must be met for the check to be considered successful, as the root operation of the assertion. Conflicts with `assertion_json`.",
+				Optional:            true,
+				CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertion](ctx),
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("assertion_json")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"and": schema.ListNestedAttribute{
+						MarkdownDescription: "Passes if all of the operations pass.",
+						Optional:            true,
+						CustomType:          supertypes.NewListNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItem](ctx),
+						Validators: []validator.List{
+							listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("or"), path.MatchRelative().AtParent().AtName("not"), path.MatchRelative().AtParent().AtName("status_code"), path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"and": schema.ListNestedAttribute{
+									MarkdownDescription: "Passes if all of the operations pass.",
+									Optional:            true,
+									CustomType:          supertypes.NewListNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemAndItem](ctx),
+									Validators: []validator.List{
+										listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("or"), path.MatchRelative().AtParent().AtName("not"), path.MatchRelative().AtParent().AtName("status_code"), path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+									},
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"status_code": schema.SingleNestedAttribute{
+												MarkdownDescription: "Compares the HTTP status code of the response.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemAndItemStatusCode](ctx),
+												Validators: []validator.Object{
+													objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+												},
+												Attributes: map[string]schema.Attribute{
+													"operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.Int64Attribute{
+														MarkdownDescription: "The HTTP status code to compare against.",
+														Required:            true,
+														CustomType:          supertypes.Int64Type{},
+													},
+												},
+											},
+											"json_path": schema.SingleNestedAttribute{
+												MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemAndItemJsonPath](ctx),
+												Attributes: map[string]schema.Attribute{
+													"path": schema.StringAttribute{
+														MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													"operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.StringAttribute{
+														MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+														},
+													},
+													"value_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the value against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+												},
+											},
+											"header": schema.SingleNestedAttribute{
+												MarkdownDescription: "Passes if a response header matches both the key and the value.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemAndItemHeader](ctx),
+												Attributes: map[string]schema.Attribute{
+													"key_operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator for the header key.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"key": schema.StringAttribute{
+														MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+														},
+													},
+													"key_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the header key against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													"value_operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator for the header value.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.StringAttribute{
+														MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+														},
+													},
+													"value_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the header value against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+												},
+											},
+										},
+									},
+								},
+								"or": schema.ListNestedAttribute{
+									MarkdownDescription: "Passes if any of the operations pass.",
+									Optional:            true,
+									CustomType:          supertypes.NewListNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemOrItem](ctx),
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"status_code": schema.SingleNestedAttribute{
+												MarkdownDescription: "Compares the HTTP status code of the response.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemOrItemStatusCode](ctx),
+												Validators: []validator.Object{
+													objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+												},
+												Attributes: map[string]schema.Attribute{
+													"operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.Int64Attribute{
+														MarkdownDescription: "The HTTP status code to compare against.",
+														Required:            true,
+														CustomType:          supertypes.Int64Type{},
+													},
+												},
+											},
+											"json_path": schema.SingleNestedAttribute{
+												MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemOrItemJsonPath](ctx),
+												Attributes: map[string]schema.Attribute{
+													"path": schema.StringAttribute{
+														MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													"operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.StringAttribute{
+														MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+														},
+													},
+													"value_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the value against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+												},
+											},
+											"header": schema.SingleNestedAttribute{
+												MarkdownDescription: "Passes if a response header matches both the key and the value.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemOrItemHeader](ctx),
+												Attributes: map[string]schema.Attribute{
+													"key_operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator for the header key.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"key": schema.StringAttribute{
+														MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+														},
+													},
+													"key_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the header key against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													"value_operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator for the header value.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.StringAttribute{
+														MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+														},
+													},
+													"value_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the header value against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+												},
+											},
+										},
+									},
+								},
+								"not": schema.SingleNestedAttribute{
+									MarkdownDescription: "Passes if the operation fails.",
+									Optional:            true,
+									CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemNot](ctx),
+									Attributes: map[string]schema.Attribute{
+										"status_code": schema.SingleNestedAttribute{
+											MarkdownDescription: "Compares the HTTP status code of the response.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemNotStatusCode](ctx),
+											Validators: []validator.Object{
+												objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+											},
+											Attributes: map[string]schema.Attribute{
+												"operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.Int64Attribute{
+													MarkdownDescription: "The HTTP status code to compare against.",
+													Required:            true,
+													CustomType:          supertypes.Int64Type{},
+												},
+											},
+										},
+										"json_path": schema.SingleNestedAttribute{
+											MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemNotJsonPath](ctx),
+											Attributes: map[string]schema.Attribute{
+												"path": schema.StringAttribute{
+													MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												"operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.StringAttribute{
+													MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+													},
+												},
+												"value_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the value against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+											},
+										},
+										"header": schema.SingleNestedAttribute{
+											MarkdownDescription: "Passes if a response header matches both the key and the value.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemNotHeader](ctx),
+											Attributes: map[string]schema.Attribute{
+												"key_operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator for the header key.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"key": schema.StringAttribute{
+													MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+													},
+												},
+												"key_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the header key against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												"value_operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator for the header value.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.StringAttribute{
+													MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+													},
+												},
+												"value_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the header value against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+											},
+										},
+									},
+								},
+								"status_code": schema.SingleNestedAttribute{
+									MarkdownDescription: "Compares the HTTP status code of the response.",
+									Optional:            true,
+									CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemStatusCode](ctx),
+									Attributes: map[string]schema.Attribute{
+										"operator": tfutils.WithEnumStringAttribute(
+											schema.StringAttribute{
+												MarkdownDescription: "The comparison operator.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											sentrydata.UptimeAssertionComparisonTypes,
+										),
+										"value": schema.Int64Attribute{
+											MarkdownDescription: "The HTTP status code to compare against.",
+											Required:            true,
+											CustomType:          supertypes.Int64Type{},
+										},
+									},
+								},
+								"json_path": schema.SingleNestedAttribute{
+									MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+									Optional:            true,
+									CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemJsonPath](ctx),
+									Attributes: map[string]schema.Attribute{
+										"path": schema.StringAttribute{
+											MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+											Required:            true,
+											CustomType:          supertypes.StringType{},
+										},
+										"operator": tfutils.WithEnumStringAttribute(
+											schema.StringAttribute{
+												MarkdownDescription: "The comparison operator.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											sentrydata.UptimeAssertionComparisonTypes,
+										),
+										"value": schema.StringAttribute{
+											MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+											Validators: []validator.String{
+												stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+											},
+										},
+										"value_pattern": schema.StringAttribute{
+											MarkdownDescription: "A glob pattern to match the value against.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+										},
+									},
+								},
+								"header": schema.SingleNestedAttribute{
+									MarkdownDescription: "Passes if a response header matches both the key and the value.",
+									Optional:            true,
+									CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionAndItemHeader](ctx),
+									Attributes: map[string]schema.Attribute{
+										"key_operator": tfutils.WithEnumStringAttribute(
+											schema.StringAttribute{
+												MarkdownDescription: "The comparison operator for the header key.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											sentrydata.UptimeAssertionComparisonTypes,
+										),
+										"key": schema.StringAttribute{
+											MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+											Validators: []validator.String{
+												stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+											},
+										},
+										"key_pattern": schema.StringAttribute{
+											MarkdownDescription: "A glob pattern to match the header key against.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+										},
+										"value_operator": tfutils.WithEnumStringAttribute(
+											schema.StringAttribute{
+												MarkdownDescription: "The comparison operator for the header value.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											sentrydata.UptimeAssertionComparisonTypes,
+										),
+										"value": schema.StringAttribute{
+											MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+											Validators: []validator.String{
+												stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+											},
+										},
+										"value_pattern": schema.StringAttribute{
+											MarkdownDescription: "A glob pattern to match the header value against.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+										},
+									},
+								},
+							},
+						},
+					},
+					"or": schema.ListNestedAttribute{
+						MarkdownDescription: "Passes if any of the operations pass.",
+						Optional:            true,
+						CustomType:          supertypes.NewListNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItem](ctx),
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"and": schema.ListNestedAttribute{
+									MarkdownDescription: "Passes if all of the operations pass.",
+									Optional:            true,
+									CustomType:          supertypes.NewListNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemAndItem](ctx),
+									Validators: []validator.List{
+										listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("or"), path.MatchRelative().AtParent().AtName("not"), path.MatchRelative().AtParent().AtName("status_code"), path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+									},
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"status_code": schema.SingleNestedAttribute{
+												MarkdownDescription: "Compares the HTTP status code of the response.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemAndItemStatusCode](ctx),
+												Validators: []validator.Object{
+													objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+												},
+												Attributes: map[string]schema.Attribute{
+													"operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.Int64Attribute{
+														MarkdownDescription: "The HTTP status code to compare against.",
+														Required:            true,
+														CustomType:          supertypes.Int64Type{},
+													},
+												},
+											},
+											"json_path": schema.SingleNestedAttribute{
+												MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemAndItemJsonPath](ctx),
+												Attributes: map[string]schema.Attribute{
+													"path": schema.StringAttribute{
+														MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													"operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.StringAttribute{
+														MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+														},
+													},
+													"value_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the value against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+												},
+											},
+											"header": schema.SingleNestedAttribute{
+												MarkdownDescription: "Passes if a response header matches both the key and the value.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemAndItemHeader](ctx),
+												Attributes: map[string]schema.Attribute{
+													"key_operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator for the header key.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"key": schema.StringAttribute{
+														MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+														},
+													},
+													"key_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the header key against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													"value_operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator for the header value.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.StringAttribute{
+														MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+														},
+													},
+													"value_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the header value against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+												},
+											},
+										},
+									},
+								},
+								"or": schema.ListNestedAttribute{
+									MarkdownDescription: "Passes if any of the operations pass.",
+									Optional:            true,
+									CustomType:          supertypes.NewListNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemOrItem](ctx),
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"status_code": schema.SingleNestedAttribute{
+												MarkdownDescription: "Compares the HTTP status code of the response.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemOrItemStatusCode](ctx),
+												Validators: []validator.Object{
+													objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+												},
+												Attributes: map[string]schema.Attribute{
+													"operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.Int64Attribute{
+														MarkdownDescription: "The HTTP status code to compare against.",
+														Required:            true,
+														CustomType:          supertypes.Int64Type{},
+													},
+												},
+											},
+											"json_path": schema.SingleNestedAttribute{
+												MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemOrItemJsonPath](ctx),
+												Attributes: map[string]schema.Attribute{
+													"path": schema.StringAttribute{
+														MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													"operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.StringAttribute{
+														MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+														},
+													},
+													"value_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the value against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+												},
+											},
+											"header": schema.SingleNestedAttribute{
+												MarkdownDescription: "Passes if a response header matches both the key and the value.",
+												Optional:            true,
+												CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemOrItemHeader](ctx),
+												Attributes: map[string]schema.Attribute{
+													"key_operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator for the header key.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"key": schema.StringAttribute{
+														MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+														},
+													},
+													"key_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the header key against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													"value_operator": tfutils.WithEnumStringAttribute(
+														schema.StringAttribute{
+															MarkdownDescription: "The comparison operator for the header value.",
+															Required:            true,
+															CustomType:          supertypes.StringType{},
+														},
+														sentrydata.UptimeAssertionComparisonTypes,
+													),
+													"value": schema.StringAttribute{
+														MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+														Validators: []validator.String{
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+														},
+													},
+													"value_pattern": schema.StringAttribute{
+														MarkdownDescription: "A glob pattern to match the header value against.",
+														Optional:            true,
+														CustomType:          supertypes.StringType{},
+													},
+												},
+											},
+										},
+									},
+								},
+								"not": schema.SingleNestedAttribute{
+									MarkdownDescription: "Passes if the operation fails.",
+									Optional:            true,
+									CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemNot](ctx),
+									Attributes: map[string]schema.Attribute{
+										"status_code": schema.SingleNestedAttribute{
+											MarkdownDescription: "Compares the HTTP status code of the response.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemNotStatusCode](ctx),
+											Validators: []validator.Object{
+												objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+											},
+											Attributes: map[string]schema.Attribute{
+												"operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.Int64Attribute{
+													MarkdownDescription: "The HTTP status code to compare against.",
+													Required:            true,
+													CustomType:          supertypes.Int64Type{},
+												},
+											},
+										},
+										"json_path": schema.SingleNestedAttribute{
+											MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemNotJsonPath](ctx),
+											Attributes: map[string]schema.Attribute{
+												"path": schema.StringAttribute{
+													MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												"operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.StringAttribute{
+													MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+													},
+												},
+												"value_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the value against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+											},
+										},
+										"header": schema.SingleNestedAttribute{
+											MarkdownDescription: "Passes if a response header matches both the key and the value.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemNotHeader](ctx),
+											Attributes: map[string]schema.Attribute{
+												"key_operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator for the header key.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"key": schema.StringAttribute{
+													MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+													},
+												},
+												"key_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the header key against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												"value_operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator for the header value.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.StringAttribute{
+													MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+													},
+												},
+												"value_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the header value against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+											},
+										},
+									},
+								},
+								"status_code": schema.SingleNestedAttribute{
+									MarkdownDescription: "Compares the HTTP status code of the response.",
+									Optional:            true,
+									CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemStatusCode](ctx),
+									Attributes: map[string]schema.Attribute{
+										"operator": tfutils.WithEnumStringAttribute(
+											schema.StringAttribute{
+												MarkdownDescription: "The comparison operator.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											sentrydata.UptimeAssertionComparisonTypes,
+										),
+										"value": schema.Int64Attribute{
+											MarkdownDescription: "The HTTP status code to compare against.",
+											Required:            true,
+											CustomType:          supertypes.Int64Type{},
+										},
+									},
+								},
+								"json_path": schema.SingleNestedAttribute{
+									MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+									Optional:            true,
+									CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemJsonPath](ctx),
+									Attributes: map[string]schema.Attribute{
+										"path": schema.StringAttribute{
+											MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+											Required:            true,
+											CustomType:          supertypes.StringType{},
+										},
+										"operator": tfutils.WithEnumStringAttribute(
+											schema.StringAttribute{
+												MarkdownDescription: "The comparison operator.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											sentrydata.UptimeAssertionComparisonTypes,
+										),
+										"value": schema.StringAttribute{
+											MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+											Validators: []validator.String{
+												stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+											},
+										},
+										"value_pattern": schema.StringAttribute{
+											MarkdownDescription: "A glob pattern to match the value against.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+										},
+									},
+								},
+								"header": schema.SingleNestedAttribute{
+									MarkdownDescription: "Passes if a response header matches both the key and the value.",
+									Optional:            true,
+									CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionOrItemHeader](ctx),
+									Attributes: map[string]schema.Attribute{
+										"key_operator": tfutils.WithEnumStringAttribute(
+											schema.StringAttribute{
+												MarkdownDescription: "The comparison operator for the header key.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											sentrydata.UptimeAssertionComparisonTypes,
+										),
+										"key": schema.StringAttribute{
+											MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+											Validators: []validator.String{
+												stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+											},
+										},
+										"key_pattern": schema.StringAttribute{
+											MarkdownDescription: "A glob pattern to match the header key against.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+										},
+										"value_operator": tfutils.WithEnumStringAttribute(
+											schema.StringAttribute{
+												MarkdownDescription: "The comparison operator for the header value.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											sentrydata.UptimeAssertionComparisonTypes,
+										),
+										"value": schema.StringAttribute{
+											MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+											Validators: []validator.String{
+												stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+											},
+										},
+										"value_pattern": schema.StringAttribute{
+											MarkdownDescription: "A glob pattern to match the header value against.",
+											Optional:            true,
+											CustomType:          supertypes.StringType{},
+										},
+									},
+								},
+							},
+						},
+					},
+					"not": schema.SingleNestedAttribute{
+						MarkdownDescription: "Passes if the operation fails.",
+						Optional:            true,
+						CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNot](ctx),
+						Attributes: map[string]schema.Attribute{
+							"and": schema.ListNestedAttribute{
+								MarkdownDescription: "Passes if all of the operations pass.",
+								Optional:            true,
+								CustomType:          supertypes.NewListNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotAndItem](ctx),
+								Validators: []validator.List{
+									listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("or"), path.MatchRelative().AtParent().AtName("not"), path.MatchRelative().AtParent().AtName("status_code"), path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+								},
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"status_code": schema.SingleNestedAttribute{
+											MarkdownDescription: "Compares the HTTP status code of the response.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotAndItemStatusCode](ctx),
+											Validators: []validator.Object{
+												objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+											},
+											Attributes: map[string]schema.Attribute{
+												"operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.Int64Attribute{
+													MarkdownDescription: "The HTTP status code to compare against.",
+													Required:            true,
+													CustomType:          supertypes.Int64Type{},
+												},
+											},
+										},
+										"json_path": schema.SingleNestedAttribute{
+											MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotAndItemJsonPath](ctx),
+											Attributes: map[string]schema.Attribute{
+												"path": schema.StringAttribute{
+													MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												"operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.StringAttribute{
+													MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+													},
+												},
+												"value_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the value against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+											},
+										},
+										"header": schema.SingleNestedAttribute{
+											MarkdownDescription: "Passes if a response header matches both the key and the value.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotAndItemHeader](ctx),
+											Attributes: map[string]schema.Attribute{
+												"key_operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator for the header key.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"key": schema.StringAttribute{
+													MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+													},
+												},
+												"key_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the header key against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												"value_operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator for the header value.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.StringAttribute{
+													MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+													},
+												},
+												"value_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the header value against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+											},
+										},
+									},
+								},
+							},
+							"or": schema.ListNestedAttribute{
+								MarkdownDescription: "Passes if any of the operations pass.",
+								Optional:            true,
+								CustomType:          supertypes.NewListNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotOrItem](ctx),
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"status_code": schema.SingleNestedAttribute{
+											MarkdownDescription: "Compares the HTTP status code of the response.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotOrItemStatusCode](ctx),
+											Validators: []validator.Object{
+												objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+											},
+											Attributes: map[string]schema.Attribute{
+												"operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.Int64Attribute{
+													MarkdownDescription: "The HTTP status code to compare against.",
+													Required:            true,
+													CustomType:          supertypes.Int64Type{},
+												},
+											},
+										},
+										"json_path": schema.SingleNestedAttribute{
+											MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotOrItemJsonPath](ctx),
+											Attributes: map[string]schema.Attribute{
+												"path": schema.StringAttribute{
+													MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												"operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.StringAttribute{
+													MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+													},
+												},
+												"value_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the value against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+											},
+										},
+										"header": schema.SingleNestedAttribute{
+											MarkdownDescription: "Passes if a response header matches both the key and the value.",
+											Optional:            true,
+											CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotOrItemHeader](ctx),
+											Attributes: map[string]schema.Attribute{
+												"key_operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator for the header key.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"key": schema.StringAttribute{
+													MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+													},
+												},
+												"key_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the header key against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												"value_operator": tfutils.WithEnumStringAttribute(
+													schema.StringAttribute{
+														MarkdownDescription: "The comparison operator for the header value.",
+														Required:            true,
+														CustomType:          supertypes.StringType{},
+													},
+													sentrydata.UptimeAssertionComparisonTypes,
+												),
+												"value": schema.StringAttribute{
+													MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+													},
+												},
+												"value_pattern": schema.StringAttribute{
+													MarkdownDescription: "A glob pattern to match the header value against.",
+													Optional:            true,
+													CustomType:          supertypes.StringType{},
+												},
+											},
+										},
+									},
+								},
+							},
+							"not": schema.SingleNestedAttribute{
+								MarkdownDescription: "Passes if the operation fails.",
+								Optional:            true,
+								CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotNot](ctx),
+								Attributes: map[string]schema.Attribute{
+									"status_code": schema.SingleNestedAttribute{
+										MarkdownDescription: "Compares the HTTP status code of the response.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotNotStatusCode](ctx),
+										Validators: []validator.Object{
+											objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("json_path"), path.MatchRelative().AtParent().AtName("header")),
+										},
+										Attributes: map[string]schema.Attribute{
+											"operator": tfutils.WithEnumStringAttribute(
+												schema.StringAttribute{
+													MarkdownDescription: "The comparison operator.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												sentrydata.UptimeAssertionComparisonTypes,
+											),
+											"value": schema.Int64Attribute{
+												MarkdownDescription: "The HTTP status code to compare against.",
+												Required:            true,
+												CustomType:          supertypes.Int64Type{},
+											},
+										},
+									},
+									"json_path": schema.SingleNestedAttribute{
+										MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotNotJsonPath](ctx),
+										Attributes: map[string]schema.Attribute{
+											"path": schema.StringAttribute{
+												MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+												Required:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											"operator": tfutils.WithEnumStringAttribute(
+												schema.StringAttribute{
+													MarkdownDescription: "The comparison operator.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												sentrydata.UptimeAssertionComparisonTypes,
+											),
+											"value": schema.StringAttribute{
+												MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+												Optional:            true,
+												CustomType:          supertypes.StringType{},
+												Validators: []validator.String{
+													stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+												},
+											},
+											"value_pattern": schema.StringAttribute{
+												MarkdownDescription: "A glob pattern to match the value against.",
+												Optional:            true,
+												CustomType:          supertypes.StringType{},
+											},
+										},
+									},
+									"header": schema.SingleNestedAttribute{
+										MarkdownDescription: "Passes if a response header matches both the key and the value.",
+										Optional:            true,
+										CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotNotHeader](ctx),
+										Attributes: map[string]schema.Attribute{
+											"key_operator": tfutils.WithEnumStringAttribute(
+												schema.StringAttribute{
+													MarkdownDescription: "The comparison operator for the header key.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												sentrydata.UptimeAssertionComparisonTypes,
+											),
+											"key": schema.StringAttribute{
+												MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+												Optional:            true,
+												CustomType:          supertypes.StringType{},
+												Validators: []validator.String{
+													stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+												},
+											},
+											"key_pattern": schema.StringAttribute{
+												MarkdownDescription: "A glob pattern to match the header key against.",
+												Optional:            true,
+												CustomType:          supertypes.StringType{},
+											},
+											"value_operator": tfutils.WithEnumStringAttribute(
+												schema.StringAttribute{
+													MarkdownDescription: "The comparison operator for the header value.",
+													Required:            true,
+													CustomType:          supertypes.StringType{},
+												},
+												sentrydata.UptimeAssertionComparisonTypes,
+											),
+											"value": schema.StringAttribute{
+												MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+												Optional:            true,
+												CustomType:          supertypes.StringType{},
+												Validators: []validator.String{
+													stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+												},
+											},
+											"value_pattern": schema.StringAttribute{
+												MarkdownDescription: "A glob pattern to match the header value against.",
+												Optional:            true,
+												CustomType:          supertypes.StringType{},
+											},
+										},
+									},
+								},
+							},
+							"status_code": schema.SingleNestedAttribute{
+								MarkdownDescription: "Compares the HTTP status code of the response.",
+								Optional:            true,
+								CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotStatusCode](ctx),
+								Attributes: map[string]schema.Attribute{
+									"operator": tfutils.WithEnumStringAttribute(
+										schema.StringAttribute{
+											MarkdownDescription: "The comparison operator.",
+											Required:            true,
+											CustomType:          supertypes.StringType{},
+										},
+										sentrydata.UptimeAssertionComparisonTypes,
+									),
+									"value": schema.Int64Attribute{
+										MarkdownDescription: "The HTTP status code to compare against.",
+										Required:            true,
+										CustomType:          supertypes.Int64Type{},
+									},
+								},
+							},
+							"json_path": schema.SingleNestedAttribute{
+								MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+								Optional:            true,
+								CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotJsonPath](ctx),
+								Attributes: map[string]schema.Attribute{
+									"path": schema.StringAttribute{
+										MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+										Required:            true,
+										CustomType:          supertypes.StringType{},
+									},
+									"operator": tfutils.WithEnumStringAttribute(
+										schema.StringAttribute{
+											MarkdownDescription: "The comparison operator.",
+											Required:            true,
+											CustomType:          supertypes.StringType{},
+										},
+										sentrydata.UptimeAssertionComparisonTypes,
+									),
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+										Optional:            true,
+										CustomType:          supertypes.StringType{},
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+										},
+									},
+									"value_pattern": schema.StringAttribute{
+										MarkdownDescription: "A glob pattern to match the value against.",
+										Optional:            true,
+										CustomType:          supertypes.StringType{},
+									},
+								},
+							},
+							"header": schema.SingleNestedAttribute{
+								MarkdownDescription: "Passes if a response header matches both the key and the value.",
+								Optional:            true,
+								CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionNotHeader](ctx),
+								Attributes: map[string]schema.Attribute{
+									"key_operator": tfutils.WithEnumStringAttribute(
+										schema.StringAttribute{
+											MarkdownDescription: "The comparison operator for the header key.",
+											Required:            true,
+											CustomType:          supertypes.StringType{},
+										},
+										sentrydata.UptimeAssertionComparisonTypes,
+									),
+									"key": schema.StringAttribute{
+										MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+										Optional:            true,
+										CustomType:          supertypes.StringType{},
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+										},
+									},
+									"key_pattern": schema.StringAttribute{
+										MarkdownDescription: "A glob pattern to match the header key against.",
+										Optional:            true,
+										CustomType:          supertypes.StringType{},
+									},
+									"value_operator": tfutils.WithEnumStringAttribute(
+										schema.StringAttribute{
+											MarkdownDescription: "The comparison operator for the header value.",
+											Required:            true,
+											CustomType:          supertypes.StringType{},
+										},
+										sentrydata.UptimeAssertionComparisonTypes,
+									),
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+										Optional:            true,
+										CustomType:          supertypes.StringType{},
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+										},
+									},
+									"value_pattern": schema.StringAttribute{
+										MarkdownDescription: "A glob pattern to match the header value against.",
+										Optional:            true,
+										CustomType:          supertypes.StringType{},
+									},
+								},
+							},
+						},
+					},
+					"status_code": schema.SingleNestedAttribute{
+						MarkdownDescription: "Compares the HTTP status code of the response.",
+						Optional:            true,
+						CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionStatusCode](ctx),
+						Attributes: map[string]schema.Attribute{
+							"operator": tfutils.WithEnumStringAttribute(
+								schema.StringAttribute{
+									MarkdownDescription: "The comparison operator.",
+									Required:            true,
+									CustomType:          supertypes.StringType{},
+								},
+								sentrydata.UptimeAssertionComparisonTypes,
+							),
+							"value": schema.Int64Attribute{
+								MarkdownDescription: "The HTTP status code to compare against.",
+								Required:            true,
+								CustomType:          supertypes.Int64Type{},
+							},
+						},
+					},
+					"json_path": schema.SingleNestedAttribute{
+						MarkdownDescription: "Evaluates a JSONPath expression against the response body and compares the result.",
+						Optional:            true,
+						CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionJsonPath](ctx),
+						Attributes: map[string]schema.Attribute{
+							"path": schema.StringAttribute{
+								MarkdownDescription: "The JSONPath expression, e.g. `$.status`.",
+								Required:            true,
+								CustomType:          supertypes.StringType{},
+							},
+							"operator": tfutils.WithEnumStringAttribute(
+								schema.StringAttribute{
+									MarkdownDescription: "The comparison operator.",
+									Required:            true,
+									CustomType:          supertypes.StringType{},
+								},
+								sentrydata.UptimeAssertionComparisonTypes,
+							),
+							"value": schema.StringAttribute{
+								MarkdownDescription: "The literal value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+								Optional:            true,
+								CustomType:          supertypes.StringType{},
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+								},
+							},
+							"value_pattern": schema.StringAttribute{
+								MarkdownDescription: "A glob pattern to match the value against.",
+								Optional:            true,
+								CustomType:          supertypes.StringType{},
+							},
+						},
+					},
+					"header": schema.SingleNestedAttribute{
+						MarkdownDescription: "Passes if a response header matches both the key and the value.",
+						Optional:            true,
+						CustomType:          supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertionHeader](ctx),
+						Attributes: map[string]schema.Attribute{
+							"key_operator": tfutils.WithEnumStringAttribute(
+								schema.StringAttribute{
+									MarkdownDescription: "The comparison operator for the header key.",
+									Required:            true,
+									CustomType:          supertypes.StringType{},
+								},
+								sentrydata.UptimeAssertionComparisonTypes,
+							),
+							"key": schema.StringAttribute{
+								MarkdownDescription: "The literal header key to compare against. Exactly one of `key` or `key_pattern` must be set.",
+								Optional:            true,
+								CustomType:          supertypes.StringType{},
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_pattern")),
+								},
+							},
+							"key_pattern": schema.StringAttribute{
+								MarkdownDescription: "A glob pattern to match the header key against.",
+								Optional:            true,
+								CustomType:          supertypes.StringType{},
+							},
+							"value_operator": tfutils.WithEnumStringAttribute(
+								schema.StringAttribute{
+									MarkdownDescription: "The comparison operator for the header value.",
+									Required:            true,
+									CustomType:          supertypes.StringType{},
+								},
+								sentrydata.UptimeAssertionComparisonTypes,
+							),
+							"value": schema.StringAttribute{
+								MarkdownDescription: "The literal header value to compare against. Exactly one of `value` or `value_pattern` must be set.",
+								Optional:            true,
+								CustomType:          supertypes.StringType{},
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_pattern")),
+								},
+							},
+							"value_pattern": schema.StringAttribute{
+								MarkdownDescription: "A glob pattern to match the header value against.",
+								Optional:            true,
+								CustomType:          supertypes.StringType{},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -312,26 +1792,390 @@ func (r *UptimeMonitorResource) ImportState(ctx context.Context, req resource.Im
 }
 
 type UptimeMonitorResourceModel struct {
-	Id                supertypes.StringValue                                                `tfsdk:"id"`
-	Organization      supertypes.StringValue                                                `tfsdk:"organization"`
-	Project           supertypes.StringValue                                                `tfsdk:"project"`
-	Enabled           supertypes.BoolValue                                                  `tfsdk:"enabled"`
-	Name              supertypes.StringValue                                                `tfsdk:"name"`
-	Description       supertypes.StringValue                                                `tfsdk:"description"`
-	Owner             supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelOwner] `tfsdk:"owner"`
-	Url               supertypes.StringValue                                                `tfsdk:"url"`
-	Method            supertypes.StringValue                                                `tfsdk:"method"`
-	Body              sentrytypes.TrimmedString                                             `tfsdk:"body"`
-	Headers           supertypes.MapValueOf[string]                                         `tfsdk:"headers"`
-	IntervalSeconds   supertypes.Int64Value                                                 `tfsdk:"interval_seconds"`
-	TimeoutMs         supertypes.Int64Value                                                 `tfsdk:"timeout_ms"`
-	Environment       supertypes.StringValue                                                `tfsdk:"environment"`
-	RecoveryThreshold supertypes.Int64Value                                                 `tfsdk:"recovery_threshold"`
-	DowntimeThreshold supertypes.Int64Value                                                 `tfsdk:"downtime_threshold"`
-	AssertionJson     jsontypes.Normalized                                                  `tfsdk:"assertion_json"`
+	Id                supertypes.StringValue                                                    `tfsdk:"id"`
+	Organization      supertypes.StringValue                                                    `tfsdk:"organization"`
+	Project           supertypes.StringValue                                                    `tfsdk:"project"`
+	Enabled           supertypes.BoolValue                                                      `tfsdk:"enabled"`
+	Name              supertypes.StringValue                                                    `tfsdk:"name"`
+	Description       supertypes.StringValue                                                    `tfsdk:"description"`
+	Owner             supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelOwner]     `tfsdk:"owner"`
+	Url               supertypes.StringValue                                                    `tfsdk:"url"`
+	Method            supertypes.StringValue                                                    `tfsdk:"method"`
+	Body              sentrytypes.TrimmedString                                                 `tfsdk:"body"`
+	Headers           supertypes.MapValueOf[string]                                             `tfsdk:"headers"`
+	IntervalSeconds   supertypes.Int64Value                                                     `tfsdk:"interval_seconds"`
+	TimeoutMs         supertypes.Int64Value                                                     `tfsdk:"timeout_ms"`
+	Environment       supertypes.StringValue                                                    `tfsdk:"environment"`
+	RecoveryThreshold supertypes.Int64Value                                                     `tfsdk:"recovery_threshold"`
+	DowntimeThreshold supertypes.Int64Value                                                     `tfsdk:"downtime_threshold"`
+	AssertionJson     jsontypes.Normalized                                                      `tfsdk:"assertion_json"`
+	Assertion         supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertion] `tfsdk:"assertion"`
 }
 
 type UptimeMonitorResourceModelOwner struct {
 	UserId supertypes.StringValue `tfsdk:"user_id"`
 	TeamId supertypes.StringValue `tfsdk:"team_id"`
 }
+
+type UptimeMonitorResourceModelAssertion struct {
+	And        supertypes.ListNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItem]      `tfsdk:"and"`
+	Or         supertypes.ListNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItem]       `tfsdk:"or"`
+	Not        supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNot]        `tfsdk:"not"`
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItem struct {
+	And        supertypes.ListNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemAndItem]      `tfsdk:"and"`
+	Or         supertypes.ListNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemOrItem]       `tfsdk:"or"`
+	Not        supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemNot]        `tfsdk:"not"`
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemAndItem struct {
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemAndItemStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemAndItemJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemAndItemHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemAndItemStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemAndItemJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemAndItemHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemOrItem struct {
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemOrItemStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemOrItemJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemOrItemHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemOrItemStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemOrItemJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemOrItemHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemNot struct {
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemNotStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemNotJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionAndItemNotHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemNotStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemNotJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemNotHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionAndItemHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItem struct {
+	And        supertypes.ListNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemAndItem]      `tfsdk:"and"`
+	Or         supertypes.ListNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemOrItem]       `tfsdk:"or"`
+	Not        supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemNot]        `tfsdk:"not"`
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemAndItem struct {
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemAndItemStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemAndItemJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemAndItemHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemAndItemStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemAndItemJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemAndItemHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemOrItem struct {
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemOrItemStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemOrItemJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemOrItemHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemOrItemStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemOrItemJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemOrItemHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemNot struct {
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemNotStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemNotJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionOrItemNotHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemNotStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemNotJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemNotHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionOrItemHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionNot struct {
+	And        supertypes.ListNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotAndItem]      `tfsdk:"and"`
+	Or         supertypes.ListNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotOrItem]       `tfsdk:"or"`
+	Not        supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotNot]        `tfsdk:"not"`
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionNotAndItem struct {
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotAndItemStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotAndItemJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotAndItemHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionNotAndItemStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionNotAndItemJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionNotAndItemHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionNotOrItem struct {
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotOrItemStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotOrItemJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotOrItemHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionNotOrItemStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionNotOrItemJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionNotOrItemHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionNotNot struct {
+	StatusCode supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotNotStatusCode] `tfsdk:"status_code"`
+	JsonPath   supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotNotJsonPath]   `tfsdk:"json_path"`
+	Header     supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertionNotNotHeader]     `tfsdk:"header"`
+}
+
+type UptimeMonitorResourceModelAssertionNotNotStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionNotNotJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionNotNotHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionNotStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionNotJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionNotHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionStatusCode struct {
+	Operator supertypes.StringValue `tfsdk:"operator"`
+	Value    supertypes.Int64Value  `tfsdk:"value"`
+}
+
+type UptimeMonitorResourceModelAssertionJsonPath struct {
+	Path         supertypes.StringValue `tfsdk:"path"`
+	Operator     supertypes.StringValue `tfsdk:"operator"`
+	Value        supertypes.StringValue `tfsdk:"value"`
+	ValuePattern supertypes.StringValue `tfsdk:"value_pattern"`
+}
+
+type UptimeMonitorResourceModelAssertionHeader struct {
+	KeyOperator   supertypes.StringValue `tfsdk:"key_operator"`
+	Key           supertypes.StringValue `tfsdk:"key"`
+	KeyPattern    supertypes.StringValue `tfsdk:"key_pattern"`
+	ValueOperator supertypes.StringValue `tfsdk:"value_operator"`
+	Value         supertypes.StringValue `tfsdk:"value"`
+	ValuePattern  supertypes.StringValue `tfsdk:"value_pattern"`
+}
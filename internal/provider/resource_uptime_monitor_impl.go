@@ -3,16 +3,48 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/jianyuan/terraform-provider-sentry/internal/apiclient"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
 	"github.com/jianyuan/terraform-provider-sentry/internal/sentrytypes"
 	"github.com/jianyuan/terraform-provider-sentry/internal/tfutils"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+	"github.com/samber/lo"
 )
 
+var _ resource.ResourceWithValidateConfig = &UptimeMonitorResource{}
+
+// ValidateConfig checks the JSON produced by `assertion` against the assertion
+// schema, like the `assertion` function does for `assertion_json`.
+func (r *UptimeMonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UptimeMonitorResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.Assertion.IsKnown() {
+		return
+	}
+
+	if v, err := data.Assertion.ToTerraformValue(ctx); err != nil || !v.IsFullyKnown() {
+		return
+	}
+
+	if _, err := uptimeMonitorAssertionJSON(ctx, data.Assertion); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("assertion"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute assertion must be a valid uptime assertion, got error: %s", err),
+		)
+	}
+}
+
 func (r *UptimeMonitorResource) getCreateJSONRequestBody(ctx context.Context, data UptimeMonitorResourceModel) (*apiclient.CreateProjectMonitorJSONRequestBody, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -37,7 +69,14 @@ func (r *UptimeMonitorResource) getCreateJSONRequestBody(ctx context.Context, da
 			outDs.Headers = append(outDs.Headers, []string{key, value})
 		}
 	}
-	if !data.AssertionJson.IsNull() && !data.AssertionJson.IsUnknown() {
+	if data.Assertion.IsKnown() {
+		assertion, err := uptimeMonitorAssertionJSON(ctx, data.Assertion)
+		if err != nil {
+			diags.AddAttributeError(path.Root("assertion"), "Invalid assertion", err.Error())
+			return nil, diags
+		}
+		outDs.Assertion.Set(assertion)
+	} else if !data.AssertionJson.IsNull() && !data.AssertionJson.IsUnknown() {
 		outDs.Assertion.Set(json.RawMessage(data.AssertionJson.ValueString()))
 	} else {
 		outDs.Assertion.SetNull()
@@ -184,12 +223,34 @@ func (m *UptimeMonitorResourceModel) Fill(ctx context.Context, data apiclient.Pr
 		return
 	}
 
+	var assertion json.RawMessage
 	if dataSource.QueryObj.Assertion.IsSpecified() && !dataSource.QueryObj.Assertion.IsNull() {
-		assertion, err := dataSource.QueryObj.Assertion.Get()
+		assertion, err = dataSource.QueryObj.Assertion.Get()
 		if err != nil {
 			diags.AddError("Invalid assertion", err.Error())
 			return
 		}
+	}
+
+	// Keep using whichever of `assertion` and `assertion_json` the
+	// configuration uses. Imported monitors use `assertion_json`.
+	if !m.Assertion.IsNull() {
+		m.AssertionJson = jsontypes.NewNormalizedNull()
+		if assertion == nil {
+			m.Assertion.SetNull(ctx)
+		} else if v, err := uptimeMonitorAssertionFromJSON(ctx, assertion); errors.Is(err, errUptimeAssertionTooDeep) {
+			diags.AddAttributeWarning(
+				path.Root("assertion"),
+				"Unsupported assertion",
+				"The assertion of the monitor is nested too deeply to be represented by the assertion attribute, changes made outside of Terraform will not be detected. Use assertion_json instead.",
+			)
+		} else if err != nil {
+			diags.AddError("Invalid assertion", err.Error())
+			return
+		} else {
+			m.Assertion = v
+		}
+	} else if assertion != nil {
 		m.AssertionJson = jsontypes.NewNormalizedValue(string(assertion))
 	} else {
 		m.AssertionJson = jsontypes.NewNormalizedNull()
@@ -197,3 +258,302 @@ func (m *UptimeMonitorResourceModel) Fill(ctx context.Context, data apiclient.Pr
 
 	return
 }
+
+var errUptimeAssertionTooDeep = errors.New("assertion is nested too deeply")
+
+// uptimeMonitorAssertionJSON converts the `assertion` attribute to the same
+// JSON that the `assertion` and `op_` functions produce.
+//
+// The nested `and`, `or` and `not` attributes have a distinct model type on
+// every level, so the conversion walks the Terraform value instead.
+func uptimeMonitorAssertionJSON(ctx context.Context, assertion supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertion]) (json.RawMessage, error) {
+	v, err := assertion.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := uptimeAssertionOpFromTerraform(v)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := json.Marshal(map[string]any{
+		"root": root,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sentrydata.ValidateJSONUptimeAssertionForDefinition("Assertion", out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func uptimeAssertionOpFromTerraform(v tftypes.Value) (map[string]any, error) {
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return nil, err
+	}
+
+	isSet := func(name string) bool {
+		v, ok := attrs[name]
+		return ok && !v.IsNull()
+	}
+
+	switch {
+	case isSet("and"), isSet("or"):
+		op := "and"
+		if isSet("or") {
+			op = "or"
+		}
+
+		var items []tftypes.Value
+		if err := attrs[op].As(&items); err != nil {
+			return nil, err
+		}
+
+		children := make([]any, 0, len(items))
+		for _, item := range items {
+			child, err := uptimeAssertionOpFromTerraform(item)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
+		}
+
+		return map[string]any{
+			"op":       op,
+			"children": children,
+		}, nil
+	case isSet("not"):
+		operand, err := uptimeAssertionOpFromTerraform(attrs["not"])
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{
+			"op":      "not",
+			"operand": operand,
+		}, nil
+	case isSet("status_code"):
+		var check struct {
+			Operator string
+			Value    big.Float
+		}
+		if err := uptimeAssertionAttributesAs(attrs["status_code"], map[string]any{
+			"operator": &check.Operator,
+			"value":    &check.Value,
+		}); err != nil {
+			return nil, err
+		}
+
+		value, _ := check.Value.Int64()
+		return map[string]any{
+			"op":       "status_code_check",
+			"operator": map[string]any{"cmp": check.Operator},
+			"value":    value,
+		}, nil
+	case isSet("json_path"):
+		var check struct {
+			Path, Operator      string
+			Value, ValuePattern *string
+		}
+		if err := uptimeAssertionAttributesAs(attrs["json_path"], map[string]any{
+			"path":          &check.Path,
+			"operator":      &check.Operator,
+			"value":         &check.Value,
+			"value_pattern": &check.ValuePattern,
+		}); err != nil {
+			return nil, err
+		}
+
+		return map[string]any{
+			"op":       "json_path",
+			"operand":  uptimeAssertionOperand("jsonpath_op", check.Value, check.ValuePattern),
+			"operator": map[string]any{"cmp": check.Operator},
+			"value":    check.Path,
+		}, nil
+	case isSet("header"):
+		var check struct {
+			KeyOperator, ValueOperator           string
+			Key, KeyPattern, Value, ValuePattern *string
+		}
+		if err := uptimeAssertionAttributesAs(attrs["header"], map[string]any{
+			"key_operator":   &check.KeyOperator,
+			"key":            &check.Key,
+			"key_pattern":    &check.KeyPattern,
+			"value_operator": &check.ValueOperator,
+			"value":          &check.Value,
+			"value_pattern":  &check.ValuePattern,
+		}); err != nil {
+			return nil, err
+		}
+
+		return map[string]any{
+			"op":            "header_check",
+			"key_op":        map[string]any{"cmp": check.KeyOperator},
+			"key_operand":   uptimeAssertionOperand("header_op", check.Key, check.KeyPattern),
+			"value_op":      map[string]any{"cmp": check.ValueOperator},
+			"value_operand": uptimeAssertionOperand("header_op", check.Value, check.ValuePattern),
+		}, nil
+	default:
+		return nil, errors.New("each operation must set exactly one of and, or, not, status_code, json_path, or header")
+	}
+}
+
+// uptimeAssertionAttributesAs decodes the attributes of an object into the
+// given targets, see tftypes.Value.As.
+func uptimeAssertionAttributesAs(v tftypes.Value, targets map[string]any) error {
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return err
+	}
+
+	for name, target := range targets {
+		if err := attrs[name].As(target); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func uptimeAssertionOperand(kind string, literal, pattern *string) map[string]any {
+	if pattern != nil {
+		return map[string]any{
+			kind:      "glob",
+			"pattern": map[string]any{"value": *pattern},
+		}
+	}
+
+	return map[string]any{
+		kind:    "literal",
+		"value": lo.FromPtr(literal),
+	}
+}
+
+// uptimeMonitorAssertionFromJSON is the inverse of uptimeMonitorAssertionJSON.
+// It returns errUptimeAssertionTooDeep if the assertion cannot be represented
+// by the `assertion` attribute.
+func uptimeMonitorAssertionFromJSON(ctx context.Context, assertion json.RawMessage) (supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertion], error) {
+	null := supertypes.NewSingleNestedObjectValueOfNull[UptimeMonitorResourceModelAssertion](ctx)
+
+	// The conversion relies on the JSON types matching the schema.
+	if err := sentrydata.ValidateJSONUptimeAssertionForDefinition("Assertion", assertion); err != nil {
+		return null, err
+	}
+
+	var in struct {
+		Root map[string]any `json:"root"`
+	}
+	if err := json.Unmarshal(assertion, &in); err != nil {
+		return null, err
+	}
+
+	typ := supertypes.NewSingleNestedObjectTypeOf[UptimeMonitorResourceModelAssertion](ctx)
+	v, err := uptimeAssertionOpToTerraform(typ.TerraformType(ctx).(tftypes.Object), in.Root)
+	if err != nil {
+		return null, err
+	}
+
+	out, err := typ.ValueFromTerraform(ctx, v)
+	if err != nil {
+		return null, err
+	}
+	return out.(supertypes.SingleNestedObjectValueOf[UptimeMonitorResourceModelAssertion]), nil
+}
+
+func uptimeAssertionOpToTerraform(typ tftypes.Object, op map[string]any) (tftypes.Value, error) {
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	// Sets a nested attribute from the given JSON keys, e.g. the `value` of
+	// `status_code` from `value`, or the `operator` from `operator.cmp`.
+	setObject := func(name string, values map[string]any) {
+		objectType := typ.AttributeTypes[name].(tftypes.Object)
+		object := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for attrName, attrType := range objectType.AttributeTypes {
+			object[attrName] = tftypes.NewValue(attrType, values[attrName])
+		}
+		attrs[name] = tftypes.NewValue(objectType, object)
+	}
+
+	switch opName, _ := op["op"].(string); opName {
+	case "and", "or":
+		listType, ok := typ.AttributeTypes[opName].(tftypes.List)
+		if !ok {
+			return tftypes.Value{}, errUptimeAssertionTooDeep
+		}
+
+		children, _ := op["children"].([]any)
+		items := make([]tftypes.Value, 0, len(children))
+		for _, child := range children {
+			childOp, _ := child.(map[string]any)
+			item, err := uptimeAssertionOpToTerraform(listType.ElementType.(tftypes.Object), childOp)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			items = append(items, item)
+		}
+		attrs[opName] = tftypes.NewValue(listType, items)
+	case "not":
+		objectType, ok := typ.AttributeTypes["not"].(tftypes.Object)
+		if !ok {
+			return tftypes.Value{}, errUptimeAssertionTooDeep
+		}
+
+		operand, _ := op["operand"].(map[string]any)
+		v, err := uptimeAssertionOpToTerraform(objectType, operand)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		attrs["not"] = v
+	case "status_code_check":
+		value, _ := op["value"].(float64)
+		setObject("status_code", map[string]any{
+			"operator": uptimeAssertionComparison(op["operator"]),
+			"value":    big.NewFloat(value),
+		})
+	case "json_path":
+		literal, pattern := uptimeAssertionOperandValues("jsonpath_op", op["operand"])
+		setObject("json_path", map[string]any{
+			"path":          op["value"],
+			"operator":      uptimeAssertionComparison(op["operator"]),
+			"value":         literal,
+			"value_pattern": pattern,
+		})
+	case "header_check":
+		key, keyPattern := uptimeAssertionOperandValues("header_op", op["key_operand"])
+		value, valuePattern := uptimeAssertionOperandValues("header_op", op["value_operand"])
+		setObject("header", map[string]any{
+			"key_operator":   uptimeAssertionComparison(op["key_op"]),
+			"key":            key,
+			"key_pattern":    keyPattern,
+			"value_operator": uptimeAssertionComparison(op["value_op"]),
+			"value":          value,
+			"value_pattern":  valuePattern,
+		})
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported operation %q", opName)
+	}
+
+	return tftypes.NewValue(typ, attrs), nil
+}
+
+func uptimeAssertionComparison(v any) any {
+	comparison, _ := v.(map[string]any)
+	return comparison["cmp"]
+}
+
+// uptimeAssertionOperandValues returns the literal value or the glob pattern of
+// an operand. The other is nil.
+func uptimeAssertionOperandValues(kind string, v any) (literal any, pattern any) {
+	operand, _ := v.(map[string]any)
+	if operand[kind] == "glob" {
+		p, _ := operand["pattern"].(map[string]any)
+		return nil, p["value"]
+	}
+	return operand["value"], nil
+}
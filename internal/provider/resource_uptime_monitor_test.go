@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -88,6 +91,93 @@ func TestAccUptimeMonitorResource_validation(t *testing.T) {
 					`If method attribute is set and the value is one of "GET", "HEAD", "OPTIONS" this attribute is NULL`,
 				),
 			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_uptime_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "uptime monitor name"
+
+						url = "https://sentry.io"
+						method = "GET"
+						interval_seconds = 60
+						timeout_ms = 5000
+
+						environment = "production"
+
+						assertion_json = provider::sentry::assertion(
+							provider::sentry::op_status_code_check("equals", 200),
+						)
+						assertion = {
+							status_code = {
+								operator = "equals"
+								value    = 200
+							}
+						}
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Attribute "assertion" cannot be specified when "assertion_json" is specified`),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_uptime_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "uptime monitor name"
+
+						url = "https://sentry.io"
+						method = "GET"
+						interval_seconds = 60
+						timeout_ms = 5000
+
+						environment = "production"
+
+						assertion = {
+							and = [
+								{
+									status_code = {
+										operator = "equals"
+										value    = 200
+									}
+									json_path = {
+										path     = "$.status"
+										operator = "equals"
+										value    = "ok"
+									}
+								},
+							]
+						}
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`2 attributes specified when one (and only one) of`),
+			},
+			{
+				PlanOnly: true,
+				Config: `
+					resource "sentry_uptime_monitor" "test" {
+						organization = "1"
+						project      = "2"
+						name         = "uptime monitor name"
+
+						url = "https://sentry.io"
+						method = "GET"
+						interval_seconds = 60
+						timeout_ms = 5000
+
+						environment = "production"
+
+						assertion = {
+							status_code = {
+								operator = "between"
+								value    = 200
+							}
+						}
+					}
+				`,
+				ExpectError: acctest.ExpectLiteralError(`Attribute assertion.status_code.operator value must be one of`),
+			},
 		},
 	})
 }
@@ -199,6 +289,170 @@ func TestAccUptimeMonitorResource_basic(t *testing.T) {
 	})
 }
 
+func TestAccUptimeMonitorResource_assertion(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-project")
+	monitorName := acctest.RandomWithPrefix("tf-uptime-monitor")
+	rn := "sentry_uptime_monitor.test"
+
+	config := func(assertion string) string {
+		return testAccUptimeMonitorResourceConfig(projectName, monitorName, `
+			url = "https://sentry.io"
+			method = "GET"
+			interval_seconds = 60
+			timeout_ms = 5000
+
+			environment = "production"
+
+			`+assertion+`
+		`)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+					assertion = {
+						and = [
+							{
+								status_code = {
+									operator = "greater_than"
+									value    = 199
+								}
+							},
+							{
+								status_code = {
+									operator = "less_than"
+									value    = 300
+								}
+							},
+							{
+								or = [
+									{
+										json_path = {
+											path     = "$.status"
+											operator = "equals"
+											value    = "ok"
+										}
+									},
+									{
+										json_path = {
+											path          = "$.status"
+											operator      = "equals"
+											value_pattern = "healthy*"
+										}
+									},
+								]
+							},
+							{
+								not = {
+									header = {
+										key_operator   = "equals"
+										key            = "X-Maintenance"
+										value_operator = "always"
+										value_pattern  = "*"
+									}
+								}
+							},
+						]
+					}
+				`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("assertion_json"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("assertion").AtMapKey("and"), knownvalue.ListSizeExact(4)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("assertion").AtMapKey("and").AtSliceIndex(2).AtMapKey("or").AtSliceIndex(1).AtMapKey("json_path").AtMapKey("value_pattern"), knownvalue.StringExact("healthy*")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("assertion").AtMapKey("and").AtSliceIndex(3).AtMapKey("not").AtMapKey("header").AtMapKey("key"), knownvalue.StringExact("X-Maintenance")),
+				},
+			},
+			{
+				// Switch to the same assertion built with the functions.
+				Config: config(`
+					assertion_json = provider::sentry::assertion(
+						provider::sentry::op_and(
+							provider::sentry::op_status_code_check("greater_than", 199),
+							provider::sentry::op_status_code_check("less_than", 300),
+							provider::sentry::op_or(
+								provider::sentry::op_jsonpath(
+									provider::sentry::op_jsonpath_operand_literal("ok"),
+									"equals",
+									"$.status",
+								),
+								provider::sentry::op_jsonpath(
+									provider::sentry::op_jsonpath_operand_glob("healthy*"),
+									"equals",
+									"$.status",
+								),
+							),
+							provider::sentry::op_not(
+								provider::sentry::op_header_check(
+									"equals",
+									provider::sentry::op_header_operand_literal("X-Maintenance"),
+									"always",
+									provider::sentry::op_header_operand_glob("*"),
+								),
+							),
+						),
+					)
+				`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("assertion_json"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("assertion"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestUptimeMonitorAssertionJSON(t *testing.T) {
+	ctx := context.Background()
+
+	// The JSON that the `assertion` and `op_` functions produce.
+	const want = `{"root":{"op":"and","children":[` +
+		`{"op":"status_code_check","operator":{"cmp":"greater_than"},"value":199},` +
+		`{"op":"or","children":[` +
+		`{"op":"json_path","operand":{"jsonpath_op":"literal","value":"ok"},"operator":{"cmp":"equals"},"value":"$.status"},` +
+		`{"op":"json_path","operand":{"jsonpath_op":"glob","pattern":{"value":"healthy*"}},"operator":{"cmp":"equals"},"value":"$.status"}` +
+		`]},` +
+		`{"op":"not","operand":{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"literal","value":"X-Maintenance"},"value_op":{"cmp":"always"},"value_operand":{"header_op":"glob","pattern":{"value":"*"}}}}` +
+		`]}}`
+
+	assertion, err := uptimeMonitorAssertionFromJSON(ctx, json.RawMessage(want))
+	if err != nil {
+		t.Fatalf("uptimeMonitorAssertionFromJSON() returned error: %v", err)
+	}
+
+	root := assertion.MustGet(ctx)
+	if got := len(root.And.MustGet(ctx)); got != 3 {
+		t.Fatalf("got %d children of and, want 3", got)
+	}
+	if got := root.And.MustGet(ctx)[0].StatusCode.MustGet(ctx).Value.Get(); got != 199 {
+		t.Errorf("status_code.value = %d, want 199", got)
+	}
+
+	got, err := uptimeMonitorAssertionJSON(ctx, assertion)
+	if err != nil {
+		t.Fatalf("uptimeMonitorAssertionJSON() returned error: %v", err)
+	}
+
+	var gotValue, wantValue any
+	_ = json.Unmarshal(got, &gotValue)
+	_ = json.Unmarshal([]byte(want), &wantValue)
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Errorf("uptimeMonitorAssertionJSON() = %s, want %s", got, want)
+	}
+}
+
+func TestUptimeMonitorAssertionFromJSON_tooDeep(t *testing.T) {
+	ctx := context.Background()
+
+	const in = `{"root":{"op":"and","children":[{"op":"or","children":[{"op":"not","operand":{"op":"status_code_check","operator":{"cmp":"equals"},"value":200}}]}]}}`
+
+	if _, err := uptimeMonitorAssertionFromJSON(ctx, json.RawMessage(in)); !errors.Is(err, errUptimeAssertionTooDeep) {
+		t.Errorf("uptimeMonitorAssertionFromJSON() error = %v, want %v", err, errUptimeAssertionTooDeep)
+	}
+}
+
 func testAccUptimeMonitorResourceConfig(projectName, name, extras string) string {
 	return fmt.Sprintf(`
 		resource "sentry_project" "test" {
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -9,9 +10,13 @@ import (
 )
 
 func TestMain(m *testing.M) {
-	ctx := context.Background()
-	acctest.SetupShared(ctx)
-	defer acctest.TeardownShared(ctx)
+	// Shared fixtures require a live Sentry organization, so only set them up
+	// for acceptance tests. Unit tests in this package run without them.
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		ctx := context.Background()
+		acctest.SetupShared(ctx)
+		defer acctest.TeardownShared(ctx)
+	}
 
 	resource.TestMain(m)
 }
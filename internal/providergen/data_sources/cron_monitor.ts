@@ -143,5 +143,54 @@ export default {
       computedOptionalRequired: "computed",
       skipFill: true,
     },
+    {
+      name: "environments",
+      type: "list_nested",
+      description: "The environments this monitor has received check-ins from.",
+      computedOptionalRequired: "computed",
+      skipFill: true,
+      attributes: [
+        {
+          name: "name",
+          type: "string",
+          description: "The name of the environment.",
+          computedOptionalRequired: "computed",
+          skipFill: true,
+        },
+        {
+          name: "status",
+          type: "string",
+          description:
+            "The status of the environment, e.g. `active`, `ok`, `error`, `missed_checkin` or `timeout`.",
+          computedOptionalRequired: "computed",
+          skipFill: true,
+        },
+        {
+          name: "is_muted",
+          type: "bool",
+          description: "Whether the environment is muted.",
+          computedOptionalRequired: "computed",
+          skipFill: true,
+        },
+        {
+          name: "last_check_in",
+          type: "string",
+          description:
+            "The RFC 3339 date of the last check-in, or `null` if there has been none.",
+          computedOptionalRequired: "computed",
+          skipFill: true,
+          nullable: true,
+        },
+        {
+          name: "next_check_in",
+          type: "string",
+          description:
+            "The RFC 3339 date the next check-in is expected, or `null` if unknown.",
+          computedOptionalRequired: "computed",
+          skipFill: true,
+          nullable: true,
+        },
+      ],
+    },
   ],
 } satisfies DataSource;
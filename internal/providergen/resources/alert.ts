@@ -69,8 +69,9 @@ export default {
     {
       name: "monitor_ids",
       type: "set",
-      description: "The IDs of the monitors to create alerts for.",
-      computedOptionalRequired: "required",
+      description:
+        "The IDs of the monitors to create alerts for. Leave unset to connect monitors with the [`sentry_alert_monitor_link`](alert_monitor_link.md) resource instead; the connected monitors are then left untouched and only read back.",
+      computedOptionalRequired: "computed_optional",
       elementType: "string",
     },
     {
@@ -113,6 +114,40 @@ export default {
           computedOptionalRequired: "optional",
           attributes: [],
         },
+        {
+          name: "raw",
+          type: "single_nested",
+          description:
+            "A trigger condition of a `type` not natively supported by this provider. Alerts with such trigger conditions are imported using this attribute.",
+          computedOptionalRequired: "optional",
+          attributes: [
+            {
+              name: "type",
+              type: "string",
+              description: "The trigger condition type.",
+              computedOptionalRequired: "required",
+            },
+            {
+              name: "comparison_json",
+              type: "string",
+              customType: {
+                type: "jsontypes.NormalizedType{}",
+                value: "jsontypes.Normalized",
+              },
+              description:
+                "The comparison of the trigger condition as a JSON string.",
+              computedOptionalRequired: "required",
+            },
+            {
+              name: "condition_result",
+              type: "bool",
+              description:
+                "The result the trigger condition must evaluate to, e.g. `false` to negate it. Defaults to `true`.",
+              computedOptionalRequired: "computed_optional",
+              default: `booldefault.StaticBool(true)`,
+            },
+          ],
+        },
       ]),
     },
     {
@@ -459,7 +494,7 @@ export default {
                   type: "string",
                   description: "The time period to compare against.",
                   computedOptionalRequired: "required",
-                  enum: `sentrydata.EventFrequencyStandardIntervals`,
+                  enum: `sentrydata.EventFrequencyComparisonIntervals`,
                 },
               ],
             },
@@ -556,7 +591,7 @@ export default {
                   type: "string",
                   description: "The time period to compare against.",
                   computedOptionalRequired: "required",
-                  enum: `sentrydata.EventFrequencyStandardIntervals`,
+                  enum: `sentrydata.EventFrequencyComparisonIntervals`,
                 },
               ],
             },
@@ -699,6 +734,225 @@ export default {
                 },
               ],
             },
+            {
+              name: "issue_open_duration",
+              type: "single_nested",
+              description:
+                "Issue has been open for longer than `value` `time`.",
+              computedOptionalRequired: "optional",
+              attributes: [
+                {
+                  name: "time",
+                  type: "string",
+                  description: "The unit of time for the duration.",
+                  computedOptionalRequired: "required",
+                  enum: `[]string{"minute", "hour", "day", "week"}`,
+                },
+                {
+                  name: "value",
+                  type: "int64",
+                  description:
+                    "The duration the issue must have been open for.",
+                  computedOptionalRequired: "required",
+                  validators: ["int64validator.AtLeast(1)"],
+                },
+              ],
+            },
+            {
+              name: "issue_priority_equals",
+              type: "single_nested",
+              description: "Issue priority is `comparison`.",
+              computedOptionalRequired: "optional",
+              attributes: [
+                {
+                  name: "comparison",
+                  type: "int64",
+                  description:
+                    "The priority the issue must be for the alert to fire. `25` for low, `50` for medium, and `75` for high.",
+                  computedOptionalRequired: "required",
+                },
+              ],
+            },
+            {
+              name: "issue_resolution_change",
+              type: "single_nested",
+              description: "The issue's status changes to `comparison`.",
+              computedOptionalRequired: "optional",
+              attributes: [
+                {
+                  name: "comparison",
+                  type: "int64",
+                  description:
+                    "The status the issue must change to for the alert to fire. `0` for unresolved, `1` for resolved, and `2` for ignored.",
+                  computedOptionalRequired: "required",
+                },
+              ],
+            },
+            {
+              name: "event_seen_count",
+              type: "single_nested",
+              description: "The issue has been seen `comparison` times.",
+              computedOptionalRequired: "optional",
+              attributes: [
+                {
+                  name: "comparison",
+                  type: "int64",
+                  description:
+                    "A positive integer representing how many times the issue has to be seen before the alert will fire.",
+                  computedOptionalRequired: "required",
+                  validators: ["int64validator.AtLeast(1)"],
+                },
+              ],
+            },
+            {
+              name: "existing_high_priority_issue",
+              type: "single_nested",
+              description: "An existing issue escalates to high priority.",
+              computedOptionalRequired: "optional",
+              attributes: [],
+            },
+            {
+              name: "new_high_priority_issue",
+              type: "single_nested",
+              description: "A new issue is created with high priority.",
+              computedOptionalRequired: "optional",
+              attributes: [],
+            },
+            {
+              name: "every_event",
+              type: "single_nested",
+              description: "Every event matches.",
+              computedOptionalRequired: "optional",
+              attributes: [],
+            },
+            {
+              name: "event_unique_user_frequency_percent",
+              type: "single_nested",
+              description: "Percent of users affected.",
+              computedOptionalRequired: "optional",
+              attributes: [
+                {
+                  name: "value",
+                  type: "int64",
+                  description:
+                    "A positive integer representing the percentage increase in users affected that must occur before the alert will fire.",
+                  computedOptionalRequired: "required",
+                  validators: ["int64validator.AtLeast(1)"],
+                },
+                {
+                  name: "filters",
+                  type: "list_nested",
+                  description:
+                    "A list of additional sub-filters to evaluate before the alert will fire.",
+                  computedOptionalRequired: "computed_optional",
+                  attributes: [
+                    {
+                      name: "key",
+                      type: "string",
+                      description:
+                        "The key of the filter. Conflicts with `attribute`.",
+                      computedOptionalRequired: "optional",
+                      validators: [
+                        `stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("attribute"))`,
+                      ],
+                    },
+                    {
+                      name: "attribute",
+                      type: "string",
+                      description:
+                        "The attribute of the filter. Conflicts with `key`.",
+                      computedOptionalRequired: "optional",
+                      validators: [
+                        `stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("key"))`,
+                      ],
+                    },
+                    {
+                      name: "match",
+                      type: "string",
+                      description: "The match type of the filter.",
+                      computedOptionalRequired: "optional",
+                      enum: "sentrydata.MatchTypeIds",
+                    },
+                    {
+                      name: "value",
+                      type: "string",
+                      description: "The value of the filter.",
+                      computedOptionalRequired: "optional",
+                    },
+                  ],
+                },
+                {
+                  name: "interval",
+                  type: "string",
+                  description:
+                    "The time period in which to evaluate the value. e.g. Number of users affected by an issue is `comparisonInterval` percent higher `value` compared to `interval`.",
+                  computedOptionalRequired: "required",
+                  enum: `sentrydata.EventFrequencyStandardIntervals`,
+                },
+                {
+                  name: "comparison_interval",
+                  type: "string",
+                  description: "The time period to compare against.",
+                  computedOptionalRequired: "required",
+                  enum: `sentrydata.EventFrequencyComparisonIntervals`,
+                },
+              ],
+            },
+            {
+              name: "event_created_by_detector",
+              type: "single_nested",
+              description: "The event was created by the monitor `monitor_id`.",
+              computedOptionalRequired: "optional",
+              attributes: [
+                {
+                  name: "monitor_id",
+                  type: "string",
+                  description: "The internal ID of the monitor.",
+                  computedOptionalRequired: "required",
+                },
+              ],
+            },
+            {
+              name: "seer_activity_trigger",
+              type: "single_nested",
+              description: "Seer activity occurs on the issue.",
+              computedOptionalRequired: "optional",
+              attributes: [],
+            },
+            {
+              name: "raw",
+              type: "single_nested",
+              description:
+                "A condition of a `type` not natively supported by this provider. Alerts with such conditions are imported using this attribute.",
+              computedOptionalRequired: "optional",
+              attributes: [
+                {
+                  name: "type",
+                  type: "string",
+                  description: "The condition type.",
+                  computedOptionalRequired: "required",
+                },
+                {
+                  name: "comparison_json",
+                  type: "string",
+                  customType: {
+                    type: "jsontypes.NormalizedType{}",
+                    value: "jsontypes.Normalized",
+                  },
+                  description:
+                    "The comparison of the condition as a JSON string.",
+                  computedOptionalRequired: "required",
+                },
+                {
+                  name: "condition_result",
+                  type: "bool",
+                  description:
+                    "The result the condition must evaluate to, e.g. `false` to negate it. Defaults to `true`.",
+                  computedOptionalRequired: "computed_optional",
+                  default: `booldefault.StaticBool(true)`,
+                },
+              ],
+            },
           ]),
         },
         {
@@ -1096,6 +1350,48 @@ export default {
                 },
               ],
             },
+            {
+              name: "raw",
+              type: "single_nested",
+              description:
+                "An action of a `type` not natively supported by this provider. Alerts with such actions are imported using this attribute.",
+              computedOptionalRequired: "optional",
+              attributes: [
+                {
+                  name: "type",
+                  type: "string",
+                  description: "The action type.",
+                  computedOptionalRequired: "required",
+                },
+                {
+                  name: "integration_id",
+                  type: "string",
+                  description:
+                    "The internal ID of the integration, if the action uses one.",
+                  computedOptionalRequired: "optional",
+                },
+                {
+                  name: "config_json",
+                  type: "string",
+                  customType: {
+                    type: "jsontypes.NormalizedType{}",
+                    value: "jsontypes.Normalized",
+                  },
+                  description: "The config of the action as a JSON string.",
+                  computedOptionalRequired: "required",
+                },
+                {
+                  name: "data_json",
+                  type: "string",
+                  customType: {
+                    type: "jsontypes.NormalizedType{}",
+                    value: "jsontypes.Normalized",
+                  },
+                  description: "The data of the action as a JSON string.",
+                  computedOptionalRequired: "optional",
+                },
+              ],
+            },
           ]),
         },
       ],
@@ -1104,7 +1400,7 @@ export default {
       name: "legacy_trigger_conditions",
       type: "list",
       description:
-        "⚠️ The trigger condition types listed here are not natively supported by this provider and may be deprecated by Sentry in a future API version. Trigger condition types present on this alert that are not representable in `trigger_conditions` (e.g. `new_high_priority_issue`, `existing_high_priority_issue`, `issue_resolution_change`). When omitted from config these will be removed on the next apply. Set explicitly to preserve them.",
+        "⚠️ The trigger condition types listed here are not natively supported by this provider and may be deprecated by Sentry in a future API version. Trigger condition types present on this alert that are not representable in `trigger_conditions` (e.g. `new_high_priority_issue`, `existing_high_priority_issue`, `issue_resolution_change`). When omitted from config these will be removed on the next apply. Set explicitly to preserve them, or use the `raw` trigger condition instead.",
       computedOptionalRequired: "optional",
       elementType: "string",
     },
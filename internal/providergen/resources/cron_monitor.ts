@@ -134,12 +134,13 @@ export default {
           name: "crontab",
           type: "string",
           description:
-            "Use the crontab syntax (e.g. `0 0 * * *`). Conflicts with `interval_value` and `interval_unit`.",
+            "Use the crontab syntax (e.g. `0 0 * * *` or `@daily`). Must not run more often than `max_runtime_minutes` or `checkin_margin_minutes`. Conflicts with `interval_value` and `interval_unit`.",
           computedOptionalRequired: "optional",
           validators: [
             `stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("interval_value"), path.MatchRelative().AtParent().AtName("interval_unit"))`,
             `stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("interval_value"))`,
             `stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("interval_unit"))`,
+            `tfutils.Crontab()`,
           ],
         },
         {
@@ -175,5 +176,49 @@ export default {
       default: `stringdefault.StaticString("UTC")`,
       enum: "sentrydata.Timezones",
     },
+    {
+      name: "environments",
+      type: "list_nested",
+      description:
+        "The environments this monitor has received check-ins from. Use `sentry_monitor_mute` with `environment` to mute a single environment.",
+      computedOptionalRequired: "computed",
+      attributes: [
+        {
+          name: "name",
+          type: "string",
+          description: "The name of the environment.",
+          computedOptionalRequired: "computed",
+        },
+        {
+          name: "status",
+          type: "string",
+          description:
+            "The status of the environment, e.g. `active`, `ok`, `error`, `missed_checkin` or `timeout`.",
+          computedOptionalRequired: "computed",
+        },
+        {
+          name: "is_muted",
+          type: "bool",
+          description: "Whether the environment is muted.",
+          computedOptionalRequired: "computed",
+        },
+        {
+          name: "last_check_in",
+          type: "string",
+          description:
+            "The RFC 3339 date of the last check-in, or `null` if there has been none.",
+          computedOptionalRequired: "computed",
+          nullable: true,
+        },
+        {
+          name: "next_check_in",
+          type: "string",
+          description:
+            "The RFC 3339 date the next check-in is expected, or `null` if unknown.",
+          computedOptionalRequired: "computed",
+          nullable: true,
+        },
+      ],
+    },
   ],
 } satisfies Resource;
@@ -1,5 +1,148 @@
 import dedent from "dedent";
-import type { Resource } from "../schema";
+import type { Attribute, Resource } from "../schema";
+
+// Terraform schemas cannot be recursive, so `and`, `or` and `not` may only be
+// nested this many levels deep. Deeper trees need `assertion_json`.
+const ASSERTION_GROUP_DEPTH = 2;
+
+const ASSERTION_OPS = [
+  "and",
+  "or",
+  "not",
+  "status_code",
+  "json_path",
+  "header",
+];
+
+function assertionComparisonAttribute(
+  name: string,
+  description: string,
+): Attribute {
+  return {
+    name,
+    type: "string",
+    description,
+    computedOptionalRequired: "required",
+    enum: "sentrydata.UptimeAssertionComparisonTypes",
+  };
+}
+
+function assertionOperandAttributes(
+  prefix: string,
+  description: string,
+): Array<Attribute> {
+  return [
+    {
+      name: prefix,
+      type: "string",
+      description: `The literal ${description} to compare against. Exactly one of \`${prefix}\` or \`${prefix}_pattern\` must be set.`,
+      computedOptionalRequired: "optional",
+      validators: [
+        `stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("${prefix}_pattern"))`,
+      ],
+    },
+    {
+      name: `${prefix}_pattern`,
+      type: "string",
+      description: `A glob pattern to match the ${description} against.`,
+      computedOptionalRequired: "optional",
+    },
+  ];
+}
+
+function assertionOpAttributes(depth: number): Array<Attribute> {
+  const ops =
+    depth < ASSERTION_GROUP_DEPTH ? ASSERTION_OPS : ASSERTION_OPS.slice(3);
+  const exactlyOneOf = ops
+    .slice(1)
+    .map((op) => `path.MatchRelative().AtParent().AtName("${op}")`)
+    .join(", ");
+
+  const attributes: Array<Attribute> = [];
+  if (depth < ASSERTION_GROUP_DEPTH) {
+    attributes.push(
+      {
+        name: "and",
+        type: "list_nested",
+        description: "Passes if all of the operations pass.",
+        computedOptionalRequired: "optional",
+        validators: [`listvalidator.ExactlyOneOf(${exactlyOneOf})`],
+        attributes: assertionOpAttributes(depth + 1),
+      },
+      {
+        name: "or",
+        type: "list_nested",
+        description: "Passes if any of the operations pass.",
+        computedOptionalRequired: "optional",
+        attributes: assertionOpAttributes(depth + 1),
+      },
+      {
+        name: "not",
+        type: "single_nested",
+        description: "Passes if the operation fails.",
+        computedOptionalRequired: "optional",
+        attributes: assertionOpAttributes(depth + 1),
+      },
+    );
+  }
+  attributes.push(
+    {
+      name: "status_code",
+      type: "single_nested",
+      description: "Compares the HTTP status code of the response.",
+      computedOptionalRequired: "optional",
+      validators:
+        depth < ASSERTION_GROUP_DEPTH
+          ? undefined
+          : [`objectvalidator.ExactlyOneOf(${exactlyOneOf})`],
+      attributes: [
+        assertionComparisonAttribute("operator", "The comparison operator."),
+        {
+          name: "value",
+          type: "int64",
+          description: "The HTTP status code to compare against.",
+          computedOptionalRequired: "required",
+        },
+      ],
+    },
+    {
+      name: "json_path",
+      type: "single_nested",
+      description:
+        "Evaluates a JSONPath expression against the response body and compares the result.",
+      computedOptionalRequired: "optional",
+      attributes: [
+        {
+          name: "path",
+          type: "string",
+          description: "The JSONPath expression, e.g. `$.status`.",
+          computedOptionalRequired: "required",
+        },
+        assertionComparisonAttribute("operator", "The comparison operator."),
+        ...assertionOperandAttributes("value", "value"),
+      ],
+    },
+    {
+      name: "header",
+      type: "single_nested",
+      description: "Passes if a response header matches both the key and the value.",
+      computedOptionalRequired: "optional",
+      attributes: [
+        assertionComparisonAttribute(
+          "key_operator",
+          "The comparison operator for the header key.",
+        ),
+        ...assertionOperandAttributes("key", "header key"),
+        assertionComparisonAttribute(
+          "value_operator",
+          "The comparison operator for the header value.",
+        ),
+        ...assertionOperandAttributes("value", "header value"),
+      ],
+    },
+  );
+  return attributes;
+}
 
 export default {
   name: "uptime_monitor",
@@ -7,6 +150,8 @@ export default {
       Create an Uptime Monitor for a Project.
 
       The \`assertion_json\` argument is a JSON string that represents the assertion to use for the monitor. It is a JSON object with a single key \`root\` whose value is the root operation of the assertion. The assertion is a tree of operations that are evaluated in order. Operations may be constructed using the \`op_\` functions.
+
+      Alternatively, the \`assertion\` argument describes the same tree with \`and\`, \`or\`, \`not\`, \`status_code\`, \`json_path\` and \`header\` attributes. Each operation must set exactly one of them. \`and\`, \`or\` and \`not\` can be nested up to ${ASSERTION_GROUP_DEPTH} levels deep; use \`assertion_json\` for deeper trees.
     `,
   api: {
     model: "ProjectMonitor",
@@ -180,8 +325,23 @@ export default {
         value: "jsontypes.Normalized",
       },
       description:
-        "Define conditions that must be met for the check to be considered successful.",
+        "Define conditions that must be met for the check to be considered successful. Conflicts with `assertion`.",
       computedOptionalRequired: "optional",
+      validators: [
+        `stringvalidator.ConflictsWith(path.MatchRoot("assertion"))`,
+      ],
+    },
+    {
+      name: "assertion",
+      type: "single_nested",
+      description:
+        "Define conditions that must be met for the check to be considered successful, as the root operation of the assertion. Conflicts with `assertion_json`.",
+      computedOptionalRequired: "optional",
+      nullable: true,
+      validators: [
+        `objectvalidator.ConflictsWith(path.MatchRoot("assertion_json"))`,
+      ],
+      attributes: assertionOpAttributes(0),
     },
   ],
 } satisfies Resource;
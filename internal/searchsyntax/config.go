@@ -0,0 +1,150 @@
+package searchsyntax
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+)
+
+// Config describes the keys a search supports, beyond the generic syntax.
+type Config struct {
+	// AllowBoolean allows `AND` and `OR` operators in the query.
+	AllowBoolean bool
+	// NumericKeys are keys whose values must be numbers, e.g. `times_seen:>10`.
+	NumericKeys []string
+	// DateKeys are keys whose values must be absolute or relative dates, e.g.
+	// `firstSeen:-24h` or `lastSeen:>2024-01-01`.
+	DateKeys []string
+	// EnumKeys are keys whose values must be one of the given values.
+	EnumKeys map[string][]string
+}
+
+// IssueSearch is the configuration of the issue stream search, used by saved
+// searches and issue views.
+//
+// https://github.com/getsentry/sentry/blob/master/src/sentry/issues/issue_search.py
+var IssueSearch = Config{
+	AllowBoolean: false,
+	NumericKeys:  []string{"times_seen"},
+	DateKeys:     []string{"age", "date", "firstSeen", "first_seen", "lastSeen", "last_seen", "timestamp"},
+	EnumKeys: map[string][]string{
+		"is": {
+			"resolved",
+			"unresolved",
+			"ignored",
+			"archived",
+			"muted",
+			"reprocessing",
+			"escalating",
+			"new",
+			"ongoing",
+			"regressed",
+			"archived_until_escalating",
+			"archived_until_condition_met",
+			"archived_forever",
+			"assigned",
+			"unassigned",
+			"for_review",
+			"linked",
+			"unlinked",
+		},
+		"issue.priority": {"high", "medium", "low"},
+		"level":          sentrydata.LogLevels,
+	},
+}
+
+// DiscoverSearch is the configuration of the Discover event search, used by
+// Discover saved queries.
+//
+// https://github.com/getsentry/sentry/blob/master/src/sentry/search/events/builder/discover.py
+var DiscoverSearch = Config{
+	AllowBoolean: true,
+	DateKeys:     []string{"timestamp"},
+	EnumKeys: map[string][]string{
+		"event.type": {"error", "default", "transaction", "csp", "hpkp", "expectct", "expectstaple", "nel", "generic"},
+		"level":      sentrydata.LogLevels,
+	},
+}
+
+var (
+	numericValueRegexp      = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?[kmbKMB]?$`)
+	relativeDateValueRegexp = regexp.MustCompile(`^[+-][0-9]+[smhdw]$`)
+	absoluteDateValueRegexp = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}(T[0-9]{2}:[0-9]{2}(:[0-9]{2}(\.[0-9]{1,6})?)?(Z|[+-][0-9]{2}:?[0-9]{2})?)?$`)
+)
+
+// Validate parses the query and checks it against the configuration.
+func (c Config) Validate(query string) error {
+	terms, err := Parse(query)
+	if err != nil {
+		return err
+	}
+
+	return c.validateTerms(terms)
+}
+
+func (c Config) validateTerms(terms []Term) error {
+	for _, term := range terms {
+		switch term.Kind {
+		case TermBoolean:
+			if !c.AllowBoolean {
+				return &Error{Pos: term.Pos, Message: `boolean statements containing "OR" or "AND" are not supported in this search`}
+			}
+		case TermParens:
+			if err := c.validateTerms(term.Children); err != nil {
+				return err
+			}
+		case TermFilter:
+			if err := c.validateFilter(term); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c Config) validateFilter(term Term) error {
+	values := term.Values
+	if !term.IsList {
+		values = []string{term.Value}
+	}
+
+	switch {
+	case slices.Contains(c.NumericKeys, term.Key):
+		for _, value := range values {
+			if !numericValueRegexp.MatchString(value) {
+				return &Error{Pos: term.Pos, Message: fmt.Sprintf("invalid number %q for %q", value, term.Key)}
+			}
+		}
+
+	case slices.Contains(c.DateKeys, term.Key):
+		if term.IsList {
+			return &Error{Pos: term.Pos, Message: fmt.Sprintf("%q does not support lists", term.Key)}
+		}
+		if relativeDateValueRegexp.MatchString(term.Value) {
+			break
+		}
+		if !absoluteDateValueRegexp.MatchString(term.Value) {
+			return &Error{Pos: term.Pos, Message: fmt.Sprintf("invalid date %q for %q, use a relative date such as -24h or an ISO 8601 date", term.Value, term.Key)}
+		}
+
+	default:
+		allowed, ok := c.EnumKeys[term.Key]
+		if !ok {
+			break
+		}
+		if term.Operator != "" && term.Operator != "=" && term.Operator != "!=" {
+			return &Error{Pos: term.Pos, Message: fmt.Sprintf("%q does not support the %q operator", term.Key, term.Operator)}
+		}
+		for _, value := range values {
+			if !slices.Contains(allowed, value) {
+				return &Error{Pos: term.Pos, Message: fmt.Sprintf("invalid value %q for %q, valid values are: %s", value, term.Key, strings.Join(allowed, ", "))}
+			}
+		}
+	}
+
+	return nil
+}
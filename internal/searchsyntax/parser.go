@@ -0,0 +1,419 @@
+// Package searchsyntax implements a parser for Sentry's search syntax, as used
+// by the issue stream, Discover and alerts, so that queries can be validated
+// at plan time.
+//
+// The grammar follows
+// https://github.com/getsentry/sentry/blob/master/src/sentry/api/event_search.py
+// closely enough to catch syntax errors, without interpreting the values.
+package searchsyntax
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TermKind int
+
+const (
+	// TermFilter is a `key:value` filter.
+	TermFilter TermKind = iota
+	// TermFreeText is text that is not part of a filter.
+	TermFreeText
+	// TermBoolean is an `AND` or `OR` operator.
+	TermBoolean
+	// TermParens is a parenthesized group of terms.
+	TermParens
+)
+
+// Term is a single element of a parsed search query.
+type Term struct {
+	Kind TermKind
+
+	// Pos is the byte offset of the term in the query.
+	Pos int
+
+	// Negated is set for filters prefixed with `!`.
+	Negated bool
+	// Key is the filter key, e.g. `is`, `tags[foo]` or `count()`.
+	Key string
+	// Operator is the comparison operator of the filter, if any.
+	Operator string
+	// Value is the unquoted value of a filter, or the text of a free text term.
+	// For boolean terms, it is the upper-cased operator.
+	Value string
+	// Values holds the elements of a `[a, b]` list value.
+	Values []string
+	// IsList is set when the filter value is a list.
+	IsList bool
+
+	// Children holds the terms of a parenthesized group.
+	Children []Term
+}
+
+// Error describes why a query could not be parsed.
+type Error struct {
+	// Pos is the byte offset in the query where the error was found.
+	Pos     int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (at position %d)", e.Message, e.Pos+1)
+}
+
+var operators = []string{">=", "<=", "!=", ">", "<", "="}
+
+type parser struct {
+	query string
+	pos   int
+}
+
+// Parse parses a search query into its terms.
+func Parse(query string) ([]Term, error) {
+	p := &parser{query: query}
+
+	terms, err := p.parseTerms(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkBooleans(terms); err != nil {
+		return nil, err
+	}
+
+	return terms, nil
+}
+
+func (p *parser) errorf(pos int, format string, a ...any) error {
+	return &Error{Pos: pos, Message: fmt.Sprintf(format, a...)}
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.query)
+}
+
+func (p *parser) peek() byte {
+	return p.query[p.pos]
+}
+
+func (p *parser) skipSpaces() {
+	for !p.eof() && isSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+func (p *parser) parseTerms(depth int) ([]Term, error) {
+	var terms []Term
+
+	for {
+		p.skipSpaces()
+
+		if p.eof() {
+			return terms, nil
+		}
+
+		switch c := p.peek(); {
+		case c == ')':
+			if depth == 0 {
+				return nil, p.errorf(p.pos, "unexpected closing parenthesis")
+			}
+			return terms, nil
+
+		case c == '(' && p.emptyParensLen() == 0:
+			start := p.pos
+			p.pos++
+
+			children, err := p.parseTerms(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			if p.eof() {
+				return nil, p.errorf(start, "missing closing parenthesis")
+			}
+			p.pos++
+
+			terms = append(terms, Term{Kind: TermParens, Pos: start, Children: children})
+
+		default:
+			term, err := p.parseTerm()
+			if err != nil {
+				return nil, err
+			}
+			terms = append(terms, term)
+		}
+	}
+}
+
+func (p *parser) parseTerm() (Term, error) {
+	start := p.pos
+
+	if op, ok := p.booleanOperator(); ok {
+		p.pos += len(op)
+		return Term{Kind: TermBoolean, Pos: start, Value: op}, nil
+	}
+
+	if term, ok, err := p.parseFilter(); err != nil || ok {
+		return term, err
+	}
+
+	p.pos = start
+
+	if p.peek() == '"' {
+		value, err := p.parseQuoted()
+		if err != nil {
+			return Term{}, err
+		}
+		return Term{Kind: TermFreeText, Pos: start, Value: value}, nil
+	}
+
+	// Empty parentheses are part of the free text, e.g. `foo() is not a
+	// function`, rather than a group.
+	for !p.eof() && !isSpace(p.peek()) {
+		if n := p.emptyParensLen(); n > 0 {
+			p.pos += n
+			continue
+		}
+		if p.peek() == '(' || p.peek() == ')' {
+			break
+		}
+		p.pos++
+	}
+
+	return Term{Kind: TermFreeText, Pos: start, Value: p.query[start:p.pos]}, nil
+}
+
+// emptyParensLen returns the length of the empty parentheses, optionally
+// containing spaces, at the current position, or 0 if there are none.
+func (p *parser) emptyParensLen() int {
+	if p.eof() || p.peek() != '(' {
+		return 0
+	}
+
+	end := p.pos + 1
+	for end < len(p.query) && isSpace(p.query[end]) {
+		end++
+	}
+	if end == len(p.query) || p.query[end] != ')' {
+		return 0
+	}
+
+	return end + 1 - p.pos
+}
+
+func (p *parser) booleanOperator() (string, bool) {
+	for _, op := range []string{"AND", "OR"} {
+		end := p.pos + len(op)
+		if end > len(p.query) || !strings.EqualFold(p.query[p.pos:end], op) {
+			continue
+		}
+		if end == len(p.query) || isSpace(p.query[end]) || p.query[end] == ')' {
+			return op, true
+		}
+	}
+
+	return "", false
+}
+
+// parseFilter parses a `!key:>value` filter. It reports false without an error
+// when the input at the current position is not a filter.
+func (p *parser) parseFilter() (Term, bool, error) {
+	term := Term{Kind: TermFilter, Pos: p.pos}
+
+	if p.peek() == '!' {
+		term.Negated = true
+		p.pos++
+	}
+
+	key, ok := p.parseKey()
+	if !ok || p.eof() || p.peek() != ':' {
+		return Term{}, false, nil
+	}
+	term.Key = key
+	p.pos++
+
+	for _, op := range operators {
+		if strings.HasPrefix(p.query[p.pos:], op) {
+			term.Operator = op
+			p.pos += len(op)
+			break
+		}
+	}
+
+	if p.eof() {
+		return term, true, nil
+	}
+
+	switch p.peek() {
+	case '"':
+		value, err := p.parseQuoted()
+		if err != nil {
+			return Term{}, false, err
+		}
+		term.Value = value
+
+	case '[':
+		values, err := p.parseList()
+		if err != nil {
+			return Term{}, false, err
+		}
+		term.IsList = true
+		term.Values = values
+
+	default:
+		start := p.pos
+		for !p.eof() && !isSpace(p.peek()) && p.peek() != '(' && p.peek() != ')' {
+			p.pos++
+		}
+		term.Value = p.query[start:p.pos]
+	}
+
+	return term, true, nil
+}
+
+func (p *parser) parseKey() (string, bool) {
+	start := p.pos
+
+	if !p.eof() && p.peek() == '"' {
+		p.pos++
+		for !p.eof() && (isKeyChar(p.peek()) || p.peek() == ':') {
+			p.pos++
+		}
+		if p.eof() || p.peek() != '"' || p.pos == start+1 {
+			return "", false
+		}
+		p.pos++
+		return p.query[start+1 : p.pos-1], true
+	}
+
+	for !p.eof() && isKeyChar(p.peek()) {
+		p.pos++
+	}
+	if p.pos == start {
+		return "", false
+	}
+
+	// Explicit tag keys, e.g. `tags[foo]` or `tags[foo,number]`.
+	if !p.eof() && p.peek() == '[' {
+		end := strings.IndexByte(p.query[p.pos:], ']')
+		if end <= 1 {
+			return "", false
+		}
+		p.pos += end + 1
+	}
+
+	// Function keys, e.g. `count()` or `p95(transaction.duration)`.
+	if !p.eof() && p.peek() == '(' {
+		end := strings.IndexByte(p.query[p.pos:], ')')
+		if end < 0 || strings.ContainsAny(p.query[p.pos+1:p.pos+end], "(\"") {
+			return "", false
+		}
+		p.pos += end + 1
+	}
+
+	return p.query[start:p.pos], true
+}
+
+func (p *parser) parseQuoted() (string, error) {
+	start := p.pos
+	p.pos++
+
+	var b strings.Builder
+	for !p.eof() {
+		switch c := p.peek(); c {
+		case '\\':
+			if p.pos+1 < len(p.query) {
+				b.WriteByte(p.query[p.pos+1])
+				p.pos += 2
+				continue
+			}
+			p.pos++
+		case '"':
+			p.pos++
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+
+	return "", p.errorf(start, "missing closing quote")
+}
+
+func (p *parser) parseList() ([]string, error) {
+	start := p.pos
+	p.pos++
+
+	var values []string
+	for {
+		p.skipSpaces()
+		if p.eof() {
+			return nil, p.errorf(start, "missing closing bracket")
+		}
+
+		if p.peek() == ']' {
+			p.pos++
+			if len(values) == 0 {
+				return nil, p.errorf(start, "lists must not be empty")
+			}
+			return values, nil
+		}
+
+		if len(values) > 0 {
+			if p.peek() != ',' {
+				return nil, p.errorf(p.pos, "expected a comma between list values")
+			}
+			p.pos++
+			p.skipSpaces()
+			if p.eof() {
+				return nil, p.errorf(start, "missing closing bracket")
+			}
+		}
+
+		if p.peek() == '"' {
+			value, err := p.parseQuoted()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+			continue
+		}
+
+		valueStart := p.pos
+		for !p.eof() && !isSpace(p.peek()) && p.peek() != ',' && p.peek() != ']' {
+			p.pos++
+		}
+		if p.pos == valueStart {
+			return nil, p.errorf(valueStart, "empty list value")
+		}
+		values = append(values, p.query[valueStart:p.pos])
+	}
+}
+
+// checkBooleans ensures boolean operators have a condition on both sides.
+func checkBooleans(terms []Term) error {
+	for i, term := range terms {
+		switch term.Kind {
+		case TermBoolean:
+			if i == 0 || terms[i-1].Kind == TermBoolean {
+				return &Error{Pos: term.Pos, Message: fmt.Sprintf("condition is missing on the left side of %q operator", term.Value)}
+			}
+			if i == len(terms)-1 {
+				return &Error{Pos: term.Pos, Message: fmt.Sprintf("condition is missing on the right side of %q operator", term.Value)}
+			}
+		case TermParens:
+			if err := checkBooleans(term.Children); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.' || c == '-'
+}
@@ -0,0 +1,160 @@
+package searchsyntax
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		query string
+		want  []Term
+	}{
+		{
+			query: "",
+			want:  nil,
+		},
+		{
+			query: "is:unresolved",
+			want: []Term{
+				{Kind: TermFilter, Pos: 0, Key: "is", Value: "unresolved"},
+			},
+		},
+		{
+			query: `!is:resolved   timesSeen:>=10 "some message" tags[foo,string]:"a b"`,
+			want: []Term{
+				{Kind: TermFilter, Pos: 0, Negated: true, Key: "is", Value: "resolved"},
+				{Kind: TermFilter, Pos: 15, Key: "timesSeen", Operator: ">=", Value: "10"},
+				{Kind: TermFreeText, Pos: 30, Value: "some message"},
+				{Kind: TermFilter, Pos: 45, Key: "tags[foo,string]", Value: "a b"},
+			},
+		},
+		{
+			query: `level:[error, "fatal"] browser:`,
+			want: []Term{
+				{Kind: TermFilter, Pos: 0, Key: "level", Values: []string{"error", "fatal"}, IsList: true},
+				{Kind: TermFilter, Pos: 23, Key: "browser"},
+			},
+		},
+		{
+			query: `(a:1 OR b:2) and count():>10 p95(transaction.duration):<1s`,
+			want: []Term{
+				{Kind: TermParens, Pos: 0, Children: []Term{
+					{Kind: TermFilter, Pos: 1, Key: "a", Value: "1"},
+					{Kind: TermBoolean, Pos: 5, Value: "OR"},
+					{Kind: TermFilter, Pos: 8, Key: "b", Value: "2"},
+				}},
+				{Kind: TermBoolean, Pos: 13, Value: "AND"},
+				{Kind: TermFilter, Pos: 17, Key: "count()", Operator: ">", Value: "10"},
+				{Kind: TermFilter, Pos: 29, Key: "p95(transaction.duration)", Operator: "<", Value: "1s"},
+			},
+		},
+		{
+			query: `TypeError: foo() is not a function`,
+			want: []Term{
+				{Kind: TermFilter, Pos: 0, Key: "TypeError"},
+				{Kind: TermFreeText, Pos: 11, Value: "foo()"},
+				{Kind: TermFreeText, Pos: 17, Value: "is"},
+				{Kind: TermFreeText, Pos: 20, Value: "not"},
+				{Kind: TermFreeText, Pos: 24, Value: "a"},
+				{Kind: TermFreeText, Pos: 26, Value: "function"},
+			},
+		},
+		{
+			query: `() ( ) (a()b)`,
+			want: []Term{
+				{Kind: TermFreeText, Pos: 0, Value: "()"},
+				{Kind: TermFreeText, Pos: 3, Value: "( )"},
+				{Kind: TermParens, Pos: 7, Children: []Term{
+					{Kind: TermFreeText, Pos: 8, Value: "a()b"},
+				}},
+			},
+		},
+		{
+			query: `"quoted:key":value oracle`,
+			want: []Term{
+				{Kind: TermFilter, Pos: 0, Key: "quoted:key", Value: "value"},
+				{Kind: TermFreeText, Pos: 19, Value: "oracle"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			got, err := Parse(tc.query)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParse_errors(t *testing.T) {
+	testCases := []struct {
+		query string
+		want  string
+	}{
+		{`message:"unterminated`, "missing closing quote (at position 9)"},
+		{`(is:unresolved`, "missing closing parenthesis (at position 1)"},
+		{`is:unresolved)`, "unexpected closing parenthesis (at position 14)"},
+		{`foo(`, "missing closing parenthesis (at position 4)"},
+		{`level:[error, fatal`, "missing closing bracket (at position 7)"},
+		{`level:[]`, "lists must not be empty (at position 7)"},
+		{`level:[error fatal]`, "expected a comma between list values (at position 14)"},
+		{`OR a:1`, `condition is missing on the left side of "OR" operator (at position 1)`},
+		{`a:1 AND`, `condition is missing on the right side of "AND" operator (at position 5)`},
+		{`a:1 AND OR b:2`, `condition is missing on the left side of "OR" operator (at position 9)`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			_, err := Parse(tc.query)
+			if err == nil {
+				t.Fatalf("Parse() error = nil, want %q", tc.want)
+			}
+			if err.Error() != tc.want {
+				t.Errorf("Parse() error = %q, want %q", err.Error(), tc.want)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  Config
+		query   string
+		wantErr string
+	}{
+		{"issue", IssueSearch, "is:unresolved is:for_review assigned:me", ""},
+		{"issue", IssueSearch, "is:unresolved issue.priority:[high, medium] firstSeen:-24h lastSeen:>2024-01-01T00:00:00Z", ""},
+		{"issue", IssueSearch, "times_seen:>1k !level:info", ""},
+		{"issue", IssueSearch, "is:unresolved TypeError: foo() is not a function", ""},
+		{"issue", IssueSearch, "is:unresolvd", `invalid value "unresolvd" for "is", valid values are: `},
+		{"issue", IssueSearch, "times_seen:>many", `invalid number "many" for "times_seen" (at position 1)`},
+		{"issue", IssueSearch, "firstSeen:24h", `invalid date "24h" for "firstSeen", use a relative date such as -24h or an ISO 8601 date (at position 1)`},
+		{"issue", IssueSearch, "is:unresolved OR is:new", `boolean statements containing "OR" or "AND" are not supported in this search (at position 15)`},
+		{"discover", DiscoverSearch, "event.type:error (level:error OR level:fatal) count():>10", ""},
+		{"discover", DiscoverSearch, "event.type:exception", `invalid value "exception" for "event.type", valid values are: `},
+		{"discover", DiscoverSearch, "timestamp:yesterday", `invalid date "yesterday" for "timestamp"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name+"/"+tc.query, func(t *testing.T) {
+			err := tc.config.Validate(tc.query)
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Errorf("Validate() error = %v", err)
+			case tc.wantErr != "" && err == nil:
+				t.Errorf("Validate() error = nil, want %q", tc.wantErr)
+			case tc.wantErr != "" && !strings.HasPrefix(err.Error(), tc.wantErr):
+				t.Errorf("Validate() error = %q, want %q", err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
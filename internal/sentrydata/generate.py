@@ -2,6 +2,7 @@ from __future__ import annotations
 
 import ast
 import pathlib
+import re
 import subprocess
 import zoneinfo
 from typing import Any, Generic, NamedTuple, OrderedDict, TypeGuard, TypeVar
@@ -251,10 +252,28 @@ def parse_models_dashboard_widget() -> dict[str, ResultData[Any]]:
                     pass
         return out
 
+    def extract_enum_names(classdef: ast.ClassDef) -> list[str]:
+        out: list[str] = []
+        for node in classdef.body:
+            match node:
+                case ast.Assign(
+                    targets=[ast.Name(id=id)],
+                    value=ast.Constant(value=int()),
+                ) if id.isupper():
+                    out.append(id.lower())
+                case _:
+                    pass
+        return out
+
     data = get_file_data("src/sentry/models/dashboard_widget.py")
     out: dict[str, ResultData[Any]] = {}
     for node in ast.walk(data.tree):
         match node:
+            case ast.ClassDef(name="DatasetSourcesTypes"):
+                out["DashboardDatasetSources"] = ResultData(
+                    github_url=data.github_url,
+                    result=extract_enum_names(node),
+                )
             case ast.ClassDef(name=name):
                 types = extract_types(node)
                 if types:
@@ -650,6 +669,129 @@ def parse_event_frequency() -> dict[str, ResultData[Any]]:
                 out["EventFrequencyStandardIntervals"] = ResultData(
                     github_url=data.github_url, result=result_intervals
                 )
+            case ast.AnnAssign(
+                target=ast.Name(id="COMPARISON_INTERVALS"),
+                value=ast.Dict(keys=keys),
+            ):
+                result_intervals = []
+                for key in keys:
+                    assert isinstance(key, ast.Constant)
+                    result_intervals.append(key.value)
+                out["EventFrequencyComparisonIntervals"] = ResultData(
+                    github_url=data.github_url, result=result_intervals
+                )
+            case _:
+                pass
+    return out
+
+
+def parse_models_savedsearch() -> dict[str, ResultData[Any]]:
+    data = get_file_data("src/sentry/models/savedsearch.py")
+    out: dict[str, ResultData[Any]] = {
+        "SavedSearchSortOptions": ResultData(github_url=data.github_url, result=[]),
+        "SavedSearchVisibilities": ResultData(github_url=data.github_url, result=[]),
+    }
+    for node in ast.walk(data.tree):
+        match node:
+            case ast.ClassDef(name="SortOptions", body=elts):
+                for elt in elts:
+                    match elt:
+                        case ast.Assign(
+                            targets=[ast.Name(id=id)],
+                            value=ast.Constant(value=value),
+                        ) if id.isupper():
+                            out["SavedSearchSortOptions"].result.append(value)
+                        case _:
+                            pass
+            case ast.ClassDef(name="Visibility", body=elts):
+                for elt in elts:
+                    match elt:
+                        case ast.Assign(
+                            targets=[ast.Name(id=id)],
+                            value=ast.Constant(value=value),
+                        ) if id.isupper():
+                            out["SavedSearchVisibilities"].result.append(value)
+                        case _:
+                            pass
+            case _:
+                pass
+    return out
+
+
+def parse_discover_models() -> dict[str, ResultData[Any]]:
+    data = get_file_data("src/sentry/discover/models.py")
+    out: dict[str, ResultData[Any]] = {}
+    for node in ast.walk(data.tree):
+        match node:
+            case ast.ClassDef(name="DiscoverSavedQueryTypes", body=body):
+                result: list[str] = []
+                for elt in body:
+                    match elt:
+                        case ast.Assign(
+                            targets=[ast.Name(id="TYPES")],
+                            value=ast.List(elts=types),
+                        ):
+                            for type_ in types:
+                                match type_:
+                                    case ast.Tuple(
+                                        elts=[ast.Name(), ast.Constant(value=value)]
+                                    ):
+                                        result.append(value)
+                                    case _:
+                                        pass
+                        case _:
+                            pass
+                out["DiscoverSavedQueryTypes"] = ResultData(
+                    github_url=data.github_url, result=result
+                )
+            case _:
+                pass
+    return out
+
+
+def parse_discover_types() -> dict[str, ResultData[Any]]:
+    path = "static/app/utils/discover/types.tsx"
+    text = get_text(path)
+    match = re.search(r"export enum DisplayModes \{(?P<body>.*?)\}", text, re.DOTALL)
+    assert match is not None
+    return {
+        "DiscoverDisplayModes": ResultData(
+            github_url=f"https://github.com/{REPO}/blob/{BRANCH}/{path}",
+            result=re.findall(r"^\s*\w+ = '([^']*)',", match["body"], re.MULTILINE),
+        )
+    }
+
+
+def parse_release_threshold_constants() -> dict[str, ResultData[Any]]:
+    data = get_file_data("src/sentry/models/release_threshold/constants.py")
+    out: dict[str, ResultData[Any]] = {
+        "ReleaseThresholdTypes": ResultData(github_url=data.github_url, result=[]),
+        "ReleaseThresholdTriggerTypes": ResultData(
+            github_url=data.github_url, result=[]
+        ),
+    }
+    for node in ast.walk(data.tree):
+        match node:
+            case ast.ClassDef(name="ReleaseThresholdType", body=elts):
+                for elt in elts:
+                    match elt:
+                        case ast.Assign(
+                            targets=[ast.Name(id=id)],
+                            value=ast.Constant(value=str() as value),
+                        ) if id.isupper() and id.endswith("_STR"):
+                            out["ReleaseThresholdTypes"].result.append(value)
+                        case _:
+                            pass
+            case ast.ClassDef(name="TriggerType", body=elts):
+                for elt in elts:
+                    match elt:
+                        case ast.Assign(
+                            targets=[ast.Name(id=id)],
+                            value=ast.Constant(value=str() as value),
+                        ) if id.isupper() and id.endswith("_STR"):
+                            out["ReleaseThresholdTriggerTypes"].result.append(value)
+                        case _:
+                            pass
             case _:
                 pass
     return out
@@ -673,6 +815,10 @@ def main() -> None:
     result.update(parse_data_condition_types())
     result.update(parse_data_condition_group_types())
     result.update(parse_event_frequency())
+    result.update(parse_models_savedsearch())
+    result.update(parse_discover_models())
+    result.update(parse_discover_types())
+    result.update(parse_release_threshold_constants())
 
     env = get_jinja2_env()
     template = env.from_string(TEMPLATE)
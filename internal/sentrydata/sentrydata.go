@@ -247,6 +247,15 @@ var DashboardWidgetDisplayTypes = []string{
 	"heatmap",
 }
 
+// https://github.com/getsentry/sentry/blob/master/src/sentry/models/dashboard_widget.py
+var DashboardDatasetSources = []string{
+	"unknown",
+	"inferred",
+	"user",
+	"forced",
+	"split_version_2",
+}
+
 // https://github.com/getsentry/sentry/blob/master/src/sentry/models/project.py
 var Platforms = []string{
 	"other",
@@ -1193,3 +1202,66 @@ var EventFrequencyStandardIntervals = []string{
 	"1w",
 	"30d",
 }
+
+// https://github.com/getsentry/sentry/blob/master/src/sentry/rules/conditions/event_frequency.py
+var EventFrequencyComparisonIntervals = []string{
+	"5m",
+	"15m",
+	"1h",
+	"1d",
+	"1w",
+	"30d",
+}
+
+// https://github.com/getsentry/sentry/blob/master/src/sentry/models/savedsearch.py
+var SavedSearchSortOptions = []string{
+	"date",
+	"new",
+	"trends",
+	"freq",
+	"user",
+	"inbox",
+}
+
+// https://github.com/getsentry/sentry/blob/master/src/sentry/models/savedsearch.py
+var SavedSearchVisibilities = []string{
+	"organization",
+	"owner",
+	"owner_pinned",
+}
+
+// https://github.com/getsentry/sentry/blob/master/src/sentry/discover/models.py
+var DiscoverSavedQueryTypes = []string{
+	"discover",
+	"error-events",
+	"transaction-like",
+}
+
+// https://github.com/getsentry/sentry/blob/master/static/app/utils/discover/types.tsx
+var DiscoverDisplayModes = []string{
+	"default",
+	"previous",
+	"top5",
+	"daily",
+	"dailytop5",
+	"bar",
+}
+
+// https://github.com/getsentry/sentry/blob/master/src/sentry/models/release_threshold/constants.py
+var ReleaseThresholdTypes = []string{
+	"total_error_count",
+	"new_issue_count",
+	"unhandled_issue_count",
+	"regressed_issue_count",
+	"failure_rate",
+	"crash_free_session_rate",
+	"crash_free_user_rate",
+}
+
+// https://github.com/getsentry/sentry/blob/master/src/sentry/models/release_threshold/constants.py
+var ReleaseThresholdTriggerTypes = []string{
+	"percent_over",
+	"percent_under",
+	"over",
+	"under",
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/jianyuan/terraform-provider-sentry/internal/must"
+	"github.com/samber/lo"
 )
 
 var uptimeAssertionSchema = &jsonschema.Schema{
@@ -297,6 +298,12 @@ var uptimeAssertionSchema = &jsonschema.Schema{
 	},
 }
 
+// UptimeAssertionComparisonTypes are the comparison operators of an uptime
+// assertion, e.g. the `cmp` of `op_status_code_check`.
+var UptimeAssertionComparisonTypes = lo.Map(uptimeAssertionSchema.Defs["ComparisonType"].Enum, func(v any, _ int) string {
+	return v.(string)
+})
+
 var uptimeSchemaRegistry sync.Map
 
 func GetResolvedUptimeAssertionSchemaForDefinition(def string) (*jsonschema.Resolved, error) {
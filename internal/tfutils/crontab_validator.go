@@ -0,0 +1,39 @@
+package tfutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/jianyuan/terraform-provider-sentry/internal/crontab"
+)
+
+// Crontab validates that a string is a crontab schedule accepted by Sentry's
+// cron monitors, including the `@daily`-style macros.
+func Crontab() validator.String {
+	return crontabValidator{}
+}
+
+type crontabValidator struct{}
+
+func (v crontabValidator) Description(_ context.Context) string {
+	return "must be a valid crontab schedule"
+}
+
+func (v crontabValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v crontabValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := crontab.Parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid crontab schedule",
+			fmt.Sprintf("Attribute %s must be a valid crontab schedule, got: %s: %s", req.Path, req.ConfigValue.ValueString(), err),
+		)
+	}
+}
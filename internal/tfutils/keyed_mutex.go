@@ -0,0 +1,27 @@
+package tfutils
+
+import "sync"
+
+// KeyedMutex serializes work per key, e.g. per remote object, while letting
+// work on different keys run concurrently. The zero value is ready to use.
+type KeyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// Lock locks the mutex for key and returns the function that unlocks it.
+func (m *KeyedMutex) Lock(key string) func() {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = make(map[string]*sync.Mutex)
+	}
+	l, ok := m.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		m.locks[key] = l
+	}
+	m.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
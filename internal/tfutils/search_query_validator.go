@@ -0,0 +1,40 @@
+package tfutils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/jianyuan/terraform-provider-sentry/internal/searchsyntax"
+)
+
+// SearchQuery validates that a string is a valid Sentry search query for the
+// given search configuration.
+func SearchQuery(config searchsyntax.Config) validator.String {
+	return searchQueryValidator{config: config}
+}
+
+type searchQueryValidator struct {
+	config searchsyntax.Config
+}
+
+func (v searchQueryValidator) Description(_ context.Context) string {
+	return "must be a valid Sentry search query"
+}
+
+func (v searchQueryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v searchQueryValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.config.Validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid search query",
+			err.Error(),
+		)
+	}
+}
@@ -0,0 +1,401 @@
+// Package uptimeassertion evaluates uptime monitor assertions against a sample
+// HTTP response, the same way Sentry's uptime checker does.
+//
+// https://github.com/getsentry/uptime-checker
+package uptimeassertion
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jianyuan/terraform-provider-sentry/internal/sentrydata"
+	"github.com/speakeasy-api/jsonpath/pkg/jsonpath"
+	"gopkg.in/yaml.v3"
+)
+
+// Response is a sample HTTP response to evaluate an assertion against.
+type Response struct {
+	StatusCode int64
+	Headers    map[string]string
+	Body       string
+}
+
+// Result is the outcome of an assertion. The explanation has one line per
+// operation, indented by its depth in the assertion.
+type Result struct {
+	Passed      bool
+	Explanation string
+}
+
+type op struct {
+	Op           string          `json:"op"`
+	Children     []op            `json:"children"`
+	Operand      json.RawMessage `json:"operand"`
+	Operator     comparison      `json:"operator"`
+	Value        json.RawMessage `json:"value"`
+	KeyOp        comparison      `json:"key_op"`
+	KeyOperand   operand         `json:"key_operand"`
+	ValueOp      comparison      `json:"value_op"`
+	ValueOperand operand         `json:"value_operand"`
+}
+
+type comparison struct {
+	Cmp string `json:"cmp"`
+}
+
+type operand struct {
+	JsonpathOp string `json:"jsonpath_op"`
+	HeaderOp   string `json:"header_op"`
+	Value      string `json:"value"`
+	Pattern    struct {
+		Value string `json:"value"`
+	} `json:"pattern"`
+}
+
+func (o operand) isGlob() bool {
+	return o.JsonpathOp == "glob" || o.HeaderOp == "glob"
+}
+
+func (o operand) String() string {
+	if o.isGlob() {
+		return "glob " + strconv.Quote(o.Pattern.Value)
+	}
+	return strconv.Quote(o.Value)
+}
+
+// Evaluate evaluates an assertion, as produced by the `assertion` function,
+// against a response. It returns an error if the assertion is invalid, e.g. it
+// does not conform to the assertion schema or has a malformed JSONPath.
+func Evaluate(assertion []byte, resp Response) (Result, error) {
+	if err := sentrydata.ValidateJSONUptimeAssertionForDefinition("Assertion", assertion); err != nil {
+		return Result{}, err
+	}
+
+	var in struct {
+		Root op `json:"root"`
+	}
+	if err := json.Unmarshal(assertion, &in); err != nil {
+		return Result{}, err
+	}
+
+	e := evaluator{resp: resp}
+	passed, err := e.eval(in.Root, 0)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Passed:      passed,
+		Explanation: strings.Join(e.lines, "\n"),
+	}, nil
+}
+
+type evaluator struct {
+	resp  Response
+	lines []string
+}
+
+// explain records the outcome of an operation and returns it.
+func (e *evaluator) explain(depth int, passed bool, format string, a ...any) bool {
+	e.lines = append(e.lines, explanationLine(depth, passed, fmt.Sprintf(format, a...)))
+	return passed
+}
+
+func explanationLine(depth int, passed bool, s string) string {
+	outcome := "failed"
+	if passed {
+		outcome = "passed"
+	}
+	return strings.Repeat("  ", depth) + s + ": " + outcome
+}
+
+func (e *evaluator) eval(o op, depth int) (bool, error) {
+	switch o.Op {
+	case "and", "or":
+		// The line of the group precedes its children, but its outcome
+		// depends on them.
+		line := len(e.lines)
+		e.lines = append(e.lines, "")
+
+		passed := o.Op == "and"
+		for _, child := range o.Children {
+			childPassed, err := e.eval(child, depth+1)
+			if err != nil {
+				return false, err
+			}
+			if o.Op == "and" {
+				passed = passed && childPassed
+			} else {
+				passed = passed || childPassed
+			}
+		}
+
+		e.lines[line] = explanationLine(depth, passed, o.Op)
+		return passed, nil
+	case "not":
+		var child op
+		if err := json.Unmarshal(o.Operand, &child); err != nil {
+			return false, err
+		}
+
+		line := len(e.lines)
+		e.lines = append(e.lines, "")
+
+		childPassed, err := e.eval(child, depth+1)
+		if err != nil {
+			return false, err
+		}
+
+		e.lines[line] = explanationLine(depth, !childPassed, "not")
+		return !childPassed, nil
+	case "status_code_check":
+		var value int64
+		if err := json.Unmarshal(o.Value, &value); err != nil {
+			return false, err
+		}
+
+		passed, err := compareLiteral(o.Operator.Cmp, strconv.FormatInt(e.resp.StatusCode, 10), strconv.FormatInt(value, 10))
+		if err != nil {
+			return false, err
+		}
+		return e.explain(depth, passed, "status_code_check: %d %s %d", e.resp.StatusCode, o.Operator.Cmp, value), nil
+	case "json_path":
+		return e.evalJsonPath(o, depth)
+	case "header_check":
+		return e.evalHeaderCheck(o, depth)
+	default:
+		return false, fmt.Errorf("unsupported operation %q", o.Op)
+	}
+}
+
+func (e *evaluator) evalJsonPath(o op, depth int) (bool, error) {
+	var path string
+	if err := json.Unmarshal(o.Value, &path); err != nil {
+		return false, err
+	}
+
+	var operand operand
+	if err := json.Unmarshal(o.Operand, &operand); err != nil {
+		return false, err
+	}
+
+	p, err := jsonpath.NewPath(path)
+	if err != nil {
+		return false, fmt.Errorf("invalid JSONPath %q: %w", path, err)
+	}
+
+	// Validate the operator before looking at the body, so that an invalid
+	// assertion is reported regardless of the response.
+	if _, err := compare(o.Operator.Cmp, "", operand); err != nil {
+		return false, err
+	}
+
+	if !json.Valid([]byte(e.resp.Body)) {
+		return e.explain(depth, false, "json_path %s: body is not valid JSON", path), nil
+	}
+
+	// JSON is a subset of YAML, which the JSONPath implementation works on.
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(e.resp.Body), &root); err != nil {
+		return false, err
+	}
+
+	nodes := p.Query(&root)
+	if len(nodes) == 0 {
+		return e.explain(depth, false, "json_path %s: no values matched", path), nil
+	}
+
+	// The assertion passes if any of the matched values passes the comparison.
+	values := make([]string, 0, len(nodes))
+	passed := false
+	for _, node := range nodes {
+		actual, err := jsonValueString(node)
+		if err != nil {
+			return false, err
+		}
+
+		ok, err := compare(o.Operator.Cmp, actual, operand)
+		if err != nil {
+			return false, err
+		}
+		passed = passed || ok
+		values = append(values, actual)
+	}
+
+	return e.explain(depth, passed, "json_path %s: [%s] %s %s", path, strings.Join(values, ", "), o.Operator.Cmp, operand), nil
+}
+
+// jsonValueString returns a matched value as compared by json_path: strings
+// are compared by their contents, everything else by its JSON encoding.
+func jsonValueString(node *yaml.Node) (string, error) {
+	if node.Kind == yaml.ScalarNode {
+		return node.Value, nil
+	}
+
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func (e *evaluator) evalHeaderCheck(o op, depth int) (bool, error) {
+	keys := make([]string, 0, len(e.resp.Headers))
+	for key := range e.resp.Headers {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	// The assertion passes if any of the headers passes both comparisons.
+	// Header names are case-insensitive.
+	keyOperand := o.KeyOperand
+	keyOperand.Value = strings.ToLower(keyOperand.Value)
+	keyOperand.Pattern.Value = strings.ToLower(keyOperand.Pattern.Value)
+
+	var matched []string
+	for _, key := range keys {
+		keyPassed, err := compare(o.KeyOp.Cmp, strings.ToLower(key), keyOperand)
+		if err != nil {
+			return false, err
+		}
+
+		valuePassed, err := compare(o.ValueOp.Cmp, e.resp.Headers[key], o.ValueOperand)
+		if err != nil {
+			return false, err
+		}
+
+		if keyPassed && valuePassed {
+			matched = append(matched, key)
+		}
+	}
+
+	// Validate the operators even if there are no headers to compare.
+	if len(keys) == 0 {
+		if _, err := compare(o.KeyOp.Cmp, "", keyOperand); err != nil {
+			return false, err
+		}
+		if _, err := compare(o.ValueOp.Cmp, "", o.ValueOperand); err != nil {
+			return false, err
+		}
+	}
+
+	check := fmt.Sprintf("header_check: key %s %s, value %s %s", o.KeyOp.Cmp, o.KeyOperand, o.ValueOp.Cmp, o.ValueOperand)
+	if len(matched) == 0 {
+		return e.explain(depth, false, "%s: no headers matched", check), nil
+	}
+	return e.explain(depth, true, "%s: matched %s", check, strings.Join(matched, ", ")), nil
+}
+
+func compare(cmp string, actual string, operand operand) (bool, error) {
+	if operand.isGlob() {
+		return compareGlob(cmp, actual, operand.Pattern.Value)
+	}
+	return compareLiteral(cmp, actual, operand.Value)
+}
+
+// compareLiteral compares two values numerically if both are numbers, and
+// lexicographically otherwise.
+func compareLiteral(cmp string, actual string, expected string) (bool, error) {
+	order := strings.Compare(actual, expected)
+	if a, err := strconv.ParseFloat(actual, 64); err == nil {
+		if b, err := strconv.ParseFloat(expected, 64); err == nil {
+			order = 0
+			if a < b {
+				order = -1
+			} else if a > b {
+				order = 1
+			}
+		}
+	}
+
+	switch cmp {
+	case "equals":
+		return order == 0, nil
+	case "not_equal":
+		return order != 0, nil
+	case "less_than":
+		return order < 0, nil
+	case "greater_than":
+		return order > 0, nil
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	default:
+		return false, fmt.Errorf("unsupported comparison %q", cmp)
+	}
+}
+
+// compareGlob matches a value against a glob pattern. Patterns can only be
+// compared for (in)equality.
+func compareGlob(cmp string, actual string, pattern string) (bool, error) {
+	switch cmp {
+	case "equals", "not_equal":
+		re, err := globRegexp(pattern)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(actual) == (cmp == "equals"), nil
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	default:
+		return false, fmt.Errorf("comparison %q is not supported for glob pattern %q", cmp, pattern)
+	}
+}
+
+// globRegexp converts a glob pattern to a regular expression. `*` matches any
+// sequence of characters, `?` matches a single character, `[...]` matches a
+// character class and `\` escapes the next character.
+func globRegexp(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+			}
+			b.WriteString(regexp.QuoteMeta(string(runes[i])))
+		case '[':
+			end := slices.Index(runes[i+1:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid glob pattern %q: unterminated character class", pattern)
+			}
+			class := string(runes[i+1 : i+1+end])
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
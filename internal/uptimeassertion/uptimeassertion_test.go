@@ -0,0 +1,289 @@
+package uptimeassertion
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	resp := Response{
+		StatusCode: 200,
+		Headers: map[string]string{
+			"Content-Type":  "application/json; charset=utf-8",
+			"X-Request-Id":  "abc123",
+			"X-Retry-After": "30",
+		},
+		Body: `{"status": "healthy-eu", "version": 12, "ready": true, "checks": [{"name": "db", "ok": true}, {"name": "cache", "ok": false}], "meta": {"region": "eu"}}`,
+	}
+
+	testCases := []struct {
+		name      string
+		assertion string
+		resp      *Response
+		want      bool
+		wantLines []string
+	}{
+		{
+			name:      "status code equals",
+			assertion: `{"root":{"op":"status_code_check","operator":{"cmp":"equals"},"value":200}}`,
+			want:      true,
+			wantLines: []string{"status_code_check: 200 equals 200: passed"},
+		},
+		{
+			name:      "status code range",
+			assertion: `{"root":{"op":"and","children":[{"op":"status_code_check","operator":{"cmp":"greater_than"},"value":199},{"op":"status_code_check","operator":{"cmp":"less_than"},"value":300}]}}`,
+			resp:      &Response{StatusCode: 503},
+			want:      false,
+			wantLines: []string{
+				"and: failed",
+				"  status_code_check: 503 greater_than 199: passed",
+				"  status_code_check: 503 less_than 300: failed",
+			},
+		},
+		{
+			name:      "status code not_equal",
+			assertion: `{"root":{"op":"status_code_check","operator":{"cmp":"not_equal"},"value":500}}`,
+			want:      true,
+		},
+		{
+			name:      "status code never",
+			assertion: `{"root":{"op":"status_code_check","operator":{"cmp":"never"},"value":200}}`,
+			want:      false,
+		},
+		{
+			name:      "or",
+			assertion: `{"root":{"op":"or","children":[{"op":"status_code_check","operator":{"cmp":"equals"},"value":201},{"op":"status_code_check","operator":{"cmp":"equals"},"value":200}]}}`,
+			want:      true,
+		},
+		{
+			name:      "empty and",
+			assertion: `{"root":{"op":"and","children":[]}}`,
+			want:      true,
+		},
+		{
+			name:      "empty or",
+			assertion: `{"root":{"op":"or","children":[]}}`,
+			want:      false,
+		},
+		{
+			name:      "not",
+			assertion: `{"root":{"op":"not","operand":{"op":"status_code_check","operator":{"cmp":"equals"},"value":200}}}`,
+			want:      false,
+			wantLines: []string{
+				"not: failed",
+				"  status_code_check: 200 equals 200: passed",
+			},
+		},
+		{
+			name:      "json path literal",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"literal","value":"healthy-eu"},"operator":{"cmp":"equals"},"value":"$.status"}}`,
+			want:      true,
+			wantLines: []string{`json_path $.status: [healthy-eu] equals "healthy-eu": passed`},
+		},
+		{
+			name:      "json path glob",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"glob","pattern":{"value":"healthy-*"}},"operator":{"cmp":"equals"},"value":"$.status"}}`,
+			want:      true,
+			wantLines: []string{`json_path $.status: [healthy-eu] equals glob "healthy-*": passed`},
+		},
+		{
+			name:      "json path glob not_equal",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"glob","pattern":{"value":"degraded*"}},"operator":{"cmp":"not_equal"},"value":"$.status"}}`,
+			want:      true,
+		},
+		{
+			name:      "json path number compared numerically",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"literal","value":"9"},"operator":{"cmp":"greater_than"},"value":"$.version"}}`,
+			want:      true,
+		},
+		{
+			name:      "json path boolean",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"literal","value":"true"},"operator":{"cmp":"equals"},"value":"$.ready"}}`,
+			want:      true,
+		},
+		{
+			name:      "json path any match",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"literal","value":"false"},"operator":{"cmp":"equals"},"value":"$.checks[*].ok"}}`,
+			want:      true,
+			wantLines: []string{`json_path $.checks[*].ok: [true, false] equals "false": passed`},
+		},
+		{
+			name:      "json path filter",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"literal","value":"true"},"operator":{"cmp":"equals"},"value":"$.checks[?@.name == 'db'].ok"}}`,
+			want:      true,
+		},
+		{
+			name:      "json path object",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"literal","value":"{\"region\":\"eu\"}"},"operator":{"cmp":"equals"},"value":"$.meta"}}`,
+			want:      true,
+		},
+		{
+			name:      "json path always requires a match",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"literal","value":""},"operator":{"cmp":"always"},"value":"$.missing"}}`,
+			want:      false,
+			wantLines: []string{"json_path $.missing: no values matched: failed"},
+		},
+		{
+			name:      "json path invalid body",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"literal","value":"ok"},"operator":{"cmp":"equals"},"value":"$.status"}}`,
+			resp:      &Response{StatusCode: 200, Body: "<html>"},
+			want:      false,
+			wantLines: []string{"json_path $.status: body is not valid JSON: failed"},
+		},
+		{
+			name:      "header literal",
+			assertion: `{"root":{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"literal","value":"x-request-id"},"value_op":{"cmp":"equals"},"value_operand":{"header_op":"literal","value":"abc123"}}}`,
+			want:      true,
+			wantLines: []string{`header_check: key equals "x-request-id", value equals "abc123": matched X-Request-Id: passed`},
+		},
+		{
+			name:      "header glob",
+			assertion: `{"root":{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"glob","pattern":{"value":"content-*"}},"value_op":{"cmp":"equals"},"value_operand":{"header_op":"glob","pattern":{"value":"application/json*"}}}}`,
+			want:      true,
+		},
+		{
+			name:      "header value mismatch",
+			assertion: `{"root":{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"literal","value":"Content-Type"},"value_op":{"cmp":"equals"},"value_operand":{"header_op":"literal","value":"text/html"}}}`,
+			want:      false,
+			wantLines: []string{`header_check: key equals "Content-Type", value equals "text/html": no headers matched: failed`},
+		},
+		{
+			name:      "header exists",
+			assertion: `{"root":{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"literal","value":"X-Retry-After"},"value_op":{"cmp":"always"},"value_operand":{"header_op":"literal","value":""}}}`,
+			want:      true,
+		},
+		{
+			name:      "header value compared numerically",
+			assertion: `{"root":{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"literal","value":"X-Retry-After"},"value_op":{"cmp":"less_than"},"value_operand":{"header_op":"literal","value":"120"}}}`,
+			want:      true,
+		},
+		{
+			name:      "header missing",
+			assertion: `{"root":{"op":"not","operand":{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"literal","value":"X-Maintenance"},"value_op":{"cmp":"always"},"value_operand":{"header_op":"glob","pattern":{"value":"*"}}}}}`,
+			want:      true,
+		},
+		{
+			name:      "header without headers",
+			assertion: `{"root":{"op":"header_check","key_op":{"cmp":"always"},"key_operand":{"header_op":"literal","value":""},"value_op":{"cmp":"always"},"value_operand":{"header_op":"literal","value":""}}}`,
+			resp:      &Response{StatusCode: 200},
+			want:      false,
+		},
+		{
+			name:      "nested explanation",
+			assertion: `{"root":{"op":"and","children":[{"op":"status_code_check","operator":{"cmp":"equals"},"value":200},{"op":"or","children":[{"op":"json_path","operand":{"jsonpath_op":"literal","value":"ok"},"operator":{"cmp":"equals"},"value":"$.status"},{"op":"not","operand":{"op":"status_code_check","operator":{"cmp":"equals"},"value":200}}]}]}}`,
+			want:      false,
+			wantLines: []string{
+				"and: failed",
+				"  status_code_check: 200 equals 200: passed",
+				"  or: failed",
+				`    json_path $.status: [healthy-eu] equals "ok": failed`,
+				"    not: failed",
+				"      status_code_check: 200 equals 200: passed",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := resp
+			if tc.resp != nil {
+				r = *tc.resp
+			}
+
+			got, err := Evaluate([]byte(tc.assertion), r)
+			if err != nil {
+				t.Fatalf("Evaluate() returned error: %v", err)
+			}
+			if got.Passed != tc.want {
+				t.Errorf("Evaluate() passed = %t, want %t\n%s", got.Passed, tc.want, got.Explanation)
+			}
+			if tc.wantLines != nil {
+				if want := strings.Join(tc.wantLines, "\n"); got.Explanation != want {
+					t.Errorf("Evaluate() explanation =\n%s\nwant\n%s", got.Explanation, want)
+				}
+			}
+		})
+	}
+}
+
+func TestEvaluate_invalid(t *testing.T) {
+	testCases := []struct {
+		name      string
+		assertion string
+		wantErr   string
+	}{
+		{
+			name:      "not JSON",
+			assertion: `{`,
+			wantErr:   "unexpected end of JSON input",
+		},
+		{
+			name:      "missing root",
+			assertion: `{"op":"status_code_check","operator":{"cmp":"equals"},"value":200}`,
+			wantErr:   "validating root",
+		},
+		{
+			name:      "unknown comparison",
+			assertion: `{"root":{"op":"status_code_check","operator":{"cmp":"between"},"value":200}}`,
+			wantErr:   "validating root",
+		},
+		{
+			name:      "unknown operand",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"regex","value":"ok"},"operator":{"cmp":"equals"},"value":"$.status"}}`,
+			wantErr:   "validating root",
+		},
+		{
+			name:      "invalid JSONPath",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"literal","value":"ok"},"operator":{"cmp":"equals"},"value":"status"}}`,
+			wantErr:   `invalid JSONPath "status"`,
+		},
+		{
+			name:      "glob ordering",
+			assertion: `{"root":{"op":"json_path","operand":{"jsonpath_op":"glob","pattern":{"value":"1*"}},"operator":{"cmp":"less_than"},"value":"$.version"}}`,
+			wantErr:   `comparison "less_than" is not supported for glob pattern "1*"`,
+		},
+		{
+			name:      "invalid glob",
+			assertion: `{"root":{"op":"header_check","key_op":{"cmp":"equals"},"key_operand":{"header_op":"glob","pattern":{"value":"x-[abc"}},"value_op":{"cmp":"always"},"value_operand":{"header_op":"literal","value":""}}}`,
+			wantErr:   "unterminated character class",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Evaluate([]byte(tc.assertion), Response{StatusCode: 200, Headers: map[string]string{"X-Test": "1"}, Body: `{"version": 12}`})
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("Evaluate() error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestGlobRegexp(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{"*", "", true},
+		{"application/*", "application/json", true},
+		{"application/*", "text/html", false},
+		{"v?", "v1", true},
+		{"v?", "v10", false},
+		{"v[0-9]", "v7", true},
+		{"v[!0-9]", "v7", false},
+		{`\*`, "*", true},
+		{`\*`, "a", false},
+		{"a.b", "axb", false},
+	}
+
+	for _, tc := range testCases {
+		re, err := globRegexp(tc.pattern)
+		if err != nil {
+			t.Fatalf("globRegexp(%q) returned error: %v", tc.pattern, err)
+		}
+		if got := re.MatchString(tc.value); got != tc.want {
+			t.Errorf("globRegexp(%q).MatchString(%q) = %t, want %t", tc.pattern, tc.value, got, tc.want)
+		}
+	}
+}
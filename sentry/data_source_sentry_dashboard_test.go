@@ -15,22 +15,15 @@ func TestAccSentryDashboardDataSource_basic(t *testing.T) {
 	rnCopy := "sentry_dashboard.test_copy"
 
 	check := func(name, dashboardTitle string) resource.TestCheckFunc {
-		var dashboardID string
-
 		return resource.ComposeTestCheckFunc(
-			testAccCheckSentryDashboardExists(name, &dashboardID),
 			resource.TestCheckResourceAttr(name, "organization", acctest.TestOrganization),
 			resource.TestCheckResourceAttr(name, "title", dashboardTitle),
 			resource.TestCheckResourceAttr(name, "widget.#", "1"),
-			resource.TestCheckTypeSetElemNestedAttrs(name, "widget.*", map[string]string{
-				"title":        "Custom Widget",
-				"display_type": "table",
-			}),
+			resource.TestCheckResourceAttr(name, "widget.0.title", "Custom Widget"),
+			resource.TestCheckResourceAttr(name, "widget.0.display_type", "table"),
 			resource.TestCheckResourceAttr(name, "widget.0.query.#", "1"),
-			resource.TestCheckTypeSetElemNestedAttrs(name, "widget.0.query.*", map[string]string{
-				"name":       "Metric",
-				"conditions": "!event.type:transaction has:geo.country_code",
-			}),
+			resource.TestCheckResourceAttr(name, "widget.0.query.0.name", "Metric"),
+			resource.TestCheckResourceAttr(name, "widget.0.query.0.conditions", "!event.type:transaction has:geo.country_code"),
 			resource.TestCheckResourceAttr(name, "widget.0.query.0.fields.#", "3"),
 			resource.TestCheckResourceAttr(name, "widget.0.query.0.fields.0", "geo.country_code"),
 			resource.TestCheckResourceAttr(name, "widget.0.query.0.fields.1", "geo.region"),
@@ -38,14 +31,11 @@ func TestAccSentryDashboardDataSource_basic(t *testing.T) {
 			resource.TestCheckResourceAttr(name, "widget.0.query.0.aggregates.#", "1"),
 			resource.TestCheckResourceAttr(name, "widget.0.query.0.aggregates.0", "count()"),
 			resource.TestCheckResourceAttr(name, "widget.0.layout.#", "1"),
-			resource.TestCheckTypeSetElemNestedAttrs(name, "widget.0.layout.*", map[string]string{
-				"x":     "0",
-				"y":     "0",
-				"w":     "2",
-				"h":     "1",
-				"min_h": "1",
-			}),
-			resource.TestCheckResourceAttrPtr(name, "internal_id", &dashboardID),
+			resource.TestCheckResourceAttr(name, "widget.0.layout.0.x", "0"),
+			resource.TestCheckResourceAttr(name, "widget.0.layout.0.y", "0"),
+			resource.TestCheckResourceAttr(name, "widget.0.layout.0.w", "2"),
+			resource.TestCheckResourceAttr(name, "widget.0.layout.0.h", "1"),
+			resource.TestCheckResourceAttr(name, "widget.0.layout.0.min_h", "1"),
 		)
 	}
 
@@ -56,9 +46,12 @@ func TestAccSentryDashboardDataSource_basic(t *testing.T) {
 			{
 				Config: testAccSentryDashboardDataSourceConfig(dashboardTitle),
 				Check: resource.ComposeTestCheckFunc(
-					check(rn, dashboardTitle),
 					check(dn, dashboardTitle),
-					check(rnCopy, dashboardTitle+"-copy"),
+					resource.TestCheckResourceAttrPair(dn, "internal_id", rn, "id"),
+					resource.TestCheckResourceAttr(rnCopy, "title", dashboardTitle+"-copy"),
+					resource.TestCheckResourceAttr(rnCopy, "widgets.#", "1"),
+					resource.TestCheckResourceAttr(rnCopy, "widgets.0.title", "Custom Widget"),
+					resource.TestCheckResourceAttr(rnCopy, "widgets.0.queries.0.fields.#", "3"),
 				),
 			},
 		},
@@ -71,69 +64,68 @@ resource "sentry_dashboard" "test" {
 	organization = data.sentry_organization.test.slug
 	title        = "%[1]s"
 
-	widget {
-		title        = "Custom Widget"
-		display_type = "table"
-
-		query {
-			name       = "Metric"
-
-			fields     = ["geo.country_code", "geo.region", "count()"]
-			aggregates = ["count()"]
-			conditions = "!event.type:transaction has:geo.country_code"
-		}
-
-		layout {
-			x     = 0
-			y     = 0
-			w     = 2
-			h     = 1
-			min_h = 1
-		}
-	}
+	widgets = [
+		{
+			title        = "Custom Widget"
+			display_type = "table"
+
+			queries = [
+				{
+					name       = "Metric"
+					fields     = ["geo.country_code", "geo.region", "count()"]
+					aggregates = ["count()"]
+					conditions = "!event.type:transaction has:geo.country_code"
+				},
+			]
+
+			layout = {
+				x     = 0
+				y     = 0
+				w     = 2
+				h     = 1
+				min_h = 1
+			}
+		},
+	]
 }
 
 data "sentry_dashboard" "test" {
 	organization = sentry_dashboard.test.organization
-	internal_id  = sentry_dashboard.test.internal_id
+	internal_id  = sentry_dashboard.test.id
 }
 
 resource "sentry_dashboard" "test_copy" {
 	organization = data.sentry_dashboard.test.organization
 	title        = "${data.sentry_dashboard.test.title}-copy"
 
-	dynamic "widget" {
-		for_each = data.sentry_dashboard.test.widget
-		content {
-			title        = widget.value.title
-			display_type = widget.value.display_type
-			interval     = widget.value.interval
-			widget_type  = widget.value.widget_type
-			limit        = widget.value.limit
-
-			dynamic "query" {
-				for_each = widget.value.query
-				content {
-					name = query.value.name
-
-					fields        = query.value.fields
-					aggregates    = query.value.aggregates
-					columns       = query.value.columns
-					field_aliases = query.value.field_aliases
-					conditions    = query.value.conditions
-					order_by      = query.value.order_by
+	widgets = [
+		for widget in data.sentry_dashboard.test.widget : {
+			title        = widget.title
+			display_type = widget.display_type
+			interval     = widget.interval
+			widget_type  = widget.widget_type
+
+			queries = [
+				for query in widget.query : {
+					name          = query.name
+					fields        = query.fields
+					aggregates    = query.aggregates
+					columns       = query.columns
+					field_aliases = query.field_aliases
+					conditions    = query.conditions
+					order_by      = query.order_by
 				}
-			}
-
-			layout {
-				x     = widget.value.layout[0].x
-				y     = widget.value.layout[0].y
-				w     = widget.value.layout[0].w
-				h     = widget.value.layout[0].h
-				min_h = widget.value.layout[0].min_h
+			]
+
+			layout = {
+				x     = widget.layout[0].x
+				y     = widget.layout[0].y
+				w     = widget.layout[0].w
+				h     = widget.layout[0].h
+				min_h = widget.layout[0].min_h
 			}
 		}
-	}
+	]
 }
 	`, dashboardTitle)
 }